@@ -6,12 +6,13 @@ package cmd
 import (
 	"log"
 	"net"
-	"net/http"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/internal/common/approval"
 	"github.com/terrariumcloud/terrarium/internal/common/gateway"
 	grpcServices "github.com/terrariumcloud/terrarium/internal/common/grpc_service"
+	"github.com/terrariumcloud/terrarium/internal/common/identity"
 	"github.com/terrariumcloud/terrarium/internal/module/services/dependency_manager"
 	"github.com/terrariumcloud/terrarium/internal/module/services/registrar"
 	storage2 "github.com/terrariumcloud/terrarium/internal/module/services/storage"
@@ -21,9 +22,13 @@ import (
 	providerVersionManager "github.com/terrariumcloud/terrarium/internal/provider/services/version_manager"
 	"github.com/terrariumcloud/terrarium/internal/release/services/release"
 	"github.com/terrariumcloud/terrarium/internal/restapi/browse"
+	"github.com/terrariumcloud/terrarium/internal/restapi/discovery"
 	modulesv1 "github.com/terrariumcloud/terrarium/internal/restapi/modules/v1"
+	"github.com/terrariumcloud/terrarium/internal/restapi/providers/mirror"
 	providersv1 "github.com/terrariumcloud/terrarium/internal/restapi/providers/v1"
+	"github.com/terrariumcloud/terrarium/internal/search/services/indexer"
 	"github.com/terrariumcloud/terrarium/internal/storage"
+	"github.com/terrariumcloud/terrarium/internal/usage/services/dependency_tracker"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
@@ -34,13 +39,10 @@ const (
 	allInOneHTTPEndpoint        = "0.0.0.0:8080"
 )
 
-type allInOneRestHandler struct {
-	router *mux.Router
-}
-
-func (a allInOneRestHandler) GetHttpHandler(mountPath string) http.Handler {
-	return a.router
-}
+var (
+	allInOneModulesV1URL   string
+	allInOneProvidersV1URL string
+)
 
 // allInOneCmd represents the allInOne command
 var allInOneCmd = &cobra.Command{
@@ -48,49 +50,86 @@ var allInOneCmd = &cobra.Command{
 	Short: "Runs all the services in a single command.",
 	Long:  `This runs all the micro-services as part of a single process, useful for developing and for trying out Terrarium.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		// The internal services are only called by the gateway and browse services of this process, which pass on the
+		// identity of the callers they accepted from the trusted proxies.
+		proxyTrust := newIdentityTrust()
+		internalTrust, err := identity.NewTrust(identity.Loopback)
+		if err != nil {
+			log.Fatalf("Invalid trusted proxies: %v", err)
+		}
+
 		dependencyServiceServer := &dependency_manager.DependencyManagerService{
-			Db:              storage.NewDynamoDbClient(awsSessionConfig),
-			ModuleTable:     dependency_manager.ModuleDependenciesTableName,
-			ModuleSchema:    dependency_manager.GetDependenciesSchema(dependency_manager.ModuleDependenciesTableName),
-			ContainerTable:  dependency_manager.ContainerDependenciesTableName,
-			ContainerSchema: dependency_manager.GetDependenciesSchema(dependency_manager.ContainerDependenciesTableName),
+			Db:                   storage.NewDynamoDbClient(awsSessionConfig),
+			ModuleTable:          dependency_manager.ModuleDependenciesTableName,
+			ModuleSchema:         dependency_manager.GetDependenciesSchema(dependency_manager.ModuleDependenciesTableName),
+			ContainerTable:       dependency_manager.ContainerDependenciesTableName,
+			ContainerSchema:      dependency_manager.GetDependenciesSchema(dependency_manager.ContainerDependenciesTableName),
+			DependentsTable:      dependency_manager.ModuleDependentsTableName,
+			DependentsSchema:     dependency_manager.GetDependentsSchema(dependency_manager.ModuleDependentsTableName),
+			ImagesTable:          dependency_manager.ContainerImagesTableName,
+			ImagesSchema:         dependency_manager.GetContainerImagesSchema(dependency_manager.ContainerImagesTableName),
+			ProvidersTable:       dependency_manager.ProviderDependentsTableName,
+			ProvidersSchema:      dependency_manager.GetProviderDependentsSchema(dependency_manager.ProviderDependentsTableName),
+			MaxConcurrentFetches: dependency_manager.MaxConcurrentFetches,
+			Registrar:            registrar.NewRegistrarGrpcClient(allInOneInternalEndpoint),
+			VersionManager:       version_manager.NewVersionManagerGrpcClient(allInOneInternalEndpoint),
 		}
 
 		registrarServiceServer := &registrar.RegistrarService{
-			Db:     storage.NewDynamoDbClient(awsSessionConfig),
-			Table:  registrar.RegistrarTableName,
-			Schema: registrar.GetModulesSchema(registrar.RegistrarTableName),
+			Db:      storage.NewDynamoDbClient(awsSessionConfig),
+			Table:   registrar.RegistrarTableName,
+			Schema:  registrar.GetModulesSchema(registrar.RegistrarTableName),
+			Indexer: indexer.NewIndexerGrpcClient(allInOneInternalEndpoint),
 		}
 
 		storageServiceServer := &storage2.StorageService{
-			Client:     storage.NewS3Client(awsSessionConfig),
-			BucketName: storage2.BucketName,
-			Region:     awsSessionConfig.Region,
+			Client:            storage.NewS3Client(awsSessionConfig),
+			BucketName:        storage2.BucketName,
+			Region:            awsSessionConfig.Region,
+			DependencyManager: dependency_manager.NewDependencyManagerGrpcClient(allInOneInternalEndpoint),
 		}
 
 		tagManagerServer := &tag_manager.TagManagerService{
-			Db:     storage.NewDynamoDbClient(awsSessionConfig),
-			Table:  tag_manager.TagTableName,
-			Schema: tag_manager.GetTagsSchema(tag_manager.TagTableName),
+			Db:          storage.NewDynamoDbClient(awsSessionConfig),
+			Table:       tag_manager.TagTableName,
+			Schema:      tag_manager.GetTagsSchema(tag_manager.TagTableName),
+			IndexTable:  tag_manager.TagIndexTableName,
+			IndexSchema: tag_manager.GetTagIndexSchema(tag_manager.TagIndexTableName),
+			Indexer:     indexer.NewIndexerGrpcClient(allInOneInternalEndpoint),
 		}
 
 		releaseServiceServer := &release.ReleaseService{
-			Db:     storage.NewDynamoDbClient(awsSessionConfig),
-			Table:  release.ReleaseTableName,
-			Schema: release.GetReleaseSchema(release.ReleaseTableName),
+			Db:                storage.NewDynamoDbClient(awsSessionConfig),
+			Table:             release.ReleaseTableName,
+			Schema:            release.GetReleaseSchema(release.ReleaseTableName),
+			DependencyTracker: dependency_tracker.NewDependencyTrackerGrpcClient(allInOneInternalEndpoint),
 		}
 
 		versionManagerServer := &version_manager.VersionManagerService{
-			Db:             storage.NewDynamoDbClient(awsSessionConfig),
-			Table:          version_manager.VersionsTableName,
-			Schema:         version_manager.GetModuleVersionsSchema(version_manager.VersionsTableName),
-			ReleaseService: release.NewPublisherGrpcClient(allInOneInternalEndpoint),
+			Db:                storage.NewDynamoDbClient(awsSessionConfig),
+			Table:             version_manager.VersionsTableName,
+			Schema:            version_manager.GetModuleVersionsSchema(version_manager.VersionsTableName),
+			ReleaseService:    release.NewPublisherGrpcClient(allInOneInternalEndpoint),
+			StorageService:    storage2.NewStorageGrpcClient(allInOneInternalEndpoint),
+			DraftTTL:          version_manager.DraftTTL,
+			ReaperInterval:    version_manager.DraftReaperInterval,
+			Approval:          approval.Policy{Organizations: version_manager.ApprovalOrganizations},
+			Indexer:           indexer.NewIndexerGrpcClient(allInOneInternalEndpoint),
+			DependencyManager: dependency_manager.NewDependencyManagerGrpcClient(allInOneInternalEndpoint),
+			Identity:          internalTrust,
 		}
 
 		providerVersionManagerServer := &providerVersionManager.VersionManagerService{
-			Db:     storage.NewDynamoDbClient(awsSessionConfig),
-			Table:  providerVersionManager.VersionsTableName,
-			Schema: providerVersionManager.GetProviderVersionsSchema(providerVersionManager.VersionsTableName),
+			Db:             storage.NewDynamoDbClient(awsSessionConfig),
+			Table:          providerVersionManager.VersionsTableName,
+			Schema:         providerVersionManager.GetProviderVersionsSchema(providerVersionManager.VersionsTableName),
+			ReleaseService: release.NewPublisherGrpcClient(allInOneInternalEndpoint),
+			StorageService: providerStorage.NewStorageGrpcClient(allInOneInternalEndpoint),
+			DraftTTL:       providerVersionManager.DraftTTL,
+			ReaperInterval: providerVersionManager.DraftReaperInterval,
+			Approval:       approval.Policy{Organizations: version_manager.ApprovalOrganizations},
+			Indexer:        indexer.NewIndexerGrpcClient(allInOneInternalEndpoint),
+			Identity:       internalTrust,
 		}
 
 		providerStorageServiceServer := &providerStorage.StorageService{
@@ -99,6 +138,29 @@ var allInOneCmd = &cobra.Command{
 			Region:     awsSessionConfig.Region,
 		}
 
+		dependencyTrackerServer := &dependency_tracker.DependencyTrackerService{
+			Db:                      storage.NewDynamoDbClient(awsSessionConfig),
+			UnitTable:               dependency_tracker.DeploymentUnitsTableName,
+			UnitSchema:              dependency_tracker.GetDeploymentUnitsSchema(dependency_tracker.DeploymentUnitsTableName),
+			UsageTable:              dependency_tracker.UsageTableName,
+			UsageSchema:             dependency_tracker.GetUsageSchema(dependency_tracker.UsageTableName),
+			DeliveryTable:           dependency_tracker.DeliveriesTableName,
+			DeliverySchema:          dependency_tracker.GetDeliveriesSchema(dependency_tracker.DeliveriesTableName),
+			MaxDeliveryAttempts:     dependency_tracker.MaxDeliveryAttempts,
+			RetryDelay:              dependency_tracker.DeliveryRetryDelay,
+			DeliveryTimeout:         dependency_tracker.DeliveryTimeout,
+			MaxConcurrentDeliveries: dependency_tracker.MaxConcurrentDeliveries,
+		}
+
+		indexerServer := &indexer.IndexerService{
+			Db:              storage.NewDynamoDbClient(awsSessionConfig),
+			DocumentsTable:  indexer.DocumentsTableName,
+			DocumentsSchema: indexer.GetDocumentsSchema(indexer.DocumentsTableName),
+			TermsTable:      indexer.TermsTableName,
+			TermsSchema:     indexer.GetTermsSchema(indexer.TermsTableName),
+			Storage:         storage2.NewStorageGrpcClient(allInOneInternalEndpoint),
+		}
+
 		services := []grpcServices.Service{
 			dependencyServiceServer,
 			registrarServiceServer,
@@ -108,12 +170,15 @@ var allInOneCmd = &cobra.Command{
 			versionManagerServer,
 			providerVersionManagerServer,
 			providerStorageServiceServer,
+			dependencyTrackerServer,
+			indexerServer,
 		}
 
 		otelShutdown := initOpenTelemetry("all-in-one")
 		defer otelShutdown()
 
-		startAllInOneGrpcServices(services, allInOneInternalEndpoint)
+		stopInternalServices := startAllInOneGrpcServices(services, allInOneInternalEndpoint)
+		defer stopInternalServices()
 
 		gatewayServer := gateway.New(registrar.NewRegistrarGrpcClient(allInOneInternalEndpoint),
 			tag_manager.NewTagManagerGrpcClient(allInOneInternalEndpoint),
@@ -123,25 +188,46 @@ var allInOneCmd = &cobra.Command{
 			release.NewPublisherGrpcClient(allInOneInternalEndpoint),
 			providerVersionManager.NewVersionManagerGrpcClient(allInOneInternalEndpoint),
 			providerStorage.NewStorageGrpcClient(allInOneInternalEndpoint),
+			dependency_tracker.NewDependencyTrackerGrpcClient(allInOneInternalEndpoint),
 		)
+		gatewayServer.Identity = proxyTrust
 
-		startAllInOneGrpcServices([]grpcServices.Service{gatewayServer}, allInOneGrpcGatewayEndpoint)
+		stopGateway := startAllInOneGrpcServices([]grpcServices.Service{gatewayServer}, allInOneGrpcGatewayEndpoint)
+		defer stopGateway()
 
 		restAPIServer := browse.New(registrar.NewRegistrarGrpcClient(allInOneInternalEndpoint),
 			version_manager.NewVersionManagerGrpcClient(allInOneInternalEndpoint),
 			release.NewBrowseGrpcClient(allInOneInternalEndpoint),
-			providerVersionManager.NewVersionManagerGrpcClient(allInOneInternalEndpoint))
+			providerVersionManager.NewVersionManagerGrpcClient(allInOneInternalEndpoint),
+			dependency_manager.NewDependencyManagerGrpcClient(allInOneInternalEndpoint),
+			dependency_tracker.NewDependencyTrackerGrpcClient(allInOneInternalEndpoint),
+			indexer.NewIndexerGrpcClient(allInOneInternalEndpoint),
+			storage2.NewStorageGrpcClient(allInOneInternalEndpoint),
+			tag_manager.NewTagManagerGrpcClient(allInOneInternalEndpoint))
+		restAPIServer.Identity = proxyTrust
+
+		upstreamRegistry := newUpstreamRegistry()
+		modulesAPIServer := modulesv1.New(registrar.NewRegistrarGrpcClient(allInOneInternalEndpoint), version_manager.NewVersionManagerGrpcClient(allInOneInternalEndpoint), storage2.NewStorageGrpcClient(allInOneInternalEndpoint), upstreamRegistry)
+		providersAPIServer := providersv1.New(providerVersionManager.NewVersionManagerGrpcClient(allInOneInternalEndpoint), providerStorage.NewStorageGrpcClient(allInOneInternalEndpoint), upstreamRegistry)
+		providersMirrorServer := mirror.New(providerVersionManager.NewVersionManagerGrpcClient(allInOneInternalEndpoint), providerStorage.NewStorageGrpcClient(allInOneInternalEndpoint))
 
-		modulesAPIServer := modulesv1.New(version_manager.NewVersionManagerGrpcClient(allInOneInternalEndpoint), storage2.NewStorageGrpcClient(allInOneInternalEndpoint))
-		providersAPIServer := providersv1.New(providerVersionManager.NewVersionManagerGrpcClient(allInOneInternalEndpoint), providerStorage.NewStorageGrpcClient(allInOneInternalEndpoint))
+		advertised := discovery.Services{ModulesV1: modulesv1.BasePath("/modules"), ProvidersV1: providersv1.BasePath("/providers")}
+		if allInOneModulesV1URL != "" {
+			advertised.ModulesV1 = allInOneModulesV1URL
+		}
+		if allInOneProvidersV1URL != "" {
+			advertised.ProvidersV1 = allInOneProvidersV1URL
+		}
 
 		router := mux.NewRouter()
+		router.Handle(discovery.Path, discovery.New(advertised).GetHttpHandler(""))
 		router.PathPrefix("/modules").Handler(modulesAPIServer.GetHttpHandler("/modules"))
+		router.PathPrefix(mirror.BasePath("/providers")).Handler(providersMirrorServer.GetHttpHandler("/providers"))
 		router.PathPrefix("/providers").Handler(providersAPIServer.GetHttpHandler("/providers"))
 		router.PathPrefix("/").Handler(restAPIServer.GetHttpHandler(""))
 
 		endpoint = allInOneHTTPEndpoint
-		startRESTAPIService("browse", "", allInOneRestHandler{router: router})
+		startRESTAPIService("browse", "", routerRestHandler{router: router})
 	},
 }
 
@@ -150,15 +236,44 @@ func init() {
 	allInOneCmd.Flags().StringVar(&storage2.BucketName, "storage-bucket", storage2.DefaultBucketName, "Module bucket name")
 	allInOneCmd.Flags().StringVar(&version_manager.VersionsTableName, "version-table", version_manager.DefaultVersionsTableName, "Module versions table name")
 	allInOneCmd.Flags().StringVar(&tag_manager.TagTableName, "tag-table", tag_manager.DefaultTagTableName, "Module tags table name")
+	allInOneCmd.Flags().StringVar(&tag_manager.TagIndexTableName, "tag-index-table", tag_manager.DefaultTagIndexTableName, "Module tag index table name")
+	allInOneCmd.Flags().StringVar(&storage2.RegistryHostname, "registry-host", "", "Host modules of this registry are sourced from, used to detect module dependencies in uploaded archives")
+	allInOneCmd.Flags().Int64Var(&storage2.MaxSourceFileSize, "max-source-file-size", storage2.DefaultMaxSourceFileSize, "Size in bytes of the largest source file displayed when browsing modules")
 	allInOneCmd.Flags().StringVar(&release.ReleaseTableName, "release-table", release.DefaultReleaseTableName, "Releases table name")
 	allInOneCmd.Flags().StringVar(&registrar.RegistrarTableName, "registrar-table", registrar.DefaultRegistrarTableName, "Module Registrar table name")
 	allInOneCmd.Flags().StringVar(&dependency_manager.ModuleDependenciesTableName, "module-dependencies-table", dependency_manager.DefaultModuleDependenciesTableName, "Module dependencies table name")
 	allInOneCmd.Flags().StringVar(&dependency_manager.ContainerDependenciesTableName, "container-dependencies-table", dependency_manager.DefaultContainerDependenciesTableName, "Module container dependencies table name")
+	allInOneCmd.Flags().StringVar(&dependency_manager.ModuleDependentsTableName, "module-dependents-table", dependency_manager.DefaultModuleDependentsTableName, "Module dependents table name")
+	allInOneCmd.Flags().StringVar(&dependency_manager.ContainerImagesTableName, "container-images-table", dependency_manager.DefaultContainerImagesTableName, "Container image index table name")
+	allInOneCmd.Flags().StringVar(&dependency_manager.ProviderDependentsTableName, "provider-dependents-table", dependency_manager.DefaultProviderDependentsTableName, "Provider dependents table name")
+	allInOneCmd.Flags().StringVar(&allInOneModulesV1URL, "modules-v1-url", "", "Base URL of the modules.v1 registry protocol advertised for service discovery, defaults to the path it is served at")
+	allInOneCmd.Flags().StringVar(&allInOneProvidersV1URL, "providers-v1-url", "", "Base URL of the providers.v1 registry protocol advertised for service discovery, defaults to the path it is served at")
+	allInOneCmd.Flags().StringVar(&dependency_tracker.DeploymentUnitsTableName, "deployment-unit-table", dependency_tracker.DefaultDeploymentUnitsTableName, "Deployment units table name")
+	allInOneCmd.Flags().StringVar(&dependency_tracker.UsageTableName, "usage-table", dependency_tracker.DefaultUsageTableName, "Usage table name")
+	allInOneCmd.Flags().StringVar(&dependency_tracker.DeliveriesTableName, "delivery-table", dependency_tracker.DefaultDeliveriesTableName, "Notification delivery log table name")
+	allInOneCmd.Flags().StringVar(&dependency_tracker.SMTPServerAddress, "smtp-server", dependency_tracker.DefaultSMTPServerAddress, "SMTP server used to send email notifications")
+	allInOneCmd.Flags().StringVar(&dependency_tracker.NotificationSender, "smtp-from", dependency_tracker.DefaultNotificationSender, "Sender address of email notifications")
+	allInOneCmd.Flags().IntVar(&dependency_tracker.MaxDeliveryAttempts, "max-delivery-attempts", dependency_tracker.DefaultMaxDeliveryAttempts, "Number of attempts to deliver a release notification")
+	allInOneCmd.Flags().DurationVar(&dependency_tracker.DeliveryRetryDelay, "delivery-retry-delay", dependency_tracker.DefaultDeliveryRetryDelay, "Delay before retrying a release notification, doubled after every attempt")
+	allInOneCmd.Flags().DurationVar(&dependency_tracker.DeliveryTimeout, "delivery-timeout", dependency_tracker.DefaultDeliveryTimeout, "Time allowed to deliver the notifications of a release")
+	allInOneCmd.Flags().IntVar(&dependency_tracker.MaxConcurrentDeliveries, "max-concurrent-deliveries", dependency_tracker.DefaultMaxConcurrentDeliveries, "Number of release notifications delivered at once")
+	allInOneCmd.Flags().DurationVar(&dependency_tracker.NotificationTimeout, "notification-timeout", dependency_tracker.DefaultNotificationTimeout, "Time allowed for a single attempt to deliver a release notification")
 	allInOneCmd.Flags().StringVar(&providerVersionManager.VersionsTableName, "provider-table", providerVersionManager.DefaultProviderVersionsTableName, "Provider versions table name")
 	allInOneCmd.Flags().StringVar(&providerStorage.BucketName, "provider-storage-bucket", providerStorage.DefaultBucketName, "Provider bucket name")
+	allInOneCmd.Flags().DurationVar(&version_manager.DraftTTL, "draft-ttl", version_manager.DefaultDraftTTL, "Age after which unpublished module versions are removed (0 disables the reaper)")
+	allInOneCmd.Flags().DurationVar(&providerVersionManager.DraftTTL, "provider-draft-ttl", providerVersionManager.DefaultDraftTTL, "Age after which unpublished provider versions are removed (0 disables the reaper)")
+	allInOneCmd.Flags().DurationVar(&version_manager.DraftReaperInterval, "draft-reaper-interval", version_manager.DefaultDraftReaperInterval, "How often to look for abandoned module versions")
+	allInOneCmd.Flags().DurationVar(&providerVersionManager.DraftReaperInterval, "provider-draft-reaper-interval", providerVersionManager.DefaultDraftReaperInterval, "How often to look for abandoned provider versions")
+	allInOneCmd.Flags().StringVar(&providerVersionManager.BrowseUrl, "browse-url", "", "Base URL of the Terrarium UI used to link provider releases to their documentation")
+	allInOneCmd.Flags().StringVar(&indexer.DocumentsTableName, "search-documents-table", indexer.DefaultDocumentsTableName, "Search documents table name")
+	allInOneCmd.Flags().StringVar(&indexer.TermsTableName, "search-terms-table", indexer.DefaultTermsTableName, "Search terms table name")
+	addUpstreamFlags(allInOneCmd)
+	addTrustedProxiesFlag(allInOneCmd, "Addresses or CIDR ranges of the proxies trusted to pass on the identity of callers")
+	allInOneCmd.Flags().StringSliceVar(&version_manager.ApprovalOrganizations, "require-approval", nil, "Organizations whose module and provider versions must be approved before they are published")
 }
 
-func startAllInOneGrpcServices(services []grpcServices.Service, endpoint string) {
+// startAllInOneGrpcServices serves services at endpoint in the background, and returns the function stopping them.
+func startAllInOneGrpcServices(services []grpcServices.Service, endpoint string) func() {
 	listener, err := net.Listen("tcp4", endpoint)
 	if err != nil {
 		log.Fatalf("Failed to start: %v", err)
@@ -181,4 +296,9 @@ func startAllInOneGrpcServices(services []grpcServices.Service, endpoint string)
 			log.Fatalf("Failed: %v", err)
 		}
 	}()
+
+	return func() {
+		grpcServer.GracefulStop()
+		closeServices(services)
+	}
 }
@@ -1,13 +1,27 @@
 package cmd
 
 import (
+	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 
+	"github.com/terrariumcloud/terrarium/internal/module/services/dependency_manager"
 	"github.com/terrariumcloud/terrarium/internal/module/services/registrar"
+	moduleStorage "github.com/terrariumcloud/terrarium/internal/module/services/storage"
+	"github.com/terrariumcloud/terrarium/internal/module/services/tag_manager"
 	"github.com/terrariumcloud/terrarium/internal/module/services/version_manager"
 	providerVersionManager "github.com/terrariumcloud/terrarium/internal/provider/services/version_manager"
 	"github.com/terrariumcloud/terrarium/internal/release/services/release"
 	"github.com/terrariumcloud/terrarium/internal/restapi/browse"
+	"github.com/terrariumcloud/terrarium/internal/restapi/discovery"
+	modulesv1 "github.com/terrariumcloud/terrarium/internal/restapi/modules/v1"
+	providersv1 "github.com/terrariumcloud/terrarium/internal/restapi/providers/v1"
+	"github.com/terrariumcloud/terrarium/internal/search/services/indexer"
+	"github.com/terrariumcloud/terrarium/internal/usage/services/dependency_tracker"
+)
+
+var (
+	browseModulesV1URL   string
+	browseProvidersV1URL string
 )
 
 var browseCmd = &cobra.Command{
@@ -22,6 +36,14 @@ func init() {
 	browseCmd.Flags().StringVarP(&version_manager.VersionManagerEndpoint, "version-manager", "", version_manager.DefaultVersionManagerEndpoint, "GRPC Endpoint for Module Version Manager Service")
 	browseCmd.Flags().StringVarP(&release.ReleaseServiceEndpoint, "release", "", release.DefaultReleaseServiceEndpoint, "GRPC Endpoint for Release Service")
 	browseCmd.Flags().StringVarP(&providerVersionManager.VersionManagerEndpoint, "provider-version-manager", "", providerVersionManager.DefaultProviderVersionManagerEndpoint, "GRPC Endpoint for Provider Version Manager Service")
+	browseCmd.Flags().StringVarP(&dependency_manager.DependencyManagerEndpoint, "dependency-manager", "", dependency_manager.DefaultDependencyManagerEndpoint, "GRPC Endpoint for Dependency Manager Service")
+	browseCmd.Flags().StringVarP(&dependency_tracker.DependencyTrackerEndpoint, "dependency-tracker", "", dependency_tracker.DefaultDependencyTrackerEndpoint, "GRPC Endpoint for Dependency Tracker Service")
+	browseCmd.Flags().StringVarP(&moduleStorage.StorageServiceEndpoint, "storage", "", moduleStorage.DefaultStorageServiceDefaultEndpoint, "GRPC Endpoint for Module Storage Service")
+	browseCmd.Flags().StringVarP(&tag_manager.TagManagerEndpoint, "tag-manager", "", tag_manager.DefaultTagManagerEndpoint, "GRPC Endpoint for Tag Service")
+	browseCmd.Flags().StringVarP(&indexer.IndexerEndpoint, "indexer", "", indexer.DefaultIndexerEndpoint, "GRPC Endpoint for Search Indexer Service")
+	browseCmd.Flags().StringVar(&browseModulesV1URL, "modules-v1-url", modulesv1.BasePath("modules"), "Base URL of the modules.v1 registry protocol advertised for service discovery")
+	browseCmd.Flags().StringVar(&browseProvidersV1URL, "providers-v1-url", providersv1.BasePath("providers"), "Base URL of the providers.v1 registry protocol advertised for service discovery")
+	addTrustedProxiesFlag(browseCmd, "Addresses or CIDR ranges of the proxies trusted to pass on the identity of callers")
 	rootCmd.AddCommand(browseCmd)
 }
 
@@ -29,6 +51,17 @@ func runBrowseServer(cmd *cobra.Command, args []string) {
 	restAPIServer := browse.New(registrar.NewRegistrarGrpcClient(registrar.RegistrarServiceEndpoint),
 		version_manager.NewVersionManagerGrpcClient(version_manager.VersionManagerEndpoint),
 		release.NewBrowseGrpcClient(release.ReleaseServiceEndpoint),
-		providerVersionManager.NewVersionManagerGrpcClient(providerVersionManager.VersionManagerEndpoint))
-	startRESTAPIService("browse", "", restAPIServer)
+		providerVersionManager.NewVersionManagerGrpcClient(providerVersionManager.VersionManagerEndpoint),
+		dependency_manager.NewDependencyManagerGrpcClient(dependency_manager.DependencyManagerEndpoint),
+		dependency_tracker.NewDependencyTrackerGrpcClient(dependency_tracker.DependencyTrackerEndpoint),
+		indexer.NewIndexerGrpcClient(indexer.IndexerEndpoint),
+		moduleStorage.NewStorageGrpcClient(moduleStorage.StorageServiceEndpoint),
+		tag_manager.NewTagManagerGrpcClient(tag_manager.TagManagerEndpoint))
+	restAPIServer.Identity = newIdentityTrust()
+	discoveryServer := discovery.New(discovery.Services{ModulesV1: browseModulesV1URL, ProvidersV1: browseProvidersV1URL})
+
+	router := mux.NewRouter()
+	router.Handle(discovery.Path, discoveryServer.GetHttpHandler(""))
+	router.PathPrefix("/").Handler(restAPIServer.GetHttpHandler(""))
+	startRESTAPIService("browse", "", routerRestHandler{router: router})
 }
@@ -2,11 +2,19 @@ package cmd
 
 import (
 	"github.com/terrariumcloud/terrarium/internal/module/services/dependency_manager"
+	"github.com/terrariumcloud/terrarium/internal/module/services/registrar"
+	"github.com/terrariumcloud/terrarium/internal/module/services/version_manager"
 	"github.com/terrariumcloud/terrarium/internal/storage"
 
 	"github.com/spf13/cobra"
 )
 
+// Optional endpoints used to annotate dependency graphs with maturity and to resolve version constraints.
+var (
+	dependencyManagerRegistrarEndpoint      string
+	dependencyManagerVersionManagerEndpoint string
+)
+
 var dependencyManagerCmd = &cobra.Command{
 	Use:   "dependency-manager",
 	Short: "Starts the Terrarium GRPC Dependency Manager service",
@@ -18,16 +26,37 @@ func init() {
 	rootCmd.AddCommand(dependencyManagerCmd)
 	dependencyManagerCmd.Flags().StringVarP(&dependency_manager.ModuleDependenciesTableName, "module-table", "m", dependency_manager.DefaultModuleDependenciesTableName, "Module dependencies table name")
 	dependencyManagerCmd.Flags().StringVarP(&dependency_manager.ContainerDependenciesTableName, "container-table", "c", dependency_manager.DefaultContainerDependenciesTableName, "Module dependencies table name")
+	dependencyManagerCmd.Flags().StringVar(&dependency_manager.ModuleDependentsTableName, "dependents-table", dependency_manager.DefaultModuleDependentsTableName, "Module dependents table name")
+	dependencyManagerCmd.Flags().StringVar(&dependency_manager.ContainerImagesTableName, "images-table", dependency_manager.DefaultContainerImagesTableName, "Container image index table name")
+	dependencyManagerCmd.Flags().StringVar(&dependency_manager.ProviderDependentsTableName, "provider-dependents-table", dependency_manager.DefaultProviderDependentsTableName, "Provider dependents table name")
+	dependencyManagerCmd.Flags().StringVar(&dependencyManagerRegistrarEndpoint, "registrar", "", "GRPC Endpoint for Registrar Service looking up module maturity in dependency graphs (disabled when empty)")
+	dependencyManagerCmd.Flags().StringVar(&dependencyManagerVersionManagerEndpoint, "version-manager", "", "GRPC Endpoint for Version Manager Service looking up version maturity in dependency graphs and resolving version constraints (disabled when empty)")
+	dependencyManagerCmd.Flags().IntVar(&dependency_manager.MaxConcurrentFetches, "max-concurrent-fetches", dependency_manager.DefaultMaxConcurrentFetches, "Maximum number of modules fetched at once while walking a dependency graph")
 }
 
 func runDependencyManager(cmd *cobra.Command, args []string) {
 
 	dependencyServiceServer := &dependency_manager.DependencyManagerService{
-		Db:              storage.NewDynamoDbClient(awsSessionConfig),
-		ModuleTable:     dependency_manager.ModuleDependenciesTableName,
-		ModuleSchema:    dependency_manager.GetDependenciesSchema(dependency_manager.ModuleDependenciesTableName),
-		ContainerTable:  dependency_manager.ContainerDependenciesTableName,
-		ContainerSchema: dependency_manager.GetDependenciesSchema(dependency_manager.ContainerDependenciesTableName),
+		Db:                   storage.NewDynamoDbClient(awsSessionConfig),
+		ModuleTable:          dependency_manager.ModuleDependenciesTableName,
+		ModuleSchema:         dependency_manager.GetDependenciesSchema(dependency_manager.ModuleDependenciesTableName),
+		ContainerTable:       dependency_manager.ContainerDependenciesTableName,
+		ContainerSchema:      dependency_manager.GetDependenciesSchema(dependency_manager.ContainerDependenciesTableName),
+		DependentsTable:      dependency_manager.ModuleDependentsTableName,
+		DependentsSchema:     dependency_manager.GetDependentsSchema(dependency_manager.ModuleDependentsTableName),
+		ImagesTable:          dependency_manager.ContainerImagesTableName,
+		ImagesSchema:         dependency_manager.GetContainerImagesSchema(dependency_manager.ContainerImagesTableName),
+		ProvidersTable:       dependency_manager.ProviderDependentsTableName,
+		ProvidersSchema:      dependency_manager.GetProviderDependentsSchema(dependency_manager.ProviderDependentsTableName),
+		MaxConcurrentFetches: dependency_manager.MaxConcurrentFetches,
+	}
+
+	if dependencyManagerRegistrarEndpoint != "" {
+		dependencyServiceServer.Registrar = registrar.NewRegistrarGrpcClient(dependencyManagerRegistrarEndpoint)
+	}
+
+	if dependencyManagerVersionManagerEndpoint != "" {
+		dependencyServiceServer.VersionManager = version_manager.NewVersionManagerGrpcClient(dependencyManagerVersionManagerEndpoint)
 	}
 
 	startGRPCService("dependency-manager", dependencyServiceServer)
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"github.com/terrariumcloud/terrarium/internal/storage"
+	"github.com/terrariumcloud/terrarium/internal/usage/services/dependency_tracker"
+
+	"github.com/spf13/cobra"
+)
+
+var dependencyTrackerCmd = &cobra.Command{
+	Use:   "dependency-tracker",
+	Short: "Starts the Terrarium GRPC Dependency Tracker service",
+	Long:  "Runs the Terrarium GRPC Dependency Tracker server, recording which deployment units use which modules and providers.",
+	Run:   runDependencyTracker,
+}
+
+func init() {
+	rootCmd.AddCommand(dependencyTrackerCmd)
+	dependencyTrackerCmd.Flags().StringVar(&dependency_tracker.DeploymentUnitsTableName, "unit-table", dependency_tracker.DefaultDeploymentUnitsTableName, "Deployment units table name")
+	dependencyTrackerCmd.Flags().StringVar(&dependency_tracker.UsageTableName, "usage-table", dependency_tracker.DefaultUsageTableName, "Usage table name")
+	dependencyTrackerCmd.Flags().StringVar(&dependency_tracker.DeliveriesTableName, "delivery-table", dependency_tracker.DefaultDeliveriesTableName, "Notification delivery log table name")
+	dependencyTrackerCmd.Flags().StringVar(&dependency_tracker.SMTPServerAddress, "smtp-server", dependency_tracker.DefaultSMTPServerAddress, "SMTP server used to send email notifications")
+	dependencyTrackerCmd.Flags().StringVar(&dependency_tracker.NotificationSender, "smtp-from", dependency_tracker.DefaultNotificationSender, "Sender address of email notifications")
+	dependencyTrackerCmd.Flags().IntVar(&dependency_tracker.MaxDeliveryAttempts, "max-delivery-attempts", dependency_tracker.DefaultMaxDeliveryAttempts, "Number of attempts to deliver a release notification")
+	dependencyTrackerCmd.Flags().DurationVar(&dependency_tracker.DeliveryRetryDelay, "delivery-retry-delay", dependency_tracker.DefaultDeliveryRetryDelay, "Delay before retrying a release notification, doubled after every attempt")
+	dependencyTrackerCmd.Flags().DurationVar(&dependency_tracker.DeliveryTimeout, "delivery-timeout", dependency_tracker.DefaultDeliveryTimeout, "Time allowed to deliver the notifications of a release")
+	dependencyTrackerCmd.Flags().IntVar(&dependency_tracker.MaxConcurrentDeliveries, "max-concurrent-deliveries", dependency_tracker.DefaultMaxConcurrentDeliveries, "Number of release notifications delivered at once")
+	dependencyTrackerCmd.Flags().DurationVar(&dependency_tracker.NotificationTimeout, "notification-timeout", dependency_tracker.DefaultNotificationTimeout, "Time allowed for a single attempt to deliver a release notification")
+}
+
+func runDependencyTracker(cmd *cobra.Command, args []string) {
+
+	dependencyTrackerServer := &dependency_tracker.DependencyTrackerService{
+		Db:                      storage.NewDynamoDbClient(awsSessionConfig),
+		UnitTable:               dependency_tracker.DeploymentUnitsTableName,
+		UnitSchema:              dependency_tracker.GetDeploymentUnitsSchema(dependency_tracker.DeploymentUnitsTableName),
+		UsageTable:              dependency_tracker.UsageTableName,
+		UsageSchema:             dependency_tracker.GetUsageSchema(dependency_tracker.UsageTableName),
+		DeliveryTable:           dependency_tracker.DeliveriesTableName,
+		DeliverySchema:          dependency_tracker.GetDeliveriesSchema(dependency_tracker.DeliveriesTableName),
+		MaxDeliveryAttempts:     dependency_tracker.MaxDeliveryAttempts,
+		RetryDelay:              dependency_tracker.DeliveryRetryDelay,
+		DeliveryTimeout:         dependency_tracker.DeliveryTimeout,
+		MaxConcurrentDeliveries: dependency_tracker.MaxConcurrentDeliveries,
+	}
+
+	startGRPCService("dependency-tracker", dependencyTrackerServer)
+}
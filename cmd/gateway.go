@@ -10,6 +10,7 @@ import (
 	providerStorage "github.com/terrariumcloud/terrarium/internal/provider/services/storage"
 	providerVersionManager "github.com/terrariumcloud/terrarium/internal/provider/services/version_manager"
 	"github.com/terrariumcloud/terrarium/internal/release/services/release"
+	"github.com/terrariumcloud/terrarium/internal/usage/services/dependency_tracker"
 
 	"github.com/spf13/cobra"
 )
@@ -31,6 +32,8 @@ func init() {
 	gatewayCmd.Flags().StringVarP(&release.ReleaseServiceEndpoint, "release", "", release.DefaultReleaseServiceEndpoint, "GRPC Endpoint for Release Service")
 	gatewayCmd.Flags().StringVarP(&providerVersionManager.VersionManagerEndpoint, "provider-version-manager", "", providerVersionManager.DefaultProviderVersionManagerEndpoint, "GRPC Endpoint for Provider Version Manager Service")
 	gatewayCmd.Flags().StringVarP(&providerStorage.StorageServiceEndpoint, "provider-storage", "", providerStorage.DefaultStorageServiceDefaultEndpoint, "GRPC Endpoint for Provider Storage Service")
+	gatewayCmd.Flags().StringVarP(&dependency_tracker.DependencyTrackerEndpoint, "dependency-tracker", "", dependency_tracker.DefaultDependencyTrackerEndpoint, "GRPC Endpoint for Dependency Tracker Service")
+	addTrustedProxiesFlag(gatewayCmd, "Addresses or CIDR ranges of the proxies trusted to pass on the identity of callers")
 }
 
 func runGateway(cmd *cobra.Command, args []string) {
@@ -43,7 +46,9 @@ func runGateway(cmd *cobra.Command, args []string) {
 		release.NewPublisherGrpcClient(release.ReleaseServiceEndpoint),
 		providerVersionManager.NewVersionManagerGrpcClient(providerVersionManager.VersionManagerEndpoint),
 		providerStorage.NewStorageGrpcClient(providerStorage.StorageServiceEndpoint),
+		dependency_tracker.NewDependencyTrackerGrpcClient(dependency_tracker.DependencyTrackerEndpoint),
 	)
+	gatewayServer.Identity = newIdentityTrust()
 
 	startGRPCService("api-gateway", gatewayServer)
 }
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	moduleStorage "github.com/terrariumcloud/terrarium/internal/module/services/storage"
+	"github.com/terrariumcloud/terrarium/internal/search/services/indexer"
+	"github.com/terrariumcloud/terrarium/internal/storage"
+
+	"github.com/spf13/cobra"
+)
+
+var indexerServiceCmd = &cobra.Command{
+	Use:   "indexer",
+	Short: "Starts the Terrarium GRPC Search Indexer service",
+	Long:  "Runs the Terrarium GRPC Search Indexer server.",
+	Run:   runIndexerService,
+}
+
+func init() {
+	rootCmd.AddCommand(indexerServiceCmd)
+	indexerServiceCmd.Flags().StringVarP(&indexer.DocumentsTableName, "documents-table", "", indexer.DefaultDocumentsTableName, "Search documents table name")
+	indexerServiceCmd.Flags().StringVarP(&indexer.TermsTableName, "terms-table", "", indexer.DefaultTermsTableName, "Search terms table name")
+	indexerServiceCmd.Flags().StringVarP(&moduleStorage.StorageServiceEndpoint, "storage", "", moduleStorage.DefaultStorageServiceDefaultEndpoint, "GRPC Endpoint for Module Storage Service")
+}
+
+func runIndexerService(cmd *cobra.Command, args []string) {
+
+	indexerServiceServer := &indexer.IndexerService{
+		Db:              storage.NewDynamoDbClient(awsSessionConfig),
+		DocumentsTable:  indexer.DocumentsTableName,
+		DocumentsSchema: indexer.GetDocumentsSchema(indexer.DocumentsTableName),
+		TermsTable:      indexer.TermsTableName,
+		TermsSchema:     indexer.GetTermsSchema(indexer.TermsTableName),
+		Storage:         moduleStorage.NewStorageGrpcClient(moduleStorage.StorageServiceEndpoint),
+	}
+
+	startGRPCService("indexer", indexerServiceServer)
+}
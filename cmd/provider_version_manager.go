@@ -1,12 +1,19 @@
 package cmd
 
 import (
+	"github.com/terrariumcloud/terrarium/internal/common/approval"
+	providerStorage "github.com/terrariumcloud/terrarium/internal/provider/services/storage"
 	"github.com/terrariumcloud/terrarium/internal/provider/services/version_manager"
+	"github.com/terrariumcloud/terrarium/internal/release/services/release"
+	"github.com/terrariumcloud/terrarium/internal/search/services/indexer"
 	"github.com/terrariumcloud/terrarium/internal/storage"
 
 	"github.com/spf13/cobra"
 )
 
+// providerVersionManagerIndexerEndpoint is the optional Search Indexer Service kept up to date with providers.
+var providerVersionManagerIndexerEndpoint string
+
 var providerVersionManagerServiceCmd = &cobra.Command{
 	Use:   "provider-version-manager",
 	Short: "Starts the Terrarium GRPC Provider Version Manager service",
@@ -17,14 +24,32 @@ var providerVersionManagerServiceCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(providerVersionManagerServiceCmd)
 	providerVersionManagerServiceCmd.Flags().StringVarP(&version_manager.VersionsTableName, "table", "t", version_manager.DefaultProviderVersionsTableName, "Provider Version Manager table name")
+	providerVersionManagerServiceCmd.Flags().StringVarP(&release.ReleaseServiceEndpoint, "release", "", release.DefaultReleaseServiceEndpoint, "GRPC Endpoint for Release Service")
+	providerVersionManagerServiceCmd.Flags().StringVarP(&version_manager.BrowseUrl, "browse-url", "", "", "Base URL of the Terrarium UI used to link provider releases to their documentation")
+	providerVersionManagerServiceCmd.Flags().StringVarP(&providerStorage.StorageServiceEndpoint, "provider-storage", "", providerStorage.DefaultStorageServiceDefaultEndpoint, "GRPC Endpoint for Provider Storage Service")
+	providerVersionManagerServiceCmd.Flags().DurationVarP(&version_manager.DraftTTL, "draft-ttl", "", version_manager.DefaultDraftTTL, "Age after which unpublished provider versions are removed (0 disables the reaper)")
+	providerVersionManagerServiceCmd.Flags().DurationVarP(&version_manager.DraftReaperInterval, "draft-reaper-interval", "", version_manager.DefaultDraftReaperInterval, "How often to look for abandoned provider versions")
+	providerVersionManagerServiceCmd.Flags().StringSliceVarP(&version_manager.ApprovalOrganizations, "require-approval", "", nil, "Organizations whose provider versions must be approved before they are published")
+	addTrustedProxiesFlag(providerVersionManagerServiceCmd, "Addresses or CIDR ranges of the gateway and browse services, trusted to pass on the identity of submitters and reviewers")
+	providerVersionManagerServiceCmd.Flags().StringVarP(&providerVersionManagerIndexerEndpoint, "indexer", "", "", "GRPC Endpoint for Search Indexer Service kept up to date with registered providers and published versions (disabled when empty)")
 }
 
 func runProviderVersionManagerService(cmd *cobra.Command, args []string) {
 
 	versionManagerServiceServer := &version_manager.VersionManagerService{
-		Db:     storage.NewDynamoDbClient(awsSessionConfig),
-		Table:  version_manager.VersionsTableName,
-		Schema: version_manager.GetProviderVersionsSchema(version_manager.VersionsTableName),
+		Db:             storage.NewDynamoDbClient(awsSessionConfig),
+		Table:          version_manager.VersionsTableName,
+		Schema:         version_manager.GetProviderVersionsSchema(version_manager.VersionsTableName),
+		ReleaseService: release.NewPublisherGrpcClient(release.ReleaseServiceEndpoint),
+		StorageService: providerStorage.NewStorageGrpcClient(providerStorage.StorageServiceEndpoint),
+		DraftTTL:       version_manager.DraftTTL,
+		ReaperInterval: version_manager.DraftReaperInterval,
+		Approval:       approval.Policy{Organizations: version_manager.ApprovalOrganizations},
+		Identity:       newIdentityTrust(),
+	}
+
+	if providerVersionManagerIndexerEndpoint != "" {
+		versionManagerServiceServer.Indexer = indexer.NewIndexerGrpcClient(providerVersionManagerIndexerEndpoint)
 	}
 
 	startGRPCService("provider-version-manager", versionManagerServiceServer)
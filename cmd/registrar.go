@@ -2,11 +2,15 @@ package cmd
 
 import (
 	"github.com/terrariumcloud/terrarium/internal/module/services/registrar"
+	"github.com/terrariumcloud/terrarium/internal/search/services/indexer"
 	"github.com/terrariumcloud/terrarium/internal/storage"
 
 	"github.com/spf13/cobra"
 )
 
+// registrarIndexerEndpoint is the optional Search Indexer Service the registrar keeps up to date.
+var registrarIndexerEndpoint string
+
 var registrarServiceCmd = &cobra.Command{
 	Use:   "registrar",
 	Short: "Starts the Terrarium GRPC Registrar service",
@@ -17,6 +21,7 @@ var registrarServiceCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(registrarServiceCmd)
 	registrarServiceCmd.Flags().StringVarP(&registrar.RegistrarTableName, "table", "t", registrar.DefaultRegistrarTableName, "Module Registrar table name")
+	registrarServiceCmd.Flags().StringVarP(&registrarIndexerEndpoint, "indexer", "", "", "GRPC Endpoint for Search Indexer Service kept up to date with registered modules (disabled when empty)")
 }
 
 func runRegistrarService(cmd *cobra.Command, args []string) {
@@ -27,5 +32,9 @@ func runRegistrarService(cmd *cobra.Command, args []string) {
 		Schema: registrar.GetModulesSchema(registrar.RegistrarTableName),
 	}
 
+	if registrarIndexerEndpoint != "" {
+		registrarServiceServer.Indexer = indexer.NewIndexerGrpcClient(registrarIndexerEndpoint)
+	}
+
 	startGRPCService("registrar", registrarServiceServer)
 }
@@ -3,10 +3,14 @@ package cmd
 import (
 	"github.com/terrariumcloud/terrarium/internal/release/services/release"
 	"github.com/terrariumcloud/terrarium/internal/storage"
+	"github.com/terrariumcloud/terrarium/internal/usage/services/dependency_tracker"
 
 	"github.com/spf13/cobra"
 )
 
+// releaseDependencyTrackerEndpoint is the optional Dependency Tracker Service notified of new releases.
+var releaseDependencyTrackerEndpoint string
+
 var releaseServiceCmd = &cobra.Command{
 	Use:   "publish",
 	Short: "Starts the Terrarium GRPC Release service",
@@ -17,6 +21,7 @@ var releaseServiceCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(releaseServiceCmd)
 	releaseServiceCmd.Flags().StringVarP(&release.ReleaseTableName, "table", "t", release.DefaultReleaseTableName, "Releases table name")
+	releaseServiceCmd.Flags().StringVarP(&releaseDependencyTrackerEndpoint, "dependency-tracker", "", "", "GRPC Endpoint for Dependency Tracker Service notifying deployment units of new releases (disabled when empty)")
 }
 
 func runReleaseService(cmd *cobra.Command, args []string) {
@@ -27,5 +32,9 @@ func runReleaseService(cmd *cobra.Command, args []string) {
 		Schema: release.GetReleaseSchema(release.ReleaseTableName),
 	}
 
+	if releaseDependencyTrackerEndpoint != "" {
+		releaseServiceServer.DependencyTracker = dependency_tracker.NewDependencyTrackerGrpcClient(releaseDependencyTrackerEndpoint)
+	}
+
 	startGRPCService("release", releaseServiceServer)
 }
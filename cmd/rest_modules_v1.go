@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/terrariumcloud/terrarium/internal/module/services/registrar"
 	"github.com/terrariumcloud/terrarium/internal/module/services/storage"
 	"github.com/terrariumcloud/terrarium/internal/module/services/version_manager"
 	modulesv1 "github.com/terrariumcloud/terrarium/internal/restapi/modules/v1"
@@ -25,14 +26,16 @@ func init() {
 		"modules",
 		"Mount path for the rest API server used to process request relative to a particular URL in a reverse proxy type setup",
 	)
+	modulesV1Cmd.Flags().StringVarP(&registrar.RegistrarServiceEndpoint, "registrar", "", registrar.DefaultRegistrarServiceEndpoint, "GRPC Endpoint for Registrar Service")
 	modulesV1Cmd.Flags().StringVarP(&version_manager.VersionManagerEndpoint, "version-manager", "", version_manager.DefaultVersionManagerEndpoint, "GRPC Endpoint for Version Manager Service")
 	modulesV1Cmd.Flags().StringVarP(&storage.StorageServiceEndpoint, "storage", "", storage.DefaultStorageServiceDefaultEndpoint, "GRPC Endpoint for Storage Service")
+	addUpstreamFlags(modulesV1Cmd)
 	rootCmd.AddCommand(modulesV1Cmd)
 }
 
 func runRESTModulesV1Server(cmd *cobra.Command, args []string) {
 
-	restAPIServer := modulesv1.New(version_manager.NewVersionManagerGrpcClient(version_manager.VersionManagerEndpoint), storage.NewStorageGrpcClient(storage.StorageServiceEndpoint))
+	restAPIServer := modulesv1.New(registrar.NewRegistrarGrpcClient(registrar.RegistrarServiceEndpoint), version_manager.NewVersionManagerGrpcClient(version_manager.VersionManagerEndpoint), storage.NewStorageGrpcClient(storage.StorageServiceEndpoint), newUpstreamRegistry())
 
 	startRESTAPIService("rest-modules-v1", mountPath, restAPIServer)
 }
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/terrariumcloud/terrarium/internal/provider/services/storage"
+	"github.com/terrariumcloud/terrarium/internal/provider/services/version_manager"
+	"github.com/terrariumcloud/terrarium/internal/restapi/providers/mirror"
+
+	"github.com/spf13/cobra"
+)
+
+var mountPathProvidersMirror string
+
+var providersMirrorCmd = &cobra.Command{
+	Use:   "providers.mirror",
+	Short: "Starts the Terrarium REST API service implementing the provider network mirror protocol",
+	Long:  "Runs the Terrarium REST server for the implementation of the provider network mirror protocol, serving the hosted providers for any hostname",
+	Run:   runRESTProvidersMirrorServer,
+}
+
+func init() {
+	providersMirrorCmd.Flags().StringVarP(
+		&mountPathProvidersMirror,
+		"mount-path",
+		"m",
+		"providers",
+		"Mount path for the rest API server used to process request relative to a particular URL in a reverse proxy type setup",
+	)
+	providersMirrorCmd.Flags().StringVarP(&version_manager.VersionManagerEndpoint, "provider-version-manager", "", version_manager.DefaultProviderVersionManagerEndpoint, "GRPC Endpoint for Version Manager Service")
+	providersMirrorCmd.Flags().StringVarP(&storage.StorageServiceEndpoint, "provider-storage", "", storage.DefaultStorageServiceDefaultEndpoint, "GRPC Endpoint for Provider Storage Service")
+
+	rootCmd.AddCommand(providersMirrorCmd)
+}
+
+func runRESTProvidersMirrorServer(cmd *cobra.Command, args []string) {
+	restAPIServer := mirror.New(
+		version_manager.NewVersionManagerGrpcClient(version_manager.VersionManagerEndpoint),
+		storage.NewStorageGrpcClient(storage.StorageServiceEndpoint),
+	)
+	startRESTAPIService("rest-providers-mirror", mountPathProvidersMirror, restAPIServer)
+}
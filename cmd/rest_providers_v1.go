@@ -27,6 +27,7 @@ func init() {
 	)
 	providersV1Cmd.Flags().StringVarP(&version_manager.VersionManagerEndpoint, "provider-version-manager", "", version_manager.DefaultProviderVersionManagerEndpoint, "GRPC Endpoint for Version Manager Service")
 	providersV1Cmd.Flags().StringVarP(&storage.StorageServiceEndpoint, "provider-storage", "", storage.DefaultStorageServiceDefaultEndpoint, "GRPC Endpoint for Provider Storage Service")
+	addUpstreamFlags(providersV1Cmd)
 
 	rootCmd.AddCommand(providersV1Cmd)
 }
@@ -35,6 +36,7 @@ func runRESTProvidersV1Server(cmd *cobra.Command, args []string) {
 	restAPIServer := providersv1.New(
 		version_manager.NewVersionManagerGrpcClient(version_manager.VersionManagerEndpoint),
 		storage.NewStorageGrpcClient(storage.StorageServiceEndpoint),
+		newUpstreamRegistry(),
 	)
 	startRESTAPIService("rest-providers-v1", mountPathProviders, restAPIServer)
 }
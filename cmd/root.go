@@ -2,16 +2,23 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/terrariumcloud/terrarium/internal/storage"
 
 	"go.opentelemetry.io/otel/propagation"
 
 	"github.com/terrariumcloud/terrarium/internal/common/grpc_service"
+	"github.com/terrariumcloud/terrarium/internal/common/identity"
+	"github.com/terrariumcloud/terrarium/internal/common/upstream"
 	"github.com/terrariumcloud/terrarium/internal/restapi"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -23,6 +30,7 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 	noop "go.opentelemetry.io/otel/trace"
 
+	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 )
@@ -35,6 +43,21 @@ const (
 	defaultEndpoint = "0.0.0.0:3001"
 )
 
+var (
+	upstreamRegistryURL     string
+	upstreamNamespaces      []string
+	upstreamMetadataTimeout time.Duration
+	upstreamMaxDownloadSize int64
+)
+
+var (
+	trustedProxies []string
+)
+
+// The endpoints of the services a service optionally calls are flags of its own command, empty by default to leave the
+// integration disabled. They are kept apart from the package endpoints, such as indexer.IndexerEndpoint, which other
+// commands default to the address of the service they always call.
+
 var (
 	endpoint            = defaultEndpoint
 	awsSessionConfig    = storage.AWSSessionConfig{}
@@ -145,10 +168,47 @@ func startGRPCService(name string, service grpc_service.Service) {
 		log.Fatalf("Failed to start: %v", err)
 	}
 
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-shutdownSignal()
+		log.Printf("Stopping %s", name)
+		grpcServer.GracefulStop()
+	}()
+
 	log.Printf("Listening at %s", endpoint)
 	if err := grpcServer.Serve(listener); err != nil {
 		log.Fatalf("Failed: %v", err)
 	}
+	<-stopped
+	closeServices([]grpc_service.Service{service})
+}
+
+// shutdownSignal returns a channel closed when the process is asked to stop with SIGINT or SIGTERM.
+func shutdownSignal() <-chan struct{} {
+	ctx, _ := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	return ctx.Done()
+}
+
+// closeServices closes the services that implement io.Closer, once the server they are registered with is stopped,
+// so that the work they run in the background is finished or stopped before the process exits.
+func closeServices(services []grpc_service.Service) {
+	for _, service := range services {
+		if closer, ok := service.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				log.Printf("Failed to close: %v", err)
+			}
+		}
+	}
+}
+
+// routerRestHandler serves the REST APIs of several services mounted on a single router.
+type routerRestHandler struct {
+	router *mux.Router
+}
+
+func (a routerRestHandler) GetHttpHandler(mountPath string) http.Handler {
+	return a.router
 }
 
 func startRESTAPIService(name, mountPath string, rootHandler restapi.RESTAPIHandler) {
@@ -158,11 +218,59 @@ func startRESTAPIService(name, mountPath string, rootHandler restapi.RESTAPIHand
 		defer otelShutdown()
 	}
 
+	server := &http.Server{Addr: endpoint, Handler: rootHandler.GetHttpHandler(mountPath)}
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-shutdownSignal()
+		log.Printf("Stopping %s", name)
+		if err := server.Shutdown(context.Background()); err != nil {
+			log.Printf("Failed to stop: %v", err)
+		}
+	}()
+
 	log.Printf("Listening on %s", endpoint)
-	if err := http.ListenAndServe(endpoint, rootHandler.GetHttpHandler(mountPath)); err != nil {
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
 		log.Fatalf("Failed: %v", err)
 	}
+	<-stopped
+}
+
+// addUpstreamFlags adds the flags of the upstream registry modules and providers are proxied from to a REST API
+// command.
+func addUpstreamFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&upstreamRegistryURL, "upstream-registry", "", "URL of the registry modules and providers of the upstream namespaces are fetched from and cached (disabled when empty)")
+	cmd.Flags().StringSliceVar(&upstreamNamespaces, "upstream-namespaces", nil, "Namespaces proxied from the upstream registry")
+	cmd.Flags().DurationVar(&upstreamMetadataTimeout, "upstream-metadata-timeout", upstream.DefaultMetadataTimeout, "Timeout of the version listings and download locations requested from the upstream registry")
+	cmd.Flags().Int64Var(&upstreamMaxDownloadSize, "upstream-max-download-size", upstream.DefaultMaxDownloadSize, "Maximum size in bytes of an archive downloaded from the upstream registry")
+}
+
+// newUpstreamRegistry returns the upstream registry set with the upstream flags, or nil when none is set.
+func newUpstreamRegistry() *upstream.Registry {
+	if upstreamRegistryURL == "" {
+		return nil
+	}
+	registry, err := upstream.New(upstreamRegistryURL, upstreamNamespaces)
+	if err != nil {
+		log.Fatalf("Invalid upstream registry: %v", err)
+	}
+	registry.MetadataTimeout = upstreamMetadataTimeout
+	registry.MaxDownloadSize = upstreamMaxDownloadSize
+	return registry
+}
 
+// addTrustedProxiesFlag adds the flag of the callers trusted to pass on the identity of users to a command.
+func addTrustedProxiesFlag(cmd *cobra.Command, usage string) {
+	cmd.Flags().StringSliceVar(&trustedProxies, "trusted-proxies", nil, usage)
+}
+
+// newIdentityTrust returns the trust of the proxies set with the trusted proxies flag.
+func newIdentityTrust() *identity.Trust {
+	trust, err := identity.NewTrust(trustedProxies)
+	if err != nil {
+		log.Fatalf("Invalid trusted proxies: %v", err)
+	}
+	return trust
 }
 
 // Execute root command
@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"github.com/terrariumcloud/terrarium/internal/module/services/dependency_manager"
 	storage2 "github.com/terrariumcloud/terrarium/internal/module/services/storage"
 	"github.com/terrariumcloud/terrarium/internal/storage"
 
 	"github.com/spf13/cobra"
 )
 
+// storageDependencyManagerEndpoint is the optional Dependency Manager Service of detected dependencies.
+var storageDependencyManagerEndpoint string
+
 var storageServiceCmd = &cobra.Command{
 	Use:   "storage",
 	Short: "Starts the Terrarium GRPC Storage service",
@@ -17,6 +21,9 @@ var storageServiceCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(storageServiceCmd)
 	storageServiceCmd.Flags().StringVarP(&storage2.BucketName, "bucket", "b", storage2.DefaultBucketName, "Module bucket name")
+	storageServiceCmd.Flags().StringVarP(&storage2.RegistryHostname, "registry-host", "", "", "Host modules of this registry are sourced from, used to detect module dependencies in uploaded archives")
+	storageServiceCmd.Flags().Int64VarP(&storage2.MaxSourceFileSize, "max-source-file-size", "", storage2.DefaultMaxSourceFileSize, "Size in bytes of the largest source file displayed when browsing modules")
+	storageServiceCmd.Flags().StringVarP(&storageDependencyManagerEndpoint, "dependency-manager", "", "", "GRPC Endpoint for Dependency Manager Service registering dependencies detected in uploaded archives (disabled when empty)")
 }
 
 func runStorageService(cmd *cobra.Command, args []string) {
@@ -27,5 +34,9 @@ func runStorageService(cmd *cobra.Command, args []string) {
 		Region:     awsSessionConfig.Region,
 	}
 
+	if storageDependencyManagerEndpoint != "" {
+		storageServiceServer.DependencyManager = dependency_manager.NewDependencyManagerGrpcClient(storageDependencyManagerEndpoint)
+	}
+
 	startGRPCService("storage-s3", storageServiceServer)
 }
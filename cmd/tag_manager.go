@@ -2,11 +2,15 @@ package cmd
 
 import (
 	"github.com/terrariumcloud/terrarium/internal/module/services/tag_manager"
+	"github.com/terrariumcloud/terrarium/internal/search/services/indexer"
 	"github.com/terrariumcloud/terrarium/internal/storage"
 
 	"github.com/spf13/cobra"
 )
 
+// tagManagerIndexerEndpoint is the optional Search Indexer Service the tag manager keeps up to date.
+var tagManagerIndexerEndpoint string
+
 var tagManagerCmd = &cobra.Command{
 	Use:   "tag-manager",
 	Short: "Starts the Terrarium GRPC Tag Manager service",
@@ -17,14 +21,22 @@ var tagManagerCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(tagManagerCmd)
 	tagManagerCmd.Flags().StringVarP(&tag_manager.TagTableName, "table", "t", tag_manager.DefaultTagTableName, "Module tags table name")
+	tagManagerCmd.Flags().StringVarP(&tag_manager.TagIndexTableName, "index-table", "", tag_manager.DefaultTagIndexTableName, "Module tag index table name")
+	tagManagerCmd.Flags().StringVarP(&tagManagerIndexerEndpoint, "indexer", "", "", "GRPC Endpoint for Search Indexer Service kept up to date with module tags (disabled when empty)")
 }
 
 func runTagManager(cmd *cobra.Command, args []string) {
 
 	tagManagerServer := &tag_manager.TagManagerService{
-		Db:     storage.NewDynamoDbClient(awsSessionConfig),
-		Table:  tag_manager.TagTableName,
-		Schema: tag_manager.GetTagsSchema(tag_manager.TagTableName),
+		Db:          storage.NewDynamoDbClient(awsSessionConfig),
+		Table:       tag_manager.TagTableName,
+		Schema:      tag_manager.GetTagsSchema(tag_manager.TagTableName),
+		IndexTable:  tag_manager.TagIndexTableName,
+		IndexSchema: tag_manager.GetTagIndexSchema(tag_manager.TagIndexTableName),
+	}
+
+	if tagManagerIndexerEndpoint != "" {
+		tagManagerServer.Indexer = indexer.NewIndexerGrpcClient(tagManagerIndexerEndpoint)
 	}
 
 	startGRPCService("tag-manager", tagManagerServer)
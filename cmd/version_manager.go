@@ -2,11 +2,21 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/internal/common/approval"
+	"github.com/terrariumcloud/terrarium/internal/module/services/dependency_manager"
+	moduleStorage "github.com/terrariumcloud/terrarium/internal/module/services/storage"
 	"github.com/terrariumcloud/terrarium/internal/module/services/version_manager"
 	"github.com/terrariumcloud/terrarium/internal/release/services/release"
+	"github.com/terrariumcloud/terrarium/internal/search/services/indexer"
 	"github.com/terrariumcloud/terrarium/internal/storage"
 )
 
+// versionManagerIndexerEndpoint is the optional Search Indexer Service the version manager keeps up to date.
+var versionManagerIndexerEndpoint string
+
+// versionManagerDependencyManagerEndpoint is the optional Dependency Manager Service reaped drafts are removed from.
+var versionManagerDependencyManagerEndpoint string
+
 var versionManagerCmd = &cobra.Command{
 	Use:   "version-manager",
 	Short: "Starts the Terrarium GRPC Version Manager service",
@@ -18,6 +28,13 @@ func init() {
 	rootCmd.AddCommand(versionManagerCmd)
 	versionManagerCmd.Flags().StringVarP(&version_manager.VersionsTableName, "table", "t", version_manager.DefaultVersionsTableName, "Module versions table name")
 	versionManagerCmd.Flags().StringVarP(&release.ReleaseServiceEndpoint, "release", "", release.DefaultReleaseServiceEndpoint, "GRPC Endpoint for Release Service")
+	versionManagerCmd.Flags().StringVarP(&moduleStorage.StorageServiceEndpoint, "storage", "", moduleStorage.DefaultStorageServiceDefaultEndpoint, "GRPC Endpoint for Module Storage Service")
+	versionManagerCmd.Flags().DurationVarP(&version_manager.DraftTTL, "draft-ttl", "", version_manager.DefaultDraftTTL, "Age after which unpublished module versions are removed (0 disables the reaper)")
+	versionManagerCmd.Flags().DurationVarP(&version_manager.DraftReaperInterval, "draft-reaper-interval", "", version_manager.DefaultDraftReaperInterval, "How often to look for abandoned module versions")
+	versionManagerCmd.Flags().StringSliceVarP(&version_manager.ApprovalOrganizations, "require-approval", "", nil, "Organizations whose module versions must be approved before they are published")
+	versionManagerCmd.Flags().StringVarP(&versionManagerIndexerEndpoint, "indexer", "", "", "GRPC Endpoint for Search Indexer Service kept up to date with published module versions (disabled when empty)")
+	addTrustedProxiesFlag(versionManagerCmd, "Addresses or CIDR ranges of the gateway and browse services, trusted to pass on the identity of submitters and reviewers")
+	versionManagerCmd.Flags().StringVarP(&versionManagerDependencyManagerEndpoint, "dependency-manager", "", "", "GRPC Endpoint for Dependency Manager Service the dependencies of reaped drafts are removed from (disabled when empty)")
 }
 
 func runVersionManager(cmd *cobra.Command, args []string) {
@@ -27,6 +44,19 @@ func runVersionManager(cmd *cobra.Command, args []string) {
 		Table:          version_manager.VersionsTableName,
 		Schema:         version_manager.GetModuleVersionsSchema(version_manager.VersionsTableName),
 		ReleaseService: release.NewPublisherGrpcClient(release.ReleaseServiceEndpoint),
+		StorageService: moduleStorage.NewStorageGrpcClient(moduleStorage.StorageServiceEndpoint),
+		DraftTTL:       version_manager.DraftTTL,
+		ReaperInterval: version_manager.DraftReaperInterval,
+		Approval:       approval.Policy{Organizations: version_manager.ApprovalOrganizations},
+		Identity:       newIdentityTrust(),
+	}
+
+	if versionManagerIndexerEndpoint != "" {
+		versionManagerServer.Indexer = indexer.NewIndexerGrpcClient(versionManagerIndexerEndpoint)
+	}
+
+	if versionManagerDependencyManagerEndpoint != "" {
+		versionManagerServer.DependencyManager = dependency_manager.NewDependencyManagerGrpcClient(versionManagerDependencyManagerEndpoint)
 	}
 
 	startGRPCService("version-manager", versionManagerServer)
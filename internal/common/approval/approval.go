@@ -0,0 +1,36 @@
+package approval
+
+import (
+	"strings"
+)
+
+// Approval states of a module or provider version.
+// Versions published outside an approval policy carry no approval state.
+const (
+	StatusPending  = "PENDING"
+	StatusApproved = "APPROVED"
+	StatusRejected = "REJECTED"
+)
+
+// Policy lists the organizations whose versions need to be approved before they are visible to consumers.
+type Policy struct {
+	Organizations []string
+}
+
+// Required reports whether a module or provider name, in the form "<organization>/...",
+// belongs to an organization that requires approval.
+func (p Policy) Required(name string) bool {
+	organization := Organization(name)
+	for _, o := range p.Organizations {
+		if o == organization {
+			return true
+		}
+	}
+	return false
+}
+
+// Organization returns the organization part of a module or provider name.
+func Organization(name string) string {
+	organization, _, _ := strings.Cut(name, "/")
+	return organization
+}
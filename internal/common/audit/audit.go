@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// SystemActor identifies actions performed by Terrarium itself rather than by a client.
+	SystemActor = "system"
+)
+
+// Record writes an audit trail entry for an action performed by an actor.
+// The entry is written to the service log and attached as an event to the span in ctx.
+func Record(ctx context.Context, actor, action string, attrs ...attribute.KeyValue) {
+	fields := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		fields = append(fields, fmt.Sprintf("%s=%q", attr.Key, attr.Value.Emit()))
+	}
+	log.Printf("AUDIT actor=%q action=%q %s", actor, action, strings.Join(fields, " "))
+
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("audit."+action, trace.WithAttributes(append(attrs, attribute.String("audit.actor", actor))...))
+}
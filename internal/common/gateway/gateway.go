@@ -2,18 +2,25 @@ package gateway
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log"
+	"sort"
+	"strings"
 
+	"github.com/terrariumcloud/terrarium/internal/common/identity"
 	moduleServices "github.com/terrariumcloud/terrarium/internal/module/services"
 	"github.com/terrariumcloud/terrarium/internal/module/services/storage"
 	providerServices "github.com/terrariumcloud/terrarium/internal/provider/services"
 	providerStorage "github.com/terrariumcloud/terrarium/internal/provider/services/storage"
 	release "github.com/terrariumcloud/terrarium/internal/release/services"
+	usage "github.com/terrariumcloud/terrarium/internal/usage/services"
 	terrariumModule "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
 	terrariumProvider "github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
 	releasePkg "github.com/terrariumcloud/terrarium/pkg/terrarium/release"
+	usagePkg "github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
 
+	"github.com/apparentlymart/go-versions/versions"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -31,6 +38,8 @@ var (
 	UnknownVersionManagerActionError  = status.Error(codes.InvalidArgument, "Unknown Version manager action requested.")
 	ForwardModuleDependenciesError    = status.Error(codes.Unknown, "Failed to send module dependencies.")
 	ForwardContainerDependenciesError = status.Error(codes.Unknown, "Failed to send module dependencies.")
+	ForwardModuleDependentsError      = status.Error(codes.Unknown, "Failed to send module dependents.")
+	InvalidVersionRangeError          = status.Error(codes.InvalidArgument, "Invalid version range.")
 )
 
 type TerrariumGrpcGateway struct {
@@ -38,6 +47,8 @@ type TerrariumGrpcGateway struct {
 	terrariumModule.UnimplementedConsumerServer
 	releasePkg.UnimplementedReleasePublisherServer
 	terrariumProvider.UnimplementedProviderPublisherServer
+	terrariumProvider.UnimplementedProviderConsumerServer
+	usagePkg.UnimplementedDependencyTrackerServer
 	providerVersionManagerClient providerServices.VersionManagerClient
 	registrarClient              moduleServices.RegistrarClient
 	tagManagerClient             moduleServices.TagManagerClient
@@ -46,6 +57,9 @@ type TerrariumGrpcGateway struct {
 	dependencyManagerClient      moduleServices.DependencyManagerClient
 	releasePublisherClient       release.PublisherClient
 	providerStorageClient        providerServices.StorageClient
+	dependencyTrackerClient      usage.DependencyTrackerClient
+	// Identity lists the proxies trusted to pass on the identity of callers, identities sent by others are dropped
+	Identity *identity.Trust
 }
 
 func New(registrarClient moduleServices.RegistrarClient,
@@ -55,7 +69,8 @@ func New(registrarClient moduleServices.RegistrarClient,
 	dependencyManagerClient moduleServices.DependencyManagerClient,
 	releasePublisherClient release.PublisherClient,
 	providerVersionManagerClient providerServices.VersionManagerClient,
-	providerStorageClient providerServices.StorageClient) *TerrariumGrpcGateway {
+	providerStorageClient providerServices.StorageClient,
+	dependencyTrackerClient usage.DependencyTrackerClient) *TerrariumGrpcGateway {
 	return &TerrariumGrpcGateway{
 		registrarClient:              registrarClient,
 		tagManagerClient:             tagManagerClient,
@@ -65,6 +80,7 @@ func New(registrarClient moduleServices.RegistrarClient,
 		releasePublisherClient:       releasePublisherClient,
 		providerVersionManagerClient: providerVersionManagerClient,
 		providerStorageClient:        providerStorageClient,
+		dependencyTrackerClient:      dependencyTrackerClient,
 	}
 }
 
@@ -73,7 +89,8 @@ func (gw *TerrariumGrpcGateway) RegisterWithServer(grpcServer grpc.ServiceRegist
 	terrariumModule.RegisterPublisherServer(grpcServer, gw)
 	terrariumModule.RegisterConsumerServer(grpcServer, gw)
 	releasePkg.RegisterReleasePublisherServer(grpcServer, gw)
-	return nil
+	usagePkg.RegisterDependencyTrackerServer(grpcServer, gw)
+	return gw.RegisterProviderWithServer(grpcServer)
 }
 
 // Register new module with Registrar service
@@ -124,11 +141,79 @@ func (gw *TerrariumGrpcGateway) PublishTagWithClient(ctx context.Context, reques
 	}
 }
 
+// AddTags adds tags to a module with Tag Manager service
+func (gw *TerrariumGrpcGateway) AddTags(ctx context.Context, request *terrariumModule.PublishTagRequest) (*terrariumModule.Response, error) {
+	return gw.AddTagsWithClient(ctx, request, gw.tagManagerClient)
+}
+
+// AddTagsWithClient calls AddTags on Tag Manager client
+func (gw *TerrariumGrpcGateway) AddTagsWithClient(ctx context.Context, request *terrariumModule.PublishTagRequest, client moduleServices.TagManagerClient) (*terrariumModule.Response, error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("gateway: adding tags with Client", trace.WithAttributes(attribute.String("Module Name", request.GetName()), attribute.StringSlice("Module Tags", request.GetTags())))
+	span.SetAttributes(
+		attribute.String("module.name", request.GetName()),
+		attribute.StringSlice("module.tags", request.GetTags()),
+	)
+
+	if res, delegateError := client.AddTags(ctx, request); delegateError != nil {
+		log.Printf("Failed: %v", delegateError)
+		span.RecordError(delegateError)
+		return nil, delegateError
+	} else {
+		log.Println("Done <= Tag Manager")
+		span.AddEvent("Successfully added tags with Client.")
+		return res, nil
+	}
+}
+
+// RemoveTags removes tags from a module with Tag Manager service
+func (gw *TerrariumGrpcGateway) RemoveTags(ctx context.Context, request *terrariumModule.PublishTagRequest) (*terrariumModule.Response, error) {
+	return gw.RemoveTagsWithClient(ctx, request, gw.tagManagerClient)
+}
+
+// RemoveTagsWithClient calls RemoveTags on Tag Manager client
+func (gw *TerrariumGrpcGateway) RemoveTagsWithClient(ctx context.Context, request *terrariumModule.PublishTagRequest, client moduleServices.TagManagerClient) (*terrariumModule.Response, error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("gateway: removing tags with Client", trace.WithAttributes(attribute.String("Module Name", request.GetName()), attribute.StringSlice("Module Tags", request.GetTags())))
+	span.SetAttributes(
+		attribute.String("module.name", request.GetName()),
+		attribute.StringSlice("module.tags", request.GetTags()),
+	)
+
+	if res, delegateError := client.RemoveTags(ctx, request); delegateError != nil {
+		log.Printf("Failed: %v", delegateError)
+		span.RecordError(delegateError)
+		return nil, delegateError
+	} else {
+		log.Println("Done <= Tag Manager")
+		span.AddEvent("Successfully removed tags with Client.")
+		return res, nil
+	}
+}
+
 // BeginVersion creates new version with Version Manager service
 func (gw *TerrariumGrpcGateway) BeginVersion(ctx context.Context, request *terrariumModule.BeginVersionRequest) (*terrariumModule.Response, error) {
+	gw.InheritModuleMaturity(ctx, request, gw.registrarClient)
 	return gw.BeginVersionWithClient(ctx, request, gw.moduleVersionManagerClient)
 }
 
+// InheritModuleMaturity sets the maturity of a new version to the maturity registered for its module
+// when the request does not specify one.
+func (gw *TerrariumGrpcGateway) InheritModuleMaturity(ctx context.Context, request *terrariumModule.BeginVersionRequest, client moduleServices.RegistrarClient) {
+	if request.Maturity != nil {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if res, err := client.GetModule(ctx, &moduleServices.GetModuleRequest{Name: request.GetModule().GetName()}); err != nil {
+		log.Printf("Failed to retrieve module maturity: %v", err)
+		span.RecordError(err)
+	} else if res.GetModule() != nil {
+		maturity := res.GetModule().GetMaturity()
+		request.Maturity = &maturity
+	}
+}
+
 // BeginVersionWithClient calls BeginVersion on Version Manager client
 func (gw *TerrariumGrpcGateway) BeginVersionWithClient(ctx context.Context, request *terrariumModule.BeginVersionRequest, client moduleServices.VersionManagerClient) (*terrariumModule.Response, error) {
 	span := trace.SpanFromContext(ctx)
@@ -148,6 +233,83 @@ func (gw *TerrariumGrpcGateway) BeginVersionWithClient(ctx context.Context, requ
 	}
 }
 
+// SetMaturity changes the maturity of a module version with Version Manager service
+func (gw *TerrariumGrpcGateway) SetMaturity(ctx context.Context, request *terrariumModule.SetMaturityRequest) (*terrariumModule.Response, error) {
+	return gw.SetMaturityWithClient(ctx, request, gw.moduleVersionManagerClient)
+}
+
+// SetMaturityWithClient calls SetMaturity on Version Manager client
+func (gw *TerrariumGrpcGateway) SetMaturityWithClient(ctx context.Context, request *terrariumModule.SetMaturityRequest, client moduleServices.VersionManagerClient) (*terrariumModule.Response, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.String("module.maturity", request.GetMaturity().String()),
+	)
+
+	if res, delegateError := client.SetMaturity(ctx, request); delegateError != nil {
+		log.Printf("Failed: %v", delegateError)
+		span.RecordError(delegateError)
+		return nil, delegateError
+	} else {
+		log.Println("Done <= Version Manager")
+		span.AddEvent("Successful call to SetMaturity.")
+		return res, nil
+	}
+}
+
+// ApproveVersion publishes a module version pending approval with Version Manager service
+func (gw *TerrariumGrpcGateway) ApproveVersion(ctx context.Context, request *terrariumModule.ReviewVersionRequest) (*terrariumModule.Response, error) {
+	return gw.ApproveVersionWithClient(ctx, request, gw.moduleVersionManagerClient)
+}
+
+// ApproveVersionWithClient calls ApproveVersion on Version Manager client
+func (gw *TerrariumGrpcGateway) ApproveVersionWithClient(ctx context.Context, request *terrariumModule.ReviewVersionRequest, client moduleServices.VersionManagerClient) (*terrariumModule.Response, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.String("approval.reviewer", gw.Identity.FromIncomingContext(ctx)),
+	)
+
+	log.Println("Approve version => Version Manager")
+	if res, delegateError := client.ApproveVersion(gw.Identity.Forward(ctx), request); delegateError != nil {
+		log.Printf("Failed: %v", delegateError)
+		span.RecordError(delegateError)
+		return nil, delegateError
+	} else {
+		log.Println("Done <= Version Manager")
+		span.AddEvent("Successful call to ApproveVersion.")
+		return res, nil
+	}
+}
+
+// RejectVersion rejects a module version pending approval with Version Manager service
+func (gw *TerrariumGrpcGateway) RejectVersion(ctx context.Context, request *terrariumModule.ReviewVersionRequest) (*terrariumModule.Response, error) {
+	return gw.RejectVersionWithClient(ctx, request, gw.moduleVersionManagerClient)
+}
+
+// RejectVersionWithClient calls RejectVersion on Version Manager client
+func (gw *TerrariumGrpcGateway) RejectVersionWithClient(ctx context.Context, request *terrariumModule.ReviewVersionRequest, client moduleServices.VersionManagerClient) (*terrariumModule.Response, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.String("approval.reviewer", gw.Identity.FromIncomingContext(ctx)),
+	)
+
+	log.Println("Reject version => Version Manager")
+	if res, delegateError := client.RejectVersion(gw.Identity.Forward(ctx), request); delegateError != nil {
+		log.Printf("Failed: %v", delegateError)
+		span.RecordError(delegateError)
+		return nil, delegateError
+	} else {
+		log.Println("Done <= Version Manager")
+		span.AddEvent("Successful call to RejectVersion.")
+		return res, nil
+	}
+}
+
 // EndVersion publishes/aborts with Version Manger service
 func (gw *TerrariumGrpcGateway) EndVersion(ctx context.Context, request *terrariumModule.EndVersionRequest) (*terrariumModule.Response, error) {
 	return gw.EndVersionWithClient(ctx, request, gw.moduleVersionManagerClient)
@@ -173,7 +335,7 @@ func (gw *TerrariumGrpcGateway) EndVersionWithClient(ctx context.Context, reques
 		}
 	} else if request.GetAction() == terrariumModule.EndVersionRequest_PUBLISH {
 		log.Println("Publish version => Version Manager")
-		if res, delegateError := client.PublishVersion(ctx, &terminateRequest); delegateError != nil {
+		if res, delegateError := client.PublishVersion(gw.Identity.Forward(ctx), &terminateRequest); delegateError != nil {
 			log.Printf("Failed: %v", delegateError)
 			span.RecordError(delegateError)
 			return nil, delegateError
@@ -437,6 +599,296 @@ func (gw *TerrariumGrpcGateway) RetrieveModuleDependenciesWithClient(request *te
 	}
 }
 
+// Retrieve the modules depending on a module from Dependency Manager service
+func (gw *TerrariumGrpcGateway) RetrieveDependents(request *terrariumModule.RetrieveDependentsRequest, server terrariumModule.Consumer_RetrieveDependentsServer) error {
+	return gw.RetrieveDependentsWithClient(request, server, gw.dependencyManagerClient)
+}
+
+func (gw *TerrariumGrpcGateway) RetrieveDependentsWithClient(request *terrariumModule.RetrieveDependentsRequest, server terrariumModule.Consumer_RetrieveDependentsServer, client moduleServices.DependencyManagerClient) error {
+	downStream, downErr := client.RetrieveDependents(server.Context(), request)
+
+	ctx := server.Context()
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("gateway: retrieving module dependents with Client", trace.WithAttributes(attribute.String("Module Name", request.Module.GetName()), attribute.String("Module Version", request.Module.GetVersion())))
+	span.SetAttributes(
+		attribute.String("module.name", request.Module.GetName()),
+		attribute.String("module.version", request.Module.GetVersion()),
+	)
+
+	if downErr != nil {
+		span.RecordError(downErr)
+		return downErr
+	}
+
+	for {
+		res, downErr := downStream.Recv()
+
+		if downErr == io.EOF {
+			log.Println("Done <= Dependency Manager")
+			span.AddEvent("Success. Received all module dependents.")
+			return nil
+		}
+
+		if downErr != nil {
+			log.Printf("Failed to recieve: %v", downErr)
+			span.RecordError(downErr)
+			return downErr
+		}
+
+		err := server.Send(res)
+
+		if err != nil {
+			log.Printf("Failed to send: %v", err)
+			span.RecordError(err)
+			downStream.CloseSend()
+			return ForwardModuleDependentsError
+		}
+	}
+}
+
+// SearchContainerImages looks up the module versions referencing container images with Dependency Manager service
+func (gw *TerrariumGrpcGateway) SearchContainerImages(ctx context.Context, request *terrariumModule.SearchContainerImagesRequest) (*terrariumModule.SearchContainerImagesResponse, error) {
+	return gw.SearchContainerImagesWithClient(ctx, request, gw.dependencyManagerClient)
+}
+
+// SearchContainerImagesWithClient calls SearchContainerImages on Dependency Manager client
+func (gw *TerrariumGrpcGateway) SearchContainerImagesWithClient(ctx context.Context, request *terrariumModule.SearchContainerImagesRequest, client moduleServices.DependencyManagerClient) (*terrariumModule.SearchContainerImagesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("gateway: searching container images with Client", trace.WithAttributes(attribute.String("Image Name", request.GetName()), attribute.String("Image Digest", request.GetDigest())))
+	span.SetAttributes(
+		attribute.String("image.name", request.GetName()),
+		attribute.String("image.namespace", request.GetNamespace()),
+		attribute.String("image.tag", request.GetTag()),
+		attribute.String("image.digest", request.GetDigest()),
+	)
+
+	if res, err := client.SearchContainerImages(ctx, request); err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	} else {
+		log.Println("Done <= Dependency Manager")
+		span.AddEvent("Successfully searched container images with Client.")
+		return res, nil
+	}
+}
+
+// ExportSBOM exports the software bill of materials of a module version with Dependency Manager service
+func (gw *TerrariumGrpcGateway) ExportSBOM(ctx context.Context, request *terrariumModule.ExportSBOMRequest) (*terrariumModule.SBOMResponse, error) {
+	return gw.ExportSBOMWithClient(ctx, request, gw.dependencyManagerClient)
+}
+
+// ExportSBOMWithClient calls ExportSBOM on Dependency Manager client
+func (gw *TerrariumGrpcGateway) ExportSBOMWithClient(ctx context.Context, request *terrariumModule.ExportSBOMRequest, client moduleServices.DependencyManagerClient) (*terrariumModule.SBOMResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("gateway: exporting SBOM with Client", trace.WithAttributes(attribute.String("Module Name", request.Module.GetName()), attribute.String("Module Version", request.Module.GetVersion())))
+	span.SetAttributes(
+		attribute.String("module.name", request.Module.GetName()),
+		attribute.String("module.version", request.Module.GetVersion()),
+		attribute.String("sbom.format", request.GetFormat().String()),
+	)
+
+	if res, err := client.ExportSBOM(ctx, request); err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	} else {
+		log.Println("Done <= Dependency Manager")
+		span.AddEvent("Successfully exported SBOM with Client.")
+		return res, nil
+	}
+}
+
+// RetrieveDependencyGraph retrieves the dependency graph of a module version from Dependency Manager service
+func (gw *TerrariumGrpcGateway) RetrieveDependencyGraph(ctx context.Context, request *terrariumModule.RetrieveDependencyGraphRequest) (*terrariumModule.DependencyGraph, error) {
+	return gw.RetrieveDependencyGraphWithClient(ctx, request, gw.dependencyManagerClient)
+}
+
+// RetrieveDependencyGraphWithClient calls RetrieveDependencyGraph on Dependency Manager client
+func (gw *TerrariumGrpcGateway) RetrieveDependencyGraphWithClient(ctx context.Context, request *terrariumModule.RetrieveDependencyGraphRequest, client moduleServices.DependencyManagerClient) (*terrariumModule.DependencyGraph, error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("gateway: retrieving dependency graph with Client", trace.WithAttributes(attribute.String("Module Name", request.Module.GetName()), attribute.String("Module Version", request.Module.GetVersion())))
+	span.SetAttributes(
+		attribute.String("module.name", request.Module.GetName()),
+		attribute.String("module.version", request.Module.GetVersion()),
+	)
+
+	if res, err := client.RetrieveDependencyGraph(ctx, request); err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	} else {
+		log.Println("Done <= Dependency Manager")
+		span.AddEvent("Successfully retrieved dependency graph with Client.")
+		return res, nil
+	}
+}
+
+// RetrieveProviderDependencies retrieves the providers required by a module version from Dependency Manager service
+func (gw *TerrariumGrpcGateway) RetrieveProviderDependencies(ctx context.Context, request *terrariumModule.RetrieveProviderDependenciesRequest) (*terrariumModule.ProviderDependenciesResponse, error) {
+	return gw.RetrieveProviderDependenciesWithClient(ctx, request, gw.dependencyManagerClient)
+}
+
+// RetrieveProviderDependenciesWithClient calls RetrieveProviderDependencies on Dependency Manager client
+func (gw *TerrariumGrpcGateway) RetrieveProviderDependenciesWithClient(ctx context.Context, request *terrariumModule.RetrieveProviderDependenciesRequest, client moduleServices.DependencyManagerClient) (*terrariumModule.ProviderDependenciesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("gateway: retrieving provider dependencies with Client", trace.WithAttributes(attribute.String("Module Name", request.Module.GetName()), attribute.String("Module Version", request.Module.GetVersion())))
+	span.SetAttributes(
+		attribute.String("module.name", request.Module.GetName()),
+		attribute.String("module.version", request.Module.GetVersion()),
+	)
+
+	if res, err := client.RetrieveProviderDependencies(ctx, request); err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	} else {
+		log.Println("Done <= Dependency Manager")
+		span.AddEvent("Successfully retrieved provider dependencies with Client.")
+		return res, nil
+	}
+}
+
+// GetTags retrieves the tags of a module from Tag Manager service
+func (gw *TerrariumGrpcGateway) GetTags(ctx context.Context, request *terrariumModule.GetTagsRequest) (*terrariumModule.ModuleTags, error) {
+	return gw.GetTagsWithClient(ctx, request, gw.tagManagerClient)
+}
+
+// GetTagsWithClient calls GetTags on Tag Manager client
+func (gw *TerrariumGrpcGateway) GetTagsWithClient(ctx context.Context, request *terrariumModule.GetTagsRequest, client moduleServices.TagManagerClient) (*terrariumModule.ModuleTags, error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("gateway: retrieving tags with Client", trace.WithAttributes(attribute.String("Module Name", request.GetName())))
+	span.SetAttributes(
+		attribute.String("module.name", request.GetName()),
+	)
+
+	if res, err := client.GetTags(ctx, request); err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	} else {
+		log.Println("Done <= Tag Manager")
+		span.AddEvent("Successfully retrieved tags with Client.")
+		return res, nil
+	}
+}
+
+// ListModulesByTag retrieves the modules having a tag from Tag Manager service
+func (gw *TerrariumGrpcGateway) ListModulesByTag(ctx context.Context, request *terrariumModule.ListModulesByTagRequest) (*terrariumModule.ListModulesByTagResponse, error) {
+	return gw.ListModulesByTagWithClient(ctx, request, gw.tagManagerClient)
+}
+
+// ListModulesByTagWithClient calls ListModulesByTag on Tag Manager client
+func (gw *TerrariumGrpcGateway) ListModulesByTagWithClient(ctx context.Context, request *terrariumModule.ListModulesByTagRequest, client moduleServices.TagManagerClient) (*terrariumModule.ListModulesByTagResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("gateway: listing modules by tag with Client", trace.WithAttributes(attribute.String("Module Tag", request.GetTag())))
+	span.SetAttributes(
+		attribute.String("module.tag", request.GetTag()),
+	)
+
+	if res, err := client.ListModulesByTag(ctx, request); err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	} else {
+		log.Println("Done <= Tag Manager")
+		span.AddEvent("Successfully listed modules by tag with Client.")
+		return res, nil
+	}
+}
+
+// ListModules lists the modules of an organization with Registrar service
+func (gw *TerrariumGrpcGateway) ListModules(ctx context.Context, request *terrariumModule.ListModulesRequest) (*terrariumModule.ListModulesResponse, error) {
+	return gw.ListModulesWithClient(ctx, request, gw.registrarClient)
+}
+
+// ListModulesWithClient calls ListModules on Registrar client, without paging so that every module is listed
+func (gw *TerrariumGrpcGateway) ListModulesWithClient(ctx context.Context, request *terrariumModule.ListModulesRequest, client moduleServices.RegistrarClient) (*terrariumModule.ListModulesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.organization", request.GetOrganization()),
+	)
+
+	res, err := client.ListModules(ctx, &moduleServices.ListModulesRequest{Organization: request.GetOrganization()})
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	response := &terrariumModule.ListModulesResponse{}
+	for _, module := range res.GetModules() {
+		response.Modules = append(response.Modules, &terrariumModule.ModuleDetails{
+			Name:        fmt.Sprintf("%s/%s/%s", module.GetOrganization(), module.GetName(), module.GetProvider()),
+			Description: module.GetDescription(),
+			Source:      module.GetSourceUrl(),
+			Maturity:    module.GetMaturity(),
+		})
+	}
+	sort.Slice(response.Modules, func(i, j int) bool { return response.Modules[i].GetName() < response.Modules[j].GetName() })
+
+	log.Println("Done <= Registrar")
+	span.AddEvent("Successfully listed modules with Client.")
+	return response, nil
+}
+
+// ListModuleVersions lists the published versions of a module with Version manager service
+func (gw *TerrariumGrpcGateway) ListModuleVersions(ctx context.Context, request *terrariumModule.ListModuleVersionsRequest) (*terrariumModule.ListModuleVersionsResponse, error) {
+	return gw.ListModuleVersionsWithClient(ctx, request, gw.moduleVersionManagerClient)
+}
+
+// ListModuleVersionsWithClient calls ListModuleVersions on Version manager client and keeps the versions in range
+func (gw *TerrariumGrpcGateway) ListModuleVersionsWithClient(ctx context.Context, request *terrariumModule.ListModuleVersionsRequest, client moduleServices.VersionManagerClient) (*terrariumModule.ListModuleVersionsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetName()),
+		attribute.String("module.version_range", request.GetVersionRange()),
+	)
+
+	inRange, err := versionFilter(request.GetVersionRange())
+	if err != nil {
+		span.RecordError(err)
+		return nil, InvalidVersionRangeError
+	}
+
+	res, err := client.ListModuleVersions(ctx, &moduleServices.ListModuleVersionsRequest{Module: request.GetName()})
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	response := &terrariumModule.ListModuleVersionsResponse{Name: request.GetName()}
+	for _, version := range res.GetVersions() {
+		if inRange(version) {
+			response.Versions = append(response.Versions, &terrariumModule.ModuleVersion{
+				Version:  version,
+				Maturity: res.GetMaturities()[version],
+			})
+		}
+	}
+
+	log.Println("Done <= Version Manager")
+	span.AddEvent("Successfully listed module versions with Client.")
+	return response, nil
+}
+
+// versionFilter returns a function reporting whether a version is within a version range, any version being within
+// an empty range.
+func versionFilter(versionRange string) (func(string) bool, error) {
+	if versionRange == "" {
+		return func(string) bool { return true }, nil
+	}
+	allowed, err := versions.MeetingConstraintsStringRuby(versionRange)
+	if err != nil {
+		return nil, err
+	}
+	return func(v string) bool {
+		parsed, err := versions.ParseVersion(strings.TrimPrefix(v, "v"))
+		return err == nil && allowed.Has(parsed)
+	}, nil
+}
+
 // Publish a new release with Release service
 func (gw *TerrariumGrpcGateway) Publish(ctx context.Context, request *releasePkg.PublishRequest) (*releasePkg.PublishResponse, error) {
 	return gw.PublishWithClient(ctx, request, gw.releasePublisherClient)
@@ -464,6 +916,7 @@ func (gw *TerrariumGrpcGateway) PublishWithClient(ctx context.Context, request *
 // RegisteProviderrWithServer registers TerrariumGrpcGateway with grpc server
 func (gw *TerrariumGrpcGateway) RegisterProviderWithServer(grpcServer grpc.ServiceRegistrar) error {
 	terrariumProvider.RegisterProviderPublisherServer(grpcServer, gw)
+	terrariumProvider.RegisterProviderConsumerServer(grpcServer, gw)
 	return nil
 }
 
@@ -524,7 +977,7 @@ func (gw *TerrariumGrpcGateway) EndProviderWithClient(ctx context.Context, reque
 		}
 	} else if request.GetAction() == terrariumProvider.EndProviderRequest_PUBLISH {
 		log.Println("Pubish Provider => Version Manager")
-		if res, delegateError := client.PublishVersion(ctx, &publishRequest); delegateError != nil {
+		if res, delegateError := client.PublishVersion(gw.Identity.Forward(ctx), &publishRequest); delegateError != nil {
 			log.Printf("Failed: %v", delegateError)
 			span.RecordError(delegateError)
 			return nil, delegateError
@@ -538,6 +991,58 @@ func (gw *TerrariumGrpcGateway) EndProviderWithClient(ctx context.Context, reque
 	}
 }
 
+// ApproveProviderVersion publishes a provider version pending approval with Version Manager service
+func (gw *TerrariumGrpcGateway) ApproveProviderVersion(ctx context.Context, request *terrariumProvider.ReviewProviderVersionRequest) (*terrariumProvider.Response, error) {
+	return gw.ApproveProviderVersionWithClient(ctx, request, gw.providerVersionManagerClient)
+}
+
+// ApproveProviderVersionWithClient calls ApproveVersion on Version Manager client
+func (gw *TerrariumGrpcGateway) ApproveProviderVersionWithClient(ctx context.Context, request *terrariumProvider.ReviewProviderVersionRequest, client providerServices.VersionManagerClient) (*terrariumProvider.Response, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.name", request.GetProvider().GetName()),
+		attribute.String("provider.version", request.GetProvider().GetVersion()),
+		attribute.String("approval.reviewer", gw.Identity.FromIncomingContext(ctx)),
+	)
+
+	log.Println("Approve provider version => Version Manager")
+	if res, delegateError := client.ApproveVersion(gw.Identity.Forward(ctx), request); delegateError != nil {
+		log.Printf("Failed: %v", delegateError)
+		span.RecordError(delegateError)
+		return nil, delegateError
+	} else {
+		log.Println("Done <= Version Manager")
+		span.AddEvent("Successful call to ApproveProviderVersion.")
+		return res, nil
+	}
+}
+
+// RejectProviderVersion rejects a provider version pending approval with Version Manager service
+func (gw *TerrariumGrpcGateway) RejectProviderVersion(ctx context.Context, request *terrariumProvider.ReviewProviderVersionRequest) (*terrariumProvider.Response, error) {
+	return gw.RejectProviderVersionWithClient(ctx, request, gw.providerVersionManagerClient)
+}
+
+// RejectProviderVersionWithClient calls RejectVersion on Version Manager client
+func (gw *TerrariumGrpcGateway) RejectProviderVersionWithClient(ctx context.Context, request *terrariumProvider.ReviewProviderVersionRequest, client providerServices.VersionManagerClient) (*terrariumProvider.Response, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.name", request.GetProvider().GetName()),
+		attribute.String("provider.version", request.GetProvider().GetVersion()),
+		attribute.String("approval.reviewer", gw.Identity.FromIncomingContext(ctx)),
+	)
+
+	log.Println("Reject provider version => Version Manager")
+	if res, delegateError := client.RejectVersion(gw.Identity.Forward(ctx), request); delegateError != nil {
+		log.Printf("Failed: %v", delegateError)
+		span.RecordError(delegateError)
+		return nil, delegateError
+	} else {
+		log.Println("Done <= Version Manager")
+		span.AddEvent("Successful call to RejectProviderVersion.")
+		return res, nil
+	}
+}
+
 // UploadProviderBinaryZip uploads provider binary zip to Storage service
 func (gw *TerrariumGrpcGateway) UploadProviderBinaryZip(server terrariumProvider.ProviderPublisher_UploadProviderBinaryZipServer) error {
 	return gw.UploadProviderBinaryZipWithClient(server, gw.providerStorageClient)
@@ -690,3 +1195,277 @@ func (gw *TerrariumGrpcGateway) UploadShasumSignatureWithClient(server terrarium
 		}
 	}
 }
+
+// ListProviders lists the providers of an organization with Version manager service
+func (gw *TerrariumGrpcGateway) ListProviders(ctx context.Context, request *terrariumProvider.ListProvidersRequest) (*terrariumProvider.ListProvidersResponse, error) {
+	return gw.ListProvidersWithClient(ctx, request, gw.providerVersionManagerClient)
+}
+
+// ListProvidersWithClient calls ListProviders on Version manager client, without paging so that every provider is
+// listed, and keeps the providers of the requested organization
+func (gw *TerrariumGrpcGateway) ListProvidersWithClient(ctx context.Context, request *terrariumProvider.ListProvidersRequest, client providerServices.VersionManagerClient) (*terrariumProvider.ListProvidersResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.organization", request.GetOrganization()),
+	)
+
+	res, err := client.ListProviders(ctx, &providerServices.ListProvidersRequest{})
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	response := &terrariumProvider.ListProvidersResponse{}
+	for _, provider := range res.GetProviders() {
+		if request.GetOrganization() != "" && !strings.EqualFold(provider.GetOrganization(), request.GetOrganization()) {
+			continue
+		}
+		response.Providers = append(response.Providers, &terrariumProvider.ProviderDetails{
+			Name:          fmt.Sprintf("%s/%s", provider.GetOrganization(), provider.GetName()),
+			Description:   provider.GetDescription(),
+			SourceRepoUrl: provider.GetSourceRepoUrl(),
+			Maturity:      provider.GetMaturity(),
+		})
+	}
+	sort.Slice(response.Providers, func(i, j int) bool { return response.Providers[i].GetName() < response.Providers[j].GetName() })
+
+	log.Println("Done <= Version Manager")
+	span.AddEvent("Successfully listed providers with Client.")
+	return response, nil
+}
+
+// ListProviderVersions lists the published versions of a provider with Version manager service
+func (gw *TerrariumGrpcGateway) ListProviderVersions(ctx context.Context, request *terrariumProvider.ListProviderVersionsRequest) (*terrariumProvider.ListProviderVersionsResponse, error) {
+	return gw.ListProviderVersionsWithClient(ctx, request, gw.providerVersionManagerClient)
+}
+
+// ListProviderVersionsWithClient calls ListProviderVersions on Version manager client and retrieves the metadata of
+// each platform of the versions in range
+func (gw *TerrariumGrpcGateway) ListProviderVersionsWithClient(ctx context.Context, request *terrariumProvider.ListProviderVersionsRequest, client providerServices.VersionManagerClient) (*terrariumProvider.ListProviderVersionsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.name", request.GetName()),
+		attribute.String("provider.version_range", request.GetVersionRange()),
+	)
+
+	inRange, err := versionFilter(request.GetVersionRange())
+	if err != nil {
+		span.RecordError(err)
+		return nil, InvalidVersionRangeError
+	}
+
+	res, err := client.ListProviderVersions(ctx, &providerServices.ProviderName{Provider: request.GetName()})
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	response := &terrariumProvider.ListProviderVersionsResponse{Name: request.GetName()}
+	for _, item := range res.GetVersions() {
+		if !inRange(item.GetVersion()) {
+			continue
+		}
+		version := &terrariumProvider.ProviderVersion{Version: item.GetVersion(), Protocols: item.GetProtocols()}
+		for _, platform := range item.GetPlatforms() {
+			metadata, err := client.GetVersionData(ctx, &providerServices.VersionDataRequest{
+				Name:    request.GetName(),
+				Version: item.GetVersion(),
+				Os:      platform.GetOs(),
+				Arch:    platform.GetArch(),
+			})
+			if err != nil {
+				log.Println(err)
+				span.RecordError(err)
+				return nil, err
+			}
+			version.Platforms = append(version.Platforms, platformItem(metadata))
+		}
+		response.Versions = append(response.Versions, version)
+	}
+
+	log.Println("Done <= Version Manager")
+	span.AddEvent("Successfully listed provider versions with Client.")
+	return response, nil
+}
+
+func platformItem(metadata *providerServices.PlatformMetadataResponse) *terrariumProvider.PlatformItem {
+	item := &terrariumProvider.PlatformItem{
+		Os:                  metadata.GetOs(),
+		Arch:                metadata.GetArch(),
+		Filename:            metadata.GetFilename(),
+		DownloadUrl:         metadata.GetDownloadUrl(),
+		ShasumsUrl:          metadata.GetShasumsUrl(),
+		ShasumsSignatureUrl: metadata.GetShasumsSignatureUrl(),
+		Shasum:              metadata.GetShasum(),
+		SigningKeys:         &terrariumProvider.SigningKeys{},
+	}
+	for _, key := range metadata.GetSigningKeys().GetGpgPublicKeys() {
+		item.SigningKeys.GpgPublicKeys = append(item.SigningKeys.GpgPublicKeys, &terrariumProvider.GPGPublicKey{
+			KeyId:          key.GetKeyId(),
+			AsciiArmor:     key.GetAsciiArmor(),
+			TrustSignature: key.GetTrustSignature(),
+			Source:         key.GetSource(),
+			SourceUrl:      key.GetSourceUrl(),
+		})
+	}
+	return item
+}
+
+// DownloadProviderBinaryZip downloads the binary zip of a provider platform from Storage service
+func (gw *TerrariumGrpcGateway) DownloadProviderBinaryZip(request *terrariumProvider.DownloadProviderBinaryZipRequest, server terrariumProvider.ProviderConsumer_DownloadProviderBinaryZipServer) error {
+	return gw.DownloadProviderBinaryZipWithClient(request, server, gw.providerStorageClient)
+}
+
+// DownloadProviderBinaryZipWithClient calls DownloadProviderSourceZip on Storage client
+func (gw *TerrariumGrpcGateway) DownloadProviderBinaryZipWithClient(request *terrariumProvider.DownloadProviderBinaryZipRequest, server terrariumProvider.ProviderConsumer_DownloadProviderBinaryZipServer, client providerServices.StorageClient) error {
+	downstream, downErr := client.DownloadProviderSourceZip(server.Context(), &providerServices.DownloadSourceZipRequest{
+		Provider: &providerServices.ProviderRequest{
+			Name:    request.GetProvider().GetName(),
+			Version: request.GetProvider().GetVersion(),
+			Os:      request.GetOs(),
+			Arch:    request.GetArch(),
+		},
+	})
+	ctx := server.Context()
+	span := trace.SpanFromContext(ctx)
+
+	if downErr != nil {
+		log.Println(downErr)
+		span.RecordError(downErr)
+		return downErr
+	}
+
+	for {
+		res, downErr := downstream.Recv()
+
+		if downErr == io.EOF {
+			log.Println("Done <= Storage")
+			span.AddEvent("Success. End of file. No more input is available.")
+			return nil
+		}
+
+		if downErr != nil {
+			log.Printf("Failed to recieve: %v", downErr)
+			span.RecordError(downErr)
+			return downErr
+		}
+
+		if err := server.Send(&terrariumProvider.ProviderBinaryZipResponse{ZipDataChunk: res.GetZipDataChunk()}); err != nil {
+			log.Printf("Failed to send: %v", err)
+			span.RecordError(err)
+			downstream.CloseSend()
+			return providerStorage.SendSourceZipError
+		}
+	}
+}
+
+// DownloadShasum downloads the shasum file of a provider version from Storage service
+func (gw *TerrariumGrpcGateway) DownloadShasum(request *terrariumProvider.DownloadShasumRequest, server terrariumProvider.ProviderConsumer_DownloadShasumServer) error {
+	return gw.DownloadShasumWithClient(request, server, gw.providerStorageClient)
+}
+
+// DownloadShasumWithClient calls DownloadShasum on Storage client
+func (gw *TerrariumGrpcGateway) DownloadShasumWithClient(request *terrariumProvider.DownloadShasumRequest, server terrariumProvider.ProviderConsumer_DownloadShasumServer, client providerServices.StorageClient) error {
+	downstream, err := client.DownloadShasum(server.Context(), &providerServices.DownloadShasumRequest{Provider: request.GetProvider()})
+	if err != nil {
+		log.Println(err)
+		trace.SpanFromContext(server.Context()).RecordError(err)
+		return err
+	}
+	return forwardShasum(server, downstream)
+}
+
+// DownloadShasumSignature downloads the shasum signature file of a provider version from Storage service
+func (gw *TerrariumGrpcGateway) DownloadShasumSignature(request *terrariumProvider.DownloadShasumRequest, server terrariumProvider.ProviderConsumer_DownloadShasumSignatureServer) error {
+	return gw.DownloadShasumSignatureWithClient(request, server, gw.providerStorageClient)
+}
+
+// DownloadShasumSignatureWithClient calls DownloadShasumSignature on Storage client
+func (gw *TerrariumGrpcGateway) DownloadShasumSignatureWithClient(request *terrariumProvider.DownloadShasumRequest, server terrariumProvider.ProviderConsumer_DownloadShasumSignatureServer, client providerServices.StorageClient) error {
+	downstream, err := client.DownloadShasumSignature(server.Context(), &providerServices.DownloadShasumRequest{Provider: request.GetProvider()})
+	if err != nil {
+		log.Println(err)
+		trace.SpanFromContext(server.Context()).RecordError(err)
+		return err
+	}
+	return forwardShasum(server, downstream)
+}
+
+// forwardShasum forwards the chunks of a shasum file, or of its signature, received from Storage client
+func forwardShasum(server terrariumProvider.ProviderConsumer_DownloadShasumServer, downstream providerServices.Storage_DownloadShasumClient) error {
+	span := trace.SpanFromContext(server.Context())
+
+	for {
+		res, downErr := downstream.Recv()
+
+		if downErr == io.EOF {
+			log.Println("Done <= Storage")
+			span.AddEvent("Success. End of file. No more input is available.")
+			return nil
+		}
+
+		if downErr != nil {
+			log.Printf("Failed to recieve: %v", downErr)
+			span.RecordError(downErr)
+			return downErr
+		}
+
+		if err := server.Send(&terrariumProvider.ShasumResponse{ShasumDataChunk: res.GetShasumDataChunk()}); err != nil {
+			log.Printf("Failed to send: %v", err)
+			span.RecordError(err)
+			downstream.CloseSend()
+			return providerStorage.SendShasumError
+		}
+	}
+}
+
+// RegisterDeploymentUnit registers a deployment unit with Dependency Tracker service
+func (gw *TerrariumGrpcGateway) RegisterDeploymentUnit(ctx context.Context, request *usagePkg.RegisterDeploymentUnitRequest) (*usagePkg.RegisterDeploymentUnitResponse, error) {
+	return gw.RegisterDeploymentUnitWithClient(ctx, request, gw.dependencyTrackerClient)
+}
+
+// RegisterDeploymentUnitWithClient calls RegisterDeploymentUnit on Dependency Tracker client
+func (gw *TerrariumGrpcGateway) RegisterDeploymentUnitWithClient(ctx context.Context, request *usagePkg.RegisterDeploymentUnitRequest, client usage.DependencyTrackerClient) (*usagePkg.RegisterDeploymentUnitResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("unit.type", request.GetUnit().GetType()),
+		attribute.String("unit.organization", request.GetUnit().GetOrganization()),
+		attribute.String("unit.name", request.GetUnit().GetName()),
+	)
+
+	if res, delegateError := client.RegisterDeploymentUnit(ctx, request); delegateError != nil {
+		log.Printf("Failed: %v", delegateError)
+		span.RecordError(delegateError)
+		return nil, delegateError
+	} else {
+		log.Println("Done <= Dependency Tracker")
+		return res, nil
+	}
+}
+
+// NotifyUsage reports the dependencies of a deployment unit version to Dependency Tracker service
+func (gw *TerrariumGrpcGateway) NotifyUsage(ctx context.Context, request *usagePkg.NotifyDependencyRequest) (*usagePkg.NotifyDependencyResponse, error) {
+	return gw.NotifyUsageWithClient(ctx, request, gw.dependencyTrackerClient)
+}
+
+// NotifyUsageWithClient calls NotifyUsage on Dependency Tracker client
+func (gw *TerrariumGrpcGateway) NotifyUsageWithClient(ctx context.Context, request *usagePkg.NotifyDependencyRequest, client usage.DependencyTrackerClient) (*usagePkg.NotifyDependencyResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("unit.type", request.GetUnit().GetUnit().GetType()),
+		attribute.String("unit.organization", request.GetUnit().GetUnit().GetOrganization()),
+		attribute.String("unit.name", request.GetUnit().GetUnit().GetName()),
+		attribute.String("unit.version", request.GetUnit().GetVersion()),
+	)
+
+	if res, delegateError := client.NotifyUsage(ctx, request); delegateError != nil {
+		log.Printf("Failed: %v", delegateError)
+		span.RecordError(delegateError)
+		return nil, delegateError
+	} else {
+		log.Println("Done <= Dependency Tracker")
+		return res, nil
+	}
+}
@@ -4,17 +4,26 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net"
+	"reflect"
 	"testing"
 
+	"github.com/terrariumcloud/terrarium/internal/common/identity"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
 	"github.com/terrariumcloud/terrarium/internal/module/services/mocks"
 	"github.com/terrariumcloud/terrarium/internal/module/services/storage"
+	providerServices "github.com/terrariumcloud/terrarium/internal/provider/services"
 	providerMocks "github.com/terrariumcloud/terrarium/internal/provider/services/mocks"
 	providerStorage "github.com/terrariumcloud/terrarium/internal/provider/services/storage"
 	releaseMocks "github.com/terrariumcloud/terrarium/internal/release/services/mocks"
+	usageMocks "github.com/terrariumcloud/terrarium/internal/usage/services/mocks"
 
 	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
 	terrariumProvider "github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
 	"github.com/terrariumcloud/terrarium/pkg/terrarium/release"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // Test_RegisterWithClient checks:
@@ -103,6 +112,195 @@ func Test_BeginVersionWithClient(t *testing.T) {
 	})
 }
 
+// Test_InheritModuleMaturity checks:
+// - if the module maturity is used when the request has none
+// - if the requested maturity is kept
+// - if the request is left unchanged when the registrar fails
+func Test_InheritModuleMaturity(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when request has no maturity", func(t *testing.T) {
+		client := &mocks.MockRegistrarClient{GetModuleResponse: &services.GetModuleResponse{
+			Module: &services.ModuleMetadata{Maturity: module.Maturity_BETA},
+		}}
+		gw := &TerrariumGrpcGateway{}
+		req := &module.BeginVersionRequest{Module: &module.Module{Name: "org/name/aws", Version: "1.0.0"}}
+
+		gw.InheritModuleMaturity(context.TODO(), req, client)
+
+		if req.Maturity == nil || *req.Maturity != module.Maturity_BETA {
+			t.Errorf("Expected maturity %v, got %v.", module.Maturity_BETA, req.Maturity)
+		}
+	})
+
+	t.Run("when request has a maturity", func(t *testing.T) {
+		client := &mocks.MockRegistrarClient{}
+		gw := &TerrariumGrpcGateway{}
+		maturity := module.Maturity_STABLE
+		req := &module.BeginVersionRequest{Module: &module.Module{Name: "org/name/aws", Version: "1.0.0"}, Maturity: &maturity}
+
+		gw.InheritModuleMaturity(context.TODO(), req, client)
+
+		if client.GetModuleInvocations != 0 {
+			t.Errorf("Expected no calls to GetModule, got %v", client.GetModuleInvocations)
+		}
+
+		if *req.Maturity != module.Maturity_STABLE {
+			t.Errorf("Expected maturity %v, got %v.", module.Maturity_STABLE, *req.Maturity)
+		}
+	})
+
+	t.Run("when registrar returns error", func(t *testing.T) {
+		client := &mocks.MockRegistrarClient{GetModuleError: errors.New("Test")}
+		gw := &TerrariumGrpcGateway{}
+		req := &module.BeginVersionRequest{Module: &module.Module{Name: "org/name/aws", Version: "1.0.0"}}
+
+		gw.InheritModuleMaturity(context.TODO(), req, client)
+
+		if req.Maturity != nil {
+			t.Errorf("Expected no maturity, got %v.", *req.Maturity)
+		}
+	})
+}
+
+// Test_SetMaturityWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_SetMaturityWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		response := &module.Response{}
+		client := &mocks.MockVersionManagerClient{SetMaturityResponse: response}
+		gw := &TerrariumGrpcGateway{}
+
+		res, err := gw.SetMaturityWithClient(context.TODO(), &module.SetMaturityRequest{}, client)
+
+		if res != response {
+			t.Errorf("Expected %v, got %v.", response, res)
+		}
+
+		if client.SetMaturityInvocations != 1 {
+			t.Errorf("Expected 1 call to SetMaturity, got %v", client.SetMaturityInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		expected := errors.New("Test")
+		client := &mocks.MockVersionManagerClient{SetMaturityError: expected}
+		gw := &TerrariumGrpcGateway{}
+
+		_, actual := gw.SetMaturityWithClient(context.TODO(), &module.SetMaturityRequest{}, client)
+
+		if actual != expected {
+			t.Errorf("Expected %v, got %v.", expected, actual)
+		}
+	})
+}
+
+// Test_ApproveVersionWithClient checks:
+// - if correct response is returned when client returns response
+// - if the identity of the caller is passed on to the client
+// - if an identity not sent by a trusted proxy is dropped
+// - if error is returned when client returns error
+func Test_ApproveVersionWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		response := &module.Response{}
+		client := &mocks.MockVersionManagerClient{ApproveVersionResponse: response}
+		proxy, _ := identity.NewTrust([]string{"10.0.0.0/24"})
+		gw := &TerrariumGrpcGateway{Identity: proxy}
+		ctx := peer.NewContext(context.TODO(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(identity.MetadataKey, "jane"))
+
+		res, err := gw.ApproveVersionWithClient(ctx, &module.ReviewVersionRequest{}, client)
+
+		if res != response {
+			t.Errorf("Expected %v, got %v.", response, res)
+		}
+
+		if md, _ := metadata.FromOutgoingContext(client.ApproveVersionContext); !reflect.DeepEqual(md.Get(identity.MetadataKey), []string{"jane"}) {
+			t.Errorf("Expected the review to be made by jane, got %v.", md)
+		}
+
+		if client.ApproveVersionInvocations != 1 {
+			t.Errorf("Expected 1 call to ApproveVersion, got %v", client.ApproveVersionInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when the identity is not sent by a trusted proxy", func(t *testing.T) {
+		client := &mocks.MockVersionManagerClient{ApproveVersionResponse: &module.Response{}}
+		gw := &TerrariumGrpcGateway{}
+		ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(identity.MetadataKey, "jane"))
+
+		_, _ = gw.ApproveVersionWithClient(ctx, &module.ReviewVersionRequest{}, client)
+
+		if md, _ := metadata.FromOutgoingContext(client.ApproveVersionContext); len(md.Get(identity.MetadataKey)) != 0 {
+			t.Errorf("Expected the identity to be dropped, got %v.", md)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		expected := errors.New("Test")
+		client := &mocks.MockVersionManagerClient{ApproveVersionError: expected}
+		gw := &TerrariumGrpcGateway{}
+
+		_, actual := gw.ApproveVersionWithClient(context.TODO(), &module.ReviewVersionRequest{}, client)
+
+		if actual != expected {
+			t.Errorf("Expected %v, got %v.", expected, actual)
+		}
+	})
+}
+
+// Test_RejectVersionWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_RejectVersionWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		response := &module.Response{}
+		client := &mocks.MockVersionManagerClient{RejectVersionResponse: response}
+		gw := &TerrariumGrpcGateway{}
+
+		res, err := gw.RejectVersionWithClient(context.TODO(), &module.ReviewVersionRequest{}, client)
+
+		if res != response {
+			t.Errorf("Expected %v, got %v.", response, res)
+		}
+
+		if client.RejectVersionInvocations != 1 {
+			t.Errorf("Expected 1 call to RejectVersion, got %v", client.RejectVersionInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		expected := errors.New("Test")
+		client := &mocks.MockVersionManagerClient{RejectVersionError: expected}
+		gw := &TerrariumGrpcGateway{}
+
+		_, actual := gw.RejectVersionWithClient(context.TODO(), &module.ReviewVersionRequest{}, client)
+
+		if actual != expected {
+			t.Errorf("Expected %v, got %v.", expected, actual)
+		}
+	})
+}
+
 // Test_EndVersionWithClient checks:
 // - if correct response is returned when client returns publish response
 // - if error is returned when client returns publish error
@@ -613,53 +811,78 @@ func Test_RegisterContainerDependenciesWithClient(t *testing.T) {
 	})
 }
 
-// Test_RetrieveContainerDependenciesWithClient checks:
-// - if error is returned when client RetrieveContainerDependencies fails
-// - if no error is returned when Recv returns EOF
-// - if error is returned when Recv fails
-// - if error is returned when Send fails
-func Test_RetrieveContainerDependenciesV2WithClient(t *testing.T) {
+// Test_SearchContainerImagesWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_SearchContainerImagesWithClient(t *testing.T) {
 	t.Parallel()
 
-	t.Run("when client RetrieveContainerDependencies fails", func(t *testing.T) {
+	t.Run("when client returns response", func(t *testing.T) {
 		gw := &TerrariumGrpcGateway{}
 
-		request := &module.RetrieveContainerDependenciesRequestV2{}
+		request := &module.SearchContainerImagesRequest{Name: "nginx"}
 
-		server := &mocks.MockConsumer_RetrieveContainerDependenciesServer{}
+		response := &module.SearchContainerImagesResponse{}
 
-		client := &mocks.MockDependencyManagerClient{RetrieveContainerDependenciesError: errors.New("some error")}
+		client := &mocks.MockDependencyManagerClient{SearchContainerImagesResponse: response}
 
-		err := gw.RetrieveContainerDependenciesV2WithClient(request, server, client)
+		actual, err := gw.SearchContainerImagesWithClient(context.TODO(), request, client)
 
-		if client.RetrieveContainerDependenciesInvocations != 1 {
-			t.Errorf("Expected 1 call to RetrieveContainerDependencies, got %v", client.RetrieveContainerDependenciesInvocations)
+		if actual != response {
+			t.Errorf("Expected %v, got %v", response, actual)
+		}
+
+		if client.SearchContainerImagesRequest != request {
+			t.Errorf("Expected the request to be forwarded, got %v", client.SearchContainerImagesRequest)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.SearchContainerImagesRequest{}
+
+		client := &mocks.MockDependencyManagerClient{SearchContainerImagesError: errors.New("some error")}
+
+		_, err := gw.SearchContainerImagesWithClient(context.TODO(), request, client)
+
+		if client.SearchContainerImagesInvocations != 1 {
+			t.Errorf("Expected 1 call to SearchContainerImages, got %v", client.SearchContainerImagesInvocations)
 		}
 
 		if err == nil {
 			t.Errorf("Expected error, got nil.")
 		}
 	})
+}
 
-	t.Run("when client Recv returns EOF", func(t *testing.T) {
-		gw := &TerrariumGrpcGateway{}
+// Test_ExportSBOMWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_ExportSBOMWithClient(t *testing.T) {
+	t.Parallel()
 
-		request := &module.RetrieveContainerDependenciesRequestV2{}
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
 
-		server := &mocks.MockConsumer_RetrieveContainerDependenciesServer{}
+		request := &module.ExportSBOMRequest{Module: &module.Module{Name: "cie/vpc/aws", Version: "1.0.0"}, Format: module.SBOMFormat_SPDX}
 
-		c := &mocks.MockDependencyManager_RetrieveContainerDependenciesClient{RecvError: io.EOF}
+		response := &module.SBOMResponse{MediaType: "application/spdx+json"}
 
-		client := &mocks.MockDependencyManagerClient{RetrieveContainerDependenciesClient: c}
+		client := &mocks.MockDependencyManagerClient{ExportSBOMResponse: response}
 
-		err := gw.RetrieveContainerDependenciesV2WithClient(request, server, client)
+		actual, err := gw.ExportSBOMWithClient(context.TODO(), request, client)
 
-		if c.RecvInvocations != 1 {
-			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		if actual != response {
+			t.Errorf("Expected %v, got %v", response, actual)
 		}
 
-		if client.RetrieveContainerDependenciesInvocations != 1 {
-			t.Errorf("Expected 1 call to RetrieveContainerDependencies, got %v", client.RetrieveContainerDependenciesInvocations)
+		if client.ExportSBOMRequest != request {
+			t.Errorf("Expected the request to be forwarded, got %v", client.ExportSBOMRequest)
 		}
 
 		if err != nil {
@@ -667,110 +890,97 @@ func Test_RetrieveContainerDependenciesV2WithClient(t *testing.T) {
 		}
 	})
 
-	t.Run("when Recv fails", func(t *testing.T) {
+	t.Run("when client returns error", func(t *testing.T) {
 		gw := &TerrariumGrpcGateway{}
 
-		request := &module.RetrieveContainerDependenciesRequestV2{}
-
-		server := &mocks.MockConsumer_RetrieveContainerDependenciesServer{}
-
-		c := &mocks.MockDependencyManager_RetrieveContainerDependenciesClient{RecvError: errors.New("some error")}
-
-		client := &mocks.MockDependencyManagerClient{RetrieveContainerDependenciesClient: c}
+		request := &module.ExportSBOMRequest{}
 
-		err := gw.RetrieveContainerDependenciesV2WithClient(request, server, client)
+		client := &mocks.MockDependencyManagerClient{ExportSBOMError: errors.New("some error")}
 
-		if c.RecvInvocations != 1 {
-			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
-		}
+		_, err := gw.ExportSBOMWithClient(context.TODO(), request, client)
 
-		if client.RetrieveContainerDependenciesInvocations != 1 {
-			t.Errorf("Expected 1 call to RetrieveContainerDependencies, got %v", client.RetrieveContainerDependenciesInvocations)
+		if client.ExportSBOMInvocations != 1 {
+			t.Errorf("Expected 1 call to ExportSBOM, got %v", client.ExportSBOMInvocations)
 		}
 
 		if err == nil {
 			t.Errorf("Expected error, got nil.")
 		}
 	})
+}
 
-	t.Run("when Send fails", func(t *testing.T) {
-		gw := &TerrariumGrpcGateway{}
-
-		request := &module.RetrieveContainerDependenciesRequestV2{}
+// Test_RetrieveDependencyGraphWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_RetrieveDependencyGraphWithClient(t *testing.T) {
+	t.Parallel()
 
-		server := &mocks.MockConsumer_RetrieveContainerDependenciesServer{SendError: errors.New("some error")}
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
 
-		c := &mocks.MockDependencyManager_RetrieveContainerDependenciesClient{}
+		request := &module.RetrieveDependencyGraphRequest{Module: &module.Module{Name: "cie/vpc/aws", Version: "1.0.0"}}
 
-		client := &mocks.MockDependencyManagerClient{RetrieveContainerDependenciesClient: c}
+		response := &module.DependencyGraph{}
 
-		err := gw.RetrieveContainerDependenciesV2WithClient(request, server, client)
+		client := &mocks.MockDependencyManagerClient{RetrieveDependencyGraphResponse: response}
 
-		if client.RetrieveContainerDependenciesInvocations != 1 {
-			t.Errorf("Expected 1 call to RetrieveContainerDependencies, got %v", client.RetrieveContainerDependenciesInvocations)
-		}
+		actual, err := gw.RetrieveDependencyGraphWithClient(context.TODO(), request, client)
 
-		if c.RecvInvocations != 1 {
-			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		if actual != response {
+			t.Errorf("Expected %v, got %v", response, actual)
 		}
 
-		if server.SendInvocations != 1 {
-			t.Errorf("Expected 1 call to Send, got %v", server.SendInvocations)
+		if client.RetrieveDependencyGraphRequest != request {
+			t.Errorf("Expected the request to be forwarded, got %v", client.RetrieveDependencyGraphRequest)
 		}
 
-		if err != ForwardModuleDependenciesError {
-			t.Errorf("Expected %v, got %v.", ForwardModuleDependenciesError, err)
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
 		}
 	})
-}
-
-// Test_RetrieveModuleDependenciesWithClient checks:
-// - if error is returned when client RetrieveModuleDependencies fails
-// - if no error is returned when Recv returns EOF
-// - if error is returned when Recv fails
-// - if error is returned when Send fails
-func Test_RetrieveModuleDependenciesWithClient(t *testing.T) {
-	t.Parallel()
 
-	t.Run("when client RetrieveModuleDependencies fails", func(t *testing.T) {
+	t.Run("when client returns error", func(t *testing.T) {
 		gw := &TerrariumGrpcGateway{}
 
-		request := &module.RetrieveModuleDependenciesRequest{}
-
-		server := &mocks.MockConsumer_RetrieveModuleDependenciesServer{}
+		request := &module.RetrieveDependencyGraphRequest{}
 
-		client := &mocks.MockDependencyManagerClient{RetrieveModuleDependenciesError: errors.New("some error")}
+		client := &mocks.MockDependencyManagerClient{RetrieveDependencyGraphError: errors.New("some error")}
 
-		err := gw.RetrieveModuleDependenciesWithClient(request, server, client)
+		_, err := gw.RetrieveDependencyGraphWithClient(context.TODO(), request, client)
 
-		if client.RetrieveModuleDependenciesInvocations != 1 {
-			t.Errorf("Expected 1 call to RetrieveModuleDependencies, got %v", client.RetrieveModuleDependenciesInvocations)
+		if client.RetrieveDependencyGraphInvocations != 1 {
+			t.Errorf("Expected 1 call to RetrieveDependencyGraph, got %v", client.RetrieveDependencyGraphInvocations)
 		}
 
 		if err == nil {
 			t.Errorf("Expected error, got nil.")
 		}
 	})
+}
 
-	t.Run("when client Recv returns EOF", func(t *testing.T) {
-		gw := &TerrariumGrpcGateway{}
+// Test_RetrieveProviderDependenciesWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_RetrieveProviderDependenciesWithClient(t *testing.T) {
+	t.Parallel()
 
-		request := &module.RetrieveModuleDependenciesRequest{}
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
 
-		server := &mocks.MockConsumer_RetrieveModuleDependenciesServer{}
+		request := &module.RetrieveProviderDependenciesRequest{Module: &module.Module{Name: "cie/vpc/aws", Version: "1.0.0"}}
 
-		c := &mocks.MockDependencyManager_RetrieveModuleDependenciesClient{RecvError: io.EOF}
+		response := &module.ProviderDependenciesResponse{}
 
-		client := &mocks.MockDependencyManagerClient{RetrieveModuleDependenciesClient: c}
+		client := &mocks.MockDependencyManagerClient{RetrieveProviderDependenciesResponse: response}
 
-		err := gw.RetrieveModuleDependenciesWithClient(request, server, client)
+		actual, err := gw.RetrieveProviderDependenciesWithClient(context.TODO(), request, client)
 
-		if c.RecvInvocations != 1 {
-			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		if actual != response {
+			t.Errorf("Expected %v, got %v", response, actual)
 		}
 
-		if client.RetrieveModuleDependenciesInvocations != 1 {
-			t.Errorf("Expected 1 call to RetrieveModuleDependencies, got %v", client.RetrieveModuleDependenciesInvocations)
+		if client.RetrieveProviderDependenciesRequest != request {
+			t.Errorf("Expected the request to be forwarded, got %v", client.RetrieveProviderDependenciesRequest)
 		}
 
 		if err != nil {
@@ -778,16 +988,200 @@ func Test_RetrieveModuleDependenciesWithClient(t *testing.T) {
 		}
 	})
 
-	t.Run("when Recv fails", func(t *testing.T) {
+	t.Run("when client returns error", func(t *testing.T) {
 		gw := &TerrariumGrpcGateway{}
 
-		request := &module.RetrieveModuleDependenciesRequest{}
+		request := &module.RetrieveProviderDependenciesRequest{}
 
-		server := &mocks.MockConsumer_RetrieveModuleDependenciesServer{}
+		client := &mocks.MockDependencyManagerClient{RetrieveProviderDependenciesError: errors.New("some error")}
 
-		c := &mocks.MockDependencyManager_RetrieveModuleDependenciesClient{RecvError: errors.New("some error")}
+		_, err := gw.RetrieveProviderDependenciesWithClient(context.TODO(), request, client)
 
-		client := &mocks.MockDependencyManagerClient{RetrieveModuleDependenciesClient: c}
+		if client.RetrieveProviderDependenciesInvocations != 1 {
+			t.Errorf("Expected 1 call to RetrieveProviderDependencies, got %v", client.RetrieveProviderDependenciesInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_RetrieveContainerDependenciesWithClient checks:
+// - if error is returned when client RetrieveContainerDependencies fails
+// - if no error is returned when Recv returns EOF
+// - if error is returned when Recv fails
+// - if error is returned when Send fails
+func Test_RetrieveContainerDependenciesV2WithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client RetrieveContainerDependencies fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveContainerDependenciesRequestV2{}
+
+		server := &mocks.MockConsumer_RetrieveContainerDependenciesServer{}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveContainerDependenciesError: errors.New("some error")}
+
+		err := gw.RetrieveContainerDependenciesV2WithClient(request, server, client)
+
+		if client.RetrieveContainerDependenciesInvocations != 1 {
+			t.Errorf("Expected 1 call to RetrieveContainerDependencies, got %v", client.RetrieveContainerDependenciesInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+
+	t.Run("when client Recv returns EOF", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveContainerDependenciesRequestV2{}
+
+		server := &mocks.MockConsumer_RetrieveContainerDependenciesServer{}
+
+		c := &mocks.MockDependencyManager_RetrieveContainerDependenciesClient{RecvError: io.EOF}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveContainerDependenciesClient: c}
+
+		err := gw.RetrieveContainerDependenciesV2WithClient(request, server, client)
+
+		if c.RecvInvocations != 1 {
+			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		}
+
+		if client.RetrieveContainerDependenciesInvocations != 1 {
+			t.Errorf("Expected 1 call to RetrieveContainerDependencies, got %v", client.RetrieveContainerDependenciesInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when Recv fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveContainerDependenciesRequestV2{}
+
+		server := &mocks.MockConsumer_RetrieveContainerDependenciesServer{}
+
+		c := &mocks.MockDependencyManager_RetrieveContainerDependenciesClient{RecvError: errors.New("some error")}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveContainerDependenciesClient: c}
+
+		err := gw.RetrieveContainerDependenciesV2WithClient(request, server, client)
+
+		if c.RecvInvocations != 1 {
+			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		}
+
+		if client.RetrieveContainerDependenciesInvocations != 1 {
+			t.Errorf("Expected 1 call to RetrieveContainerDependencies, got %v", client.RetrieveContainerDependenciesInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+
+	t.Run("when Send fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveContainerDependenciesRequestV2{}
+
+		server := &mocks.MockConsumer_RetrieveContainerDependenciesServer{SendError: errors.New("some error")}
+
+		c := &mocks.MockDependencyManager_RetrieveContainerDependenciesClient{}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveContainerDependenciesClient: c}
+
+		err := gw.RetrieveContainerDependenciesV2WithClient(request, server, client)
+
+		if client.RetrieveContainerDependenciesInvocations != 1 {
+			t.Errorf("Expected 1 call to RetrieveContainerDependencies, got %v", client.RetrieveContainerDependenciesInvocations)
+		}
+
+		if c.RecvInvocations != 1 {
+			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		}
+
+		if server.SendInvocations != 1 {
+			t.Errorf("Expected 1 call to Send, got %v", server.SendInvocations)
+		}
+
+		if err != ForwardModuleDependenciesError {
+			t.Errorf("Expected %v, got %v.", ForwardModuleDependenciesError, err)
+		}
+	})
+}
+
+// Test_RetrieveModuleDependenciesWithClient checks:
+// - if error is returned when client RetrieveModuleDependencies fails
+// - if no error is returned when Recv returns EOF
+// - if error is returned when Recv fails
+// - if error is returned when Send fails
+func Test_RetrieveModuleDependenciesWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client RetrieveModuleDependencies fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveModuleDependenciesRequest{}
+
+		server := &mocks.MockConsumer_RetrieveModuleDependenciesServer{}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveModuleDependenciesError: errors.New("some error")}
+
+		err := gw.RetrieveModuleDependenciesWithClient(request, server, client)
+
+		if client.RetrieveModuleDependenciesInvocations != 1 {
+			t.Errorf("Expected 1 call to RetrieveModuleDependencies, got %v", client.RetrieveModuleDependenciesInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+
+	t.Run("when client Recv returns EOF", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveModuleDependenciesRequest{}
+
+		server := &mocks.MockConsumer_RetrieveModuleDependenciesServer{}
+
+		c := &mocks.MockDependencyManager_RetrieveModuleDependenciesClient{RecvError: io.EOF}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveModuleDependenciesClient: c}
+
+		err := gw.RetrieveModuleDependenciesWithClient(request, server, client)
+
+		if c.RecvInvocations != 1 {
+			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		}
+
+		if client.RetrieveModuleDependenciesInvocations != 1 {
+			t.Errorf("Expected 1 call to RetrieveModuleDependencies, got %v", client.RetrieveModuleDependenciesInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when Recv fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveModuleDependenciesRequest{}
+
+		server := &mocks.MockConsumer_RetrieveModuleDependenciesServer{}
+
+		c := &mocks.MockDependencyManager_RetrieveModuleDependenciesClient{RecvError: errors.New("some error")}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveModuleDependenciesClient: c}
 
 		err := gw.RetrieveModuleDependenciesWithClient(request, server, client)
 
@@ -835,6 +1229,115 @@ func Test_RetrieveModuleDependenciesWithClient(t *testing.T) {
 	})
 }
 
+// Test_RetrieveDependentsWithClient checks:
+// - if error is returned when client RetrieveDependents fails
+// - if every response is forwarded until Recv returns EOF
+// - if error is returned when Recv fails
+// - if error is returned when Send fails
+func Test_RetrieveDependentsWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client RetrieveDependents fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveDependentsRequest{}
+
+		server := &mocks.MockConsumer_RetrieveDependentsServer{}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveDependentsError: errors.New("some error")}
+
+		err := gw.RetrieveDependentsWithClient(request, server, client)
+
+		if client.RetrieveDependentsInvocations != 1 {
+			t.Errorf("Expected 1 call to RetrieveDependents, got %v", client.RetrieveDependentsInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+
+	t.Run("when dependents are forwarded", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveDependentsRequest{Module: &module.Module{Name: "cie/vpc/aws"}, Recursive: true}
+
+		server := &mocks.MockConsumer_RetrieveDependentsServer{}
+
+		c := &mocks.MockDependencyManager_RetrieveDependentsClient{
+			RecvResponses: []*module.DependentsResponse{
+				{Module: &module.Module{Name: "cie/vpc/aws"}, Dependents: []*module.Module{{Name: "cie/eks/aws", Version: "3.0.0"}}},
+				{Module: &module.Module{Name: "cie/eks/aws", Version: "3.0.0"}},
+			},
+			RecvError: io.EOF,
+		}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveDependentsClient: c}
+
+		err := gw.RetrieveDependentsWithClient(request, server, client)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if client.RetrieveDependentsRequest != request {
+			t.Errorf("Expected request to be forwarded, got %v", client.RetrieveDependentsRequest)
+		}
+
+		if c.RecvInvocations != 3 {
+			t.Errorf("Expected 3 calls to Recv, got %v", c.RecvInvocations)
+		}
+
+		if server.SendInvocations != 2 {
+			t.Errorf("Expected 2 calls to Send, got %v", server.SendInvocations)
+		}
+	})
+
+	t.Run("when Recv fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveDependentsRequest{}
+
+		server := &mocks.MockConsumer_RetrieveDependentsServer{}
+
+		c := &mocks.MockDependencyManager_RetrieveDependentsClient{RecvError: errors.New("some error")}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveDependentsClient: c}
+
+		err := gw.RetrieveDependentsWithClient(request, server, client)
+
+		if c.RecvInvocations != 1 {
+			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+
+	t.Run("when Send fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.RetrieveDependentsRequest{}
+
+		server := &mocks.MockConsumer_RetrieveDependentsServer{SendError: errors.New("some error")}
+
+		c := &mocks.MockDependencyManager_RetrieveDependentsClient{RecvResponses: []*module.DependentsResponse{{}}}
+
+		client := &mocks.MockDependencyManagerClient{RetrieveDependentsClient: c}
+
+		err := gw.RetrieveDependentsWithClient(request, server, client)
+
+		if server.SendInvocations != 1 {
+			t.Errorf("Expected 1 call to Send, got %v", server.SendInvocations)
+		}
+
+		if err != ForwardModuleDependentsError {
+			t.Errorf("Expected %v, got %v.", ForwardModuleDependentsError, err)
+		}
+	})
+}
+
 // Test_PublishWithClient checks:
 // - if correct response is returned when client returns response
 // - if error is returned when client returns error
@@ -1047,10 +1550,88 @@ func Test_EndProviderWithClient(t *testing.T) {
 	})
 }
 
-// Test_UploadProviderBinaryZipWithClient checks:
-// - if error is returned when client UploadProviderBinaryZip fails
-// - if error is returned when Recv returns EOF and client fails
-// - if no error is returned when Recv returns EOF and both client and server close stream
+// Test_ApproveProviderVersionWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_ApproveProviderVersionWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		response := &terrariumProvider.Response{}
+		client := &mocks.MockProviderVersionManagerClient{ApproveVersionResponse: response}
+		gw := &TerrariumGrpcGateway{}
+
+		res, err := gw.ApproveProviderVersionWithClient(context.TODO(), &terrariumProvider.ReviewProviderVersionRequest{}, client)
+
+		if res != response {
+			t.Errorf("Expected %v, got %v.", response, res)
+		}
+
+		if client.ApproveVersionInvocations != 1 {
+			t.Errorf("Expected 1 call to ApproveVersion, got %v", client.ApproveVersionInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		expected := errors.New("Test")
+		client := &mocks.MockProviderVersionManagerClient{ApproveVersionError: expected}
+		gw := &TerrariumGrpcGateway{}
+
+		_, actual := gw.ApproveProviderVersionWithClient(context.TODO(), &terrariumProvider.ReviewProviderVersionRequest{}, client)
+
+		if actual != expected {
+			t.Errorf("Expected %v, got %v.", expected, actual)
+		}
+	})
+}
+
+// Test_RejectProviderVersionWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_RejectProviderVersionWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		response := &terrariumProvider.Response{}
+		client := &mocks.MockProviderVersionManagerClient{RejectVersionResponse: response}
+		gw := &TerrariumGrpcGateway{}
+
+		res, err := gw.RejectProviderVersionWithClient(context.TODO(), &terrariumProvider.ReviewProviderVersionRequest{}, client)
+
+		if res != response {
+			t.Errorf("Expected %v, got %v.", response, res)
+		}
+
+		if client.RejectVersionInvocations != 1 {
+			t.Errorf("Expected 1 call to RejectVersion, got %v", client.RejectVersionInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		expected := errors.New("Test")
+		client := &mocks.MockProviderVersionManagerClient{RejectVersionError: expected}
+		gw := &TerrariumGrpcGateway{}
+
+		_, actual := gw.RejectProviderVersionWithClient(context.TODO(), &terrariumProvider.ReviewProviderVersionRequest{}, client)
+
+		if actual != expected {
+			t.Errorf("Expected %v, got %v.", expected, actual)
+		}
+	})
+}
+
+// Test_UploadProviderBinaryZipWithClient checks:
+// - if error is returned when client UploadProviderBinaryZip fails
+// - if error is returned when Recv returns EOF and client fails
+// - if no error is returned when Recv returns EOF and both client and server close stream
 // - if error is returned when Recv fails
 // - if no error is returned when Send returns EOF
 // - if error is returned when Send fails
@@ -1571,3 +2152,675 @@ func Test_UploadShasumSignatureWithClient(t *testing.T) {
 		}
 	})
 }
+
+// Test_RegisterDeploymentUnitWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_RegisterDeploymentUnitWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		response := &usage.RegisterDeploymentUnitResponse{}
+		client := &usageMocks.MockDependencyTrackerClient{RegisterDeploymentUnitResponse: response}
+		gw := &TerrariumGrpcGateway{}
+
+		res, err := gw.RegisterDeploymentUnitWithClient(context.TODO(), &usage.RegisterDeploymentUnitRequest{}, client)
+
+		if res != response {
+			t.Errorf("Expected %v, got %v.", response, res)
+		}
+
+		if client.RegisterDeploymentUnitInvocations != 1 {
+			t.Errorf("Expected 1 call to RegisterDeploymentUnit, got %v", client.RegisterDeploymentUnitInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		expected := errors.New("Test")
+		client := &usageMocks.MockDependencyTrackerClient{RegisterDeploymentUnitError: expected}
+		gw := &TerrariumGrpcGateway{}
+
+		_, actual := gw.RegisterDeploymentUnitWithClient(context.TODO(), &usage.RegisterDeploymentUnitRequest{}, client)
+
+		if actual != expected {
+			t.Errorf("Expected %v, got %v.", expected, actual)
+		}
+
+		if client.RegisterDeploymentUnitInvocations != 1 {
+			t.Errorf("Expected 1 call to RegisterDeploymentUnit, got %v", client.RegisterDeploymentUnitInvocations)
+		}
+	})
+}
+
+// Test_NotifyUsageWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_NotifyUsageWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		response := &usage.NotifyDependencyResponse{}
+		client := &usageMocks.MockDependencyTrackerClient{NotifyUsageResponse: response}
+		gw := &TerrariumGrpcGateway{}
+
+		res, err := gw.NotifyUsageWithClient(context.TODO(), &usage.NotifyDependencyRequest{}, client)
+
+		if res != response {
+			t.Errorf("Expected %v, got %v.", response, res)
+		}
+
+		if client.NotifyUsageInvocations != 1 {
+			t.Errorf("Expected 1 call to NotifyUsage, got %v", client.NotifyUsageInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		expected := errors.New("Test")
+		client := &usageMocks.MockDependencyTrackerClient{NotifyUsageError: expected}
+		gw := &TerrariumGrpcGateway{}
+
+		_, actual := gw.NotifyUsageWithClient(context.TODO(), &usage.NotifyDependencyRequest{}, client)
+
+		if actual != expected {
+			t.Errorf("Expected %v, got %v.", expected, actual)
+		}
+
+		if client.NotifyUsageInvocations != 1 {
+			t.Errorf("Expected 1 call to NotifyUsage, got %v", client.NotifyUsageInvocations)
+		}
+	})
+}
+
+// Test_AddTagsWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_AddTagsWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{"network"}}
+
+		response := &module.Response{Message: "Tags added."}
+
+		client := &mocks.MockTagManagerClient{AddTagsResponse: response}
+
+		actual, err := gw.AddTagsWithClient(context.TODO(), request, client)
+
+		if actual != response {
+			t.Errorf("Expected %v, got %v", response, actual)
+		}
+
+		if client.AddTagsRequest != request {
+			t.Errorf("Expected the request to be forwarded, got %v", client.AddTagsRequest)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.PublishTagRequest{}
+
+		client := &mocks.MockTagManagerClient{AddTagsError: errors.New("some error")}
+
+		_, err := gw.AddTagsWithClient(context.TODO(), request, client)
+
+		if client.AddTagsInvocations != 1 {
+			t.Errorf("Expected 1 call to AddTags, got %v", client.AddTagsInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_RemoveTagsWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_RemoveTagsWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{"network"}}
+
+		response := &module.Response{Message: "Tags removed."}
+
+		client := &mocks.MockTagManagerClient{RemoveTagsResponse: response}
+
+		actual, err := gw.RemoveTagsWithClient(context.TODO(), request, client)
+
+		if actual != response {
+			t.Errorf("Expected %v, got %v", response, actual)
+		}
+
+		if client.RemoveTagsRequest != request {
+			t.Errorf("Expected the request to be forwarded, got %v", client.RemoveTagsRequest)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.PublishTagRequest{}
+
+		client := &mocks.MockTagManagerClient{RemoveTagsError: errors.New("some error")}
+
+		_, err := gw.RemoveTagsWithClient(context.TODO(), request, client)
+
+		if client.RemoveTagsInvocations != 1 {
+			t.Errorf("Expected 1 call to RemoveTags, got %v", client.RemoveTagsInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_GetTagsWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_GetTagsWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.GetTagsRequest{Name: "cie/vpc/aws"}
+
+		response := &module.ModuleTags{Name: "cie/vpc/aws", Tags: []string{"network"}}
+
+		client := &mocks.MockTagManagerClient{GetTagsResponse: response}
+
+		actual, err := gw.GetTagsWithClient(context.TODO(), request, client)
+
+		if actual != response {
+			t.Errorf("Expected %v, got %v", response, actual)
+		}
+
+		if client.GetTagsRequest != request {
+			t.Errorf("Expected the request to be forwarded, got %v", client.GetTagsRequest)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.GetTagsRequest{}
+
+		client := &mocks.MockTagManagerClient{GetTagsError: errors.New("some error")}
+
+		_, err := gw.GetTagsWithClient(context.TODO(), request, client)
+
+		if client.GetTagsInvocations != 1 {
+			t.Errorf("Expected 1 call to GetTags, got %v", client.GetTagsInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_ListModulesByTagWithClient checks:
+// - if correct response is returned when client returns response
+// - if error is returned when client returns error
+func Test_ListModulesByTagWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.ListModulesByTagRequest{Tag: "network"}
+
+		response := &module.ListModulesByTagResponse{Tag: "network", Modules: []string{"cie/vpc/aws"}}
+
+		client := &mocks.MockTagManagerClient{ListModulesByTagResponse: response}
+
+		actual, err := gw.ListModulesByTagWithClient(context.TODO(), request, client)
+
+		if actual != response {
+			t.Errorf("Expected %v, got %v", response, actual)
+		}
+
+		if client.ListModulesByTagRequest != request {
+			t.Errorf("Expected the request to be forwarded, got %v", client.ListModulesByTagRequest)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.ListModulesByTagRequest{}
+
+		client := &mocks.MockTagManagerClient{ListModulesByTagError: errors.New("some error")}
+
+		_, err := gw.ListModulesByTagWithClient(context.TODO(), request, client)
+
+		if client.ListModulesByTagInvocations != 1 {
+			t.Errorf("Expected 1 call to ListModulesByTag, got %v", client.ListModulesByTagInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_ListModulesWithClient checks:
+// - if the modules of the organization are listed by full name, sorted, without paging
+// - if error is returned when client returns error
+func Test_ListModulesWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.ListModulesRequest{Organization: "cie"}
+
+		client := &mocks.MockRegistrarClient{ListModulesResponse: &services.ListModulesResponse{
+			Modules: []*services.ModuleMetadata{
+				{Organization: "cie", Name: "vpc", Provider: "aws", Description: "VPC", SourceUrl: "https://example.com/vpc", Maturity: module.Maturity_STABLE},
+				{Organization: "cie", Name: "eks", Provider: "aws"},
+			},
+		}}
+
+		actual, err := gw.ListModulesWithClient(context.TODO(), request, client)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if client.ListModulesRequest.GetOrganization() != "cie" || client.ListModulesRequest.Page != nil {
+			t.Errorf("Expected the organization to be requested without paging, got %v", client.ListModulesRequest)
+		}
+
+		if len(actual.GetModules()) != 2 || actual.GetModules()[0].GetName() != "cie/eks/aws" {
+			t.Fatalf("Expected the modules sorted by name, got %v", actual.GetModules())
+		}
+
+		vpc := actual.GetModules()[1]
+		if vpc.GetName() != "cie/vpc/aws" || vpc.GetDescription() != "VPC" || vpc.GetSource() != "https://example.com/vpc" || vpc.GetMaturity() != module.Maturity_STABLE {
+			t.Errorf("Expected the details of cie/vpc/aws, got %v", vpc)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.ListModulesRequest{}
+
+		client := &mocks.MockRegistrarClient{ListModulesError: errors.New("some error")}
+
+		_, err := gw.ListModulesWithClient(context.TODO(), request, client)
+
+		if client.ListModulesInvocations != 1 {
+			t.Errorf("Expected 1 call to ListModules, got %v", client.ListModulesInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_ListModuleVersionsWithClient checks:
+// - if the versions in range are listed with their maturity
+// - if InvalidVersionRangeError is returned for a range that cannot be parsed
+// - if error is returned when client returns error
+func Test_ListModuleVersionsWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.ListModuleVersionsRequest{Name: "cie/vpc/aws", VersionRange: ">= 1.1.0, < 2.0.0"}
+
+		client := &mocks.MockVersionManagerClient{ListModuleVersionsResponse: &services.ListModuleVersionsResponse{
+			Versions:   []string{"1.0.0", "1.1.0", "v1.2.0", "2.0.0"},
+			Maturities: map[string]module.Maturity{"1.1.0": module.Maturity_STABLE},
+		}}
+
+		actual, err := gw.ListModuleVersionsWithClient(context.TODO(), request, client)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if client.ListModuleVersionsRequest.GetModule() != "cie/vpc/aws" {
+			t.Errorf("Expected the versions of cie/vpc/aws to be requested, got %v", client.ListModuleVersionsRequest)
+		}
+
+		versions := actual.GetVersions()
+		if len(versions) != 2 || versions[0].GetVersion() != "1.1.0" || versions[0].GetMaturity() != module.Maturity_STABLE || versions[1].GetVersion() != "v1.2.0" {
+			t.Errorf("Expected 1.1.0 and v1.2.0, got %v", versions)
+		}
+	})
+
+	t.Run("when the version range is invalid", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.ListModuleVersionsRequest{Name: "cie/vpc/aws", VersionRange: "not a range"}
+
+		client := &mocks.MockVersionManagerClient{}
+
+		_, err := gw.ListModuleVersionsWithClient(context.TODO(), request, client)
+
+		if err != InvalidVersionRangeError {
+			t.Errorf("Expected %v, got %v.", InvalidVersionRangeError, err)
+		}
+
+		if client.ListModuleVersionsInvocations != 0 {
+			t.Errorf("Expected no call to ListModuleVersions, got %v", client.ListModuleVersionsInvocations)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &module.ListModuleVersionsRequest{}
+
+		client := &mocks.MockVersionManagerClient{ListModuleVersionsError: errors.New("some error")}
+
+		_, err := gw.ListModuleVersionsWithClient(context.TODO(), request, client)
+
+		if client.ListModuleVersionsInvocations != 1 {
+			t.Errorf("Expected 1 call to ListModuleVersions, got %v", client.ListModuleVersionsInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_ListProvidersWithClient checks:
+// - if only the providers of the organization are listed by full name
+// - if error is returned when client returns error
+func Test_ListProvidersWithClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &terrariumProvider.ListProvidersRequest{Organization: "cie"}
+
+		client := &mocks.MockProviderVersionManagerClient{ListProvidersResponse: &providerServices.ListProvidersResponse{
+			Providers: []*providerServices.ListProviderItem{
+				{Organization: "cie", Name: "test", Description: "Test", Maturity: terrariumProvider.Maturity_BETA},
+				{Organization: "other", Name: "test"},
+			},
+		}}
+
+		actual, err := gw.ListProvidersWithClient(context.TODO(), request, client)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		providers := actual.GetProviders()
+		if len(providers) != 1 || providers[0].GetName() != "cie/test" || providers[0].GetDescription() != "Test" || providers[0].GetMaturity() != terrariumProvider.Maturity_BETA {
+			t.Errorf("Expected cie/test only, got %v", providers)
+		}
+	})
+
+	t.Run("when client returns error", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &terrariumProvider.ListProvidersRequest{}
+
+		client := &mocks.MockProviderVersionManagerClient{ListProvidersError: errors.New("some error")}
+
+		_, err := gw.ListProvidersWithClient(context.TODO(), request, client)
+
+		if client.ListProvidersInvocations != 1 {
+			t.Errorf("Expected 1 call to ListProviders, got %v", client.ListProvidersInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_ListProviderVersionsWithClient checks:
+// - if the versions in range are listed with the metadata of each of their platforms
+// - if error is returned when client ListProviderVersions fails
+// - if error is returned when client GetVersionData fails
+func Test_ListProviderVersionsWithClient(t *testing.T) {
+	t.Parallel()
+
+	versions := &providerServices.ProviderVersionsResponse{
+		Versions: []*providerServices.VersionItem{
+			{Version: "1.0.0", Protocols: []string{"5.0"}, Platforms: []*providerServices.Platform{{Os: "linux", Arch: "amd64"}, {Os: "darwin", Arch: "arm64"}}},
+			{Version: "2.0.0", Platforms: []*providerServices.Platform{{Os: "linux", Arch: "amd64"}}},
+		},
+	}
+
+	t.Run("when client returns response", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &terrariumProvider.ListProviderVersionsRequest{Name: "cie/test", VersionRange: "~> 1.0"}
+
+		metadata := &providerServices.PlatformMetadataResponse{Os: "linux", Arch: "amd64", Shasum: "5f9c", SigningKeys: &providerServices.SigningKeys{
+			GpgPublicKeys: []*providerServices.GPGPublicKey{{KeyId: "ABC", AsciiArmor: "KEY"}},
+		}}
+
+		client := &mocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: versions, GetVersionDataResponse: metadata}
+
+		actual, err := gw.ListProviderVersionsWithClient(context.TODO(), request, client)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(actual.GetVersions()) != 1 || actual.GetVersions()[0].GetVersion() != "1.0.0" {
+			t.Fatalf("Expected version 1.0.0 only, got %v", actual.GetVersions())
+		}
+
+		if client.GetVersionDataInvocations != 2 || client.GetVersionDataRequests[1].GetOs() != "darwin" {
+			t.Errorf("Expected the metadata of each platform, got %v", client.GetVersionDataRequests)
+		}
+
+		platform := actual.GetVersions()[0].GetPlatforms()[0]
+		if platform.GetShasum() != "5f9c" || platform.GetSigningKeys().GetGpgPublicKeys()[0].GetKeyId() != "ABC" {
+			t.Errorf("Expected the metadata of the platform, got %v", platform)
+		}
+	})
+
+	t.Run("when client ListProviderVersions fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &terrariumProvider.ListProviderVersionsRequest{}
+
+		client := &mocks.MockProviderVersionManagerClient{ListProviderVersionsError: errors.New("some error")}
+
+		_, err := gw.ListProviderVersionsWithClient(context.TODO(), request, client)
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+
+	t.Run("when client GetVersionData fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		request := &terrariumProvider.ListProviderVersionsRequest{Name: "cie/test"}
+
+		client := &mocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: versions, GetVersionDataError: errors.New("some error")}
+
+		_, err := gw.ListProviderVersionsWithClient(context.TODO(), request, client)
+
+		if client.GetVersionDataInvocations != 1 {
+			t.Errorf("Expected 1 call to GetVersionData, got %v", client.GetVersionDataInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_DownloadProviderBinaryZipWithClient checks:
+// - if error is returned when client DownloadProviderSourceZip fails
+// - if no error is returned when client Recv returns EOF
+// - if SendSourceZipError is returned when Send fails
+func Test_DownloadProviderBinaryZipWithClient(t *testing.T) {
+	t.Parallel()
+
+	request := &terrariumProvider.DownloadProviderBinaryZipRequest{Provider: &terrariumProvider.Provider{Name: "cie/test", Version: "1.0.0"}, Os: "linux", Arch: "amd64"}
+
+	t.Run("when client DownloadProviderSourceZip fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		server := &providerMocks.MockProviderConsumer_DownloadProviderBinaryZipServer{}
+
+		client := &providerMocks.MockProviderStorageClient{DownloadSourceZipError: errors.New("some error")}
+
+		err := gw.DownloadProviderBinaryZipWithClient(request, server, client)
+
+		if client.DownloadSourceZipInvocations != 1 {
+			t.Errorf("Expected 1 call to DownloadProviderSourceZip, got %v", client.DownloadSourceZipInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+
+	t.Run("when client Recv returns EOF", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		server := &providerMocks.MockProviderConsumer_DownloadProviderBinaryZipServer{}
+
+		c := &providerMocks.MockStorage_DownloadProviderSourceZipClient{RecvError: io.EOF}
+
+		client := &providerMocks.MockProviderStorageClient{DownloadSourceZipClient: c}
+
+		err := gw.DownloadProviderBinaryZipWithClient(request, server, client)
+
+		if c.RecvInvocations != 1 {
+			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when Send fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		server := &providerMocks.MockProviderConsumer_DownloadProviderBinaryZipServer{SendError: errors.New("some error")}
+
+		c := &providerMocks.MockStorage_DownloadProviderSourceZipClient{RecvResponse: &providerServices.SourceZipResponse{ZipDataChunk: []byte("PK")}}
+
+		client := &providerMocks.MockProviderStorageClient{DownloadSourceZipClient: c}
+
+		err := gw.DownloadProviderBinaryZipWithClient(request, server, client)
+
+		if server.SendInvocations != 1 || string(server.SendResponse.GetZipDataChunk()) != "PK" {
+			t.Errorf("Expected the chunk to be sent, got %v", server.SendResponse)
+		}
+
+		if err != providerStorage.SendSourceZipError {
+			t.Errorf("Expected %v, got %v.", providerStorage.SendSourceZipError, err)
+		}
+	})
+}
+
+// Test_DownloadShasumWithClient checks:
+// - if error is returned when client DownloadShasum fails
+// - if no error is returned when client Recv returns EOF
+// - if SendShasumError is returned when Send fails
+func Test_DownloadShasumWithClient(t *testing.T) {
+	t.Parallel()
+
+	request := &terrariumProvider.DownloadShasumRequest{Provider: &terrariumProvider.Provider{Name: "cie/test", Version: "1.0.0"}}
+
+	t.Run("when client DownloadShasum fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		server := &providerMocks.MockProviderConsumer_DownloadShasumServer{}
+
+		client := &providerMocks.MockProviderStorageClient{DownloadShasumError: errors.New("some error")}
+
+		err := gw.DownloadShasumWithClient(request, server, client)
+
+		if client.DownloadShasumInvocations != 1 {
+			t.Errorf("Expected 1 call to DownloadShasum, got %v", client.DownloadShasumInvocations)
+		}
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+
+	t.Run("when client Recv returns EOF", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		server := &providerMocks.MockProviderConsumer_DownloadShasumServer{}
+
+		c := &providerMocks.MockStorage_DownloadProviderShasumClient{RecvError: io.EOF}
+
+		client := &providerMocks.MockProviderStorageClient{DownloadShasumClient: c}
+
+		err := gw.DownloadShasumWithClient(request, server, client)
+
+		if c.RecvInvocations != 1 {
+			t.Errorf("Expected 1 call to Recv, got %v", c.RecvInvocations)
+		}
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+	})
+
+	t.Run("when Send fails", func(t *testing.T) {
+		gw := &TerrariumGrpcGateway{}
+
+		server := &providerMocks.MockProviderConsumer_DownloadShasumServer{SendError: errors.New("some error")}
+
+		c := &providerMocks.MockStorage_DownloadProviderShasumClient{RecvResponse: &providerServices.DownloadShasumResponse{ShasumDataChunk: []byte("5f9c")}}
+
+		client := &providerMocks.MockProviderStorageClient{DownloadShasumClient: c}
+
+		err := gw.DownloadShasumWithClient(request, server, client)
+
+		if server.SendInvocations != 1 || string(server.SendResponse.GetShasumDataChunk()) != "5f9c" {
+			t.Errorf("Expected the chunk to be sent, got %v", server.SendResponse)
+		}
+
+		if err != providerStorage.SendShasumError {
+			t.Errorf("Expected %v, got %v.", providerStorage.SendShasumError, err)
+		}
+	})
+}
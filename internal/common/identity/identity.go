@@ -0,0 +1,116 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Terrarium does not authenticate callers itself. The gateway and the browse API are expected to be exposed through
+// a proxy that authenticates callers and passes their identity on. The identity is only accepted from the proxies a
+// service is told to trust, values sent by any other caller are ignored.
+const (
+	// Header is the HTTP header the authenticating proxy sets to the identity of the caller of the REST APIs.
+	Header = "X-Forwarded-User"
+	// MetadataKey is the gRPC metadata key the identity of the caller is passed on between services with.
+	MetadataKey = "x-terrarium-identity"
+)
+
+// Loopback lists the addresses of the services of the same host, trusted by services only reached by other services
+// of the same process.
+var Loopback = []string{"127.0.0.0/8", "::1/128"}
+
+// Trust accepts the identity of callers from a set of trusted proxies.
+// A nil Trust trusts no proxy, so that no identity is ever accepted.
+type Trust struct {
+	proxies []netip.Prefix
+}
+
+// NewTrust returns a Trust accepting the identity of callers from the given addresses or CIDR ranges.
+func NewTrust(proxies []string) (*Trust, error) {
+	trust := &Trust{}
+	for _, proxy := range proxies {
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(proxy)
+			if addrErr != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		trust.proxies = append(trust.proxies, prefix.Masked())
+	}
+	return trust, nil
+}
+
+// trusted reports whether a caller address, with or without a port, is one of the trusted proxies.
+func (t *Trust) trusted(address string) bool {
+	if t == nil {
+		return false
+	}
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, proxy := range t.proxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// FromIncomingContext returns the identity of the caller of the gRPC request being served,
+// or "" when none was given or the request was not made by a trusted proxy.
+func (t *Trust) FromIncomingContext(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil || !t.trusted(p.Addr.String()) {
+		return ""
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// FromRequest returns the identity of the caller of an HTTP request,
+// or "" when none was given or the request was not made by a trusted proxy.
+func (t *Trust) FromRequest(r *http.Request) string {
+	if !t.trusted(r.RemoteAddr) {
+		return ""
+	}
+	return r.Header.Get(Header)
+}
+
+// Forward returns a context passing the identity of the caller of the gRPC request being served on to the requests
+// made with it. Any identity the caller sent without being trusted is dropped.
+func (t *Trust) Forward(ctx context.Context) context.Context {
+	return NewOutgoingContext(ctx, t.FromIncomingContext(ctx))
+}
+
+// NewOutgoingContext returns a context passing identity on to the gRPC requests made with it,
+// replacing any identity already set. No identity is passed on when identity is empty.
+func NewOutgoingContext(ctx context.Context, identity string) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	if identity == "" {
+		md.Delete(MetadataKey)
+	} else {
+		md.Set(MetadataKey, identity)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
@@ -0,0 +1,139 @@
+package identity
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// incomingContext returns the context of a gRPC request made from address with the given identity.
+func incomingContext(address string, identity string) context.Context {
+	ctx := peer.NewContext(context.TODO(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 50000}})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, identity))
+}
+
+// Test_NewTrust checks:
+// - if addresses and CIDR ranges are accepted
+// - if error is returned when a proxy is invalid
+func Test_NewTrust(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when proxies are valid", func(t *testing.T) {
+		trust, err := NewTrust([]string{"10.0.0.1", "192.168.1.0/24", "::1"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		for _, address := range []string{"10.0.0.1:443", "192.168.1.20:443", "[::1]:443", "::ffff:10.0.0.1"} {
+			if !trust.trusted(address) {
+				t.Errorf("Expected %s to be trusted.", address)
+			}
+		}
+
+		for _, address := range []string{"10.0.0.2:443", "192.168.2.1:443", "invalid"} {
+			if trust.trusted(address) {
+				t.Errorf("Expected %s not to be trusted.", address)
+			}
+		}
+	})
+
+	t.Run("when a proxy is invalid", func(t *testing.T) {
+		_, err := NewTrust([]string{"proxy.internal"})
+
+		if err == nil {
+			t.Errorf("Expected an error, got none.")
+		}
+	})
+}
+
+// Test_FromIncomingContext checks:
+// - if the identity is returned when sent by a trusted proxy
+// - if the identity is ignored when sent by another caller
+// - if the identity is ignored when no proxy is trusted
+func Test_FromIncomingContext(t *testing.T) {
+	t.Parallel()
+
+	trust, _ := NewTrust([]string{"10.0.0.0/24"})
+
+	t.Run("when sent by a trusted proxy", func(t *testing.T) {
+		if actual := trust.FromIncomingContext(incomingContext("10.0.0.5", "jane")); actual != "jane" {
+			t.Errorf("Expected jane, got %q.", actual)
+		}
+	})
+
+	t.Run("when sent by another caller", func(t *testing.T) {
+		if actual := trust.FromIncomingContext(incomingContext("10.0.1.5", "jane")); actual != "" {
+			t.Errorf("Expected no identity, got %q.", actual)
+		}
+	})
+
+	t.Run("when no proxy is trusted", func(t *testing.T) {
+		var none *Trust
+
+		if actual := none.FromIncomingContext(incomingContext("10.0.0.5", "jane")); actual != "" {
+			t.Errorf("Expected no identity, got %q.", actual)
+		}
+	})
+}
+
+// Test_FromRequest checks:
+// - if the identity is returned when sent by a trusted proxy
+// - if the identity is ignored when sent by another caller
+func Test_FromRequest(t *testing.T) {
+	t.Parallel()
+
+	trust, _ := NewTrust([]string{"10.0.0.1"})
+
+	t.Run("when sent by a trusted proxy", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/api/approvals", nil)
+		r.RemoteAddr = "10.0.0.1:50000"
+		r.Header.Set(Header, "jane")
+
+		if actual := trust.FromRequest(r); actual != "jane" {
+			t.Errorf("Expected jane, got %q.", actual)
+		}
+	})
+
+	t.Run("when sent by another caller", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/api/approvals", nil)
+		r.RemoteAddr = "10.0.0.2:50000"
+		r.Header.Set(Header, "jane")
+
+		if actual := trust.FromRequest(r); actual != "" {
+			t.Errorf("Expected no identity, got %q.", actual)
+		}
+	})
+}
+
+// Test_Forward checks:
+// - if the identity sent by a trusted proxy is passed on
+// - if an identity already set on the outgoing context is dropped when the caller is not trusted
+func Test_Forward(t *testing.T) {
+	t.Parallel()
+
+	trust, _ := NewTrust([]string{"10.0.0.1"})
+
+	t.Run("when sent by a trusted proxy", func(t *testing.T) {
+		md, _ := metadata.FromOutgoingContext(trust.Forward(incomingContext("10.0.0.1", "jane")))
+
+		if !reflect.DeepEqual(md.Get(MetadataKey), []string{"jane"}) {
+			t.Errorf("Expected jane to be passed on, got %v.", md)
+		}
+	})
+
+	t.Run("when sent by another caller", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(incomingContext("10.0.0.2", "jane"), MetadataKey, "john")
+
+		md, _ := metadata.FromOutgoingContext(trust.Forward(ctx))
+
+		if len(md.Get(MetadataKey)) != 0 {
+			t.Errorf("Expected no identity to be passed on, got %v.", md)
+		}
+	})
+}
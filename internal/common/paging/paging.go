@@ -0,0 +1,61 @@
+package paging
+
+import (
+	"encoding/base64"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var InvalidCursorError = status.Error(codes.InvalidArgument, "Invalid page cursor.")
+
+// Paginate sorts items by their key and returns the page requested. Keys are expected to be unique, the cursor of
+// the next page being the key of the last item returned, so that pages do not shift when items are added or removed
+// between requests. All items are returned without page information when no page is requested.
+func Paginate[T any](items []T, key func(T) string, page *PageInfoRequest) ([]T, *PageInfoResponse, error) {
+	if page == nil {
+		return items, nil, nil
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return key(items[i]) < key(items[j]) })
+	total := uint64(len(items))
+
+	start := page.GetOffset()
+	if page.GetCursor() != "" {
+		after, err := DecodeCursor(page.GetCursor())
+		if err != nil {
+			return nil, nil, err
+		}
+		start = uint64(sort.Search(len(items), func(i int) bool { return key(items[i]) > after }))
+	}
+	if start > total {
+		start = total
+	}
+
+	end := total
+	if page.GetCount() > 0 && start+page.GetCount() < total {
+		end = start + page.GetCount()
+	}
+
+	info := &PageInfoResponse{TotalCount: total}
+	if end < total {
+		info.NextOffset = end
+		info.NextCursor = EncodeCursor(key(items[end-1]))
+	}
+	return items[start:end], info, nil
+}
+
+// EncodeCursor returns the opaque cursor pointing to the item with the given key.
+func EncodeCursor(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// DecodeCursor returns the key of the item a cursor points to.
+func DecodeCursor(cursor string) (string, error) {
+	key, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", InvalidCursorError
+	}
+	return string(key), nil
+}
@@ -0,0 +1,75 @@
+package paging
+
+import (
+	"reflect"
+	"testing"
+)
+
+func identity(s string) string {
+	return s
+}
+
+// Test_Paginate checks:
+// - if all items are returned unsorted when no page is requested
+// - if pages are returned sorted by key from an offset or a cursor
+// - if the page after a cursor does not shift when an earlier item is removed
+// - if error is returned when the cursor is invalid
+func Test_Paginate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when no page is requested", func(t *testing.T) {
+		items, info, err := Paginate([]string{"c", "a", "b"}, identity, nil)
+
+		if err != nil || info != nil || !reflect.DeepEqual(items, []string{"c", "a", "b"}) {
+			t.Errorf("Expected all items unchanged, got %v, %v and %v.", items, info, err)
+		}
+	})
+
+	t.Run("when pages are walked with cursors", func(t *testing.T) {
+		all := []string{"e", "c", "a", "d", "b"}
+
+		first, info, err := Paginate(all, identity, &PageInfoRequest{Count: 2})
+
+		if err != nil || !reflect.DeepEqual(first, []string{"a", "b"}) {
+			t.Fatalf("Expected a and b, got %v and %v.", first, err)
+		}
+
+		if info.NextOffset != 2 || info.TotalCount != 5 || info.NextCursor != EncodeCursor("b") {
+			t.Errorf("Expected next page at 2 after b, got %v.", info)
+		}
+
+		second, info, _ := Paginate([]string{"e", "c", "d", "b"}, identity, &PageInfoRequest{Count: 2, Cursor: info.NextCursor})
+
+		if !reflect.DeepEqual(second, []string{"c", "d"}) {
+			t.Errorf("Expected c and d, got %v.", second)
+		}
+
+		last, info, _ := Paginate(all, identity, &PageInfoRequest{Count: 2, Cursor: info.NextCursor})
+
+		if !reflect.DeepEqual(last, []string{"e"}) || info.NextCursor != "" || info.NextOffset != 0 {
+			t.Errorf("Expected last page with e, got %v and %v.", last, info)
+		}
+	})
+
+	t.Run("when a page is requested by offset", func(t *testing.T) {
+		items, info, _ := Paginate([]string{"b", "a", "c"}, identity, &PageInfoRequest{Offset: 1})
+
+		if !reflect.DeepEqual(items, []string{"b", "c"}) || info.NextCursor != "" {
+			t.Errorf("Expected b and c, got %v and %v.", items, info)
+		}
+
+		items, _, _ = Paginate([]string{"b", "a", "c"}, identity, &PageInfoRequest{Offset: 5, Count: 1})
+
+		if len(items) != 0 {
+			t.Errorf("Expected no item, got %v.", items)
+		}
+	})
+
+	t.Run("when the cursor is invalid", func(t *testing.T) {
+		_, _, err := Paginate([]string{"a"}, identity, &PageInfoRequest{Cursor: "not a cursor!"})
+
+		if err != InvalidCursorError {
+			t.Errorf("Expected %v, got %v.", InvalidCursorError, err)
+		}
+	})
+}
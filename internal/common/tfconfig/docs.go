@@ -0,0 +1,225 @@
+package tfconfig
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+const (
+	ManagedResourceMode = "managed"
+	DataResourceMode    = "data"
+
+	submodulesDir = "modules"
+	examplesDir   = "examples"
+)
+
+// Docs is the documentation of a module, or of one of its submodules or examples, read from its Terraform files.
+type Docs struct {
+	// Path is the directory of the module in the archive, "." for the module itself
+	Path              string
+	Readme            string
+	Variables         []*Variable
+	Outputs           []*Output
+	RequiredProviders []*ProviderRequirement
+	Resources         []*Resource
+	ModuleCalls       []*ModuleCall
+	Submodules        []*Docs
+	Examples          []*Docs
+	// Errors holds the files that could not be read, which are otherwise skipped
+	Errors []error
+}
+
+// Variable is a `variable` block. Its type and default are kept as written.
+type Variable struct {
+	Name        string
+	Type        string
+	Default     string
+	Description string
+	Sensitive   bool
+	Required    bool
+}
+
+// Output is an `output` block.
+type Output struct {
+	Name        string
+	Description string
+	Sensitive   bool
+}
+
+// Resource is a `resource` or `data` block.
+type Resource struct {
+	Mode string
+	Type string
+	Name string
+}
+
+// undocumentedDirs hold Terraform files that are neither part of the module nor examples of its use.
+var undocumentedDirs = map[string]bool{
+	"test":       true,
+	"tests":      true,
+	".terraform": true,
+}
+
+// Docs returns the documentation of the archive, with the submodules found under modules/ and the examples found
+// under examples/.
+func (a *Archive) Docs() (*Docs, error) {
+	load := func(dir string) (*Docs, error) {
+		docs := loadDocs(dir, a.files[dir])
+		readme, err := a.readme(dir)
+		docs.Readme = readme
+		return docs, err
+	}
+
+	docs, err := load(".")
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range a.dirs() {
+		switch path.Dir(dir) {
+		case submodulesDir:
+			submodule, err := load(dir)
+			if err != nil {
+				return nil, err
+			}
+			docs.Submodules = append(docs.Submodules, submodule)
+		case examplesDir:
+			example, err := load(dir)
+			if err != nil {
+				return nil, err
+			}
+			docs.Examples = append(docs.Examples, example)
+		}
+	}
+	return docs, nil
+}
+
+// readme returns the README of a directory of the archive, or an empty string when it has none.
+func (a *Archive) readme(dir string) (string, error) {
+	file, ok := a.readmes[dir]
+	if !ok {
+		return "", nil
+	}
+	content, err := readFile(file, MaxReadmeSize)
+	return string(content), err
+}
+
+// dirs returns the directories of the archive holding Terraform files or a README.
+func (a *Archive) dirs() []string {
+	seen := map[string]bool{}
+	for dir := range a.files {
+		seen[dir] = true
+	}
+	for dir := range a.readmes {
+		seen[dir] = true
+	}
+	dirs := make([]string, 0, len(seen))
+	for dir := range seen {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// LoadDocsZip reads the documentation of a module archive, with the submodules found under modules/
+// and the examples found under examples/.
+func LoadDocsZip(data []byte) (*Docs, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	archive, err := ReadZip(reader)
+	if err != nil {
+		return nil, err
+	}
+	return archive.Docs()
+}
+
+// LoadDocsFiles reads the documentation of the Terraform files of a single directory, keyed by name.
+func LoadDocsFiles(dir string, files map[string][]byte) *Docs {
+	return loadDocs(dir, parseFiles(files))
+}
+
+func loadDocs(dir string, files []*file) *Docs {
+	docs := &Docs{Path: dir}
+	providers := map[string]*ProviderRequirement{}
+	for _, f := range files {
+		if f.err != nil {
+			docs.Errors = append(docs.Errors, f.err)
+			continue
+		}
+
+		for _, block := range f.body.Blocks {
+			switch {
+			case block.Type == "variable" && len(block.Labels) == 1:
+				docs.Variables = append(docs.Variables, newVariable(f, block))
+			case block.Type == "output" && len(block.Labels) == 1:
+				output := &Output{Name: block.Labels[0]}
+				output.Description, _ = stringAttribute(block.Body, "description")
+				output.Sensitive, _ = boolAttribute(block.Body, "sensitive")
+				docs.Outputs = append(docs.Outputs, output)
+			case block.Type == "resource" && len(block.Labels) == 2:
+				docs.Resources = append(docs.Resources, &Resource{Mode: ManagedResourceMode, Type: block.Labels[0], Name: block.Labels[1]})
+			case block.Type == "data" && len(block.Labels) == 2:
+				docs.Resources = append(docs.Resources, &Resource{Mode: DataResourceMode, Type: block.Labels[0], Name: block.Labels[1]})
+			case block.Type == "module" && len(block.Labels) == 1:
+				docs.ModuleCalls = append(docs.ModuleCalls, newModuleCall(f, block))
+			case block.Type == "terraform":
+				addProviderRequirements(providers, block)
+			}
+		}
+	}
+	docs.RequiredProviders = providerRequirementList(providers)
+
+	sort.SliceStable(docs.Variables, func(i, j int) bool { return docs.Variables[i].Name < docs.Variables[j].Name })
+	sort.SliceStable(docs.Outputs, func(i, j int) bool { return docs.Outputs[i].Name < docs.Outputs[j].Name })
+	sort.SliceStable(docs.Resources, func(i, j int) bool {
+		a, b := docs.Resources[i], docs.Resources[j]
+		if a.Mode != b.Mode {
+			return a.Mode == ManagedResourceMode
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Name < b.Name
+	})
+	return docs
+}
+
+func newVariable(f *file, block *hclsyntax.Block) *Variable {
+	variable := &Variable{Name: block.Labels[0], Type: "any", Required: true}
+	if attr, ok := block.Body.Attributes["type"]; ok {
+		variable.Type = f.exprText(attr.Expr)
+	}
+	if attr, ok := block.Body.Attributes["default"]; ok {
+		variable.Default = f.exprText(attr.Expr)
+		variable.Required = false
+	}
+	variable.Description, _ = stringAttribute(block.Body, "description")
+	variable.Sensitive, _ = boolAttribute(block.Body, "sensitive")
+	return variable
+}
+
+func inUndocumentedDir(dir string) bool {
+	for _, part := range strings.Split(dir, "/") {
+		if undocumentedDirs[part] {
+			return true
+		}
+	}
+	return false
+}
+
+// readFile returns the content of a file of an archive, up to limit bytes.
+func readFile(file *zip.File, limit int64) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+	return io.ReadAll(io.LimitReader(rc, limit))
+}
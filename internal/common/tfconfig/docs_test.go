@@ -0,0 +1,136 @@
+package tfconfig
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testVariables = `
+variable "name" {
+  description = "Name of the VPC"
+  type        = string
+}
+
+variable "cidr_blocks" {
+  type = list(object({
+    cidr = string
+    az   = string
+  }))
+  default = []
+}
+
+variable "password" {
+  sensitive = true
+  default   = null
+}
+`
+
+const testOutputs = `
+output "vpc_id" {
+  description = <<-EOT
+    ID of the VPC
+  EOT
+  value       = aws_vpc.this.id
+}
+
+output "secret" {
+  value     = var.password
+  sensitive = true
+}
+
+resource "aws_vpc" "this" {
+  cidr_block = "10.0.0.0/16"
+}
+
+data "aws_region" "current" {}
+
+resource "aws_subnet" "private" {
+  vpc_id = aws_vpc.this.id
+}
+`
+
+// Test_LoadDocsZip checks:
+// - if variables, outputs, resources, module calls and provider requirements of the module are read
+// - if submodules and examples are documented apart, with their README
+// - if tests are left out
+// - if files that cannot be parsed are reported and skipped
+// - if error is returned when the archive cannot be read
+func Test_LoadDocsZip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the archive holds a module", func(t *testing.T) {
+		data := zipFiles(t, map[string]string{
+			"main.tf":                   testConfig,
+			"variables.tf":              testVariables,
+			"outputs.tf":                testOutputs,
+			"broken.tf":                 "variable \"x\" {\n",
+			"README.md":                 "# vpc\n",
+			"modules/subnets/main.tf":   "variable \"vpc_id\" {}\n",
+			"modules/subnets/README.md": "# subnets\n",
+			"examples/simple/main.tf":   "module \"vpc\" {\n  source = \"../..\"\n}\n",
+			"tests/main.tf":             "variable \"test\" {}\n",
+			"modules/subnets/lib/x.tf":  "variable \"nested\" {}\n",
+		})
+
+		docs, err := LoadDocsZip(data)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		expectedVariables := []*Variable{
+			{Name: "cidr_blocks", Type: "list(object({\n    cidr = string\n    az   = string\n  }))", Default: "[]"},
+			{Name: "name", Type: "string", Description: "Name of the VPC", Required: true},
+			{Name: "password", Type: "any", Default: "null", Sensitive: true},
+			{Name: "prefix", Type: "any", Default: `"cie"`},
+		}
+		if !reflect.DeepEqual(docs.Variables, expectedVariables) {
+			t.Errorf("Expected %v, got %v.", expectedVariables, docs.Variables)
+		}
+
+		expectedOutputs := []*Output{
+			{Name: "secret", Sensitive: true},
+			{Name: "vpc_id", Description: "ID of the VPC\n"},
+		}
+		if !reflect.DeepEqual(docs.Outputs, expectedOutputs) {
+			t.Errorf("Expected %v, got %v.", expectedOutputs, docs.Outputs)
+		}
+
+		expectedResources := []*Resource{
+			{Mode: ManagedResourceMode, Type: "aws_subnet", Name: "private"},
+			{Mode: ManagedResourceMode, Type: "aws_vpc", Name: "this"},
+			{Mode: DataResourceMode, Type: "aws_region", Name: "current"},
+		}
+		if !reflect.DeepEqual(docs.Resources, expectedResources) {
+			t.Errorf("Expected %v, got %v.", expectedResources, docs.Resources)
+		}
+
+		if len(docs.ModuleCalls) != 1 || len(docs.RequiredProviders) != 2 {
+			t.Errorf("Expected the vpc module call and 2 providers, got %v and %v.", docs.ModuleCalls, docs.RequiredProviders)
+		}
+
+		if docs.Path != "." || docs.Readme != "# vpc\n" {
+			t.Errorf("Expected the README of the module, got %q at %q.", docs.Readme, docs.Path)
+		}
+
+		if len(docs.Errors) != 1 {
+			t.Errorf("Expected broken.tf to be reported, got %v.", docs.Errors)
+		}
+
+		if len(docs.Submodules) != 1 || docs.Submodules[0].Path != "modules/subnets" || docs.Submodules[0].Readme != "# subnets\n" || len(docs.Submodules[0].Variables) != 1 {
+			t.Errorf("Expected the subnets submodule, got %v.", docs.Submodules)
+		}
+
+		if len(docs.Examples) != 1 || docs.Examples[0].Path != "examples/simple" || len(docs.Examples[0].ModuleCalls) != 1 {
+			t.Errorf("Expected the simple example, got %v.", docs.Examples)
+		}
+	})
+
+	t.Run("when the archive cannot be read", func(t *testing.T) {
+		_, err := LoadDocsZip([]byte("not a zip"))
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
@@ -0,0 +1,120 @@
+// Package tfconfig reads the parts of Terraform configuration that Terrarium needs
+// from the native HCL syntax, without evaluating any expression.
+package tfconfig
+
+import (
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// file is a Terraform file, parsed once for everything read from it.
+type file struct {
+	name string
+	src  []byte
+	body *hclsyntax.Body
+	// err is set when the file could not be read or parsed
+	err error
+}
+
+// parseFile parses a Terraform file written in the native syntax.
+func parseFile(name string, src []byte) *file {
+	parsed, diags := hclsyntax.ParseConfig(src, name, hcl.InitialPos)
+	if diags.HasErrors() {
+		return &file{name: name, err: diags}
+	}
+	return &file{name: name, src: src, body: parsed.Body.(*hclsyntax.Body)}
+}
+
+// parseFiles parses Terraform files keyed by name, in name order.
+func parseFiles(files map[string][]byte) []*file {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parsed := make([]*file, 0, len(names))
+	for _, name := range names {
+		parsed = append(parsed, parseFile(name, files[name]))
+	}
+	return parsed
+}
+
+// exprText returns the source text of an expression of the file, kept as written.
+func (f *file) exprText(expr hclsyntax.Expression) string {
+	return string(expr.Range().SliceBytes(f.src))
+}
+
+// blocksOfType returns the blocks of a body with the given type.
+func blocksOfType(body *hclsyntax.Body, blockType string) []*hclsyntax.Block {
+	var blocks []*hclsyntax.Block
+	for _, block := range body.Blocks {
+		if block.Type == blockType {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// literalValue returns the value of an expression that references no variable and calls no function.
+func literalValue(expr hclsyntax.Expression) (cty.Value, bool) {
+	if expr == nil {
+		return cty.NilVal, false
+	}
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || !value.IsWhollyKnown() || value.IsNull() {
+		return cty.NilVal, false
+	}
+	return value, true
+}
+
+// literalString returns the value of an expression that is a literal string. Templates with interpolations or
+// directives are not literal.
+func literalString(expr hclsyntax.Expression) (string, bool) {
+	value, ok := literalValue(expr)
+	if !ok || value.Type() != cty.String {
+		return "", false
+	}
+	return value.AsString(), true
+}
+
+// stringAttribute returns the value of an attribute set to a literal string.
+func stringAttribute(body *hclsyntax.Body, name string) (string, bool) {
+	attr, ok := body.Attributes[name]
+	if !ok {
+		return "", false
+	}
+	return literalString(attr.Expr)
+}
+
+// boolAttribute returns the value of an attribute set to a literal boolean.
+func boolAttribute(body *hclsyntax.Body, name string) (bool, bool) {
+	attr, ok := body.Attributes[name]
+	if !ok {
+		return false, false
+	}
+	value, ok := literalValue(attr.Expr)
+	if !ok || value.Type() != cty.Bool {
+		return false, false
+	}
+	return value.True(), true
+}
+
+// objectItems returns the items of an expression that is an object constructor, such as
+// `{ source = "hashicorp/aws" }`, keyed by name.
+func objectItems(expr hclsyntax.Expression) (map[string]hclsyntax.Expression, bool) {
+	object, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return nil, false
+	}
+	items := map[string]hclsyntax.Expression{}
+	for _, item := range object.Items {
+		if key, ok := literalString(item.KeyExpr); ok {
+			items[key] = item.ValueExpr
+		}
+	}
+	return items, true
+}
@@ -0,0 +1,61 @@
+package tfconfig
+
+import (
+	"testing"
+)
+
+// Test_parseFile checks:
+// - if literal strings, including heredocs without their indentation, are returned and templates are not
+// - if object constructors are split into items keyed by name
+// - if the source text of expressions is kept as written
+// - if error is set for unclosed blocks and unterminated strings
+func Test_parseFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the configuration is valid", func(t *testing.T) {
+		f := parseFile("main.tf", []byte(testConfig))
+
+		if f.err != nil {
+			t.Fatalf("Expected no error, got %v.", f.err)
+		}
+
+		module := blocksOfType(f.body, "module")[0]
+		if source, ok := stringAttribute(module.Body, "source"); !ok || source != "terrarium.example.com/cie/vpc/aws" {
+			t.Errorf("Expected the source of vpc, got %v.", source)
+		}
+
+		if _, ok := stringAttribute(module.Body, "name"); ok {
+			t.Errorf("Expected templates not to be returned as strings.")
+		}
+
+		if expr := f.exprText(module.Body.Attributes["name"].Expr); expr != `"${var.prefix}-vpc"` {
+			t.Errorf("Expected the expression text, got %v.", expr)
+		}
+
+		tags, ok := objectItems(module.Body.Attributes["tags"].Expr)
+		if !ok || len(tags) != 2 || tags["team"] == nil || tags["Name"] == nil {
+			t.Errorf("Expected 2 tags, got %v.", tags)
+		}
+
+		policy, ok := stringAttribute(blocksOfType(f.body, "locals")[0].Body, "policy")
+		if !ok || policy != "{\n  \"Version\": \"2012-10-17\"\n}\n" {
+			t.Errorf("Expected the heredoc without indentation, got %q.", policy)
+		}
+	})
+
+	t.Run("when a block is not closed", func(t *testing.T) {
+		f := parseFile("main.tf", []byte("module \"vpc\" {\n  source = \"x\"\n"))
+
+		if f.err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+
+	t.Run("when a string is not terminated", func(t *testing.T) {
+		f := parseFile("main.tf", []byte("module \"vpc\" {\n  source = \"x\n}\n"))
+
+		if f.err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
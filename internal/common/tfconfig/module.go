@@ -0,0 +1,246 @@
+package tfconfig
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// Module is the configuration read from the Terraform files of a module archive.
+type Module struct {
+	ModuleCalls       []*ModuleCall
+	RequiredProviders []*ProviderRequirement
+	// Errors holds the files that could not be read, which are otherwise skipped
+	Errors []error
+}
+
+// ModuleCall is a `module` block.
+type ModuleCall struct {
+	Name    string
+	Source  string
+	Version string
+	File    string
+}
+
+// ProviderRequirement is an entry of `required_providers`. Constraints found in several files are all kept.
+type ProviderRequirement struct {
+	Name               string
+	Source             string
+	VersionConstraints []string
+}
+
+// MaxTerraformFileSize is the size of the largest Terraform file that is read, larger files being reported and skipped.
+const MaxTerraformFileSize = 1024 * 1024
+
+// skippedDirs hold Terraform files that are not part of the module itself.
+var skippedDirs = map[string]bool{
+	"examples":   true,
+	"test":       true,
+	"tests":      true,
+	".terraform": true,
+}
+
+// Archive is a module archive whose Terraform files are read and parsed once, for both the configuration of the
+// module and its documentation to be loaded from.
+type Archive struct {
+	// files holds the Terraform files by directory, in name order
+	files   map[string][]*file
+	readmes map[string]*zip.File
+}
+
+// ReadZip reads the Terraform files and READMEs of a module archive, leaving out tests and downloaded modules.
+func ReadZip(reader *zip.Reader) (*Archive, error) {
+	archive := &Archive{files: map[string][]*file{}, readmes: map[string]*zip.File{}}
+	for _, entry := range reader.File {
+		dir := path.Dir(path.Clean(entry.Name))
+		if entry.FileInfo().IsDir() || inUndocumentedDir(dir) {
+			continue
+		}
+		if isReadme(entry.Name) {
+			if current, ok := archive.readmes[dir]; !ok || entry.Name < current.Name {
+				archive.readmes[dir] = entry
+			}
+			continue
+		}
+		if path.Ext(entry.Name) != ".tf" {
+			continue
+		}
+		if entry.UncompressedSize64 > MaxTerraformFileSize {
+			archive.files[dir] = append(archive.files[dir], &file{name: entry.Name, err: fmt.Errorf("%s: larger than %d bytes", entry.Name, MaxTerraformFileSize)})
+			continue
+		}
+		content, err := readFile(entry, MaxTerraformFileSize)
+		if err != nil {
+			return nil, err
+		}
+		archive.files[dir] = append(archive.files[dir], parseFile(entry.Name, content))
+	}
+	for _, files := range archive.files {
+		sort.Slice(files, func(i, j int) bool { return files[i].name < files[j].name })
+	}
+	return archive, nil
+}
+
+// Module returns the module calls and provider requirements of the archive, leaving out examples.
+func (a *Archive) Module() *Module {
+	var files []*file
+	for _, dirFiles := range a.files {
+		for _, f := range dirFiles {
+			if !inSkippedDir(f.name) {
+				files = append(files, f)
+			}
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].name < files[j].name })
+	return loadModule(files)
+}
+
+// LoadZip reads the `.tf` files of a module archive, leaving out examples, tests and downloaded modules.
+func LoadZip(data []byte) (*Module, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	archive, err := ReadZip(reader)
+	if err != nil {
+		return nil, err
+	}
+	return archive.Module(), nil
+}
+
+func inSkippedDir(name string) bool {
+	for _, dir := range strings.Split(path.Dir(name), "/") {
+		if skippedDirs[dir] {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadFiles reads the module calls and provider requirements of Terraform files keyed by name.
+func LoadFiles(files map[string][]byte) *Module {
+	return loadModule(parseFiles(files))
+}
+
+func loadModule(files []*file) *Module {
+	module := &Module{}
+	providers := map[string]*ProviderRequirement{}
+	for _, f := range files {
+		if f.err != nil {
+			module.Errors = append(module.Errors, f.err)
+			continue
+		}
+
+		for _, block := range blocksOfType(f.body, "module") {
+			if len(block.Labels) != 1 {
+				continue
+			}
+			module.ModuleCalls = append(module.ModuleCalls, newModuleCall(f, block))
+		}
+
+		for _, terraform := range blocksOfType(f.body, "terraform") {
+			addProviderRequirements(providers, terraform)
+		}
+	}
+
+	module.RequiredProviders = providerRequirementList(providers)
+	return module
+}
+
+func newModuleCall(f *file, block *hclsyntax.Block) *ModuleCall {
+	call := &ModuleCall{Name: block.Labels[0], File: f.name}
+	call.Source, _ = stringAttribute(block.Body, "source")
+	call.Version, _ = stringAttribute(block.Body, "version")
+	return call
+}
+
+// addProviderRequirements adds the entries of the `required_providers` blocks of a `terraform` block.
+func addProviderRequirements(providers map[string]*ProviderRequirement, terraform *hclsyntax.Block) {
+	for _, required := range blocksOfType(terraform.Body, "required_providers") {
+		for _, attr := range required.Body.Attributes {
+			addProviderRequirement(providers, attr)
+		}
+	}
+}
+
+// providerRequirementList returns the provider requirements sorted by name, defaulting their source.
+func providerRequirementList(providers map[string]*ProviderRequirement) []*ProviderRequirement {
+	var requirements []*ProviderRequirement
+	for _, name := range sortedKeys(providers) {
+		requirement := providers[name]
+		if requirement.Source == "" {
+			// the source Terraform assumes for providers without one
+			requirement.Source = "hashicorp/" + name
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements
+}
+
+func addProviderRequirement(providers map[string]*ProviderRequirement, attr *hclsyntax.Attribute) {
+	requirement, ok := providers[attr.Name]
+	if !ok {
+		requirement = &ProviderRequirement{Name: attr.Name}
+		providers[attr.Name] = requirement
+	}
+
+	// Terraform 0.12 accepted a version constraint in place of the object
+	if constraint, ok := literalString(attr.Expr); ok {
+		requirement.VersionConstraints = appendConstraint(requirement.VersionConstraints, constraint)
+		return
+	}
+
+	items, _ := objectItems(attr.Expr)
+	if source, ok := literalString(items["source"]); ok {
+		requirement.Source = source
+	}
+	if constraint, ok := literalString(items["version"]); ok {
+		requirement.VersionConstraints = appendConstraint(requirement.VersionConstraints, constraint)
+	}
+}
+
+func appendConstraint(constraints []string, constraint string) []string {
+	for _, c := range constraints {
+		if c == constraint {
+			return constraints
+		}
+	}
+	return append(constraints, constraint)
+}
+
+func sortedKeys(providers map[string]*ProviderRequirement) []string {
+	keys := make([]string, 0, len(providers))
+	for key := range providers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RegistryModule returns the "<organization>/<name>/<provider>" address of a module call
+// sourced from the registry served on host, ignoring any subdirectory.
+func (c *ModuleCall) RegistryModule(host string) (string, bool) {
+	if host == "" {
+		return "", false
+	}
+	source := c.Source
+	if i := strings.Index(source, "//"); i >= 0 {
+		source = source[:i]
+	}
+
+	parts := strings.Split(source, "/")
+	if len(parts) != 4 || !strings.EqualFold(parts[0], host) {
+		return "", false
+	}
+	for _, part := range parts[1:] {
+		if part == "" {
+			return "", false
+		}
+	}
+	return strings.Join(parts[1:], "/"), true
+}
@@ -0,0 +1,163 @@
+package tfconfig
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testConfig = `
+# Network for the platform
+terraform {
+  required_version = ">= 1.3"
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0" // pinned for the EKS module
+    }
+    ldap = { source = "terrarium.example.com/cie/ldap", version = ">= 0.3" }
+  }
+}
+
+/* the vpc
+   module */
+module "vpc" {
+  source  = "terrarium.example.com/cie/vpc/aws"
+  version = "~> 1.2"
+
+  name = "${var.prefix}-vpc"
+  tags = {
+    "team" = "platform"
+    Name   = "vpc"
+  }
+}
+
+variable "prefix" { default = "cie" }
+
+locals {
+  policy = <<-EOT
+    {
+      "Version": "2012-10-17"
+    }
+  EOT
+}
+`
+
+func zipFiles(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// Test_LoadZip checks:
+// - if module calls and provider requirements of every file are read, including submodules
+// - if examples are left out
+// - if provider constraints of several files are merged and sources defaulted
+// - if files that cannot be parsed are reported and skipped
+// - if files larger than MaxTerraformFileSize are reported and skipped
+// - if error is returned when the archive cannot be read
+func Test_LoadZip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the archive holds a module", func(t *testing.T) {
+		data := zipFiles(t, map[string]string{
+			"main.tf":                 testConfig,
+			"versions.tf":             "terraform {\n  required_providers {\n    aws = \">= 4.0\"\n    random = {}\n  }\n}\n",
+			"modules/subnets/main.tf": "module \"labels\" {\n  source = \"terrarium.example.com/cie/labels/null\"\n}\n",
+			"examples/simple/main.tf": "module \"example\" {\n  source = \"../..\"\n}\n",
+			"broken.tf":               "module \"x\" {\n",
+			"README.md":               "# vpc\n",
+		})
+
+		module, err := LoadZip(data)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(module.ModuleCalls) != 2 || module.ModuleCalls[0].Name != "vpc" || module.ModuleCalls[1].Name != "labels" {
+			t.Errorf("Expected the vpc and labels module calls, got %v.", module.ModuleCalls)
+		}
+
+		if module.ModuleCalls[0].Version != "~> 1.2" {
+			t.Errorf("Expected the version constraint of vpc, got %v.", module.ModuleCalls[0].Version)
+		}
+
+		expected := []*ProviderRequirement{
+			{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{"~> 5.0", ">= 4.0"}},
+			{Name: "ldap", Source: "terrarium.example.com/cie/ldap", VersionConstraints: []string{">= 0.3"}},
+			{Name: "random", Source: "hashicorp/random"},
+		}
+		if !reflect.DeepEqual(module.RequiredProviders, expected) {
+			t.Errorf("Expected %v, got %v.", expected, module.RequiredProviders)
+		}
+
+		if len(module.Errors) != 1 {
+			t.Errorf("Expected broken.tf to be reported, got %v.", module.Errors)
+		}
+	})
+
+	t.Run("when a file is too large", func(t *testing.T) {
+		data := zipFiles(t, map[string]string{
+			"main.tf":      testConfig,
+			"generated.tf": "# " + strings.Repeat("x", MaxTerraformFileSize) + "\n",
+		})
+
+		module, err := LoadZip(data)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(module.ModuleCalls) != 1 || len(module.Errors) != 1 {
+			t.Errorf("Expected generated.tf to be reported and main.tf to be read, got %v and %v.", module.ModuleCalls, module.Errors)
+		}
+	})
+
+	t.Run("when the archive cannot be read", func(t *testing.T) {
+		_, err := LoadZip([]byte("not a zip"))
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_RegistryModule checks:
+// - if sources of the registry host resolve to the module address
+// - if other sources are ignored
+func Test_RegistryModule(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+		ok     bool
+	}{
+		{"terrarium.example.com/cie/vpc/aws", "cie/vpc/aws", true},
+		{"Terrarium.Example.com/cie/vpc/aws//modules/subnets", "cie/vpc/aws", true},
+		{"cie/vpc/aws", "", false},
+		{"registry.terraform.io/hashicorp/consul/aws", "", false},
+		{"git::https://terrarium.example.com/cie/vpc.git", "", false},
+		{"./modules/subnets", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := (&ModuleCall{Source: tt.source}).RegistryModule("terrarium.example.com")
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("RegistryModule(%s) = %v, %v, want %v, %v", tt.source, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package tfconfig
+
+import (
+	"archive/zip"
+	"bytes"
+	"path"
+	"strings"
+)
+
+// MaxReadmeSize is the number of bytes of a README that are read, the rest being left out.
+const MaxReadmeSize = 64 * 1024
+
+// LoadReadmeZip returns the README of a module archive, the one closest to the root when there are several,
+// or an empty string when the archive has none.
+func LoadReadmeZip(data []byte) (string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", err
+	}
+
+	var readme *zip.File
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() || !isReadme(file.Name) || inSkippedDir(file.Name) {
+			continue
+		}
+		if readme == nil || depth(file.Name) < depth(readme.Name) {
+			readme = file
+		}
+	}
+	if readme == nil {
+		return "", nil
+	}
+
+	content, err := readFile(readme, MaxReadmeSize)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func isReadme(name string) bool {
+	base := strings.ToLower(path.Base(name))
+	return base == "readme" || strings.TrimSuffix(base, path.Ext(base)) == "readme"
+}
+
+func depth(name string) int {
+	return strings.Count(path.Clean(name), "/")
+}
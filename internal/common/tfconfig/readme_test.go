@@ -0,0 +1,57 @@
+package tfconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test_LoadReadmeZip checks:
+// - if the README closest to the root is returned
+// - if READMEs of examples are left out
+// - if an empty string is returned when there is no README
+// - if large READMEs are truncated
+// - if error is returned when the archive cannot be read
+func Test_LoadReadmeZip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the archive has several READMEs", func(t *testing.T) {
+		data := zipFiles(t, map[string]string{
+			"main.tf":                   testConfig,
+			"modules/subnets/README.md": "# subnets\n",
+			"Readme.markdown":           "# vpc\n",
+			"examples/README.md":        "# examples\n",
+		})
+
+		readme, err := LoadReadmeZip(data)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if readme != "# vpc\n" {
+			t.Errorf("Expected the README of the module, got %q.", readme)
+		}
+	})
+
+	t.Run("when the archive has no README", func(t *testing.T) {
+		readme, err := LoadReadmeZip(zipFiles(t, map[string]string{"main.tf": testConfig, "examples/README.md": "# examples\n"}))
+
+		if err != nil || readme != "" {
+			t.Errorf("Expected no README and no error, got %q and %v.", readme, err)
+		}
+	})
+
+	t.Run("when the README is large", func(t *testing.T) {
+		readme, _ := LoadReadmeZip(zipFiles(t, map[string]string{"README": strings.Repeat("a", MaxReadmeSize+10)}))
+
+		if len(readme) != MaxReadmeSize {
+			t.Errorf("Expected %v bytes, got %v.", MaxReadmeSize, len(readme))
+		}
+	})
+
+	t.Run("when the archive is invalid", func(t *testing.T) {
+		if _, err := LoadReadmeZip([]byte("not a zip")); err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
@@ -0,0 +1,383 @@
+// Package upstream fetches modules and providers from an upstream Terraform registry, for the namespaces the
+// registry is allowed to proxy.
+package upstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DiscoveryPath          = "/.well-known/terraform.json"
+	DefaultTimeout         = 5 * time.Minute
+	DefaultMetadataTimeout = 10 * time.Second
+	DefaultMaxDownloadSize = 512 << 20
+)
+
+var (
+	ErrNotFound          = errors.New("not found in the upstream registry")
+	ErrUnsupportedSource = errors.New("module source of the upstream registry is not a zip archive")
+	ErrServiceNotOffered = errors.New("service not offered by the upstream registry")
+	ErrTooLarge          = errors.New("download exceeds the maximum size accepted from the upstream registry")
+)
+
+// ProviderVersion is a version of a provider listed by the upstream registry.
+type ProviderVersion struct {
+	Version   string     `json:"version"`
+	Protocols []string   `json:"protocols"`
+	Platforms []Platform `json:"platforms"`
+}
+
+type Platform struct {
+	Os   string `json:"os"`
+	Arch string `json:"arch"`
+}
+
+// ProviderPackage is the package of a provider version for a platform, its URLs resolved against the upstream
+// registry.
+type ProviderPackage struct {
+	Protocols           []string    `json:"protocols"`
+	Os                  string      `json:"os"`
+	Arch                string      `json:"arch"`
+	Filename            string      `json:"filename"`
+	DownloadURL         string      `json:"download_url"`
+	ShasumsURL          string      `json:"shasums_url"`
+	ShasumsSignatureURL string      `json:"shasums_signature_url"`
+	Shasum              string      `json:"shasum"`
+	SigningKeys         SigningKeys `json:"signing_keys"`
+}
+
+type SigningKeys struct {
+	GPGPublicKeys []GPGPublicKey `json:"gpg_public_keys"`
+}
+
+type GPGPublicKey struct {
+	KeyID          string `json:"key_id"`
+	ASCIIArmor     string `json:"ascii_armor"`
+	TrustSignature string `json:"trust_signature"`
+	Source         string `json:"source"`
+	SourceURL      string `json:"source_url"`
+}
+
+// Registry is an upstream registry, reached through the services it advertises for service discovery.
+type Registry struct {
+	// URL of the registry host, whose discovery document is served at DiscoveryPath
+	URL *url.URL
+	// Namespaces are the only organizations proxied, the others being left to the registry itself
+	Namespaces []string
+	Client     *http.Client
+	// MetadataTimeout bounds the requests for the discovery document, version listings and download locations,
+	// which are made while serving clients
+	MetadataTimeout time.Duration
+	// MaxDownloadSize is the largest content in bytes that is downloaded, larger downloads failing with ErrTooLarge
+	MaxDownloadSize int64
+
+	mu       sync.Mutex
+	services map[string]*url.URL
+}
+
+// New returns the upstream registry at a URL, proxying the given namespaces.
+func New(rawURL string, namespaces []string) (*Registry, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("upstream registry URL %q must be http or https", rawURL)
+	}
+	return &Registry{
+		URL:             u,
+		Namespaces:      namespaces,
+		Client:          &http.Client{Timeout: DefaultTimeout},
+		MetadataTimeout: DefaultMetadataTimeout,
+		MaxDownloadSize: DefaultMaxDownloadSize,
+	}, nil
+}
+
+// Allowed reports whether a namespace is proxied. No namespace is proxied without a registry.
+func (r *Registry) Allowed(namespace string) bool {
+	if r == nil {
+		return false
+	}
+	for _, allowed := range r.Namespaces {
+		if strings.EqualFold(allowed, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// Host returns the host of the registry, recorded as the source of what is fetched from it.
+func (r *Registry) Host() string {
+	return r.URL.Host
+}
+
+// ModuleVersions returns the versions of a module.
+func (r *Registry) ModuleVersions(ctx context.Context, namespace, name, provider string) ([]string, error) {
+	u, err := r.serviceURL(ctx, "modules.v1", namespace, name, provider, "versions")
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Modules []struct {
+			Versions []struct {
+				Version string `json:"version"`
+			} `json:"versions"`
+		} `json:"modules"`
+	}
+	if err := r.getJSON(ctx, u, &response); err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for _, module := range response.Modules {
+		for _, version := range module.Versions {
+			versions = append(versions, version.Version)
+		}
+	}
+	return versions, nil
+}
+
+// DownloadModule returns the archive of a module version. Only sources that are zip archives served over HTTP can
+// be fetched, other sources such as git repositories being refused with ErrUnsupportedSource.
+func (r *Registry) DownloadModule(ctx context.Context, namespace, name, provider, version string) ([]byte, error) {
+	source, err := r.moduleSource(ctx, namespace, name, provider, version)
+	if err != nil {
+		return nil, err
+	}
+	return r.Download(ctx, source.String())
+}
+
+// moduleSource returns the archive a module version is downloaded from.
+func (r *Registry) moduleSource(ctx context.Context, namespace, name, provider, version string) (*url.URL, error) {
+	ctx, cancel := r.metadataContext(ctx)
+	defer cancel()
+
+	u, err := r.serviceURL(ctx, "modules.v1", namespace, name, provider, version, "download")
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	location := res.Header.Get("X-Terraform-Get")
+	if location == "" && res.StatusCode == http.StatusOK {
+		var body struct {
+			Location string `json:"location"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		location = body.Location
+	}
+	if location == "" {
+		return nil, fmt.Errorf("no module source returned by %s", u)
+	}
+	return archiveSource(u, location)
+}
+
+// archiveSource resolves a module source against the download URL it was returned by, keeping only the zip
+// archives served over HTTP. The archive argument forcing the archive type is dropped, as go-getter does.
+func archiveSource(base *url.URL, location string) (*url.URL, error) {
+	if strings.Contains(location, "::") {
+		return nil, ErrUnsupportedSource
+	}
+	source, err := base.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	if source.Scheme != "http" && source.Scheme != "https" {
+		return nil, ErrUnsupportedSource
+	}
+
+	query := source.Query()
+	archive := query.Get("archive")
+	if archive == "" && path.Ext(source.Path) == ".zip" {
+		archive = "zip"
+	}
+	if archive != "zip" {
+		return nil, ErrUnsupportedSource
+	}
+	query.Del("archive")
+	source.RawQuery = query.Encode()
+	return source, nil
+}
+
+// ProviderVersions returns the versions of a provider with their platforms.
+func (r *Registry) ProviderVersions(ctx context.Context, namespace, providerType string) ([]*ProviderVersion, error) {
+	u, err := r.serviceURL(ctx, "providers.v1", namespace, providerType, "versions")
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Versions []*ProviderVersion `json:"versions"`
+	}
+	if err := r.getJSON(ctx, u, &response); err != nil {
+		return nil, err
+	}
+	return response.Versions, nil
+}
+
+// ProviderPackage returns the package of a provider version for a platform.
+func (r *Registry) ProviderPackage(ctx context.Context, namespace, providerType, version, os, arch string) (*ProviderPackage, error) {
+	u, err := r.serviceURL(ctx, "providers.v1", namespace, providerType, version, "download", os, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &ProviderPackage{}
+	if err := r.getJSON(ctx, u, pkg); err != nil {
+		return nil, err
+	}
+
+	for _, location := range []*string{&pkg.DownloadURL, &pkg.ShasumsURL, &pkg.ShasumsSignatureURL} {
+		if *location == "" {
+			continue
+		}
+		resolved, err := u.Parse(*location)
+		if err != nil {
+			return nil, err
+		}
+		*location = resolved.String()
+	}
+	return pkg, nil
+}
+
+// Download returns the content at a URL of the upstream registry, failing with ErrTooLarge when it exceeds
+// MaxDownloadSize.
+func (r *Registry) Download(ctx context.Context, rawURL string) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if r.MaxDownloadSize <= 0 {
+		return io.ReadAll(res.Body)
+	}
+	if res.ContentLength > r.MaxDownloadSize {
+		return nil, fmt.Errorf("%w: %s is %d bytes", ErrTooLarge, u, res.ContentLength)
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, r.MaxDownloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > r.MaxDownloadSize {
+		return nil, fmt.Errorf("%w: %s exceeds %d bytes", ErrTooLarge, u, r.MaxDownloadSize)
+	}
+	return data, nil
+}
+
+// serviceURL returns the URL of a path of a service advertised by the registry.
+func (r *Registry) serviceURL(ctx context.Context, service string, elem ...string) (*url.URL, error) {
+	base, err := r.service(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	escaped := make([]string, 0, len(elem))
+	for _, e := range elem {
+		escaped = append(escaped, url.PathEscape(e))
+	}
+	return base.Parse(strings.Join(escaped, "/"))
+}
+
+// service returns the base URL of a service of the registry, read from its discovery document the first time.
+func (r *Registry) service(ctx context.Context, service string) (*url.URL, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.services == nil {
+		discovery, err := r.URL.Parse(DiscoveryPath)
+		if err != nil {
+			return nil, err
+		}
+		var advertised map[string]any
+		if err := r.getJSON(ctx, discovery, &advertised); err != nil {
+			return nil, fmt.Errorf("failed to discover the services of %s: %w", r.URL, err)
+		}
+
+		services := map[string]*url.URL{}
+		for name, value := range advertised {
+			raw, ok := value.(string)
+			if !ok {
+				continue
+			}
+			if !strings.HasSuffix(raw, "/") {
+				raw += "/"
+			}
+			u, err := discovery.Parse(raw)
+			if err != nil {
+				return nil, err
+			}
+			services[name] = u
+		}
+		r.services = services
+	}
+
+	u, ok := r.services[service]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrServiceNotOffered, service)
+	}
+	return u, nil
+}
+
+// metadataContext bounds a context by MetadataTimeout, when one is set.
+func (r *Registry) metadataContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.MetadataTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, r.MetadataTimeout)
+}
+
+func (r *Registry) getJSON(ctx context.Context, u *url.URL, v any) error {
+	ctx, cancel := r.metadataContext(ctx)
+	defer cancel()
+
+	res, err := r.get(ctx, u)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// get returns the response to a GET request, failing with ErrNotFound on a 404 and with an error on any status other
+// than a success.
+func (r *Registry) get(ctx context.Context, u *url.URL) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		_ = res.Body.Close()
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, u)
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		_ = res.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s from %s", res.Status, u)
+	}
+	return res, nil
+}
@@ -0,0 +1,225 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// standIn returns a stand-in upstream registry serving cie/vpc/aws 1.0.0 and the cie/test provider 2.0.0.
+func standIn(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc(DiscoveryPath, func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(`{"modules.v1": "/modules/v1/", "providers.v1": "/providers/v1"}`))
+	})
+	mux.HandleFunc("/modules/v1/cie/vpc/aws/versions", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(`{"modules": [{"versions": [{"version": "1.0.0"}, {"version": "1.1.0"}]}]}`))
+	})
+	mux.HandleFunc("/modules/v1/cie/slow/aws/versions", func(rw http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	mux.HandleFunc("/modules/v1/cie/vpc/aws/1.0.0/download", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("X-Terraform-Get", "./archive?archive=zip")
+		rw.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/modules/v1/cie/vpc/aws/1.0.0/archive", func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			http.Error(rw, "unexpected query", http.StatusBadRequest)
+			return
+		}
+		_, _ = rw.Write([]byte("PK module"))
+	})
+	mux.HandleFunc("/modules/v1/cie/git/aws/1.0.0/download", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("X-Terraform-Get", "git::https://example.com/cie/git.git?ref=v1.0.0")
+		rw.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/providers/v1/cie/test/versions", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(`{"versions": [{"version": "2.0.0", "protocols": ["5.0"], "platforms": [{"os": "linux", "arch": "amd64"}]}]}`))
+	})
+	mux.HandleFunc("/providers/v1/cie/test/2.0.0/download/linux/amd64", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(`{"protocols": ["5.0"], "os": "linux", "arch": "amd64", "filename": "terraform-provider-test_2.0.0_linux_amd64.zip",
+			"download_url": "../../linux/amd64/terraform-provider-test_2.0.0_linux_amd64.zip",
+			"shasums_url": "https://releases.example.com/test/2.0.0/SHA256SUMS", "shasum": "5f9c",
+			"signing_keys": {"gpg_public_keys": [{"key_id": "ABC", "ascii_armor": "KEY"}]}}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newRegistry(t *testing.T, server *httptest.Server) *Registry {
+	t.Helper()
+	registry, err := New(server.URL, []string{"cie"})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return registry
+}
+
+// Test_ModuleVersions checks:
+// - if the versions of a module are listed through the advertised modules service
+// - if ErrNotFound is returned for a module missing upstream
+func Test_ModuleVersions(t *testing.T) {
+	t.Parallel()
+
+	registry := newRegistry(t, standIn(t))
+
+	t.Run("when the module exists", func(t *testing.T) {
+		versions, err := registry.ModuleVersions(context.TODO(), "cie", "vpc", "aws")
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if !reflect.DeepEqual(versions, []string{"1.0.0", "1.1.0"}) {
+			t.Errorf("Expected 1.0.0 and 1.1.0, got %v", versions)
+		}
+	})
+
+	t.Run("when the module is missing", func(t *testing.T) {
+		_, err := registry.ModuleVersions(context.TODO(), "cie", "eks", "aws")
+
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected %v, got %v.", ErrNotFound, err)
+		}
+	})
+
+	t.Run("when the upstream registry does not answer in time", func(t *testing.T) {
+		slow := newRegistry(t, standIn(t))
+		slow.MetadataTimeout = 50 * time.Millisecond
+
+		_, err := slow.ModuleVersions(context.TODO(), "cie", "slow", "aws")
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected %v, got %v.", context.DeadlineExceeded, err)
+		}
+	})
+}
+
+// Test_DownloadModule checks:
+// - if the archive is fetched from the relative source returned by the download endpoint
+// - if ErrUnsupportedSource is returned for a git source
+// - if ErrTooLarge is returned for an archive exceeding the maximum download size
+func Test_DownloadModule(t *testing.T) {
+	t.Parallel()
+
+	registry := newRegistry(t, standIn(t))
+
+	t.Run("when the source is a zip archive", func(t *testing.T) {
+		archive, err := registry.DownloadModule(context.TODO(), "cie", "vpc", "aws", "1.0.0")
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if string(archive) != "PK module" {
+			t.Errorf("Expected the module archive, got %q", archive)
+		}
+	})
+
+	t.Run("when the source is a git repository", func(t *testing.T) {
+		_, err := registry.DownloadModule(context.TODO(), "cie", "git", "aws", "1.0.0")
+
+		if !errors.Is(err, ErrUnsupportedSource) {
+			t.Errorf("Expected %v, got %v.", ErrUnsupportedSource, err)
+		}
+	})
+
+	t.Run("when the archive exceeds the maximum download size", func(t *testing.T) {
+		small := newRegistry(t, standIn(t))
+		small.MaxDownloadSize = 4
+
+		_, err := small.DownloadModule(context.TODO(), "cie", "vpc", "aws", "1.0.0")
+
+		if !errors.Is(err, ErrTooLarge) {
+			t.Errorf("Expected %v, got %v.", ErrTooLarge, err)
+		}
+	})
+}
+
+// Test_ProviderPackage checks:
+// - if the versions of a provider are listed with their platforms
+// - if the relative URLs of a package are resolved against the package endpoint
+func Test_ProviderPackage(t *testing.T) {
+	t.Parallel()
+
+	server := standIn(t)
+	registry := newRegistry(t, server)
+
+	versions, err := registry.ProviderVersions(context.TODO(), "cie", "test")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v.", err)
+	}
+	want := []*ProviderVersion{{Version: "2.0.0", Protocols: []string{"5.0"}, Platforms: []Platform{{Os: "linux", Arch: "amd64"}}}}
+	if !reflect.DeepEqual(versions, want) {
+		t.Errorf("Expected %v, got %v", want, versions)
+	}
+
+	pkg, err := registry.ProviderPackage(context.TODO(), "cie", "test", "2.0.0", "linux", "amd64")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v.", err)
+	}
+
+	if pkg.DownloadURL != server.URL+"/providers/v1/cie/test/2.0.0/linux/amd64/terraform-provider-test_2.0.0_linux_amd64.zip" {
+		t.Errorf("Expected the download URL to be resolved, got %v", pkg.DownloadURL)
+	}
+
+	if pkg.ShasumsURL != "https://releases.example.com/test/2.0.0/SHA256SUMS" || pkg.Shasum != "5f9c" || pkg.SigningKeys.GPGPublicKeys[0].KeyID != "ABC" {
+		t.Errorf("Expected the package metadata, got %v", pkg)
+	}
+}
+
+func Test_Allowed(t *testing.T) {
+	registry := &Registry{Namespaces: []string{"cie", "Hashicorp"}}
+	tests := []struct {
+		name      string
+		registry  *Registry
+		namespace string
+		want      bool
+	}{
+		{"allowed namespace", registry, "cie", true},
+		{"namespace in another case", registry, "hashicorp", true},
+		{"other namespace", registry, "other", false},
+		{"no registry", nil, "cie", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.registry.Allowed(tt.namespace); got != tt.want {
+				t.Errorf("Allowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_archiveSource(t *testing.T) {
+	base, _ := url.Parse("https://registry.example.com/modules/v1/cie/vpc/aws/1.0.0/download")
+	tests := []struct {
+		name     string
+		location string
+		want     string
+		wantErr  bool
+	}{
+		{"relative archive", "./archive?archive=zip", "https://registry.example.com/modules/v1/cie/vpc/aws/1.0.0/archive", false},
+		{"zip file", "https://files.example.com/vpc.zip?token=abc", "https://files.example.com/vpc.zip?token=abc", false},
+		{"forced getter", "git::https://example.com/vpc.git", "", true},
+		{"tarball", "https://files.example.com/vpc.tar.gz", "", true},
+		{"other scheme", "s3://bucket/vpc.zip", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := archiveSource(base, tt.location)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("archiveSource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("archiveSource() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
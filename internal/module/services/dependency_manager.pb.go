@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.31.0
+// 	protoc-gen-go v1.35.1
 // 	protoc        v4.24.4
 // source: pb/terrarium/module/services/dependency_manager.proto
 
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -20,6 +21,277 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RegisterDetectedDependenciesRequest records the dependencies found in the Terraform source of a module version.
+type RegisterDetectedDependenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module    *module.Module                `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Modules   []*module.ModuleRequirement   `protobuf:"bytes,2,rep,name=modules,proto3" json:"modules,omitempty"`
+	Providers []*module.ProviderRequirement `protobuf:"bytes,3,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *RegisterDetectedDependenciesRequest) Reset() {
+	*x = RegisterDetectedDependenciesRequest{}
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDetectedDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDetectedDependenciesRequest) ProtoMessage() {}
+
+func (x *RegisterDetectedDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDetectedDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDetectedDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_services_dependency_manager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterDetectedDependenciesRequest) GetModule() *module.Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *RegisterDetectedDependenciesRequest) GetModules() []*module.ModuleRequirement {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *RegisterDetectedDependenciesRequest) GetProviders() []*module.ProviderRequirement {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// UnregisterDependenciesRequest removes the dependencies recorded for a module version that is removed, together with
+// its entries of the dependents indexes.
+type UnregisterDependenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module *module.Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+}
+
+func (x *UnregisterDependenciesRequest) Reset() {
+	*x = UnregisterDependenciesRequest{}
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDependenciesRequest) ProtoMessage() {}
+
+func (x *UnregisterDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_services_dependency_manager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UnregisterDependenciesRequest) GetModule() *module.Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+// RetrieveProviderDependentsRequest looks up the module versions requiring a provider given as "<namespace>/<type>",
+// restricted to the ones whose constraints allow a version when one is given.
+type RetrieveProviderDependentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Version  string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RetrieveProviderDependentsRequest) Reset() {
+	*x = RetrieveProviderDependentsRequest{}
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveProviderDependentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveProviderDependentsRequest) ProtoMessage() {}
+
+func (x *RetrieveProviderDependentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveProviderDependentsRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveProviderDependentsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_services_dependency_manager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RetrieveProviderDependentsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RetrieveProviderDependentsRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// ProviderDependent is a module version requiring a provider, with the source and constraints it is required with.
+type ProviderDependent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module             *module.Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Source             string         `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	VersionConstraints []string       `protobuf:"bytes,3,rep,name=version_constraints,json=versionConstraints,proto3" json:"version_constraints,omitempty"`
+}
+
+func (x *ProviderDependent) Reset() {
+	*x = ProviderDependent{}
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderDependent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDependent) ProtoMessage() {}
+
+func (x *ProviderDependent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDependent.ProtoReflect.Descriptor instead.
+func (*ProviderDependent) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_services_dependency_manager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProviderDependent) GetModule() *module.Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ProviderDependent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ProviderDependent) GetVersionConstraints() []string {
+	if x != nil {
+		return x.VersionConstraints
+	}
+	return nil
+}
+
+type ProviderDependentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dependents []*ProviderDependent `protobuf:"bytes,1,rep,name=dependents,proto3" json:"dependents,omitempty"`
+}
+
+func (x *ProviderDependentsResponse) Reset() {
+	*x = ProviderDependentsResponse{}
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderDependentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDependentsResponse) ProtoMessage() {}
+
+func (x *ProviderDependentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_services_dependency_manager_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDependentsResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDependentsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_services_dependency_manager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProviderDependentsResponse) GetDependents() []*ProviderDependent {
+	if x != nil {
+		return x.Dependents
+	}
+	return nil
+}
+
 var File_pb_terrarium_module_services_dependency_manager_proto protoreflect.FileDescriptor
 
 var file_pb_terrarium_module_services_dependency_manager_proto_rawDesc = []byte{
@@ -30,70 +302,223 @@ var file_pb_terrarium_module_services_dependency_manager_proto_rawDesc = []byte{
 	0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x73, 0x1a, 0x20, 0x70, 0x62, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
 	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0x94, 0x04, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x6f, 0x0a, 0x1a, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
-	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
-	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x1d, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x74,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdb, 0x01, 0x0a, 0x23, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
 	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
-	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x90, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x12, 0x38, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
-	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d,
+	0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x43, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0x51, 0x0a, 0x1d, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x59, 0x0a, 0x21, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x8e, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x2f, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0x6a, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x32, 0xd4, 0x0b,
+	0x0a, 0x11, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x12, 0x6f, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x33, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
 	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x32, 0x1a, 0x31,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x90, 0x01, 0x0a, 0x1d,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x38, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x32, 0x1a, 0x31, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x22, 0x00, 0x30, 0x01, 0x12, 0x83,
+	0x01, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x6b, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x7c, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x3e, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x7a, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x42, 0x4f, 0x4d, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72,
+	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x42, 0x4f, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
 	0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
-	0x32, 0x22, 0x00, 0x30, 0x01, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
-	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x65, 0x72, 0x72,
-	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x3e, 0x5a, 0x3c, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
-	0x69, 0x75, 0x6d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
-	0x75, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var file_pb_terrarium_module_services_dependency_manager_proto_goTypes = []interface{}{
-	(*module.RegisterModuleDependenciesRequest)(nil),      // 0: terrarium.module.RegisterModuleDependenciesRequest
-	(*module.RegisterContainerDependenciesRequest)(nil),   // 1: terrarium.module.RegisterContainerDependenciesRequest
-	(*module.RetrieveContainerDependenciesRequestV2)(nil), // 2: terrarium.module.RetrieveContainerDependenciesRequestV2
-	(*module.RetrieveModuleDependenciesRequest)(nil),      // 3: terrarium.module.RetrieveModuleDependenciesRequest
-	(*module.Response)(nil),                               // 4: terrarium.module.Response
-	(*module.ContainerDependenciesResponseV2)(nil),        // 5: terrarium.module.ContainerDependenciesResponseV2
-	(*module.ModuleDependenciesResponse)(nil),             // 6: terrarium.module.ModuleDependenciesResponse
+	0x65, 0x2e, 0x53, 0x42, 0x4f, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x70, 0x0a, 0x17, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x30, 0x2e, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x22, 0x00, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x93, 0x01, 0x0a,
+	0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x2e, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x74, 0x65, 0x72, 0x72,
+	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x70, 0x0a, 0x16, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x38, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pb_terrarium_module_services_dependency_manager_proto_rawDescOnce sync.Once
+	file_pb_terrarium_module_services_dependency_manager_proto_rawDescData = file_pb_terrarium_module_services_dependency_manager_proto_rawDesc
+)
+
+func file_pb_terrarium_module_services_dependency_manager_proto_rawDescGZIP() []byte {
+	file_pb_terrarium_module_services_dependency_manager_proto_rawDescOnce.Do(func() {
+		file_pb_terrarium_module_services_dependency_manager_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_terrarium_module_services_dependency_manager_proto_rawDescData)
+	})
+	return file_pb_terrarium_module_services_dependency_manager_proto_rawDescData
+}
+
+var file_pb_terrarium_module_services_dependency_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pb_terrarium_module_services_dependency_manager_proto_goTypes = []any{
+	(*RegisterDetectedDependenciesRequest)(nil),           // 0: terrarium.module.services.RegisterDetectedDependenciesRequest
+	(*UnregisterDependenciesRequest)(nil),                 // 1: terrarium.module.services.UnregisterDependenciesRequest
+	(*RetrieveProviderDependentsRequest)(nil),             // 2: terrarium.module.services.RetrieveProviderDependentsRequest
+	(*ProviderDependent)(nil),                             // 3: terrarium.module.services.ProviderDependent
+	(*ProviderDependentsResponse)(nil),                    // 4: terrarium.module.services.ProviderDependentsResponse
+	(*module.Module)(nil),                                 // 5: terrarium.module.Module
+	(*module.ModuleRequirement)(nil),                      // 6: terrarium.module.ModuleRequirement
+	(*module.ProviderRequirement)(nil),                    // 7: terrarium.module.ProviderRequirement
+	(*module.RegisterModuleDependenciesRequest)(nil),      // 8: terrarium.module.RegisterModuleDependenciesRequest
+	(*module.RegisterContainerDependenciesRequest)(nil),   // 9: terrarium.module.RegisterContainerDependenciesRequest
+	(*module.RetrieveContainerDependenciesRequestV2)(nil), // 10: terrarium.module.RetrieveContainerDependenciesRequestV2
+	(*module.RetrieveModuleDependenciesRequest)(nil),      // 11: terrarium.module.RetrieveModuleDependenciesRequest
+	(*module.RetrieveDependentsRequest)(nil),              // 12: terrarium.module.RetrieveDependentsRequest
+	(*module.SearchContainerImagesRequest)(nil),           // 13: terrarium.module.SearchContainerImagesRequest
+	(*module.ExportSBOMRequest)(nil),                      // 14: terrarium.module.ExportSBOMRequest
+	(*module.RetrieveDependencyGraphRequest)(nil),         // 15: terrarium.module.RetrieveDependencyGraphRequest
+	(*module.RetrieveProviderDependenciesRequest)(nil),    // 16: terrarium.module.RetrieveProviderDependenciesRequest
+	(*module.Response)(nil),                               // 17: terrarium.module.Response
+	(*module.ContainerDependenciesResponseV2)(nil),        // 18: terrarium.module.ContainerDependenciesResponseV2
+	(*module.ModuleDependenciesResponse)(nil),             // 19: terrarium.module.ModuleDependenciesResponse
+	(*module.DependentsResponse)(nil),                     // 20: terrarium.module.DependentsResponse
+	(*module.SearchContainerImagesResponse)(nil),          // 21: terrarium.module.SearchContainerImagesResponse
+	(*module.SBOMResponse)(nil),                           // 22: terrarium.module.SBOMResponse
+	(*module.DependencyGraph)(nil),                        // 23: terrarium.module.DependencyGraph
+	(*module.ProviderDependenciesResponse)(nil),           // 24: terrarium.module.ProviderDependenciesResponse
 }
 var file_pb_terrarium_module_services_dependency_manager_proto_depIdxs = []int32{
-	0, // 0: terrarium.module.services.DependencyManager.RegisterModuleDependencies:input_type -> terrarium.module.RegisterModuleDependenciesRequest
-	1, // 1: terrarium.module.services.DependencyManager.RegisterContainerDependencies:input_type -> terrarium.module.RegisterContainerDependenciesRequest
-	2, // 2: terrarium.module.services.DependencyManager.RetrieveContainerDependencies:input_type -> terrarium.module.RetrieveContainerDependenciesRequestV2
-	3, // 3: terrarium.module.services.DependencyManager.RetrieveModuleDependencies:input_type -> terrarium.module.RetrieveModuleDependenciesRequest
-	4, // 4: terrarium.module.services.DependencyManager.RegisterModuleDependencies:output_type -> terrarium.module.Response
-	4, // 5: terrarium.module.services.DependencyManager.RegisterContainerDependencies:output_type -> terrarium.module.Response
-	5, // 6: terrarium.module.services.DependencyManager.RetrieveContainerDependencies:output_type -> terrarium.module.ContainerDependenciesResponseV2
-	6, // 7: terrarium.module.services.DependencyManager.RetrieveModuleDependencies:output_type -> terrarium.module.ModuleDependenciesResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5,  // 0: terrarium.module.services.RegisterDetectedDependenciesRequest.module:type_name -> terrarium.module.Module
+	6,  // 1: terrarium.module.services.RegisterDetectedDependenciesRequest.modules:type_name -> terrarium.module.ModuleRequirement
+	7,  // 2: terrarium.module.services.RegisterDetectedDependenciesRequest.providers:type_name -> terrarium.module.ProviderRequirement
+	5,  // 3: terrarium.module.services.UnregisterDependenciesRequest.module:type_name -> terrarium.module.Module
+	5,  // 4: terrarium.module.services.ProviderDependent.module:type_name -> terrarium.module.Module
+	3,  // 5: terrarium.module.services.ProviderDependentsResponse.dependents:type_name -> terrarium.module.services.ProviderDependent
+	8,  // 6: terrarium.module.services.DependencyManager.RegisterModuleDependencies:input_type -> terrarium.module.RegisterModuleDependenciesRequest
+	9,  // 7: terrarium.module.services.DependencyManager.RegisterContainerDependencies:input_type -> terrarium.module.RegisterContainerDependenciesRequest
+	10, // 8: terrarium.module.services.DependencyManager.RetrieveContainerDependencies:input_type -> terrarium.module.RetrieveContainerDependenciesRequestV2
+	11, // 9: terrarium.module.services.DependencyManager.RetrieveModuleDependencies:input_type -> terrarium.module.RetrieveModuleDependenciesRequest
+	12, // 10: terrarium.module.services.DependencyManager.RetrieveDependents:input_type -> terrarium.module.RetrieveDependentsRequest
+	0,  // 11: terrarium.module.services.DependencyManager.RegisterDetectedDependencies:input_type -> terrarium.module.services.RegisterDetectedDependenciesRequest
+	13, // 12: terrarium.module.services.DependencyManager.SearchContainerImages:input_type -> terrarium.module.SearchContainerImagesRequest
+	14, // 13: terrarium.module.services.DependencyManager.ExportSBOM:input_type -> terrarium.module.ExportSBOMRequest
+	15, // 14: terrarium.module.services.DependencyManager.RetrieveDependencyGraph:input_type -> terrarium.module.RetrieveDependencyGraphRequest
+	16, // 15: terrarium.module.services.DependencyManager.RetrieveProviderDependencies:input_type -> terrarium.module.RetrieveProviderDependenciesRequest
+	2,  // 16: terrarium.module.services.DependencyManager.RetrieveProviderDependents:input_type -> terrarium.module.services.RetrieveProviderDependentsRequest
+	1,  // 17: terrarium.module.services.DependencyManager.UnregisterDependencies:input_type -> terrarium.module.services.UnregisterDependenciesRequest
+	17, // 18: terrarium.module.services.DependencyManager.RegisterModuleDependencies:output_type -> terrarium.module.Response
+	17, // 19: terrarium.module.services.DependencyManager.RegisterContainerDependencies:output_type -> terrarium.module.Response
+	18, // 20: terrarium.module.services.DependencyManager.RetrieveContainerDependencies:output_type -> terrarium.module.ContainerDependenciesResponseV2
+	19, // 21: terrarium.module.services.DependencyManager.RetrieveModuleDependencies:output_type -> terrarium.module.ModuleDependenciesResponse
+	20, // 22: terrarium.module.services.DependencyManager.RetrieveDependents:output_type -> terrarium.module.DependentsResponse
+	17, // 23: terrarium.module.services.DependencyManager.RegisterDetectedDependencies:output_type -> terrarium.module.Response
+	21, // 24: terrarium.module.services.DependencyManager.SearchContainerImages:output_type -> terrarium.module.SearchContainerImagesResponse
+	22, // 25: terrarium.module.services.DependencyManager.ExportSBOM:output_type -> terrarium.module.SBOMResponse
+	23, // 26: terrarium.module.services.DependencyManager.RetrieveDependencyGraph:output_type -> terrarium.module.DependencyGraph
+	24, // 27: terrarium.module.services.DependencyManager.RetrieveProviderDependencies:output_type -> terrarium.module.ProviderDependenciesResponse
+	4,  // 28: terrarium.module.services.DependencyManager.RetrieveProviderDependents:output_type -> terrarium.module.services.ProviderDependentsResponse
+	17, // 29: terrarium.module.services.DependencyManager.UnregisterDependencies:output_type -> terrarium.module.Response
+	18, // [18:30] is the sub-list for method output_type
+	6,  // [6:18] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_pb_terrarium_module_services_dependency_manager_proto_init() }
@@ -107,12 +532,13 @@ func file_pb_terrarium_module_services_dependency_manager_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_terrarium_module_services_dependency_manager_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   0,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pb_terrarium_module_services_dependency_manager_proto_goTypes,
 		DependencyIndexes: file_pb_terrarium_module_services_dependency_manager_proto_depIdxs,
+		MessageInfos:      file_pb_terrarium_module_services_dependency_manager_proto_msgTypes,
 	}.Build()
 	File_pb_terrarium_module_services_dependency_manager_proto = out.File
 	file_pb_terrarium_module_services_dependency_manager_proto_rawDesc = nil
@@ -73,6 +73,23 @@ func (d dependencyManagerGrpcClient) RetrieveModuleDependencies(ctx context.Cont
 	}
 }
 
+func (d dependencyManagerGrpcClient) RetrieveDependents(ctx context.Context, in *module.RetrieveDependentsRequest, opts ...grpc.CallOption) (services.DependencyManager_RetrieveDependentsClient, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		client := services.NewDependencyManagerClient(conn)
+		if deps, err := client.RetrieveDependents(ctx, in, opts...); err == nil {
+			return &dependencyManager_RetrieveDependentsClient{
+				conn:   conn,
+				client: deps,
+			}, nil
+		} else {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+}
+
 type dependencyManager_RetrieveContainerDependenciesClient struct {
 	grpc.ClientStream
 	conn   *grpc.ClientConn
@@ -100,3 +117,94 @@ func (d dependencyManager_RetrieveModuleDependenciesClient) Recv() (*module.Modu
 	}
 	return result, err
 }
+
+type dependencyManager_RetrieveDependentsClient struct {
+	grpc.ClientStream
+	conn   *grpc.ClientConn
+	client services.DependencyManager_RetrieveDependentsClient
+}
+
+func (d dependencyManager_RetrieveDependentsClient) Recv() (*module.DependentsResponse, error) {
+	result, err := d.client.Recv()
+	if err == io.EOF {
+		_ = d.conn.Close()
+	}
+	return result, err
+}
+
+func (d dependencyManagerGrpcClient) RegisterDetectedDependencies(ctx context.Context, in *services.RegisterDetectedDependenciesRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyManagerClient(conn)
+		return client.RegisterDetectedDependencies(ctx, in, opts...)
+	}
+}
+
+func (d dependencyManagerGrpcClient) SearchContainerImages(ctx context.Context, in *module.SearchContainerImagesRequest, opts ...grpc.CallOption) (*module.SearchContainerImagesResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyManagerClient(conn)
+		return client.SearchContainerImages(ctx, in, opts...)
+	}
+}
+
+func (d dependencyManagerGrpcClient) ExportSBOM(ctx context.Context, in *module.ExportSBOMRequest, opts ...grpc.CallOption) (*module.SBOMResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyManagerClient(conn)
+		return client.ExportSBOM(ctx, in, opts...)
+	}
+}
+
+func (d dependencyManagerGrpcClient) RetrieveDependencyGraph(ctx context.Context, in *module.RetrieveDependencyGraphRequest, opts ...grpc.CallOption) (*module.DependencyGraph, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyManagerClient(conn)
+		return client.RetrieveDependencyGraph(ctx, in, opts...)
+	}
+}
+
+func (d dependencyManagerGrpcClient) RetrieveProviderDependencies(ctx context.Context, in *module.RetrieveProviderDependenciesRequest, opts ...grpc.CallOption) (*module.ProviderDependenciesResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyManagerClient(conn)
+		return client.RetrieveProviderDependencies(ctx, in, opts...)
+	}
+}
+
+func (d dependencyManagerGrpcClient) RetrieveProviderDependents(ctx context.Context, in *services.RetrieveProviderDependentsRequest, opts ...grpc.CallOption) (*services.ProviderDependentsResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyManagerClient(conn)
+		return client.RetrieveProviderDependents(ctx, in, opts...)
+	}
+}
+
+func (d dependencyManagerGrpcClient) UnregisterDependencies(ctx context.Context, in *services.UnregisterDependenciesRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyManagerClient(conn)
+		return client.UnregisterDependencies(ctx, in, opts...)
+	}
+}
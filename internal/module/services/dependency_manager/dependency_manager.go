@@ -2,7 +2,12 @@ package dependency_manager
 
 import (
 	"context"
+	"sort"
+	"strings"
+
+	"github.com/apparentlymart/go-versions/versions"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -23,20 +28,33 @@ import (
 const (
 	DefaultModuleDependenciesTableName    = "terrarium-module-dependencies"
 	DefaultContainerDependenciesTableName = "terrarium-container-dependencies"
+	DefaultModuleDependentsTableName      = "terrarium-module-dependents"
+	DefaultContainerImagesTableName       = "terrarium-container-images"
+	DefaultProviderDependentsTableName    = "terrarium-provider-dependents"
 	DefaultDependencyManagerEndpoint      = "dependency_manager:3001"
+	DefaultMaxConcurrentFetches           = 10
 )
 
 var (
 	ModuleDependenciesTableName    = DefaultModuleDependenciesTableName
 	ContainerDependenciesTableName = DefaultContainerDependenciesTableName
+	ModuleDependentsTableName      = DefaultModuleDependentsTableName
+	ContainerImagesTableName       = DefaultContainerImagesTableName
+	ProviderDependentsTableName    = DefaultProviderDependentsTableName
 	DependencyManagerEndpoint      = DefaultDependencyManagerEndpoint
+	MaxConcurrentFetches           = DefaultMaxConcurrentFetches
 
 	ModuleDependenciesRegistered    = &terrarium.Response{Message: "Module dependencies successfully registered."}
+	ModuleDependenciesUnregistered  = &terrarium.Response{Message: "Module dependencies successfully unregistered."}
 	ContainerDependenciesRegistered = &terrarium.Response{Message: "Container dependencies successfully registered."}
 
 	ModuleDependenciesTableInitializationError    = status.Error(codes.Unavailable, "Failed to initialize table for module dependencies.")
 	ContainerDependenciesTableInitializationError = status.Error(codes.Unavailable, "Failed to initialize table for container dependencies.")
+	ModuleDependentsTableInitializationError      = status.Error(codes.Unavailable, "Failed to initialize table for module dependents.")
+	ContainerImagesTableInitializationError       = status.Error(codes.Unavailable, "Failed to initialize table for container images.")
+	ProviderDependentsTableInitializationError    = status.Error(codes.Unavailable, "Failed to initialize table for provider dependents.")
 	RegisterDependenciesError                     = status.Error(codes.Unknown, "Failed to register dependencies.")
+	UnregisterDependenciesError                   = status.Error(codes.Unknown, "Failed to unregister dependencies.")
 	MarshalDependenciesError                      = status.Error(codes.Unknown, "Failed to marshal dependencies.")
 	SendModuleDependenciesError                   = status.Error(codes.Unknown, "Failed to send module dependencies.")
 	SendContainerDependenciesError                = status.Error(codes.Unknown, "Failed to send container dependencies.")
@@ -44,21 +62,46 @@ var (
 	UnmarshalContainerDependenciesError           = status.Error(codes.Unknown, "Failed to unmarshal container dependencies.")
 	GetModuleDependenciesError                    = status.Error(codes.Unknown, "Failed to get module dependencies.")
 	GetContainerDependenciesError                 = status.Error(codes.Unknown, "Failed to get container dependencies.")
+	GetModuleDependentsError                      = status.Error(codes.Unknown, "Failed to get module dependents.")
+	SendModuleDependentsError                     = status.Error(codes.Unknown, "Failed to send module dependents.")
+	ModuleNameRequiredError                       = status.Error(codes.InvalidArgument, "Module name is required.")
+	InvalidVersionRangeError                      = status.Error(codes.InvalidArgument, "Invalid version range.")
+	SearchCriteriaRequiredError                   = status.Error(codes.InvalidArgument, "At least one of image name, namespace, tag or digest is required.")
+	SearchContainerImagesError                    = status.Error(codes.Unknown, "Failed to search container images.")
 )
 
 type DependencyManagerService struct {
 	services.UnimplementedDependencyManagerServer
-	Db              storage.DynamoDBTableCreator
-	ModuleTable     string
-	ModuleSchema    *dynamodb.CreateTableInput
-	ContainerTable  string
-	ContainerSchema *dynamodb.CreateTableInput
+	Db               storage.DynamoDBTableCreator
+	ModuleTable      string
+	ModuleSchema     *dynamodb.CreateTableInput
+	ContainerTable   string
+	ContainerSchema  *dynamodb.CreateTableInput
+	DependentsTable  string
+	DependentsSchema *dynamodb.CreateTableInput
+	ImagesTable      string
+	ImagesSchema     *dynamodb.CreateTableInput
+	ProvidersTable   string
+	ProvidersSchema  *dynamodb.CreateTableInput
+	// MaxConcurrentFetches bounds the modules fetched at once while walking a dependency graph, 1 when unset
+	MaxConcurrentFetches int
+	// Registrar and VersionManager are used to annotate the nodes of dependency graphs with their maturity,
+	// which is left out when either is unset. VersionManager also resolves the version constraints of
+	// requirements, which are then only resolved when they pin a version.
+	Registrar      services.RegistrarClient
+	VersionManager services.VersionManagerClient
 }
 
+// ModuleDependencies holds the dependencies registered for a module version, and the ones detected in its source.
 type ModuleDependencies struct {
-	Name    string              `json:"name" bson:"name" dynamodbav:"name"`
-	Version string              `json:"version" bson:"version" dynamodbav:"version"`
-	Modules []*terrarium.Module `json:"modules" bson:"modules" dynamodbav:"modules"`
+	Name         string                         `json:"name" bson:"name" dynamodbav:"name"`
+	Version      string                         `json:"version" bson:"version" dynamodbav:"version"`
+	Modules      []*terrarium.Module            `json:"modules" bson:"modules" dynamodbav:"modules"`
+	Requirements []*terrarium.ModuleRequirement `json:"requirements" bson:"requirements" dynamodbav:"requirements"`
+	Detected     []*terrarium.ModuleRequirement `json:"detected" bson:"detected" dynamodbav:"detected"`
+	// Providers holds the providers found in the source and RegisteredProviders the ones registered explicitly
+	Providers           []*terrarium.ProviderRequirement `json:"providers" bson:"providers" dynamodbav:"providers"`
+	RegisteredProviders []*terrarium.ProviderRequirement `json:"registered_providers" bson:"registered_providers" dynamodbav:"registered_providers"`
 }
 type ContainerDependencies struct {
 	Name    string                                      `json:"name" bson:"name" dynamodbav:"name"`
@@ -66,6 +109,16 @@ type ContainerDependencies struct {
 	Images  map[string]*terrarium.ContainerImageDetails `json:"images" bson:"images" dynamodbav:"images"`
 }
 
+// ModuleDependent is an entry of the reverse dependency index.
+// Name is the module being depended on and Version the version of it used by the dependent.
+type ModuleDependent struct {
+	Name             string `json:"name" bson:"name" dynamodbav:"name"`
+	Dependent        string `json:"dependent" bson:"dependent" dynamodbav:"dependent"`
+	Version          string `json:"version" bson:"version" dynamodbav:"version"`
+	DependentName    string `json:"dependent_name" bson:"dependent_name" dynamodbav:"dependent_name"`
+	DependentVersion string `json:"dependent_version" bson:"dependent_version" dynamodbav:"dependent_version"`
+}
+
 // RegisterWithServer Registers DependencyManagerService with grpc server
 func (s *DependencyManagerService) RegisterWithServer(grpcServer grpc.ServiceRegistrar) error {
 	if err := storage.InitializeDynamoDb(s.ModuleTable, s.ModuleSchema, s.Db); err != nil {
@@ -78,6 +131,21 @@ func (s *DependencyManagerService) RegisterWithServer(grpcServer grpc.ServiceReg
 		return ContainerDependenciesTableInitializationError
 	}
 
+	if err := storage.InitializeDynamoDb(s.DependentsTable, s.DependentsSchema, s.Db); err != nil {
+		log.Println(err)
+		return ModuleDependentsTableInitializationError
+	}
+
+	if err := storage.InitializeDynamoDb(s.ImagesTable, s.ImagesSchema, s.Db); err != nil {
+		log.Println(err)
+		return ContainerImagesTableInitializationError
+	}
+
+	if err := storage.InitializeDynamoDb(s.ProvidersTable, s.ProvidersSchema, s.Db); err != nil {
+		log.Println(err)
+		return ProviderDependentsTableInitializationError
+	}
+
 	services.RegisterDependencyManagerServer(grpcServer, s)
 
 	return nil
@@ -96,7 +164,7 @@ func (s *DependencyManagerService) registerDependencies(ctx context.Context, tab
 	}
 
 	if _, err = s.Db.PutItem(ctx, item); err != nil {
-
+		log.Println(err)
 		return RegisterDependenciesError
 	}
 	return nil
@@ -109,22 +177,144 @@ func (s *DependencyManagerService) RegisterModuleDependencies(ctx context.Contex
 	span.SetAttributes(
 		attribute.String("module.name", request.Module.GetName()),
 		attribute.String("module.version", request.Module.GetVersion()),
+		attribute.Int("requirements", len(request.GetRequirements())),
 	)
-	item := ModuleDependencies{
-		Name:    request.Module.GetName(),
-		Version: request.Module.GetVersion(),
-		Modules: request.GetDependencies(),
+	requirements, err := s.recordRequirements(ctx, request.GetRequirements())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	item, err := s.getModuleDependenciesItem(ctx, request.Module)
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, RegisterDependenciesError
 	}
+	published := newPublishedVersions()
+	previous := s.moduleDependencies(ctx, item, published)
+	previousProviders := item.providers()
+
+	item.Name = request.Module.GetName()
+	item.Version = request.Module.GetVersion()
+	item.Modules = request.GetDependencies()
+	item.Requirements = requirements
+	item.RegisteredProviders = request.GetProviders()
+	s.reportConflicts(ctx, item)
 
 	if err := s.registerDependencies(ctx, s.ModuleTable, item); err != nil {
 		span.RecordError(err)
 		log.Println(err)
 		return nil, err
 	}
+
+	if err := s.updateDependents(ctx, request.Module, previous, s.moduleDependencies(ctx, item, published)); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := s.updateProviderDependents(ctx, request.Module, previousProviders, item.providers()); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, err
+	}
 	log.Printf("Module dependencies registered for %s/%s.\n", request.Module.GetName(), request.Module.GetVersion())
 	return ModuleDependenciesRegistered, nil
 }
 
+// updateDependents keeps the reverse dependency index in line with the dependencies registered for a module,
+// removing entries for dependencies that are no longer used.
+func (s *DependencyManagerService) updateDependents(ctx context.Context, module *terrarium.Module, previous, current []*terrarium.Module) error {
+	dependent := moduleKey(module)
+
+	for _, dependency := range previous {
+		if containsModuleName(current, dependency.GetName()) {
+			continue
+		}
+		key, err := attributevalue.MarshalMap(map[string]string{"name": dependency.GetName(), "dependent": dependent})
+		if err != nil {
+			log.Println(err)
+			return MarshalDependenciesError
+		}
+		if _, err := s.Db.DeleteItem(ctx, &dynamodb.DeleteItemInput{TableName: aws.String(s.DependentsTable), Key: key}); err != nil {
+			log.Println(err)
+			return RegisterDependenciesError
+		}
+	}
+
+	for _, dependency := range current {
+		item := ModuleDependent{
+			Name:             dependency.GetName(),
+			Dependent:        dependent,
+			Version:          dependency.GetVersion(),
+			DependentName:    module.GetName(),
+			DependentVersion: module.GetVersion(),
+		}
+		if err := s.registerDependencies(ctx, s.DependentsTable, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnregisterDependencies removes the dependencies recorded for a module version, registered or detected, together
+// with its entries of the module and provider dependents indexes. It is used when a draft version is removed.
+func (s *DependencyManagerService) UnregisterDependencies(ctx context.Context, request *services.UnregisterDependenciesRequest) (*terrarium.Response, error) {
+	log.Printf("Unregistering dependencies for %s/%s.\n", request.GetModule().GetName(), request.GetModule().GetVersion())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+	)
+
+	if request.GetModule().GetName() == "" {
+		span.RecordError(ModuleNameRequiredError)
+		return nil, ModuleNameRequiredError
+	}
+
+	item, err := s.getModuleDependenciesItem(ctx, request.GetModule())
+	if err != nil {
+		span.RecordError(err)
+		return nil, UnregisterDependenciesError
+	}
+
+	// the dependents index is keyed by name, constraints need not be resolved to remove its entries
+	unresolved := func(*terrarium.ModuleRequirement) string { return "" }
+	if err := s.updateDependents(ctx, request.GetModule(), item.dependencies(unresolved), nil); err != nil {
+		span.RecordError(err)
+		return nil, UnregisterDependenciesError
+	}
+	if err := s.updateProviderDependents(ctx, request.GetModule(), item.providers(), nil); err != nil {
+		span.RecordError(err)
+		return nil, UnregisterDependenciesError
+	}
+
+	key, err := s.GetModuleKey(request.GetModule())
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, UnregisterDependenciesError
+	}
+	if _, err := s.Db.DeleteItem(ctx, &dynamodb.DeleteItemInput{TableName: aws.String(s.ModuleTable), Key: key}); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, UnregisterDependenciesError
+	}
+
+	log.Printf("Dependencies unregistered for %s/%s.\n", request.GetModule().GetName(), request.GetModule().GetVersion())
+	return ModuleDependenciesUnregistered, nil
+}
+
+func containsModuleName(modules []*terrarium.Module, name string) bool {
+	for _, m := range modules {
+		if m.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
 // RegisterContainerDependencies Registers Container dependencies in Terrarium
 func (s *DependencyManagerService) RegisterContainerDependencies(ctx context.Context, request *terrarium.RegisterContainerDependenciesRequest) (*terrarium.Response, error) {
 	log.Printf("Registering container dependencies for %s/%s.\n", request.Module.GetName(), request.Module.GetVersion())
@@ -133,6 +323,13 @@ func (s *DependencyManagerService) RegisterContainerDependencies(ctx context.Con
 		attribute.String("module.name", request.Module.GetName()),
 		attribute.String("module.version", request.Module.GetVersion()),
 	)
+	previous, err := s.GetContainerDependencies(ctx, request.Module)
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, RegisterDependenciesError
+	}
+
 	item := ContainerDependencies{
 		Name:    request.Module.GetName(),
 		Version: request.Module.GetVersion(),
@@ -144,6 +341,12 @@ func (s *DependencyManagerService) RegisterContainerDependencies(ctx context.Con
 		log.Println(err)
 		return nil, err
 	}
+
+	if err := s.updateContainerImages(ctx, request.Module, previous, request.Images); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, err
+	}
 	log.Printf("Container dependencies registered for %s/%s.\n", request.Module.GetName(), request.Module.GetVersion())
 	return ContainerDependenciesRegistered, nil
 }
@@ -151,51 +354,49 @@ func (s *DependencyManagerService) RegisterContainerDependencies(ctx context.Con
 // RetrieveContainerDependencies Retrieve Container dependencies from Terrarium
 func (s *DependencyManagerService) RetrieveContainerDependencies(request *terrarium.RetrieveContainerDependenciesRequestV2, server services.DependencyManager_RetrieveContainerDependenciesServer) error {
 	log.Println("Retrieving container dependencies.")
-	controlCh := make(chan *terrarium.Module, 250)
-	controlCh <- request.Module
-
-	moreModulesToProcess := true
 	ctx := server.Context()
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(
 		attribute.String("module.name", request.Module.GetName()),
 		attribute.String("module.version", request.Module.GetVersion()),
+		attribute.Int("max_depth", int(request.GetMaxDepth())),
 	)
-	for moreModulesToProcess {
-		select {
-		case moduleToProcess := <-controlCh:
-			dep, err := s.GetModuleDependencies(ctx, moduleToProcess)
-			if err != nil {
-				log.Println(err)
-				span.RecordError(err)
-				return err
-			}
-
-			images, err := s.GetContainerDependencies(ctx, moduleToProcess)
-			if err != nil {
-				log.Println(err)
-				span.RecordError(err)
-				return err
-			}
-
-			res := &terrarium.ContainerDependenciesResponseV2{
-				Module:       moduleToProcess,
-				Dependencies: images,
-			}
-			if err := server.Send(res); err != nil {
-				log.Println(err)
-				span.RecordError(err)
-				return SendContainerDependenciesError
-			}
 
-			for _, dependency := range dep {
-				controlCh <- dependency
-			}
-		default:
-			moreModulesToProcess = false
-			close(controlCh)
+	published := newPublishedVersions()
+	nodes, err := traverse(ctx, request.Module, int(request.GetMaxDepth()), s.MaxConcurrentFetches, func(ctx context.Context, node *graphNode) error {
+		dep, err := s.getModuleDependencies(ctx, node.Module, published)
+		if err != nil {
+			return err
+		}
+		images, err := s.GetContainerDependencies(ctx, node.Module)
+		if err != nil {
+			return err
 		}
+		node.Dependencies = dep
+		node.Images = images
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return err
+	}
 
+	for _, node := range nodes {
+		if len(node.Cycles) > 0 {
+			span.AddEvent("Dependency cycle detected", trace.WithAttributes(attribute.String("module.name", node.Module.GetName())))
+		}
+		res := &terrarium.ContainerDependenciesResponseV2{
+			Module:       node.Module,
+			Dependencies: node.Images,
+			Cycles:       node.Cycles,
+			Depth:        int32(node.Depth),
+		}
+		if err := server.Send(res); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return SendContainerDependenciesError
+		}
 	}
 
 	log.Println("Container dependencies retrieved.")
@@ -217,7 +418,20 @@ func (s *DependencyManagerService) GetModuleKey(module *terrarium.Module) (map[s
 	}, nil
 }
 
+// GetModuleDependencies returns the dependencies of a module version, merging the registered and detected ones.
 func (s *DependencyManagerService) GetModuleDependencies(ctx context.Context, module *terrarium.Module) ([]*terrarium.Module, error) {
+	return s.getModuleDependencies(ctx, module, newPublishedVersions())
+}
+
+func (s *DependencyManagerService) getModuleDependencies(ctx context.Context, module *terrarium.Module, published *publishedVersions) ([]*terrarium.Module, error) {
+	item, err := s.getModuleDependenciesItem(ctx, module)
+	if err != nil {
+		return nil, err
+	}
+	return s.moduleDependencies(ctx, item, published), nil
+}
+
+func (s *DependencyManagerService) getModuleDependenciesItem(ctx context.Context, module *terrarium.Module) (*ModuleDependencies, error) {
 	log.Printf("GetModuleDependencies for module: %s/%s", module.GetName(), module.GetVersion())
 	span := trace.SpanFromContext(ctx)
 	moduleKey, err := s.GetModuleKey(module)
@@ -239,15 +453,18 @@ func (s *DependencyManagerService) GetModuleDependencies(ctx context.Context, mo
 		return nil, GetModuleDependenciesError
 	}
 
-	dependencies := ModuleDependencies{}
-	if err := attributevalue.UnmarshalMap(out.Item, &dependencies); err != nil {
+	dependencies := &ModuleDependencies{}
+	if out == nil {
+		return dependencies, nil
+	}
+	if err := attributevalue.UnmarshalMap(out.Item, dependencies); err != nil {
 		log.Println(err)
 		span.RecordError(err)
 		// When no entry is found in the table, for compatibility just return an empty list
-		return dependencies.Modules, nil
+		return &ModuleDependencies{}, nil
 	}
-	log.Printf("GetModuleDependencies returned %d entries\n", len(dependencies.Modules))
-	return dependencies.Modules, nil
+	log.Printf("GetModuleDependencies returned %d entries\n", len(dependencies.Modules)+len(dependencies.Detected))
+	return dependencies, nil
 }
 
 func (s *DependencyManagerService) GetContainerDependencies(ctx context.Context, module *terrarium.Module) (map[string]*terrarium.ContainerImageDetails, error) {
@@ -272,6 +489,9 @@ func (s *DependencyManagerService) GetContainerDependencies(ctx context.Context,
 	}
 
 	dependencies := ContainerDependencies{}
+	if out == nil {
+		return dependencies.Images, nil
+	}
 	if err := attributevalue.UnmarshalMap(out.Item, &dependencies); err != nil {
 		log.Println(err)
 		span.RecordError(err)
@@ -288,45 +508,194 @@ func (s *DependencyManagerService) RetrieveModuleDependencies(request *terrarium
 	span.SetAttributes(
 		attribute.String("module.name", request.Module.GetName()),
 		attribute.String("module.version", request.Module.GetVersion()),
+		attribute.Int("max_depth", int(request.GetMaxDepth())),
 	)
-	controlCh := make(chan *terrarium.Module, 250)
-	controlCh <- request.Module
-
-	moreModulesToProcess := true
-
-	for moreModulesToProcess {
-		select {
-		case moduleToProcess := <-controlCh:
-			dep, err := s.GetModuleDependencies(context.TODO(), moduleToProcess)
-			if err != nil {
-				log.Println(err)
-				span.RecordError(err)
-				return err
-			}
 
-			res := &terrarium.ModuleDependenciesResponse{
-				Module:       moduleToProcess,
-				Dependencies: dep,
-			}
-			if err := server.Send(res); err != nil {
-				log.Println(err)
-				span.RecordError(err)
-				return SendModuleDependenciesError
+	published := newPublishedVersions()
+	nodes, err := traverse(ctx, request.Module, int(request.GetMaxDepth()), s.MaxConcurrentFetches, func(ctx context.Context, node *graphNode) error {
+		item, err := s.getModuleDependenciesItem(ctx, node.Module)
+		if err != nil {
+			return err
+		}
+		node.Dependencies = s.moduleDependencies(ctx, item, published)
+		node.Requirements = item.Requirements
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return err
+	}
+
+	for _, node := range nodes {
+		if len(node.Cycles) > 0 {
+			span.AddEvent("Dependency cycle detected", trace.WithAttributes(attribute.String("module.name", node.Module.GetName())))
+		}
+		res := &terrarium.ModuleDependenciesResponse{
+			Module:       node.Module,
+			Dependencies: node.Dependencies,
+			Cycles:       node.Cycles,
+			Depth:        int32(node.Depth),
+			Requirements: s.resolveRequirements(ctx, node.Requirements, published),
+		}
+		if err := server.Send(res); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return SendModuleDependenciesError
+		}
+	}
+
+	log.Println("Module dependencies retrieved.")
+	return nil
+}
+
+// RetrieveDependents Retrieve the modules depending on a module from Terrarium
+func (s *DependencyManagerService) RetrieveDependents(request *terrarium.RetrieveDependentsRequest, server services.DependencyManager_RetrieveDependentsServer) error {
+	ctx := server.Context()
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.Module.GetName()),
+		attribute.String("module.version", request.Module.GetVersion()),
+		attribute.String("module.version_range", request.GetVersionRange()),
+		attribute.Bool("recursive", request.GetRecursive()),
+	)
+
+	if request.Module.GetName() == "" {
+		span.RecordError(ModuleNameRequiredError)
+		return ModuleNameRequiredError
+	}
+
+	rangeMatches, err := versionMatcher(request.Module.GetVersion(), request.GetVersionRange())
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return InvalidVersionRangeError
+	}
+
+	queue := []*terrarium.Module{request.Module}
+	visited := map[string]bool{moduleKey(request.Module): true}
+
+	for len(queue) > 0 {
+		moduleToProcess := queue[0]
+		queue = queue[1:]
+
+		// Modules further up the chain depend on the exact version of the dependent found
+		matches := rangeMatches
+		if moduleToProcess != request.Module {
+			matches, _ = versionMatcher(moduleToProcess.GetVersion(), "")
+		}
+
+		entries, err := s.GetModuleDependents(ctx, moduleToProcess.GetName())
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return err
+		}
+
+		dependents := make([]*terrarium.Module, 0, len(entries))
+		for _, entry := range entries {
+			if !matches(entry.Version) {
+				continue
 			}
+			dependent := &terrarium.Module{Name: entry.DependentName, Version: entry.DependentVersion}
+			dependents = append(dependents, dependent)
 
-			for _, dependency := range dep {
-				controlCh <- dependency
+			if request.GetRecursive() && !visited[entry.Dependent] {
+				visited[entry.Dependent] = true
+				queue = append(queue, dependent)
 			}
-		default:
-			moreModulesToProcess = false
-			close(controlCh)
+		}
+
+		res := &terrarium.DependentsResponse{
+			Module:     moduleToProcess,
+			Dependents: dependents,
+		}
+		if err := server.Send(res); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return SendModuleDependentsError
 		}
 	}
 
-	log.Println("Module dependencies retrieved.")
+	log.Println("Module dependents retrieved.")
 	return nil
 }
 
+// versionMatcher returns a function reporting whether a dependency version satisfies
+// the requested version range, or equals the requested version when no range is given.
+// Any version matches when neither is given.
+func versionMatcher(version, versionRange string) (func(string) bool, error) {
+	if versionRange != "" {
+		allowed, err := versions.MeetingConstraintsStringRuby(versionRange)
+		if err != nil {
+			return nil, err
+		}
+		return func(v string) bool {
+			parsed, err := versions.ParseVersion(strings.TrimPrefix(v, "v"))
+			return err == nil && allowed.Has(parsed)
+		}, nil
+	}
+	if version != "" {
+		return func(v string) bool {
+			return strings.TrimPrefix(v, "v") == strings.TrimPrefix(version, "v")
+		}, nil
+	}
+	return func(string) bool { return true }, nil
+}
+
+// GetModuleDependents returns the entries of the reverse dependency index for a module, sorted by dependent.
+func (s *DependencyManagerService) GetModuleDependents(ctx context.Context, name string) ([]ModuleDependent, error) {
+	log.Printf("GetModuleDependents for module: %s", name)
+	span := trace.SpanFromContext(ctx)
+
+	keyCondition := expression.Key("name").Equal(expression.Value(name))
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, GetModuleDependentsError
+	}
+
+	in := &dynamodb.QueryInput{
+		TableName:                 aws.String(s.DependentsTable),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+
+	var dependents []ModuleDependent
+	for {
+		out, err := s.Db.Query(ctx, in)
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, GetModuleDependentsError
+		}
+		if out == nil {
+			break
+		}
+
+		var page []ModuleDependent
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &page); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, GetModuleDependentsError
+		}
+		dependents = append(dependents, page...)
+
+		if len(out.LastEvaluatedKey) == 0 {
+			break
+		}
+		in.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+
+	sort.Slice(dependents, func(i, j int) bool {
+		return dependents[i].Dependent < dependents[j].Dependent
+	})
+	log.Printf("GetModuleDependents returned %d entries\n", len(dependents))
+	return dependents, nil
+}
+
 // GetDependenciesSchema returns CreateTableInput
 // that can be used to create table if it does not exist
 func GetDependenciesSchema(table string) *dynamodb.CreateTableInput {
@@ -355,3 +724,88 @@ func GetDependenciesSchema(table string) *dynamodb.CreateTableInput {
 		BillingMode: types.BillingModePayPerRequest,
 	}
 }
+
+// GetDependentsSchema returns CreateTableInput
+// that can be used to create the reverse dependency table if it does not exist
+func GetDependentsSchema(table string) *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("name"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("dependent"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("name"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("dependent"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		TableName:   aws.String(table),
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
+
+// GetContainerImagesSchema returns CreateTableInput
+// that can be used to create the container image index if it does not exist
+func GetContainerImagesSchema(table string) *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("image"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("module"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("image"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("module"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		TableName:   aws.String(table),
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
+
+func GetProviderDependentsSchema(table string) *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("provider"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("module"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("provider"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("module"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		TableName:   aws.String(table),
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
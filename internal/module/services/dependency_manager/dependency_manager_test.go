@@ -80,6 +80,23 @@ func (src *MockRetrieveModuleDependenciesServer) Context() context.Context {
 	return context.TODO()
 }
 
+type MockRetrieveDependentsServer struct {
+	grpc.ServerStream
+	SendInvocations int
+	Responses       []*terrarium.DependentsResponse
+	Err             error
+}
+
+func (srv *MockRetrieveDependentsServer) Send(res *terrarium.DependentsResponse) error {
+	srv.SendInvocations++
+	srv.Responses = append(srv.Responses, res)
+	return srv.Err
+}
+
+func (src *MockRetrieveDependentsServer) Context() context.Context {
+	return context.TODO()
+}
+
 type MockGetDependenciesResponse struct {
 	Dependencies []*terrarium.Module
 	Err          error
@@ -95,14 +112,20 @@ func (m *MockGetDependenciesResponse) GetDependencies(request *terrarium.Module)
 // - if there was no error with table init
 // - if error is returned when Module Dependencies Table initialization fails
 // - if error is returned when Container Dependencies Table initialization fails
+// - if error is returned when Module Dependents Table initialization fails
+// - if error is returned when Container Images Table initialization fails
+// - if error is returned when Provider Dependents Table initialization fails
 func Test_RegisterDependencyManagerWithServer(t *testing.T) {
 	t.Parallel()
 
 	t.Run("when there is no error with table init", func(t *testing.T) {
-		// The dependencies are to be stored in two tables at this stage:
+		// The dependencies are to be stored in five tables at this stage:
 		// - Module dependencies
 		// - Container dependencies
-		expectedDescribeTableInvocations := 2
+		// - Module dependents
+		// - Container images
+		// - Provider dependents
+		expectedDescribeTableInvocations := 5
 		expectedCreateTableInvocations := 0
 
 		db := &mocks.DynamoDB{}
@@ -189,10 +212,99 @@ func Test_RegisterDependencyManagerWithServer(t *testing.T) {
 			t.Errorf("Expected %d calls to CreateTable, got %v.", expectedCreateTableInvocations, db.CreateTableInvocations)
 		}
 	})
+
+	t.Run("when Module Dependents Table initialization fails", func(t *testing.T) {
+		expectedError := ModuleDependentsTableInitializationError
+		expectedDescribeTableInvocations := 3
+		expectedCreateTableInvocations := 1
+
+		db := &mocks.DynamoDB{
+			DescribeTableErrors: []error{nil, nil, errors.New("some error")},
+			CreateTableError:    errors.New("some error"),
+		}
+
+		dms := &DependencyManagerService{Db: db}
+
+		s := grpc.NewServer(*new([]grpc.ServerOption)...)
+
+		err := dms.RegisterWithServer(s)
+
+		if err != expectedError {
+			t.Errorf("Expected '%s', got '%s'.", expectedError, err)
+		}
+
+		if db.DescribeTableInvocations != expectedDescribeTableInvocations {
+			t.Errorf("Expected %d call to DescribeTable, got %d.", expectedDescribeTableInvocations, db.DescribeTableInvocations)
+		}
+
+		if db.CreateTableInvocations != expectedCreateTableInvocations {
+			t.Errorf("Expected %d calls to CreateTable, got %v.", expectedCreateTableInvocations, db.CreateTableInvocations)
+		}
+	})
+
+	t.Run("when Container Images Table initialization fails", func(t *testing.T) {
+		expectedError := ContainerImagesTableInitializationError
+		expectedDescribeTableInvocations := 4
+		expectedCreateTableInvocations := 1
+
+		db := &mocks.DynamoDB{
+			DescribeTableErrors: []error{nil, nil, nil, errors.New("some error")},
+			CreateTableError:    errors.New("some error"),
+		}
+
+		dms := &DependencyManagerService{Db: db}
+
+		s := grpc.NewServer(*new([]grpc.ServerOption)...)
+
+		err := dms.RegisterWithServer(s)
+
+		if err != expectedError {
+			t.Errorf("Expected '%s', got '%s'.", expectedError, err)
+		}
+
+		if db.DescribeTableInvocations != expectedDescribeTableInvocations {
+			t.Errorf("Expected %d call to DescribeTable, got %d.", expectedDescribeTableInvocations, db.DescribeTableInvocations)
+		}
+
+		if db.CreateTableInvocations != expectedCreateTableInvocations {
+			t.Errorf("Expected %d calls to CreateTable, got %v.", expectedCreateTableInvocations, db.CreateTableInvocations)
+		}
+	})
+
+	t.Run("when Provider Dependents Table initialization fails", func(t *testing.T) {
+		expectedError := ProviderDependentsTableInitializationError
+		expectedDescribeTableInvocations := 5
+		expectedCreateTableInvocations := 1
+
+		db := &mocks.DynamoDB{
+			DescribeTableErrors: []error{nil, nil, nil, nil, errors.New("some error")},
+			CreateTableError:    errors.New("some error"),
+		}
+
+		dms := &DependencyManagerService{Db: db}
+
+		s := grpc.NewServer(*new([]grpc.ServerOption)...)
+
+		err := dms.RegisterWithServer(s)
+
+		if err != expectedError {
+			t.Errorf("Expected '%s', got '%s'.", expectedError, err)
+		}
+
+		if db.DescribeTableInvocations != expectedDescribeTableInvocations {
+			t.Errorf("Expected %d call to DescribeTable, got %d.", expectedDescribeTableInvocations, db.DescribeTableInvocations)
+		}
+
+		if db.CreateTableInvocations != expectedCreateTableInvocations {
+			t.Errorf("Expected %d calls to CreateTable, got %v.", expectedCreateTableInvocations, db.CreateTableInvocations)
+		}
+	})
 }
 
 // Test_RegisterModuleDependencies checks:
 // - if correct response is returned when module dependencies are registered
+// - if reverse index entries of dependencies no longer used are removed
+// - if error is returned when the previous dependencies cannot be read
 // - if error is returned when PutItem fails
 func Test_RegisterModuleDependencies(t *testing.T) {
 	t.Parallel()
@@ -200,7 +312,7 @@ func Test_RegisterModuleDependencies(t *testing.T) {
 	t.Run("when module dependencies are registered", func(t *testing.T) {
 		db := &mocks.DynamoDB{}
 
-		svc := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName}
+		svc := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName, DependentsTable: ModuleDependentsTableName}
 
 		req := &terrarium.RegisterModuleDependenciesRequest{
 			Module: &terrarium.Module{Name: "test", Version: "v1"},
@@ -216,12 +328,17 @@ func Test_RegisterModuleDependencies(t *testing.T) {
 			t.Errorf("Expected no error, got %v", err)
 		}
 
-		if db.PutItemInvocations != 1 {
-			t.Errorf("Expected 1 call to PutItem, got %v", db.PutItemInvocations)
+		// One entry for the module dependencies and one reverse index entry per dependency
+		if db.PutItemInvocations != 3 {
+			t.Errorf("Expected 3 calls to PutItem, got %v", db.PutItemInvocations)
 		}
 
-		if db.TableName != ModuleDependenciesTableName {
-			t.Errorf("Expected tableName to be %v, got %v.", ModuleDependenciesTableName, db.TableName)
+		if db.DeleteItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to DeleteItem, got %v", db.DeleteItemInvocations)
+		}
+
+		if db.TableName != ModuleDependentsTableName {
+			t.Errorf("Expected tableName to be %v, got %v.", ModuleDependentsTableName, db.TableName)
 		}
 
 		if res != ModuleDependenciesRegistered {
@@ -229,6 +346,72 @@ func Test_RegisterModuleDependencies(t *testing.T) {
 		}
 	})
 
+	t.Run("when a dependency is no longer used", func(t *testing.T) {
+		previous := makeGetItemOutput(ModuleDependencies{
+			Name:    "test",
+			Version: "v1",
+			Modules: []*terrarium.Module{
+				{Name: "test", Version: "v1.0.0"},
+				{Name: "old", Version: "v0.1.0"},
+			},
+		}, t)
+
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{previous}}
+
+		svc := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName, DependentsTable: ModuleDependentsTableName}
+
+		req := &terrarium.RegisterModuleDependenciesRequest{
+			Module: &terrarium.Module{Name: "test", Version: "v1"},
+			Dependencies: []*terrarium.Module{
+				{Name: "test", Version: "v1.0.0"},
+				{Name: "test2", Version: "v1.1.0"},
+			},
+		}
+
+		res, err := svc.RegisterModuleDependencies(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if db.DeleteItemInvocations != 1 {
+			t.Errorf("Expected 1 call to DeleteItem, got %v", db.DeleteItemInvocations)
+		}
+
+		if db.PutItemInvocations != 3 {
+			t.Errorf("Expected 3 calls to PutItem, got %v", db.PutItemInvocations)
+		}
+
+		if res != ModuleDependenciesRegistered {
+			t.Errorf("Expected %v, got %v.", ModuleDependenciesRegistered, res)
+		}
+	})
+
+	t.Run("when the previous dependencies cannot be read", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemErrors: []error{errors.New("some error")}}
+
+		svc := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName, DependentsTable: ModuleDependentsTableName}
+
+		req := &terrarium.RegisterModuleDependenciesRequest{
+			Module:       &terrarium.Module{Name: "test", Version: "v1"},
+			Dependencies: []*terrarium.Module{{Name: "test2", Version: "v1.1.0"}},
+		}
+
+		res, err := svc.RegisterModuleDependencies(context.TODO(), req)
+
+		if res != nil {
+			t.Errorf("Expected no response, got %v", res)
+		}
+
+		if err != RegisterDependenciesError {
+			t.Errorf("Expected %v, got %v.", RegisterDependenciesError, err)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to PutItem, got %v", db.PutItemInvocations)
+		}
+	})
+
 	// TODO: Test for MarshalModuleDependenciesError
 
 	t.Run("when PutItem fails", func(t *testing.T) {
@@ -266,18 +449,26 @@ func Test_RegisterModuleDependencies(t *testing.T) {
 
 // Test_RegisterContainerDependencies checks:
 // - if correct response is returned when container dependencies are registered
+// - if the container image index is updated, removing images no longer referenced
+// - if error is returned when the previous images cannot be read
 // - if error is returned when UpdateItem fails
 func Test_RegisterContainerDependencies(t *testing.T) {
 	t.Parallel()
 
 	t.Run("when container dependencies are registered", func(t *testing.T) {
-		var expectedPutItemInvocations = 1
-		var expectedTableName = ContainerDependenciesTableName
+		// the container dependencies, then an index entry for grafana and kubescaler
+		var expectedPutItemInvocations = 3
+		var expectedTableName = ContainerImagesTableName
 		var expectedResponse = ContainerDependenciesRegistered
 		var expectedError error = nil
 
-		db := &mocks.DynamoDB{}
-		svc := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName}
+		previous := ContainerDependencies{
+			Name:    "test",
+			Version: "v1",
+			Images:  map[string]*terrarium.ContainerImageDetails{"grafana": {Tag: "0.1.0"}, "prometheus": {Tag: "2.0.0"}},
+		}
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{makeGetItemOutput(previous, t)}}
+		svc := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName, ImagesTable: ContainerImagesTableName}
 		req := &registerContainerDependenciesTestData
 		res, err := svc.RegisterContainerDependencies(context.TODO(), req)
 
@@ -290,7 +481,11 @@ func Test_RegisterContainerDependencies(t *testing.T) {
 		}
 
 		if db.PutItemInvocations != expectedPutItemInvocations {
-			t.Errorf("Expected %d call to PutItem, got %d", expectedPutItemInvocations, db.UpdateItemInvocations)
+			t.Errorf("Expected %d call to PutItem, got %d", expectedPutItemInvocations, db.PutItemInvocations)
+		}
+
+		if db.DeleteItemInvocations != 1 {
+			t.Errorf("Expected 1 call to DeleteItem for prometheus, got %d", db.DeleteItemInvocations)
 		}
 
 		if db.TableName != expectedTableName {
@@ -298,6 +493,21 @@ func Test_RegisterContainerDependencies(t *testing.T) {
 		}
 	})
 
+	t.Run("when GetItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemErrors: []error{errors.New("some error")}}
+		svc := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName, ImagesTable: ContainerImagesTableName}
+		req := &registerContainerDependenciesTestData
+		_, err := svc.RegisterContainerDependencies(context.TODO(), req)
+
+		if err != RegisterDependenciesError {
+			t.Errorf("Expected %v, got %v.", RegisterDependenciesError, err)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to PutItem, got %d", db.PutItemInvocations)
+		}
+	})
+
 	t.Run("when UpdateItem fails", func(t *testing.T) {
 		var expectedPutItemInvocations = 1
 		var expectedTableName = ContainerDependenciesTableName
@@ -457,6 +667,7 @@ func Test_RetrieveContainerDependencies(t *testing.T) {
 			{
 				Module:       &subModule,
 				Dependencies: submoduleContainerDependencies,
+				Depth:        1,
 			},
 		}
 		err := dms.RetrieveContainerDependencies(req, srv)
@@ -714,6 +925,204 @@ func Test_RetrieveModuleDependencies(t *testing.T) {
 	})
 }
 
+func makeQueryOutput(t *testing.T, entries ...ModuleDependent) *dynamodb.QueryOutput {
+	t.Helper()
+	items := make([]map[string]types.AttributeValue, 0, len(entries))
+	for _, entry := range entries {
+		item, err := attributevalue.MarshalMap(entry)
+		if err != nil {
+			t.Fatalf("Failed to marshal test data %s", err)
+		}
+		items = append(items, item)
+	}
+	return &dynamodb.QueryOutput{Items: items}
+}
+
+func makeModuleDependent(dependency, version, dependentName, dependentVersion string) ModuleDependent {
+	return ModuleDependent{
+		Name:             dependency,
+		Dependent:        dependentName + "@" + dependentVersion,
+		Version:          version,
+		DependentName:    dependentName,
+		DependentVersion: dependentVersion,
+	}
+}
+
+// Test_RetrieveDependents checks:
+// - if only dependents of the requested version are returned
+// - if dependents are filtered by version range
+// - if dependents of dependents are returned once each when recursive, even with a cycle
+// - if error is returned when the module name is missing
+// - if error is returned when the version range is invalid
+// - if error is returned when Query fails
+// - if error is returned when Send fails
+func Test_RetrieveDependents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when dependents of a version are retrieved", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryOut: makeQueryOutput(t,
+			makeModuleDependent("cie/vpc/aws", "1.0.0", "cie/eks/aws", "3.0.0"),
+			makeModuleDependent("cie/vpc/aws", "2.0.0", "cie/rds/aws", "1.4.0"),
+		)}
+		dms := &DependencyManagerService{Db: db, DependentsTable: ModuleDependentsTableName}
+		srv := &MockRetrieveDependentsServer{}
+
+		req := &terrarium.RetrieveDependentsRequest{Module: &terrarium.Module{Name: "cie/vpc/aws", Version: "1.0.0"}}
+
+		err := dms.RetrieveDependents(req, srv)
+
+		if err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+
+		if db.TableName != ModuleDependentsTableName {
+			t.Errorf("Expected tableName to be %v, got %v.", ModuleDependentsTableName, db.TableName)
+		}
+
+		if srv.SendInvocations != 1 {
+			t.Fatalf("Expected 1 call to Send, got %v.", srv.SendInvocations)
+		}
+
+		expected := []*terrarium.Module{{Name: "cie/eks/aws", Version: "3.0.0"}}
+		if !reflect.DeepEqual(srv.Responses[0].Dependents, expected) {
+			t.Errorf("Expected %v, got %v.", expected, srv.Responses[0].Dependents)
+		}
+	})
+
+	t.Run("when dependents are filtered by version range", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryOut: makeQueryOutput(t,
+			makeModuleDependent("cie/vpc/aws", "0.9.0", "cie/app/aws", "1.0.0"),
+			makeModuleDependent("cie/vpc/aws", "1.2.0", "cie/eks/aws", "3.0.0"),
+			makeModuleDependent("cie/vpc/aws", "2.0.0", "cie/rds/aws", "1.4.0"),
+		)}
+		dms := &DependencyManagerService{Db: db, DependentsTable: ModuleDependentsTableName}
+		srv := &MockRetrieveDependentsServer{}
+
+		req := &terrarium.RetrieveDependentsRequest{
+			Module:       &terrarium.Module{Name: "cie/vpc/aws"},
+			VersionRange: ">= 1.0.0, < 2.0.0",
+		}
+
+		err := dms.RetrieveDependents(req, srv)
+
+		if err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+
+		if srv.SendInvocations != 1 {
+			t.Fatalf("Expected 1 call to Send, got %v.", srv.SendInvocations)
+		}
+
+		expected := []*terrarium.Module{{Name: "cie/eks/aws", Version: "3.0.0"}}
+		if !reflect.DeepEqual(srv.Responses[0].Dependents, expected) {
+			t.Errorf("Expected %v, got %v.", expected, srv.Responses[0].Dependents)
+		}
+	})
+
+	t.Run("when dependents are retrieved recursively", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryOuts: []*dynamodb.QueryOutput{
+			makeQueryOutput(t, makeModuleDependent("cie/vpc/aws", "1.0.0", "cie/eks/aws", "3.0.0")),
+			makeQueryOutput(t,
+				makeModuleDependent("cie/eks/aws", "3.0.0", "cie/platform/aws", "2.0.0"),
+				makeModuleDependent("cie/eks/aws", "2.0.0", "cie/legacy/aws", "1.0.0"),
+			),
+			// cie/platform/aws 2.0.0 is used by cie/vpc/aws 1.0.0, closing a cycle
+			makeQueryOutput(t, makeModuleDependent("cie/platform/aws", "2.0.0", "cie/vpc/aws", "1.0.0")),
+		}}
+		dms := &DependencyManagerService{Db: db, DependentsTable: ModuleDependentsTableName}
+		srv := &MockRetrieveDependentsServer{}
+
+		req := &terrarium.RetrieveDependentsRequest{
+			Module:    &terrarium.Module{Name: "cie/vpc/aws", Version: "1.0.0"},
+			Recursive: true,
+		}
+
+		err := dms.RetrieveDependents(req, srv)
+
+		if err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+
+		if db.QueryItemInvocations != 3 {
+			t.Errorf("Expected 3 calls to Query, got %v.", db.QueryItemInvocations)
+		}
+
+		if srv.SendInvocations != 3 {
+			t.Fatalf("Expected 3 calls to Send, got %v.", srv.SendInvocations)
+		}
+
+		expected := []*terrarium.Module{{Name: "cie/platform/aws", Version: "2.0.0"}}
+		if !reflect.DeepEqual(srv.Responses[1].Dependents, expected) {
+			t.Errorf("Expected %v, got %v.", expected, srv.Responses[1].Dependents)
+		}
+	})
+
+	t.Run("when the module name is missing", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		dms := &DependencyManagerService{Db: db, DependentsTable: ModuleDependentsTableName}
+		srv := &MockRetrieveDependentsServer{}
+
+		err := dms.RetrieveDependents(&terrarium.RetrieveDependentsRequest{}, srv)
+
+		if err != ModuleNameRequiredError {
+			t.Errorf("Expected %v, got %v", ModuleNameRequiredError, err)
+		}
+
+		if db.QueryItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to Query, got %v.", db.QueryItemInvocations)
+		}
+	})
+
+	t.Run("when the version range is invalid", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		dms := &DependencyManagerService{Db: db, DependentsTable: ModuleDependentsTableName}
+		srv := &MockRetrieveDependentsServer{}
+
+		req := &terrarium.RetrieveDependentsRequest{
+			Module:       &terrarium.Module{Name: "cie/vpc/aws"},
+			VersionRange: "not a range",
+		}
+
+		err := dms.RetrieveDependents(req, srv)
+
+		if err != InvalidVersionRangeError {
+			t.Errorf("Expected %v, got %v", InvalidVersionRangeError, err)
+		}
+	})
+
+	t.Run("when Query fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryError: errors.New("some error")}
+		dms := &DependencyManagerService{Db: db, DependentsTable: ModuleDependentsTableName}
+		srv := &MockRetrieveDependentsServer{}
+
+		req := &terrarium.RetrieveDependentsRequest{Module: &terrarium.Module{Name: "cie/vpc/aws"}}
+
+		err := dms.RetrieveDependents(req, srv)
+
+		if err != GetModuleDependentsError {
+			t.Errorf("Expected %v, got %v", GetModuleDependentsError, err)
+		}
+
+		if srv.SendInvocations != 0 {
+			t.Errorf("Expected 0 calls to Send, got %v.", srv.SendInvocations)
+		}
+	})
+
+	t.Run("when Send fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryOut: makeQueryOutput(t)}
+		dms := &DependencyManagerService{Db: db, DependentsTable: ModuleDependentsTableName}
+		srv := &MockRetrieveDependentsServer{Err: errors.New("some error")}
+
+		req := &terrarium.RetrieveDependentsRequest{Module: &terrarium.Module{Name: "cie/vpc/aws"}}
+
+		err := dms.RetrieveDependents(req, srv)
+
+		if err != SendModuleDependentsError {
+			t.Errorf("Expected %v, got %v", SendModuleDependentsError, err)
+		}
+	})
+}
+
 //
 //// Test_GetDependencies checks:
 //// - if correct response is returned when dependencies are retrieved
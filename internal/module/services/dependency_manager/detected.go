@@ -0,0 +1,154 @@
+package dependency_manager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/apparentlymart/go-versions/versions"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+var DetectedDependenciesRegistered = &terrarium.Response{Message: "Detected dependencies successfully registered."}
+
+// RegisterDetectedDependencies records the modules and providers found in the source of a module version,
+// replacing the ones detected before. Dependencies registered explicitly are kept.
+func (s *DependencyManagerService) RegisterDetectedDependencies(ctx context.Context, request *services.RegisterDetectedDependenciesRequest) (*terrarium.Response, error) {
+	log.Printf("Registering detected dependencies for %s/%s.\n", request.GetModule().GetName(), request.GetModule().GetVersion())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.Int("detected.modules", len(request.GetModules())),
+		attribute.Int("detected.providers", len(request.GetProviders())),
+	)
+
+	if request.GetModule().GetName() == "" {
+		span.RecordError(ModuleNameRequiredError)
+		return nil, ModuleNameRequiredError
+	}
+
+	item, err := s.getModuleDependenciesItem(ctx, request.GetModule())
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, RegisterDependenciesError
+	}
+	published := newPublishedVersions()
+	previous := s.moduleDependencies(ctx, item, published)
+	previousProviders := item.providers()
+
+	item.Name = request.GetModule().GetName()
+	item.Version = request.GetModule().GetVersion()
+	item.Detected = request.GetModules()
+	item.Providers = request.GetProviders()
+	s.reportConflicts(ctx, item)
+
+	if err := s.registerDependencies(ctx, s.ModuleTable, item); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := s.updateDependents(ctx, request.GetModule(), previous, s.moduleDependencies(ctx, item, published)); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := s.updateProviderDependents(ctx, request.GetModule(), previousProviders, item.providers()); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, err
+	}
+	log.Printf("Detected dependencies registered for %s/%s.\n", request.GetModule().GetName(), request.GetModule().GetVersion())
+	return DetectedDependenciesRegistered, nil
+}
+
+// dependencies merges the registered dependencies with the detected ones. A registered dependency wins over
+// a requirement of the same name, which wins over a detected module. Requirements are listed at the version
+// recorded when they were registered. Requirements without one and detected modules are otherwise listed at
+// the version their constraint pins, or at the version resolve returns for it when it allows several, which is
+// empty when no published version meets it.
+func (d *ModuleDependencies) dependencies(resolve func(*terrarium.ModuleRequirement) string) []*terrarium.Module {
+	merged := append([]*terrarium.Module{}, d.Modules...)
+	for _, requirement := range d.Requirements {
+		if containsModuleName(merged, requirement.GetName()) {
+			continue
+		}
+		version := requirement.GetVersion()
+		if version == "" {
+			version = constraintVersion(requirement, resolve)
+		}
+		merged = append(merged, &terrarium.Module{Name: requirement.GetName(), Version: version})
+	}
+	for _, detected := range d.Detected {
+		if containsModuleName(merged, detected.GetName()) {
+			continue
+		}
+		merged = append(merged, &terrarium.Module{Name: detected.GetName(), Version: constraintVersion(detected, resolve)})
+	}
+	return merged
+}
+
+func constraintVersion(requirement *terrarium.ModuleRequirement, resolve func(*terrarium.ModuleRequirement) string) string {
+	if exact, ok := exactVersion(requirement.GetVersionConstraint()); ok {
+		return exact
+	}
+	return resolve(requirement)
+}
+
+// moduleDependencies returns the dependencies of a module version, resolving the constraints that allow several
+// versions to the newest published version meeting them, so that they can be followed and indexed as versions.
+func (s *DependencyManagerService) moduleDependencies(ctx context.Context, item *ModuleDependencies, published *publishedVersions) []*terrarium.Module {
+	return item.dependencies(func(requirement *terrarium.ModuleRequirement) string {
+		return s.resolveConstraint(ctx, requirement, published)
+	})
+}
+
+// conflicts describes the registered dependencies whose version does not meet the constraint found in the source.
+func (d *ModuleDependencies) conflicts() []string {
+	var conflicts []string
+	for _, detected := range d.Detected {
+		if detected.GetVersionConstraint() == "" {
+			continue
+		}
+		constraint, err := versions.MeetingConstraintsStringRuby(detected.GetVersionConstraint())
+		if err != nil {
+			continue
+		}
+		for _, registered := range d.Modules {
+			if registered.GetName() != detected.GetName() {
+				continue
+			}
+			version, err := versions.ParseVersion(strings.TrimPrefix(registered.GetVersion(), "v"))
+			if err == nil && !constraint.Has(version) {
+				conflicts = append(conflicts, fmt.Sprintf("%s %s does not meet %q", registered.GetName(), registered.GetVersion(), detected.GetVersionConstraint()))
+			}
+		}
+	}
+	return conflicts
+}
+
+// reportConflicts logs the registered dependencies contradicting the source of the module version.
+func (s *DependencyManagerService) reportConflicts(ctx context.Context, item *ModuleDependencies) {
+	span := trace.SpanFromContext(ctx)
+	for _, conflict := range item.conflicts() {
+		log.Printf("Registered dependency of %s/%s conflicts with its source: %s", item.Name, item.Version, conflict)
+		span.AddEvent("Registered dependency conflicts with source", trace.WithAttributes(attribute.String("conflict", conflict)))
+	}
+}
+
+// exactVersion returns the version a constraint such as "1.2.0" or "= 1.2.0" pins.
+func exactVersion(constraint string) (string, bool) {
+	version := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(constraint), "="))
+	if _, err := versions.ParseVersion(strings.TrimPrefix(version, "v")); err != nil {
+		return "", false
+	}
+	return version, true
+}
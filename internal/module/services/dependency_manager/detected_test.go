@@ -0,0 +1,233 @@
+package dependency_manager
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	servicesMocks "github.com/terrariumcloud/terrarium/internal/module/services/mocks"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+// Test_RegisterDetectedDependencies checks:
+// - if detected dependencies are stored and indexed along with the registered ones
+// - if error is returned when the module name is missing
+// - if error is returned when the current dependencies cannot be read
+func Test_RegisterDetectedDependencies(t *testing.T) {
+	t.Parallel()
+
+	request := &services.RegisterDetectedDependenciesRequest{
+		Module: &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"},
+		Modules: []*terrarium.ModuleRequirement{
+			{Name: "cie/vpc/aws", VersionConstraint: "~> 1.2"},
+			{Name: "cie/labels/null", VersionConstraint: "= 0.5.0"},
+		},
+		Providers: []*terrarium.ProviderRequirement{{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{"~> 5.0"}}},
+	}
+
+	t.Run("when dependencies are detected", func(t *testing.T) {
+		registered, err := attributevalue.MarshalMap(ModuleDependencies{
+			Name:    "cie/eks/aws",
+			Version: "3.0.0",
+			Modules: []*terrarium.Module{{Name: "cie/vpc/aws", Version: "1.3.0"}},
+		})
+		if err != nil {
+			t.Fatalf("Failed to marshal test data %s", err)
+		}
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{{Item: registered}}}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, DependentsTable: ModuleDependentsTableName}
+
+		res, err := dms.RegisterDetectedDependencies(context.TODO(), request)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if res != DetectedDependenciesRegistered {
+			t.Errorf("Expected %v, got %v.", DetectedDependenciesRegistered, res)
+		}
+
+		// the dependencies entry, a dependents entry for vpc and labels, then a provider dependents entry for aws
+		if db.PutItemInvocations != 4 {
+			t.Errorf("Expected 4 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+
+		if db.DeleteItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to DeleteItem, got %v.", db.DeleteItemInvocations)
+		}
+	})
+
+	t.Run("when the module name is missing", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		dms := &DependencyManagerService{Db: db}
+
+		_, err := dms.RegisterDetectedDependencies(context.TODO(), &services.RegisterDetectedDependenciesRequest{})
+
+		if err != ModuleNameRequiredError {
+			t.Errorf("Expected %v, got %v.", ModuleNameRequiredError, err)
+		}
+	})
+
+	t.Run("when GetItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemErrors: []error{errors.New("some error")}}
+		dms := &DependencyManagerService{Db: db}
+
+		_, err := dms.RegisterDetectedDependencies(context.TODO(), request)
+
+		if err != RegisterDependenciesError {
+			t.Errorf("Expected %v, got %v.", RegisterDependenciesError, err)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+}
+
+// Test_UnregisterDependencies checks:
+// - if the dependencies entry is removed with the dependents entries of its modules and providers
+// - if error is returned when the module name is missing
+// - if error is returned when an entry cannot be removed
+func Test_UnregisterDependencies(t *testing.T) {
+	t.Parallel()
+
+	request := &services.UnregisterDependenciesRequest{Module: &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"}}
+	recorded, err := attributevalue.MarshalMap(ModuleDependencies{
+		Name:      "cie/eks/aws",
+		Version:   "3.0.0",
+		Modules:   []*terrarium.Module{{Name: "cie/vpc/aws", Version: "1.3.0"}},
+		Detected:  []*terrarium.ModuleRequirement{{Name: "cie/labels/null", VersionConstraint: "~> 0.5"}},
+		Providers: []*terrarium.ProviderRequirement{{Name: "aws", Source: "hashicorp/aws"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal test data %s", err)
+	}
+
+	t.Run("when dependencies are recorded", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{{Item: recorded}}}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, DependentsTable: ModuleDependentsTableName, ProvidersTable: ProviderDependentsTableName}
+
+		res, err := dms.UnregisterDependencies(context.TODO(), request)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if res != ModuleDependenciesUnregistered {
+			t.Errorf("Expected %v, got %v.", ModuleDependenciesUnregistered, res)
+		}
+
+		// dependents entries for vpc and labels, a provider dependents entry for aws, then the dependencies entry
+		if db.DeleteItemInvocations != 4 || db.TableName != ModuleDependenciesTableName {
+			t.Errorf("Expected 4 calls to DeleteItem ending with %v, got %v on %v.", ModuleDependenciesTableName, db.DeleteItemInvocations, db.TableName)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when the module name is missing", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{}}
+
+		_, err := dms.UnregisterDependencies(context.TODO(), &services.UnregisterDependenciesRequest{})
+
+		if err != ModuleNameRequiredError {
+			t.Errorf("Expected %v, got %v.", ModuleNameRequiredError, err)
+		}
+	})
+
+	t.Run("when DeleteItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{{Item: recorded}}, DeleteItemError: errors.New("some error")}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, DependentsTable: ModuleDependentsTableName, ProvidersTable: ProviderDependentsTableName}
+
+		_, err := dms.UnregisterDependencies(context.TODO(), request)
+
+		if err != UnregisterDependenciesError {
+			t.Errorf("Expected %v, got %v.", UnregisterDependenciesError, err)
+		}
+	})
+}
+
+// Test_RegisterDetectedDependencies_ranged checks:
+// - if detected modules whose constraint allows several versions are indexed at the newest published version meeting it
+func Test_RegisterDetectedDependencies_ranged(t *testing.T) {
+	t.Parallel()
+
+	db := &puttingDynamoDB{DynamoDB: &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{{}}}}
+	versionManager := &servicesMocks.MockVersionManagerClient{
+		ListModuleVersionsResponse: &services.ListModuleVersionsResponse{Versions: []string{"1.2.0", "1.4.1", "2.0.0"}},
+	}
+	dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, DependentsTable: ModuleDependentsTableName, VersionManager: versionManager}
+
+	_, err := dms.RegisterDetectedDependencies(context.TODO(), &services.RegisterDetectedDependenciesRequest{
+		Module:  &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"},
+		Modules: []*terrarium.ModuleRequirement{{Name: "cie/vpc/aws", VersionConstraint: "~> 1.2"}},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v.", err)
+	}
+
+	dependent := ModuleDependent{}
+	if err := attributevalue.UnmarshalMap(db.items[ModuleDependentsTableName][0], &dependent); err != nil {
+		t.Fatalf("Failed to unmarshal dependents entry %s", err)
+	}
+	if dependent.Name != "cie/vpc/aws" || dependent.Version != "1.4.1" {
+		t.Errorf("Expected cie/vpc/aws to be indexed at 1.4.1, got %v at %v.", dependent.Name, dependent.Version)
+	}
+}
+
+// puttingDynamoDB records the items put in every table.
+type puttingDynamoDB struct {
+	*mocks.DynamoDB
+	items map[string][]map[string]types.AttributeValue
+}
+
+func (db *puttingDynamoDB) PutItem(ctx context.Context, in *dynamodb.PutItemInput, opts ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	if db.items == nil {
+		db.items = map[string][]map[string]types.AttributeValue{}
+	}
+	db.items[*in.TableName] = append(db.items[*in.TableName], in.Item)
+	return db.DynamoDB.PutItem(ctx, in, opts...)
+}
+
+// Test_ModuleDependencies checks:
+// - if registered dependencies win over detected modules of the same name
+// - if detected modules are listed at the version pinned by their constraint, or at the version it resolves to
+// - if registered versions not meeting the constraint found in the source are reported
+func Test_ModuleDependencies(t *testing.T) {
+	dependencies := &ModuleDependencies{
+		Modules: []*terrarium.Module{{Name: "cie/vpc/aws", Version: "1.0.0"}},
+		Detected: []*terrarium.ModuleRequirement{
+			{Name: "cie/vpc/aws", VersionConstraint: "~> 1.2"},
+			{Name: "cie/labels/null", VersionConstraint: "= 0.5.0"},
+			{Name: "cie/iam/aws", VersionConstraint: ">= 2.0, < 3.0"},
+		},
+	}
+
+	expected := []*terrarium.Module{
+		{Name: "cie/vpc/aws", Version: "1.0.0"},
+		{Name: "cie/labels/null", Version: "0.5.0"},
+		{Name: "cie/iam/aws", Version: "2.4.0"},
+	}
+	resolve := func(requirement *terrarium.ModuleRequirement) string {
+		if requirement.GetVersionConstraint() == ">= 2.0, < 3.0" {
+			return "2.4.0"
+		}
+		return ""
+	}
+	if got := dependencies.dependencies(resolve); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v.", expected, got)
+	}
+
+	if conflicts := dependencies.conflicts(); len(conflicts) != 1 {
+		t.Errorf("Expected 1 conflict, got %v.", conflicts)
+	}
+}
@@ -0,0 +1,199 @@
+package dependency_manager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+// RetrieveDependencyGraph returns the module graph of a module version as nodes and edges,
+// with the container images referenced by every module as leaves.
+func (s *DependencyManagerService) RetrieveDependencyGraph(ctx context.Context, request *terrarium.RetrieveDependencyGraphRequest) (*terrarium.DependencyGraph, error) {
+	log.Printf("Retrieving dependency graph for %s/%s.\n", request.GetModule().GetName(), request.GetModule().GetVersion())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.Int("max_depth", int(request.GetMaxDepth())),
+	)
+
+	if request.GetModule().GetName() == "" {
+		span.RecordError(ModuleNameRequiredError)
+		return nil, ModuleNameRequiredError
+	}
+
+	published := newPublishedVersions()
+	nodes, err := traverse(ctx, request.GetModule(), int(request.GetMaxDepth()), s.MaxConcurrentFetches, func(ctx context.Context, node *graphNode) error {
+		dep, err := s.getModuleDependencies(ctx, node.Module, published)
+		if err != nil {
+			return err
+		}
+		images, err := s.GetContainerDependencies(ctx, node.Module)
+		if err != nil {
+			return err
+		}
+		node.Dependencies = dep
+		node.Images = images
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	graph := newDependencyGraph(nodes)
+	s.annotateMaturity(ctx, graph)
+
+	log.Printf("Dependency graph retrieved with %d nodes.\n", len(graph.Nodes))
+	return graph, nil
+}
+
+// newDependencyGraph lists the modules walked and the images they reference as nodes, in the order they were reached.
+// Dependencies of the modules at the maximum depth are left out, along with their edges. Unresolved dependencies are
+// listed without a version.
+func newDependencyGraph(nodes []*graphNode) *terrarium.DependencyGraph {
+	graph := &terrarium.DependencyGraph{}
+	listed := map[string]bool{}
+	for _, node := range nodes {
+		listed[moduleNodeID(node.Module)] = true
+	}
+
+	for _, node := range nodes {
+		id := moduleNodeID(node.Module)
+		graph.Nodes = append(graph.Nodes, &terrarium.GraphNode{
+			Id:      id,
+			Type:    terrarium.GraphNode_MODULE,
+			Name:    node.Module.GetName(),
+			Version: node.Module.GetVersion(),
+			Depth:   int32(node.Depth),
+		})
+
+		for _, dependency := range node.Dependencies {
+			dependencyID := moduleNodeID(dependency)
+			if !listed[dependencyID] || dependency.GetVersion() == "" {
+				continue
+			}
+			graph.Edges = append(graph.Edges, &terrarium.GraphEdge{
+				From:  id,
+				To:    dependencyID,
+				Cycle: containsModule(node.Cycles, dependency),
+			})
+		}
+
+		for _, dependency := range node.Unresolved {
+			dependencyID := moduleNodeID(dependency)
+			if !listed[dependencyID] {
+				listed[dependencyID] = true
+				graph.Nodes = append(graph.Nodes, &terrarium.GraphNode{
+					Id:    dependencyID,
+					Type:  terrarium.GraphNode_MODULE,
+					Name:  dependency.GetName(),
+					Depth: int32(node.Depth + 1),
+				})
+			}
+			graph.Edges = append(graph.Edges, &terrarium.GraphEdge{From: id, To: dependencyID})
+		}
+
+		names := make([]string, 0, len(node.Images))
+		for name := range node.Images {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			details := node.Images[name]
+			if details.GetNamespace() != "" {
+				name = details.GetNamespace() + "/" + name
+			}
+			imageID := fmt.Sprintf("image:%s:%s", name, details.GetTag())
+			if !listed[imageID] {
+				listed[imageID] = true
+				graph.Nodes = append(graph.Nodes, &terrarium.GraphNode{
+					Id:      imageID,
+					Type:    terrarium.GraphNode_CONTAINER,
+					Name:    name,
+					Version: details.GetTag(),
+					Depth:   int32(node.Depth + 1),
+				})
+			}
+			graph.Edges = append(graph.Edges, &terrarium.GraphEdge{From: id, To: imageID})
+		}
+	}
+	return graph
+}
+
+func moduleNodeID(module *terrarium.Module) string {
+	return "module:" + moduleKey(module)
+}
+
+func containsModule(modules []*terrarium.Module, module *terrarium.Module) bool {
+	for _, m := range modules {
+		if moduleKey(m) == moduleKey(module) {
+			return true
+		}
+	}
+	return false
+}
+
+// moduleMaturities holds the maturity of a module and the ones set on its versions.
+type moduleMaturities struct {
+	Module   terrarium.Maturity
+	Versions map[string]terrarium.Maturity
+}
+
+// annotateMaturity sets the maturity of the module nodes, from the version when set and the module otherwise.
+// Nodes of modules that cannot be looked up are left as they are.
+func (s *DependencyManagerService) annotateMaturity(ctx context.Context, graph *terrarium.DependencyGraph) {
+	if s.Registrar == nil || s.VersionManager == nil {
+		return
+	}
+
+	lookedUp := map[string]*moduleMaturities{}
+	for _, node := range graph.Nodes {
+		if node.Type != terrarium.GraphNode_MODULE {
+			continue
+		}
+		maturities, ok := lookedUp[node.Name]
+		if !ok {
+			maturities = s.getModuleMaturities(ctx, node.Name)
+			lookedUp[node.Name] = maturities
+		}
+		if maturities == nil {
+			continue
+		}
+
+		maturity := maturities.Module
+		if versionMaturity, ok := maturities.Versions[node.Version]; ok {
+			maturity = versionMaturity
+		}
+		node.Maturity = &maturity
+		node.Deprecated = maturity == terrarium.Maturity_DEPRECATED || maturity == terrarium.Maturity_END_OF_LIFE
+	}
+}
+
+func (s *DependencyManagerService) getModuleMaturities(ctx context.Context, name string) *moduleMaturities {
+	span := trace.SpanFromContext(ctx)
+
+	module, err := s.Registrar.GetModule(ctx, &services.GetModuleRequest{Name: name})
+	if err != nil {
+		log.Printf("Failed to get module %s: %v", name, err)
+		span.AddEvent("Maturity of module not found", trace.WithAttributes(attribute.String("module.name", name)))
+		return nil
+	}
+
+	versions, err := s.VersionManager.ListModuleVersions(ctx, &services.ListModuleVersionsRequest{Module: name})
+	if err != nil {
+		log.Printf("Failed to list versions of module %s: %v", name, err)
+		span.AddEvent("Maturity of module versions not found", trace.WithAttributes(attribute.String("module.name", name)))
+		return nil
+	}
+
+	return &moduleMaturities{Module: module.GetModule().GetMaturity(), Versions: versions.GetMaturities()}
+}
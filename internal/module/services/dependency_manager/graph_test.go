@@ -0,0 +1,190 @@
+package dependency_manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	servicesMocks "github.com/terrariumcloud/terrarium/internal/module/services/mocks"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+// Test_RetrieveDependencyGraph checks:
+// - if modules and the images they reference are returned as nodes with an edge per dependency
+// - if edges leading back to a module on the path are flagged as cycles
+// - if dependencies whose version range cannot be resolved are listed without a version
+// - if dependencies beyond the maximum depth are left out
+// - if nodes are annotated with maturity when registrar and version manager are set
+// - if nodes are left without maturity when the module cannot be looked up
+// - if error is returned when the module name is missing
+// - if error is returned when the graph cannot be walked
+func Test_RetrieveDependencyGraph(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the graph has images", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"a@1.0.0": {mod("b")},
+		})
+		db.images["b@1.0.0"] = map[string]*terrarium.ContainerImageDetails{"nginx": {Namespace: "base", Tag: "1.23"}}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName}
+
+		graph, err := dms.RetrieveDependencyGraph(context.TODO(), &terrarium.RetrieveDependencyGraphRequest{Module: mod("a")})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(graph.Nodes) != 3 {
+			t.Fatalf("Expected 3 nodes, got %d.", len(graph.Nodes))
+		}
+
+		image := graph.Nodes[2]
+		if image.Id != "image:base/nginx:1.23" || image.Type != terrarium.GraphNode_CONTAINER || image.Depth != 2 {
+			t.Errorf("Expected nginx at depth 2, got %+v.", image)
+		}
+
+		if len(graph.Edges) != 2 || graph.Edges[0].To != "module:b@1.0.0" || graph.Edges[1].From != "module:b@1.0.0" {
+			t.Errorf("Expected a -> b -> nginx, got %+v.", graph.Edges)
+		}
+
+		if graph.Nodes[0].Maturity != nil {
+			t.Errorf("Expected no maturity, got %v.", graph.Nodes[0].Maturity)
+		}
+	})
+
+	t.Run("when the graph has a cycle", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"a@1.0.0": {mod("b")},
+			"b@1.0.0": {mod("a")},
+		})
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName}
+
+		graph, err := dms.RetrieveDependencyGraph(context.TODO(), &terrarium.RetrieveDependencyGraphRequest{Module: mod("a")})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(graph.Edges) != 2 || graph.Edges[0].Cycle || !graph.Edges[1].Cycle {
+			t.Errorf("Expected b -> a to be flagged as a cycle, got %+v.", graph.Edges)
+		}
+	})
+
+	t.Run("when a version range cannot be resolved", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{})
+		db.detected = map[string][]*terrarium.ModuleRequirement{
+			"a@1.0.0": {{Name: "b", VersionConstraint: "~> 1.0"}},
+		}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName}
+
+		graph, err := dms.RetrieveDependencyGraph(context.TODO(), &terrarium.RetrieveDependencyGraphRequest{Module: mod("a")})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(graph.Nodes) != 2 || graph.Nodes[1].Name != "b" || graph.Nodes[1].Version != "" {
+			t.Fatalf("Expected b to be listed without a version, got %+v.", graph.Nodes)
+		}
+
+		if len(graph.Edges) != 1 || graph.Edges[0].To != graph.Nodes[1].Id {
+			t.Errorf("Expected a -> b, got %+v.", graph.Edges)
+		}
+	})
+
+	t.Run("when the maximum depth is reached", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"a@1.0.0": {mod("b")},
+			"b@1.0.0": {mod("c")},
+		})
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName}
+
+		graph, err := dms.RetrieveDependencyGraph(context.TODO(), &terrarium.RetrieveDependencyGraphRequest{Module: mod("a"), MaxDepth: 1})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(graph.Nodes) != 2 || len(graph.Edges) != 1 {
+			t.Errorf("Expected a -> b only, got %+v and %+v.", graph.Nodes, graph.Edges)
+		}
+	})
+
+	t.Run("when maturity can be looked up", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"a@1.0.0": {mod("b")},
+		})
+		registrar := &servicesMocks.MockRegistrarClient{
+			GetModuleResponse: &services.GetModuleResponse{Module: &services.ModuleMetadata{Maturity: terrarium.Maturity_STABLE}},
+		}
+		versionManager := &servicesMocks.MockVersionManagerClient{
+			ListModuleVersionsResponse: &services.ListModuleVersionsResponse{Maturities: map[string]terrarium.Maturity{"1.0.0": terrarium.Maturity_DEPRECATED}},
+		}
+		dms := &DependencyManagerService{
+			Db:             db,
+			ModuleTable:    ModuleDependenciesTableName,
+			ContainerTable: ContainerDependenciesTableName,
+			Registrar:      registrar,
+			VersionManager: versionManager,
+		}
+
+		graph, err := dms.RetrieveDependencyGraph(context.TODO(), &terrarium.RetrieveDependencyGraphRequest{Module: mod("a")})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		for _, node := range graph.Nodes {
+			if node.GetMaturity() != terrarium.Maturity_DEPRECATED || !node.Deprecated {
+				t.Errorf("Expected %s to be deprecated, got %v.", node.Id, node.GetMaturity())
+			}
+		}
+
+		if registrar.GetModuleInvocations != 2 || versionManager.ListModuleVersionsInvocations != 2 {
+			t.Errorf("Expected 2 lookups, got %d and %d.", registrar.GetModuleInvocations, versionManager.ListModuleVersionsInvocations)
+		}
+	})
+
+	t.Run("when maturity cannot be looked up", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{})
+		dms := &DependencyManagerService{
+			Db:             db,
+			ModuleTable:    ModuleDependenciesTableName,
+			ContainerTable: ContainerDependenciesTableName,
+			Registrar:      &servicesMocks.MockRegistrarClient{GetModuleError: errors.New("some error")},
+			VersionManager: &servicesMocks.MockVersionManagerClient{},
+		}
+
+		graph, err := dms.RetrieveDependencyGraph(context.TODO(), &terrarium.RetrieveDependencyGraphRequest{Module: mod("a")})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if graph.Nodes[0].Maturity != nil || graph.Nodes[0].Deprecated {
+			t.Errorf("Expected no maturity, got %v.", graph.Nodes[0].Maturity)
+		}
+	})
+
+	t.Run("when the module name is missing", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{}}
+
+		_, err := dms.RetrieveDependencyGraph(context.TODO(), &terrarium.RetrieveDependencyGraphRequest{Module: &terrarium.Module{}})
+
+		if err != ModuleNameRequiredError {
+			t.Errorf("Expected %v, got %v.", ModuleNameRequiredError, err)
+		}
+	})
+
+	t.Run("when GetItem fails", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{GetItemErrors: []error{errors.New("some error")}}}
+
+		_, err := dms.RetrieveDependencyGraph(context.TODO(), &terrarium.RetrieveDependencyGraphRequest{Module: mod("a")})
+
+		if err != GetModuleDependenciesError {
+			t.Errorf("Expected %v, got %v.", GetModuleDependenciesError, err)
+		}
+	})
+}
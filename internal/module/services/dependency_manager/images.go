@@ -0,0 +1,261 @@
+package dependency_manager
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/apparentlymart/go-versions/versions"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+// ContainerImageEntry is an entry of the container image index.
+// Image is the name the image is registered under and Module the "<name>@<version>" of the module version referencing it.
+type ContainerImageEntry struct {
+	Image         string                         `json:"image" bson:"image" dynamodbav:"image"`
+	Module        string                         `json:"module" bson:"module" dynamodbav:"module"`
+	Namespace     string                         `json:"namespace" bson:"namespace" dynamodbav:"namespace"`
+	Tag           string                         `json:"tag" bson:"tag" dynamodbav:"tag"`
+	Images        []*terrarium.ContainerImageRef `json:"images" bson:"images" dynamodbav:"images"`
+	ModuleName    string                         `json:"module_name" bson:"module_name" dynamodbav:"module_name"`
+	ModuleVersion string                         `json:"module_version" bson:"module_version" dynamodbav:"module_version"`
+}
+
+// updateContainerImages keeps the container image index in line with the images registered for a module version,
+// removing entries for images that are no longer referenced.
+func (s *DependencyManagerService) updateContainerImages(ctx context.Context, module *terrarium.Module, previous, current map[string]*terrarium.ContainerImageDetails) error {
+	key := moduleKey(module)
+
+	for image := range previous {
+		if _, ok := current[image]; ok {
+			continue
+		}
+		entryKey, err := attributevalue.MarshalMap(map[string]string{"image": image, "module": key})
+		if err != nil {
+			log.Println(err)
+			return MarshalDependenciesError
+		}
+		if _, err := s.Db.DeleteItem(ctx, &dynamodb.DeleteItemInput{TableName: aws.String(s.ImagesTable), Key: entryKey}); err != nil {
+			log.Println(err)
+			return RegisterDependenciesError
+		}
+	}
+
+	for image, details := range current {
+		entry := ContainerImageEntry{
+			Image:         image,
+			Module:        key,
+			Namespace:     details.GetNamespace(),
+			Tag:           details.GetTag(),
+			Images:        details.GetImages(),
+			ModuleName:    module.GetName(),
+			ModuleVersion: module.GetVersion(),
+		}
+		if err := s.registerDependencies(ctx, s.ImagesTable, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SearchContainerImages returns the module versions referencing the container images matching the request,
+// grouped by module with the latest version first.
+func (s *DependencyManagerService) SearchContainerImages(ctx context.Context, request *terrarium.SearchContainerImagesRequest) (*terrarium.SearchContainerImagesResponse, error) {
+	log.Printf("Searching container images %s/%s:%s@%s.\n", request.GetNamespace(), request.GetName(), request.GetTag(), request.GetDigest())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("image.name", request.GetName()),
+		attribute.String("image.namespace", request.GetNamespace()),
+		attribute.String("image.tag", request.GetTag()),
+		attribute.String("image.digest", request.GetDigest()),
+	)
+
+	if request.GetName() == "" && request.GetNamespace() == "" && request.GetTag() == "" && request.GetDigest() == "" {
+		span.RecordError(SearchCriteriaRequiredError)
+		return nil, SearchCriteriaRequiredError
+	}
+
+	entries, err := s.GetContainerImageEntries(ctx, request.GetName())
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var matches []ContainerImageEntry
+	for _, entry := range entries {
+		if entry.matches(request) {
+			matches = append(matches, entry)
+		}
+	}
+
+	log.Printf("Found %d module versions referencing matching container images.\n", len(matches))
+	return &terrarium.SearchContainerImagesResponse{Modules: groupContainerImages(matches)}, nil
+}
+
+// GetContainerImageEntries returns the entries of the container image index for an image name, or all of them
+// when no name is given.
+func (s *DependencyManagerService) GetContainerImageEntries(ctx context.Context, image string) ([]ContainerImageEntry, error) {
+	log.Printf("GetContainerImageEntries for image: %s", image)
+	span := trace.SpanFromContext(ctx)
+
+	var page func(startKey map[string]types.AttributeValue) ([]map[string]types.AttributeValue, map[string]types.AttributeValue, error)
+	if image != "" {
+		keyCondition := expression.Key("image").Equal(expression.Value(image))
+		expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, SearchContainerImagesError
+		}
+		page = func(startKey map[string]types.AttributeValue) ([]map[string]types.AttributeValue, map[string]types.AttributeValue, error) {
+			out, err := s.Db.Query(ctx, &dynamodb.QueryInput{
+				TableName:                 aws.String(s.ImagesTable),
+				KeyConditionExpression:    expr.KeyCondition(),
+				ExpressionAttributeNames:  expr.Names(),
+				ExpressionAttributeValues: expr.Values(),
+				ExclusiveStartKey:         startKey,
+			})
+			if err != nil || out == nil {
+				return nil, nil, err
+			}
+			return out.Items, out.LastEvaluatedKey, nil
+		}
+	} else {
+		page = func(startKey map[string]types.AttributeValue) ([]map[string]types.AttributeValue, map[string]types.AttributeValue, error) {
+			out, err := s.Db.Scan(ctx, &dynamodb.ScanInput{
+				TableName:         aws.String(s.ImagesTable),
+				ExclusiveStartKey: startKey,
+			})
+			if err != nil || out == nil {
+				return nil, nil, err
+			}
+			return out.Items, out.LastEvaluatedKey, nil
+		}
+	}
+
+	var entries []ContainerImageEntry
+	var startKey map[string]types.AttributeValue
+	for {
+		items, lastKey, err := page(startKey)
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, SearchContainerImagesError
+		}
+
+		var pageEntries []ContainerImageEntry
+		if err := attributevalue.UnmarshalListOfMaps(items, &pageEntries); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, SearchContainerImagesError
+		}
+		entries = append(entries, pageEntries...)
+
+		if len(lastKey) == 0 {
+			break
+		}
+		startKey = lastKey
+	}
+
+	log.Printf("GetContainerImageEntries returned %d entries\n", len(entries))
+	return entries, nil
+}
+
+// matches reports whether the entry meets every criterion given in the request.
+func (e ContainerImageEntry) matches(request *terrarium.SearchContainerImagesRequest) bool {
+	if request.GetName() != "" && e.Image != request.GetName() {
+		return false
+	}
+	if request.GetNamespace() != "" && e.Namespace != request.GetNamespace() {
+		return false
+	}
+	if request.GetTag() != "" && e.Tag != request.GetTag() {
+		return false
+	}
+	if request.GetDigest() == "" {
+		return true
+	}
+	for _, ref := range e.Images {
+		if digestMatches(imageDigest(ref.GetImage()), request.GetDigest()) {
+			return true
+		}
+	}
+	return false
+}
+
+// imageDigest returns the digest of an image reference such as "nginx@sha256:0d17...", if any.
+func imageDigest(image string) string {
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		return image[i+1:]
+	}
+	return ""
+}
+
+// digestMatches reports whether the digest starts with prefix, which may leave out the algorithm.
+func digestMatches(digest, prefix string) bool {
+	if digest == "" {
+		return false
+	}
+	if strings.HasPrefix(digest, prefix) {
+		return true
+	}
+	if i := strings.Index(digest, ":"); i >= 0 {
+		return strings.HasPrefix(digest[i+1:], prefix)
+	}
+	return false
+}
+
+// groupContainerImages groups the matching entries by module, sorted by name, listing the versions
+// of each module from the latest.
+func groupContainerImages(entries []ContainerImageEntry) []*terrarium.ContainerImageUsage {
+	modules := map[string]*terrarium.ContainerImageUsage{}
+	versionsByModule := map[string]map[string]*terrarium.ContainerImageVersion{}
+	for _, entry := range entries {
+		usage, ok := modules[entry.ModuleName]
+		if !ok {
+			usage = &terrarium.ContainerImageUsage{Name: entry.ModuleName}
+			modules[entry.ModuleName] = usage
+			versionsByModule[entry.ModuleName] = map[string]*terrarium.ContainerImageVersion{}
+		}
+		version, ok := versionsByModule[entry.ModuleName][entry.ModuleVersion]
+		if !ok {
+			version = &terrarium.ContainerImageVersion{Version: entry.ModuleVersion, Images: map[string]*terrarium.ContainerImageDetails{}}
+			versionsByModule[entry.ModuleName][entry.ModuleVersion] = version
+			usage.Versions = append(usage.Versions, version)
+		}
+		version.Images[entry.Image] = &terrarium.ContainerImageDetails{Namespace: entry.Namespace, Tag: entry.Tag, Images: entry.Images}
+	}
+
+	grouped := make([]*terrarium.ContainerImageUsage, 0, len(modules))
+	for _, usage := range modules {
+		sort.Slice(usage.Versions, func(i, j int) bool {
+			return versionNewer(usage.Versions[i].GetVersion(), usage.Versions[j].GetVersion())
+		})
+		usage.LatestVersion = usage.Versions[0].GetVersion()
+		grouped = append(grouped, usage)
+	}
+	sort.Slice(grouped, func(i, j int) bool {
+		return grouped[i].GetName() < grouped[j].GetName()
+	})
+	return grouped
+}
+
+// versionNewer reports whether version a is greater than b, comparing the text of versions that cannot be parsed.
+func versionNewer(a, b string) bool {
+	parsedA, errA := versions.ParseVersion(strings.TrimPrefix(a, "v"))
+	parsedB, errB := versions.ParseVersion(strings.TrimPrefix(b, "v"))
+	if errA != nil || errB != nil {
+		return a > b
+	}
+	return parsedA.GreaterThan(parsedB)
+}
@@ -0,0 +1,152 @@
+package dependency_manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+func containerImageItems(t *testing.T, entries ...ContainerImageEntry) []map[string]types.AttributeValue {
+	t.Helper()
+	items := make([]map[string]types.AttributeValue, 0, len(entries))
+	for _, entry := range entries {
+		item, err := attributevalue.MarshalMap(entry)
+		if err != nil {
+			t.Fatalf("Failed to marshal test data %s", err)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func nginxEntry(module, version, tag, digest string) ContainerImageEntry {
+	return ContainerImageEntry{
+		Image:         "nginx",
+		Module:        module + "@" + version,
+		Namespace:     "base",
+		Tag:           tag,
+		Images:        []*terrarium.ContainerImageRef{{Arch: "amd64", Image: "registry.example.com/base/nginx@" + digest}},
+		ModuleName:    module,
+		ModuleVersion: version,
+	}
+}
+
+// Test_SearchContainerImages checks:
+// - if the index is queried by image name and the results grouped by module, latest version first
+// - if the index is scanned when no image name is given
+// - if tags and digest prefixes are matched, with or without the algorithm
+// - if error is returned when no criterion is given
+// - if error is returned when the index cannot be read
+func Test_SearchContainerImages(t *testing.T) {
+	t.Parallel()
+
+	entries := containerImageItems(t,
+		nginxEntry("cie/web/aws", "1.2.0", "1.23", "sha256:0d17b565"),
+		nginxEntry("cie/web/aws", "1.10.0", "1.23", "sha256:0d17b565"),
+		nginxEntry("cie/proxy/aws", "0.3.0", "1.25", "sha256:a5967740"),
+	)
+
+	t.Run("when searching by image name", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryOut: &dynamodb.QueryOutput{Items: entries}}
+		dms := &DependencyManagerService{Db: db, ImagesTable: ContainerImagesTableName}
+
+		res, err := dms.SearchContainerImages(context.TODO(), &terrarium.SearchContainerImagesRequest{Name: "nginx", Tag: "1.23"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.QueryItemInvocations != 1 || db.ScanItemInvocations != 0 {
+			t.Errorf("Expected the index to be queried once, got %d queries and %d scans.", db.QueryItemInvocations, db.ScanItemInvocations)
+		}
+
+		if len(res.Modules) != 1 {
+			t.Fatalf("Expected 1 module, got %v.", res.Modules)
+		}
+
+		web := res.Modules[0]
+		if web.Name != "cie/web/aws" || web.LatestVersion != "1.10.0" {
+			t.Errorf("Expected cie/web/aws at 1.10.0, got %s at %s.", web.Name, web.LatestVersion)
+		}
+
+		if len(web.Versions) != 2 || web.Versions[0].Version != "1.10.0" || web.Versions[1].Version != "1.2.0" {
+			t.Errorf("Expected versions 1.10.0 and 1.2.0, got %v.", web.Versions)
+		}
+
+		if web.Versions[0].Images["nginx"].GetTag() != "1.23" {
+			t.Errorf("Expected the nginx image details, got %v.", web.Versions[0].Images)
+		}
+	})
+
+	t.Run("when searching by digest prefix", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: &dynamodb.ScanOutput{Items: entries}}
+		dms := &DependencyManagerService{Db: db, ImagesTable: ContainerImagesTableName}
+
+		res, err := dms.SearchContainerImages(context.TODO(), &terrarium.SearchContainerImagesRequest{Digest: "a596"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.ScanItemInvocations != 1 || db.QueryItemInvocations != 0 {
+			t.Errorf("Expected the index to be scanned once, got %d scans and %d queries.", db.ScanItemInvocations, db.QueryItemInvocations)
+		}
+
+		if len(res.Modules) != 1 || res.Modules[0].Name != "cie/proxy/aws" {
+			t.Errorf("Expected cie/proxy/aws, got %v.", res.Modules)
+		}
+	})
+
+	t.Run("when no criterion is given", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		dms := &DependencyManagerService{Db: db, ImagesTable: ContainerImagesTableName}
+
+		_, err := dms.SearchContainerImages(context.TODO(), &terrarium.SearchContainerImagesRequest{})
+
+		if err != SearchCriteriaRequiredError {
+			t.Errorf("Expected %v, got %v.", SearchCriteriaRequiredError, err)
+		}
+
+		if db.ScanItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to Scan, got %d.", db.ScanItemInvocations)
+		}
+	})
+
+	t.Run("when Query fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryError: errors.New("some error")}
+		dms := &DependencyManagerService{Db: db, ImagesTable: ContainerImagesTableName}
+
+		_, err := dms.SearchContainerImages(context.TODO(), &terrarium.SearchContainerImagesRequest{Name: "nginx"})
+
+		if err != SearchContainerImagesError {
+			t.Errorf("Expected %v, got %v.", SearchContainerImagesError, err)
+		}
+	})
+}
+
+// Test_digestMatches checks:
+// - if prefixes are matched with and without the algorithm
+// - if references without a digest never match
+func Test_digestMatches(t *testing.T) {
+	tests := []struct {
+		image  string
+		prefix string
+		want   bool
+	}{
+		{"base/nginx@sha256:0d17b565", "sha256:0d17", true},
+		{"base/nginx@sha256:0d17b565", "0d17", true},
+		{"base/nginx@sha256:0d17b565", "b565", false},
+		{"base/nginx:1.23", "1.23", false},
+	}
+	for _, tt := range tests {
+		if got := digestMatches(imageDigest(tt.image), tt.prefix); got != tt.want {
+			t.Errorf("digestMatches(%s, %s) = %v, want %v", tt.image, tt.prefix, got, tt.want)
+		}
+	}
+}
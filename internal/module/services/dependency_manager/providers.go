@@ -0,0 +1,263 @@
+package dependency_manager
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/apparentlymart/go-versions/versions"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+var (
+	ProviderRequiredError            = status.Error(codes.InvalidArgument, "Provider is required.")
+	InvalidProviderVersionError      = status.Error(codes.InvalidArgument, "Invalid provider version.")
+	GetProviderDependentsError       = status.Error(codes.Unknown, "Failed to get provider dependents.")
+	UnmarshalProviderDependentsError = status.Error(codes.Unknown, "Failed to unmarshal provider dependents.")
+)
+
+// ProviderDependentEntry is an entry of the provider dependents index.
+// Provider is the "<namespace>/<type>" address of the provider and Module the "<name>@<version>" of the module version
+// requiring it.
+type ProviderDependentEntry struct {
+	Provider           string   `json:"provider" bson:"provider" dynamodbav:"provider"`
+	Module             string   `json:"module" bson:"module" dynamodbav:"module"`
+	Source             string   `json:"source" bson:"source" dynamodbav:"source"`
+	VersionConstraints []string `json:"version_constraints" bson:"version_constraints" dynamodbav:"version_constraints"`
+	ModuleName         string   `json:"module_name" bson:"module_name" dynamodbav:"module_name"`
+	ModuleVersion      string   `json:"module_version" bson:"module_version" dynamodbav:"module_version"`
+}
+
+// providers merges the providers registered for a module version with the ones found in its source.
+// A registered provider wins over a detected one with the same address.
+func (d *ModuleDependencies) providers() []*terrarium.ProviderRequirement {
+	merged := append([]*terrarium.ProviderRequirement{}, d.RegisteredProviders...)
+	for _, detected := range d.Providers {
+		if !containsProvider(merged, providerAddress(detected)) {
+			merged = append(merged, detected)
+		}
+	}
+	return merged
+}
+
+func containsProvider(requirements []*terrarium.ProviderRequirement, address string) bool {
+	for _, requirement := range requirements {
+		if providerAddress(requirement) == address {
+			return true
+		}
+	}
+	return false
+}
+
+// providerAddress returns the "<namespace>/<type>" a requirement refers to, in lower case. The registry host is
+// left out, so that a provider is found whichever registry or mirror modules source it from, and requirements
+// without namespace refer to the hashicorp one as they do in Terraform.
+func providerAddress(requirement *terrarium.ProviderRequirement) string {
+	source := strings.ToLower(requirement.GetSource())
+	if source == "" {
+		source = strings.ToLower(requirement.GetName())
+	}
+	parts := strings.Split(source, "/")
+	switch len(parts) {
+	case 1:
+		return "hashicorp/" + parts[0]
+	case 3:
+		return parts[1] + "/" + parts[2]
+	}
+	return source
+}
+
+// updateProviderDependents keeps the provider dependents index in line with the providers required by a module version,
+// removing entries for providers that are no longer required.
+func (s *DependencyManagerService) updateProviderDependents(ctx context.Context, module *terrarium.Module, previous, current []*terrarium.ProviderRequirement) error {
+	key := moduleKey(module)
+
+	for _, requirement := range previous {
+		address := providerAddress(requirement)
+		if containsProvider(current, address) {
+			continue
+		}
+		entryKey, err := attributevalue.MarshalMap(map[string]string{"provider": address, "module": key})
+		if err != nil {
+			log.Println(err)
+			return MarshalDependenciesError
+		}
+		if _, err := s.Db.DeleteItem(ctx, &dynamodb.DeleteItemInput{TableName: aws.String(s.ProvidersTable), Key: entryKey}); err != nil {
+			log.Println(err)
+			return RegisterDependenciesError
+		}
+	}
+
+	for _, requirement := range current {
+		entry := ProviderDependentEntry{
+			Provider:           providerAddress(requirement),
+			Module:             key,
+			Source:             requirement.GetSource(),
+			VersionConstraints: requirement.GetVersionConstraints(),
+			ModuleName:         module.GetName(),
+			ModuleVersion:      module.GetVersion(),
+		}
+		if err := s.registerDependencies(ctx, s.ProvidersTable, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetrieveProviderDependencies returns the providers required by a module version.
+func (s *DependencyManagerService) RetrieveProviderDependencies(ctx context.Context, request *terrarium.RetrieveProviderDependenciesRequest) (*terrarium.ProviderDependenciesResponse, error) {
+	log.Printf("Retrieving provider dependencies for %s/%s.\n", request.GetModule().GetName(), request.GetModule().GetVersion())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+	)
+
+	if request.GetModule().GetName() == "" {
+		span.RecordError(ModuleNameRequiredError)
+		return nil, ModuleNameRequiredError
+	}
+
+	item, err := s.getModuleDependenciesItem(ctx, request.GetModule())
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	log.Println("Provider dependencies retrieved.")
+	return &terrarium.ProviderDependenciesResponse{Module: request.GetModule(), Providers: item.providers()}, nil
+}
+
+// RetrieveProviderDependents returns the module versions requiring a provider, sorted by module name with the latest
+// version first. When a provider version is given, only the module versions whose constraints allow it are returned.
+func (s *DependencyManagerService) RetrieveProviderDependents(ctx context.Context, request *services.RetrieveProviderDependentsRequest) (*services.ProviderDependentsResponse, error) {
+	log.Printf("Retrieving dependents of provider %s %s.\n", request.GetProvider(), request.GetVersion())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.name", request.GetProvider()),
+		attribute.String("provider.version", request.GetVersion()),
+	)
+
+	if request.GetProvider() == "" {
+		span.RecordError(ProviderRequiredError)
+		return nil, ProviderRequiredError
+	}
+
+	var version versions.Version
+	if request.GetVersion() != "" {
+		parsed, err := versions.ParseVersion(strings.TrimPrefix(request.GetVersion(), "v"))
+		if err != nil {
+			span.RecordError(InvalidProviderVersionError)
+			return nil, InvalidProviderVersionError
+		}
+		version = parsed
+	}
+
+	entries, err := s.GetProviderDependentEntries(ctx, providerAddress(&terrarium.ProviderRequirement{Source: request.GetProvider()}))
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	response := &services.ProviderDependentsResponse{}
+	for _, entry := range entries {
+		if request.GetVersion() != "" && !constraintsAllow(entry.VersionConstraints, version) {
+			continue
+		}
+		response.Dependents = append(response.Dependents, &services.ProviderDependent{
+			Module:             &terrarium.Module{Name: entry.ModuleName, Version: entry.ModuleVersion},
+			Source:             entry.Source,
+			VersionConstraints: entry.VersionConstraints,
+		})
+	}
+	sort.Slice(response.Dependents, func(i, j int) bool {
+		a, b := response.Dependents[i].GetModule(), response.Dependents[j].GetModule()
+		if a.GetName() != b.GetName() {
+			return a.GetName() < b.GetName()
+		}
+		return versionNewer(a.GetVersion(), b.GetVersion())
+	})
+
+	log.Printf("Found %d module versions requiring provider %s.\n", len(response.Dependents), request.GetProvider())
+	return response, nil
+}
+
+// constraintsAllow reports whether a provider version meets every constraint it is required with.
+// Constraints that cannot be parsed are taken to allow any version, so that no dependent is missed.
+func constraintsAllow(constraints []string, version versions.Version) bool {
+	for _, constraint := range constraints {
+		allowed, err := versions.MeetingConstraintsStringRuby(constraint)
+		if err != nil {
+			log.Printf("Skipping invalid provider version constraint: %v", constraint)
+			continue
+		}
+		if !allowed.Has(version) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetProviderDependentEntries returns the entries of the provider dependents index for a provider address.
+func (s *DependencyManagerService) GetProviderDependentEntries(ctx context.Context, provider string) ([]ProviderDependentEntry, error) {
+	log.Printf("GetProviderDependentEntries for provider: %s", provider)
+	span := trace.SpanFromContext(ctx)
+
+	keyCondition := expression.Key("provider").Equal(expression.Value(provider))
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).Build()
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, GetProviderDependentsError
+	}
+
+	var entries []ProviderDependentEntry
+	var startKey map[string]types.AttributeValue
+	for {
+		out, err := s.Db.Query(ctx, &dynamodb.QueryInput{
+			TableName:                 aws.String(s.ProvidersTable),
+			KeyConditionExpression:    expr.KeyCondition(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+			ExclusiveStartKey:         startKey,
+		})
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, GetProviderDependentsError
+		}
+		if out == nil {
+			break
+		}
+
+		var page []ProviderDependentEntry
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &page); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, UnmarshalProviderDependentsError
+		}
+		entries = append(entries, page...)
+
+		if len(out.LastEvaluatedKey) == 0 {
+			break
+		}
+		startKey = out.LastEvaluatedKey
+	}
+
+	log.Printf("GetProviderDependentEntries returned %d entries\n", len(entries))
+	return entries, nil
+}
@@ -0,0 +1,256 @@
+package dependency_manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+func Test_providerAddress(t *testing.T) {
+	tests := []struct {
+		requirement *terrarium.ProviderRequirement
+		want        string
+	}{
+		{&terrarium.ProviderRequirement{Name: "aws", Source: "hashicorp/aws"}, "hashicorp/aws"},
+		{&terrarium.ProviderRequirement{Name: "aws", Source: "registry.terraform.io/HashiCorp/AWS"}, "hashicorp/aws"},
+		{&terrarium.ProviderRequirement{Name: "widget", Source: "terrarium.example.com/cie/widget"}, "cie/widget"},
+		{&terrarium.ProviderRequirement{Name: "aws"}, "hashicorp/aws"},
+		{&terrarium.ProviderRequirement{Name: "random", Source: "random"}, "hashicorp/random"},
+	}
+	for _, tt := range tests {
+		if got := providerAddress(tt.requirement); got != tt.want {
+			t.Errorf("providerAddress(%v) = %s, want %s", tt.requirement, got, tt.want)
+		}
+	}
+}
+
+// Test_RegisterModuleDependencies_providers checks:
+// - if registered providers are indexed along with the detected ones they do not replace
+// - if provider dependents entries of providers no longer required are removed
+func Test_RegisterModuleDependencies_providers(t *testing.T) {
+	t.Parallel()
+
+	module := &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"}
+	previous := ModuleDependencies{
+		Name:    "cie/eks/aws",
+		Version: "3.0.0",
+		Providers: []*terrarium.ProviderRequirement{
+			{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{"~> 5.0"}},
+			{Name: "google", Source: "hashicorp/google"},
+		},
+	}
+
+	t.Run("when a provider is registered", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{makeGetItemOutput(previous, t)}}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, DependentsTable: ModuleDependentsTableName, ProvidersTable: ProviderDependentsTableName}
+
+		_, err := dms.RegisterModuleDependencies(context.TODO(), &terrarium.RegisterModuleDependenciesRequest{
+			Module:    module,
+			Providers: []*terrarium.ProviderRequirement{{Name: "google", Source: "registry.terraform.io/hashicorp/google", VersionConstraints: []string{">= 5.0"}}},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		// the dependencies entry, then a provider dependents entry for google and aws
+		if db.PutItemInvocations != 3 {
+			t.Errorf("Expected 3 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+
+		if db.DeleteItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to DeleteItem, got %v.", db.DeleteItemInvocations)
+		}
+	})
+
+	t.Run("when a provider is no longer required", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{makeGetItemOutput(previous, t)}}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, DependentsTable: ModuleDependentsTableName, ProvidersTable: ProviderDependentsTableName}
+
+		_, err := dms.RegisterDetectedDependencies(context.TODO(), &services.RegisterDetectedDependenciesRequest{
+			Module:    module,
+			Providers: []*terrarium.ProviderRequirement{{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{"~> 5.1"}}},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.DeleteItemInvocations != 1 || db.TableName != ProviderDependentsTableName {
+			t.Errorf("Expected 1 call to DeleteItem, got %v.", db.DeleteItemInvocations)
+		}
+	})
+}
+
+// Test_RetrieveProviderDependencies checks:
+// - if registered providers are returned ahead of detected ones, which they take precedence over
+// - if error is returned when the module name is missing
+// - if error is returned when GetItem fails
+func Test_RetrieveProviderDependencies(t *testing.T) {
+	t.Parallel()
+
+	module := &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"}
+
+	t.Run("when providers are registered and detected", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{makeGetItemOutput(ModuleDependencies{
+			Name:    "cie/eks/aws",
+			Version: "3.0.0",
+			Providers: []*terrarium.ProviderRequirement{
+				{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{"~> 5.0"}},
+				{Name: "random", Source: "hashicorp/random"},
+			},
+			RegisteredProviders: []*terrarium.ProviderRequirement{{Name: "aws", Source: "registry.terraform.io/hashicorp/aws", VersionConstraints: []string{"~> 5.1"}}},
+		}, t)}}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName}
+
+		res, err := dms.RetrieveProviderDependencies(context.TODO(), &terrarium.RetrieveProviderDependenciesRequest{Module: module})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(res.Providers) != 2 || res.Providers[0].VersionConstraints[0] != "~> 5.1" || res.Providers[1].Name != "random" {
+			t.Errorf("Expected the registered aws and the detected random providers, got %v.", res.Providers)
+		}
+	})
+
+	t.Run("when the module name is missing", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{}}
+
+		_, err := dms.RetrieveProviderDependencies(context.TODO(), &terrarium.RetrieveProviderDependenciesRequest{})
+
+		if err != ModuleNameRequiredError {
+			t.Errorf("Expected %v, got %v.", ModuleNameRequiredError, err)
+		}
+	})
+
+	t.Run("when GetItem fails", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{GetItemErrors: []error{errors.New("some error")}}}
+
+		_, err := dms.RetrieveProviderDependencies(context.TODO(), &terrarium.RetrieveProviderDependenciesRequest{Module: module})
+
+		if err != GetModuleDependenciesError {
+			t.Errorf("Expected %v, got %v.", GetModuleDependenciesError, err)
+		}
+	})
+}
+
+func makeProviderDependentsQueryOutput(t *testing.T, entries ...ProviderDependentEntry) *dynamodb.QueryOutput {
+	t.Helper()
+	out := &dynamodb.QueryOutput{}
+	for _, entry := range entries {
+		item, err := attributevalue.MarshalMap(entry)
+		if err != nil {
+			t.Fatalf("Failed to marshal test data %s", err)
+		}
+		out.Items = append(out.Items, item)
+	}
+	return out
+}
+
+// Test_RetrieveProviderDependents checks:
+// - if every module version requiring the provider is returned, sorted by name with the latest version first
+// - if only the module versions whose constraints allow the version are returned when one is given
+// - if error is returned when the provider is missing
+// - if error is returned when the version is invalid
+// - if error is returned when Query fails
+// - if the entries of every page are returned
+func Test_RetrieveProviderDependents(t *testing.T) {
+	t.Parallel()
+
+	entries := []ProviderDependentEntry{
+		{Provider: "hashicorp/aws", Module: "cie/vpc/aws@1.0.0", Source: "hashicorp/aws", VersionConstraints: []string{"~> 4.0"}, ModuleName: "cie/vpc/aws", ModuleVersion: "1.0.0"},
+		{Provider: "hashicorp/aws", Module: "cie/vpc/aws@2.0.0", Source: "hashicorp/aws", VersionConstraints: []string{">= 4.0, < 6.0"}, ModuleName: "cie/vpc/aws", ModuleVersion: "2.0.0"},
+		{Provider: "hashicorp/aws", Module: "cie/eks/aws@3.0.0", Source: "hashicorp/aws", ModuleName: "cie/eks/aws", ModuleVersion: "3.0.0"},
+	}
+
+	t.Run("when no version is given", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryOut: makeProviderDependentsQueryOutput(t, entries...)}
+		dms := &DependencyManagerService{Db: db, ProvidersTable: ProviderDependentsTableName}
+
+		res, err := dms.RetrieveProviderDependents(context.TODO(), &services.RetrieveProviderDependentsRequest{Provider: "registry.terraform.io/hashicorp/aws"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		expected := []string{"cie/eks/aws@3.0.0", "cie/vpc/aws@2.0.0", "cie/vpc/aws@1.0.0"}
+		if len(res.Dependents) != len(expected) {
+			t.Fatalf("Expected %d dependents, got %v.", len(expected), res.Dependents)
+		}
+		for i, dependent := range res.Dependents {
+			if moduleKey(dependent.Module) != expected[i] {
+				t.Errorf("Expected %s, got %s.", expected[i], moduleKey(dependent.Module))
+			}
+		}
+	})
+
+	t.Run("when a version is given", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryOut: makeProviderDependentsQueryOutput(t, entries...)}
+		dms := &DependencyManagerService{Db: db, ProvidersTable: ProviderDependentsTableName}
+
+		res, err := dms.RetrieveProviderDependents(context.TODO(), &services.RetrieveProviderDependentsRequest{Provider: "hashicorp/aws", Version: "5.2.0"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(res.Dependents) != 2 || res.Dependents[1].Module.Version != "2.0.0" {
+			t.Errorf("Expected eks 3.0.0 and vpc 2.0.0, got %v.", res.Dependents)
+		}
+	})
+
+	t.Run("when the provider is missing", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{}}
+
+		_, err := dms.RetrieveProviderDependents(context.TODO(), &services.RetrieveProviderDependentsRequest{})
+
+		if err != ProviderRequiredError {
+			t.Errorf("Expected %v, got %v.", ProviderRequiredError, err)
+		}
+	})
+
+	t.Run("when the version is invalid", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{}}
+
+		_, err := dms.RetrieveProviderDependents(context.TODO(), &services.RetrieveProviderDependentsRequest{Provider: "hashicorp/aws", Version: "five"})
+
+		if err != InvalidProviderVersionError {
+			t.Errorf("Expected %v, got %v.", InvalidProviderVersionError, err)
+		}
+	})
+
+	t.Run("when Query fails", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{QueryError: errors.New("some error")}}
+
+		_, err := dms.RetrieveProviderDependents(context.TODO(), &services.RetrieveProviderDependentsRequest{Provider: "hashicorp/aws"})
+
+		if err != GetProviderDependentsError {
+			t.Errorf("Expected %v, got %v.", GetProviderDependentsError, err)
+		}
+	})
+
+	t.Run("when results span several pages", func(t *testing.T) {
+		first := makeProviderDependentsQueryOutput(t, entries[0])
+		first.LastEvaluatedKey = map[string]types.AttributeValue{"module": &types.AttributeValueMemberS{Value: "cie/vpc/aws@1.0.0"}}
+		db := &mocks.DynamoDB{QueryOuts: []*dynamodb.QueryOutput{first, makeProviderDependentsQueryOutput(t, entries[1:]...)}}
+		dms := &DependencyManagerService{Db: db, ProvidersTable: ProviderDependentsTableName}
+
+		res, err := dms.RetrieveProviderDependents(context.TODO(), &services.RetrieveProviderDependentsRequest{Provider: "hashicorp/aws"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(res.Dependents) != 3 || db.QueryItemInvocations != 2 {
+			t.Errorf("Expected 3 dependents over 2 queries, got %d over %d.", len(res.Dependents), db.QueryItemInvocations)
+		}
+	})
+}
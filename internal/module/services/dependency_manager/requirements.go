@@ -0,0 +1,136 @@
+package dependency_manager
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/apparentlymart/go-versions/versions"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+var InvalidVersionConstraintError = status.Error(codes.InvalidArgument, "Invalid version constraint.")
+
+// recordRequirements validates the constraints of the requirements registered for a module version and records
+// the newest version meeting each one. Requirements are recorded without version when no published version
+// meets their constraint, or when the version manager is unset and the constraint does not pin one.
+func (s *DependencyManagerService) recordRequirements(ctx context.Context, requirements []*terrarium.ModuleRequirement) ([]*terrarium.ModuleRequirement, error) {
+	published := newPublishedVersions()
+	recorded := make([]*terrarium.ModuleRequirement, 0, len(requirements))
+	for _, requirement := range requirements {
+		if requirement.GetName() == "" {
+			return nil, ModuleNameRequiredError
+		}
+		if _, err := versions.MeetingConstraintsStringRuby(requirement.GetVersionConstraint()); err != nil {
+			log.Printf("Invalid version constraint %q for %s: %v", requirement.GetVersionConstraint(), requirement.GetName(), err)
+			return nil, InvalidVersionConstraintError
+		}
+		recorded = append(recorded, &terrarium.ModuleRequirement{
+			Name:              requirement.GetName(),
+			VersionConstraint: requirement.GetVersionConstraint(),
+			Version:           s.resolveConstraint(ctx, requirement, published),
+		})
+	}
+	return recorded, nil
+}
+
+// resolveRequirements resolves the requirements of a module version against the versions published now.
+// The versions of every module are listed once per call through the published cache.
+func (s *DependencyManagerService) resolveRequirements(ctx context.Context, requirements []*terrarium.ModuleRequirement, published *publishedVersions) []*terrarium.ResolvedRequirement {
+	var resolved []*terrarium.ResolvedRequirement
+	for _, requirement := range requirements {
+		version := s.resolveConstraint(ctx, requirement, published)
+		resolved = append(resolved, &terrarium.ResolvedRequirement{
+			Name:              requirement.GetName(),
+			VersionConstraint: requirement.GetVersionConstraint(),
+			RecordedVersion:   requirement.GetVersion(),
+			ResolvedVersion:   version,
+			Differs:           version != "" && version != requirement.GetVersion(),
+		})
+	}
+	return resolved
+}
+
+// resolveConstraint returns the newest published version of a module meeting the constraint of a requirement,
+// or the version the constraint pins when the published versions cannot be listed.
+func (s *DependencyManagerService) resolveConstraint(ctx context.Context, requirement *terrarium.ModuleRequirement, published *publishedVersions) string {
+	available, ok := published.get(requirement.GetName())
+	if !ok {
+		available, ok = s.listPublishedVersions(ctx, requirement.GetName())
+		if !ok {
+			version, _ := exactVersion(requirement.GetVersionConstraint())
+			return version
+		}
+		published.set(requirement.GetName(), available)
+	}
+	return newestMeeting(requirement.GetVersionConstraint(), available)
+}
+
+// publishedVersions caches the published versions of modules for the duration of a call. It is safe for use by the
+// concurrent fetches of a traversal.
+type publishedVersions struct {
+	mu       sync.Mutex
+	versions map[string][]string
+}
+
+func newPublishedVersions() *publishedVersions {
+	return &publishedVersions{versions: map[string][]string{}}
+}
+
+func (p *publishedVersions) get(name string) ([]string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	versions, ok := p.versions[name]
+	return versions, ok
+}
+
+func (p *publishedVersions) set(name string, versions []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.versions[name] = versions
+}
+
+func (s *DependencyManagerService) listPublishedVersions(ctx context.Context, name string) ([]string, bool) {
+	if s.VersionManager == nil {
+		return nil, false
+	}
+	span := trace.SpanFromContext(ctx)
+
+	res, err := s.VersionManager.ListModuleVersions(ctx, &services.ListModuleVersionsRequest{Module: name})
+	if err != nil {
+		log.Printf("Failed to list versions of module %s: %v", name, err)
+		span.AddEvent("Published versions of module not found", trace.WithAttributes(attribute.String("module.name", name)))
+		return nil, false
+	}
+	return res.GetVersions(), true
+}
+
+// newestMeeting returns the newest of the available versions meeting a constraint, as it was published,
+// or an empty string when none does.
+func newestMeeting(constraint string, available []string) string {
+	allowed, err := versions.MeetingConstraintsStringRuby(constraint)
+	if err != nil {
+		return ""
+	}
+
+	var newest versions.Version
+	var newestName string
+	for _, name := range available {
+		version, err := versions.ParseVersion(strings.TrimPrefix(name, "v"))
+		if err != nil || !allowed.Has(version) {
+			continue
+		}
+		if newestName == "" || version.GreaterThan(newest) {
+			newest = version
+			newestName = name
+		}
+	}
+	return newestName
+}
@@ -0,0 +1,166 @@
+package dependency_manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	servicesMocks "github.com/terrariumcloud/terrarium/internal/module/services/mocks"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+// Test_recordRequirements checks:
+// - if the newest published version meeting the constraint is recorded
+// - if the pinned version is recorded when the version manager is unset
+// - if the pinned version is recorded when the published versions cannot be listed
+// - if error is returned when a constraint is invalid
+func Test_recordRequirements(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when versions are published", func(t *testing.T) {
+		versionManager := &servicesMocks.MockVersionManagerClient{
+			ListModuleVersionsResponse: &services.ListModuleVersionsResponse{Versions: []string{"2.0.0", "2.1.0", "2.1.3", "3.0.0"}},
+		}
+		dms := &DependencyManagerService{VersionManager: versionManager}
+
+		recorded, err := dms.recordRequirements(context.TODO(), []*terrarium.ModuleRequirement{
+			{Name: "cie/vpc/aws", VersionConstraint: "~> 2.1"},
+			{Name: "cie/vpc/aws", VersionConstraint: ">= 4.0"},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if recorded[0].Version != "2.1.3" || recorded[1].Version != "" {
+			t.Errorf("Expected 2.1.3 and no version, got %v and %v.", recorded[0].Version, recorded[1].Version)
+		}
+
+		if versionManager.ListModuleVersionsInvocations != 1 {
+			t.Errorf("Expected 1 call to ListModuleVersions, got %v.", versionManager.ListModuleVersionsInvocations)
+		}
+	})
+
+	t.Run("when the version manager is unset", func(t *testing.T) {
+		dms := &DependencyManagerService{}
+
+		recorded, err := dms.recordRequirements(context.TODO(), []*terrarium.ModuleRequirement{
+			{Name: "cie/vpc/aws", VersionConstraint: "= 1.2.0"},
+			{Name: "cie/eks/aws", VersionConstraint: "~> 1.2"},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if recorded[0].Version != "1.2.0" || recorded[1].Version != "" {
+			t.Errorf("Expected 1.2.0 and no version, got %v and %v.", recorded[0].Version, recorded[1].Version)
+		}
+	})
+
+	t.Run("when ListModuleVersions fails", func(t *testing.T) {
+		dms := &DependencyManagerService{VersionManager: &servicesMocks.MockVersionManagerClient{ListModuleVersionsError: errors.New("some error")}}
+
+		recorded, err := dms.recordRequirements(context.TODO(), []*terrarium.ModuleRequirement{{Name: "cie/vpc/aws", VersionConstraint: "1.2.0"}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if recorded[0].Version != "1.2.0" {
+			t.Errorf("Expected 1.2.0, got %v.", recorded[0].Version)
+		}
+	})
+
+	t.Run("when a constraint is invalid", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		dms := &DependencyManagerService{Db: db}
+
+		_, err := dms.RegisterModuleDependencies(context.TODO(), &terrarium.RegisterModuleDependenciesRequest{
+			Module:       &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"},
+			Requirements: []*terrarium.ModuleRequirement{{Name: "cie/vpc/aws", VersionConstraint: "~> two"}},
+		})
+
+		if err != InvalidVersionConstraintError {
+			t.Errorf("Expected %v, got %v.", InvalidVersionConstraintError, err)
+		}
+
+		if db.GetItemInvocations != 0 || db.PutItemInvocations != 0 {
+			t.Errorf("Expected no call to DynamoDB, got %v and %v.", db.GetItemInvocations, db.PutItemInvocations)
+		}
+	})
+}
+
+// Test_RetrieveModuleDependencies_requirements checks:
+// - if requirements are returned with the recorded and the newest version meeting their constraint
+// - if requirements are followed at their recorded version
+func Test_RetrieveModuleDependencies_requirements(t *testing.T) {
+	t.Parallel()
+
+	db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{
+		makeGetItemOutput(ModuleDependencies{
+			Name:    "cie/eks/aws",
+			Version: "3.0.0",
+			Requirements: []*terrarium.ModuleRequirement{
+				{Name: "cie/vpc/aws", VersionConstraint: "~> 2.1", Version: "2.1.0"},
+				{Name: "cie/dns/aws", VersionConstraint: "~> 1.0", Version: "1.0.4"},
+			},
+		}, t),
+		{},
+		{},
+	}}
+	versionManager := &servicesMocks.MockVersionManagerClient{
+		ListModuleVersionsResponse: &services.ListModuleVersionsResponse{Versions: []string{"1.0.4", "2.1.0", "2.1.3"}},
+	}
+	dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, VersionManager: versionManager}
+
+	srv := &collectingModuleDependenciesServer{}
+
+	err := dms.RetrieveModuleDependencies(&terrarium.RetrieveModuleDependenciesRequest{Module: &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"}, MaxDepth: 1}, srv)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v.", err)
+	}
+
+	root := srv.Responses[0]
+	if len(root.Dependencies) != 2 || root.Dependencies[0].Version != "2.1.0" {
+		t.Errorf("Expected cie/vpc/aws at 2.1.0, got %v.", root.Dependencies)
+	}
+
+	if len(root.Requirements) != 2 {
+		t.Fatalf("Expected 2 requirements, got %v.", len(root.Requirements))
+	}
+
+	vpc := root.Requirements[0]
+	if vpc.RecordedVersion != "2.1.0" || vpc.ResolvedVersion != "2.1.3" || !vpc.Differs {
+		t.Errorf("Expected 2.1.0 to differ from 2.1.3, got %v.", vpc)
+	}
+
+	dns := root.Requirements[1]
+	if dns.ResolvedVersion != "1.0.4" || dns.Differs {
+		t.Errorf("Expected 1.0.4 not to differ, got %v.", dns)
+	}
+}
+
+func Test_newestMeeting(t *testing.T) {
+	tests := []struct {
+		constraint string
+		available  []string
+		want       string
+	}{
+		{"~> 2.1", []string{"2.1.0", "2.2.0", "3.0.0"}, "2.2.0"},
+		{"~> 2.1.0", []string{"v2.1.0", "v2.1.5", "v2.2.0"}, "v2.1.5"},
+		{">= 1.0, < 2.0", []string{"0.9.0", "1.9.9", "2.0.0", "not-a-version"}, "1.9.9"},
+		{"", []string{"1.0.0", "1.1.0"}, "1.1.0"},
+		{"~> 4.0", []string{"1.0.0"}, ""},
+		{"~> two", []string{"1.0.0"}, ""},
+	}
+	for _, tt := range tests {
+		if got := newestMeeting(tt.constraint, tt.available); got != tt.want {
+			t.Errorf("newestMeeting(%q) = %q, want %q", tt.constraint, got, tt.want)
+		}
+	}
+}
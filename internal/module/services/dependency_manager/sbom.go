@@ -0,0 +1,235 @@
+package dependency_manager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+const (
+	CycloneDXMediaType = "application/vnd.cyclonedx+json"
+	SPDXMediaType      = "application/spdx+json"
+)
+
+var (
+	ModuleVersionRequiredError = status.Error(codes.InvalidArgument, "Module version is required.")
+	UnsupportedSBOMFormatError = status.Error(codes.InvalidArgument, "Unsupported SBOM format.")
+	ExportSBOMError            = status.Error(codes.Unknown, "Failed to export SBOM.")
+)
+
+// Kinds of the components of a bill of materials.
+const (
+	moduleComponent    = "module"
+	containerComponent = "container"
+	providerComponent  = "provider"
+)
+
+// sbomComponent is a module, container image or provider found in the dependency graph of a module version.
+type sbomComponent struct {
+	Ref     string
+	Kind    string
+	Name    string
+	Version string
+	// Digest is the "<algorithm>:<hex>" digest of container images referenced by digest
+	Digest string
+	// VersionConstraints holds the constraints providers are required with
+	VersionConstraints []string
+	Properties         map[string]string
+	DependsOn          []string
+}
+
+// billOfMaterials holds the components of the dependency graph of a module version, the root excluded,
+// in the order they were reached.
+type billOfMaterials struct {
+	Root       *sbomComponent
+	Components []*sbomComponent
+}
+
+// ExportSBOM returns the software bill of materials of a module version in the requested format.
+// The whole dependency graph is walked, whatever its depth.
+func (s *DependencyManagerService) ExportSBOM(ctx context.Context, request *terrarium.ExportSBOMRequest) (*terrarium.SBOMResponse, error) {
+	log.Printf("Exporting %s SBOM for %s/%s.\n", request.GetFormat(), request.GetModule().GetName(), request.GetModule().GetVersion())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.String("sbom.format", request.GetFormat().String()),
+	)
+
+	if request.GetModule().GetName() == "" {
+		span.RecordError(ModuleNameRequiredError)
+		return nil, ModuleNameRequiredError
+	}
+	if request.GetModule().GetVersion() == "" {
+		span.RecordError(ModuleVersionRequiredError)
+		return nil, ModuleVersionRequiredError
+	}
+
+	published := newPublishedVersions()
+	nodes, err := traverse(ctx, request.GetModule(), 0, s.MaxConcurrentFetches, func(ctx context.Context, node *graphNode) error {
+		item, err := s.getModuleDependenciesItem(ctx, node.Module)
+		if err != nil {
+			return err
+		}
+		images, err := s.GetContainerDependencies(ctx, node.Module)
+		if err != nil {
+			return err
+		}
+		node.Dependencies = s.moduleDependencies(ctx, item, published)
+		node.Providers = item.providers()
+		node.Images = images
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	bom := newBillOfMaterials(nodes)
+	created := time.Now().UTC()
+
+	var document []byte
+	var mediaType string
+	switch request.GetFormat() {
+	case terrarium.SBOMFormat_CYCLONEDX:
+		document, err = encodeCycloneDX(bom, created, uuid.NewString())
+		mediaType = CycloneDXMediaType
+	case terrarium.SBOMFormat_SPDX:
+		document, err = encodeSPDX(bom, created, uuid.NewString())
+		mediaType = SPDXMediaType
+	default:
+		span.RecordError(UnsupportedSBOMFormatError)
+		return nil, UnsupportedSBOMFormatError
+	}
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, ExportSBOMError
+	}
+
+	log.Printf("SBOM exported with %d components.\n", len(bom.Components)+1)
+	return &terrarium.SBOMResponse{MediaType: mediaType, Document: document}, nil
+}
+
+// newBillOfMaterials lists the modules of the graph with the images they reference and the providers they require.
+// Images referenced per architecture are listed once per architecture, and providers required by several modules once
+// with all their constraints.
+func newBillOfMaterials(nodes []*graphNode) *billOfMaterials {
+	bom := &billOfMaterials{}
+	listed := map[string]*sbomComponent{}
+	add := func(component *sbomComponent) *sbomComponent {
+		if existing, ok := listed[component.Ref]; ok {
+			return existing
+		}
+		listed[component.Ref] = component
+		bom.Components = append(bom.Components, component)
+		return component
+	}
+
+	for i, node := range nodes {
+		module := newModuleComponent(node.Module)
+		if i == 0 {
+			bom.Root = module
+			listed[module.Ref] = module
+		} else {
+			module = add(module)
+		}
+
+		for _, dependency := range node.Dependencies {
+			module.DependsOn = append(module.DependsOn, add(newModuleComponent(dependency)).Ref)
+		}
+
+		for _, image := range newContainerComponents(node.Images) {
+			module.DependsOn = append(module.DependsOn, add(image).Ref)
+		}
+
+		for _, requirement := range node.Providers {
+			provider := add(&sbomComponent{
+				Ref:  "provider:" + requirement.GetSource(),
+				Kind: providerComponent,
+				Name: requirement.GetSource(),
+			})
+			for _, constraint := range requirement.GetVersionConstraints() {
+				if !containsString(provider.VersionConstraints, constraint) {
+					provider.VersionConstraints = append(provider.VersionConstraints, constraint)
+				}
+			}
+			module.DependsOn = append(module.DependsOn, provider.Ref)
+		}
+	}
+	return bom
+}
+
+func newModuleComponent(module *terrarium.Module) *sbomComponent {
+	return &sbomComponent{
+		Ref:     "module:" + moduleKey(module),
+		Kind:    moduleComponent,
+		Name:    module.GetName(),
+		Version: module.GetVersion(),
+	}
+}
+
+// newContainerComponents returns a component per architecture of every image, sorted by image name.
+func newContainerComponents(images map[string]*terrarium.ContainerImageDetails) []*sbomComponent {
+	names := make([]string, 0, len(images))
+	for name := range images {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var components []*sbomComponent
+	for _, name := range names {
+		details := images[name]
+		fullName := name
+		if details.GetNamespace() != "" {
+			fullName = details.GetNamespace() + "/" + name
+		}
+		if len(details.GetImages()) == 0 {
+			components = append(components, &sbomComponent{
+				Ref:     fmt.Sprintf("image:%s:%s", fullName, details.GetTag()),
+				Kind:    containerComponent,
+				Name:    fullName,
+				Version: details.GetTag(),
+			})
+			continue
+		}
+		for _, ref := range details.GetImages() {
+			components = append(components, &sbomComponent{
+				Ref:        "image:" + ref.GetImage(),
+				Kind:       containerComponent,
+				Name:       fullName,
+				Version:    details.GetTag(),
+				Digest:     imageDigest(ref.GetImage()),
+				Properties: map[string]string{"oci:arch": ref.GetArch(), "oci:image": ref.GetImage()},
+			})
+		}
+	}
+	return components
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sha256Digest returns the hex encoded hash of a "sha256:<hex>" digest.
+func sha256Digest(digest string) (string, bool) {
+	hash, ok := strings.CutPrefix(digest, "sha256:")
+	return hash, ok && hash != ""
+}
@@ -0,0 +1,231 @@
+package dependency_manager
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sbomToolName = "terrarium"
+
+type cycloneDXDocument struct {
+	BOMFormat    string                 `json:"bomFormat"`
+	SpecVersion  string                 `json:"specVersion"`
+	SerialNumber string                 `json:"serialNumber"`
+	Version      int                    `json:"version"`
+	Metadata     cycloneDXMetadata      `json:"metadata"`
+	Components   []*cycloneDXComponent  `json:"components"`
+	Dependencies []*cycloneDXDependency `json:"dependencies"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string              `json:"timestamp"`
+	Tools     cycloneDXTools      `json:"tools"`
+	Component *cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTools struct {
+	Components []*cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	Type       string               `json:"type"`
+	BOMRef     string               `json:"bom-ref,omitempty"`
+	Name       string               `json:"name"`
+	Version    string               `json:"version,omitempty"`
+	Hashes     []*cycloneDXHash     `json:"hashes,omitempty"`
+	Properties []*cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+// encodeCycloneDX writes the bill of materials as a CycloneDX 1.5 JSON document.
+func encodeCycloneDX(bom *billOfMaterials, created time.Time, serial string) ([]byte, error) {
+	document := &cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + serial,
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: created.Format(time.RFC3339),
+			Tools:     cycloneDXTools{Components: []*cycloneDXComponent{{Type: "application", Name: sbomToolName}}},
+			Component: newCycloneDXComponent(bom.Root),
+		},
+		Components:   make([]*cycloneDXComponent, 0, len(bom.Components)),
+		Dependencies: []*cycloneDXDependency{{Ref: bom.Root.Ref, DependsOn: nonNil(bom.Root.DependsOn)}},
+	}
+	for _, component := range bom.Components {
+		document.Components = append(document.Components, newCycloneDXComponent(component))
+		document.Dependencies = append(document.Dependencies, &cycloneDXDependency{Ref: component.Ref, DependsOn: nonNil(component.DependsOn)})
+	}
+	return json.MarshalIndent(document, "", "  ")
+}
+
+func newCycloneDXComponent(component *sbomComponent) *cycloneDXComponent {
+	converted := &cycloneDXComponent{
+		Type:       "library",
+		BOMRef:     component.Ref,
+		Name:       component.Name,
+		Version:    component.Version,
+		Properties: []*cycloneDXProperty{{Name: "terrarium:kind", Value: component.Kind}},
+	}
+	if component.Kind == containerComponent {
+		converted.Type = "container"
+	}
+	if hash, ok := sha256Digest(component.Digest); ok {
+		converted.Hashes = []*cycloneDXHash{{Alg: "SHA-256", Content: hash}}
+	}
+	for _, constraint := range component.VersionConstraints {
+		converted.Properties = append(converted.Properties, &cycloneDXProperty{Name: "terraform:version_constraint", Value: constraint})
+	}
+	for _, name := range sortedPropertyNames(component.Properties) {
+		converted.Properties = append(converted.Properties, &cycloneDXProperty{Name: name, Value: component.Properties[name]})
+	}
+	return converted
+}
+
+type spdxDocument struct {
+	SPDXVersion       string              `json:"spdxVersion"`
+	DataLicense       string              `json:"dataLicense"`
+	SPDXID            string              `json:"SPDXID"`
+	Name              string              `json:"name"`
+	DocumentNamespace string              `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo    `json:"creationInfo"`
+	Packages          []*spdxPackage      `json:"packages"`
+	Relationships     []*spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name                  string          `json:"name"`
+	SPDXID                string          `json:"SPDXID"`
+	VersionInfo           string          `json:"versionInfo,omitempty"`
+	DownloadLocation      string          `json:"downloadLocation"`
+	FilesAnalyzed         bool            `json:"filesAnalyzed"`
+	Checksums             []*spdxChecksum `json:"checksums,omitempty"`
+	PrimaryPackagePurpose string          `json:"primaryPackagePurpose"`
+	Comment               string          `json:"comment,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+var spdxIDInvalidCharacters = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+// encodeSPDX writes the bill of materials as an SPDX 2.3 JSON document.
+func encodeSPDX(bom *billOfMaterials, created time.Time, serial string) ([]byte, error) {
+	ids := map[string]string{}
+	used := map[string]bool{}
+	idOf := func(ref string) string {
+		if id, ok := ids[ref]; ok {
+			return id
+		}
+		base := "SPDXRef-" + strings.Trim(spdxIDInvalidCharacters.ReplaceAllString(ref, "-"), "-")
+		id := base
+		for n := 2; used[id]; n++ {
+			id = base + "-" + strconv.Itoa(n)
+		}
+		ids[ref] = id
+		used[id] = true
+		return id
+	}
+
+	document := &spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              bom.Root.Name + "@" + bom.Root.Version,
+		DocumentNamespace: "urn:uuid:" + serial,
+		CreationInfo: spdxCreationInfo{
+			Created:  created.Format(time.RFC3339),
+			Creators: []string{"Tool: " + sbomToolName},
+		},
+		Packages:      make([]*spdxPackage, 0, len(bom.Components)+1),
+		Relationships: []*spdxRelationship{{SPDXElementID: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSPDXElement: idOf(bom.Root.Ref)}},
+	}
+
+	for _, component := range append([]*sbomComponent{bom.Root}, bom.Components...) {
+		document.Packages = append(document.Packages, newSPDXPackage(component, idOf(component.Ref)))
+		for _, dependency := range component.DependsOn {
+			document.Relationships = append(document.Relationships, &spdxRelationship{
+				SPDXElementID:      idOf(component.Ref),
+				RelationshipType:   "DEPENDS_ON",
+				RelatedSPDXElement: idOf(dependency),
+			})
+		}
+	}
+	return json.MarshalIndent(document, "", "  ")
+}
+
+func newSPDXPackage(component *sbomComponent, id string) *spdxPackage {
+	converted := &spdxPackage{
+		Name:                  component.Name,
+		SPDXID:                id,
+		VersionInfo:           component.Version,
+		DownloadLocation:      "NOASSERTION",
+		PrimaryPackagePurpose: "LIBRARY",
+	}
+	switch component.Kind {
+	case containerComponent:
+		converted.PrimaryPackagePurpose = "CONTAINER"
+	case providerComponent:
+		converted.PrimaryPackagePurpose = "APPLICATION"
+	}
+	if hash, ok := sha256Digest(component.Digest); ok {
+		converted.Checksums = []*spdxChecksum{{Algorithm: "SHA256", ChecksumValue: hash}}
+	}
+
+	var comments []string
+	if len(component.VersionConstraints) > 0 {
+		comments = append(comments, "Version constraints: "+strings.Join(component.VersionConstraints, "; "))
+	}
+	for _, name := range sortedPropertyNames(component.Properties) {
+		comments = append(comments, name+": "+component.Properties[name])
+	}
+	converted.Comment = strings.Join(comments, "\n")
+	return converted
+}
+
+func sortedPropertyNames(properties map[string]string) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func nonNil(refs []string) []string {
+	if refs == nil {
+		return []string{}
+	}
+	return refs
+}
@@ -0,0 +1,194 @@
+package dependency_manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+// sbomGraph returns the items of cie/eks/aws 3.0.0, depending on cie/vpc/aws 1.0.0 and requiring the aws provider,
+// and of cie/vpc/aws, referencing nginx for two architectures and requiring the aws provider as well.
+func sbomGraph(t *testing.T) []*dynamodb.GetItemOutput {
+	t.Helper()
+	return []*dynamodb.GetItemOutput{
+		makeGetItemOutput(ModuleDependencies{
+			Name:      "cie/eks/aws",
+			Version:   "3.0.0",
+			Modules:   []*terrarium.Module{{Name: "cie/vpc/aws", Version: "1.0.0"}},
+			Providers: []*terrarium.ProviderRequirement{{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{"~> 5.0"}}},
+		}, t),
+		makeGetItemOutput(ContainerDependencies{Name: "cie/eks/aws", Version: "3.0.0"}, t),
+		makeGetItemOutput(ModuleDependencies{
+			Name:      "cie/vpc/aws",
+			Version:   "1.0.0",
+			Providers: []*terrarium.ProviderRequirement{{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{">= 4.0, < 6.0"}}},
+		}, t),
+		makeGetItemOutput(ContainerDependencies{
+			Name:    "cie/vpc/aws",
+			Version: "1.0.0",
+			Images: map[string]*terrarium.ContainerImageDetails{
+				"nginx": {Namespace: "base", Tag: "1.23", Images: []*terrarium.ContainerImageRef{
+					{Arch: "amd64", Image: "registry.example.com/base/nginx@sha256:0d17b565"},
+					{Arch: "arm64", Image: "registry.example.com/base/nginx:1.23-arm64"},
+				}},
+			},
+		}, t),
+	}
+}
+
+// Test_ExportSBOM checks:
+// - if the graph is exported as CycloneDX with hashes for images referenced by digest
+// - if the graph is exported as SPDX with a relationship per dependency
+// - if error is returned when the module version is missing
+// - if error is returned when the format is not supported
+// - if error is returned when the graph cannot be walked
+func Test_ExportSBOM(t *testing.T) {
+	t.Parallel()
+
+	module := &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"}
+
+	t.Run("when exported as CycloneDX", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: sbomGraph(t)}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName}
+
+		res, err := dms.ExportSBOM(context.TODO(), &terrarium.ExportSBOMRequest{Module: module, Format: terrarium.SBOMFormat_CYCLONEDX})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if res.MediaType != CycloneDXMediaType {
+			t.Errorf("Expected %s, got %s.", CycloneDXMediaType, res.MediaType)
+		}
+
+		document := &cycloneDXDocument{}
+		if err := json.Unmarshal(res.Document, document); err != nil {
+			t.Fatalf("Expected a JSON document, got %v.", err)
+		}
+
+		if document.BOMFormat != "CycloneDX" || document.Metadata.Component.Name != "cie/eks/aws" {
+			t.Errorf("Expected a CycloneDX document for cie/eks/aws, got %s for %s.", document.BOMFormat, document.Metadata.Component.Name)
+		}
+
+		// vpc, the provider and nginx for each architecture
+		if len(document.Components) != 4 {
+			t.Fatalf("Expected 4 components, got %d.", len(document.Components))
+		}
+
+		nginx := document.Components[2]
+		if nginx.Type != "container" || len(nginx.Hashes) != 1 || nginx.Hashes[0].Content != "0d17b565" {
+			t.Errorf("Expected the amd64 nginx image with its digest, got %+v.", nginx)
+		}
+
+		if len(document.Components[3].Hashes) != 0 {
+			t.Errorf("Expected no hash for the image referenced by tag, got %v.", document.Components[3].Hashes)
+		}
+
+		if len(document.Dependencies) != 5 || len(document.Dependencies[0].DependsOn) != 2 || len(document.Dependencies[1].DependsOn) != 3 {
+			t.Errorf("Expected the dependencies of eks and vpc, got %+v.", document.Dependencies)
+		}
+	})
+
+	t.Run("when exported as SPDX", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: sbomGraph(t)}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName}
+
+		res, err := dms.ExportSBOM(context.TODO(), &terrarium.ExportSBOMRequest{Module: module, Format: terrarium.SBOMFormat_SPDX})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if res.MediaType != SPDXMediaType {
+			t.Errorf("Expected %s, got %s.", SPDXMediaType, res.MediaType)
+		}
+
+		document := &spdxDocument{}
+		if err := json.Unmarshal(res.Document, document); err != nil {
+			t.Fatalf("Expected a JSON document, got %v.", err)
+		}
+
+		if len(document.Packages) != 5 {
+			t.Errorf("Expected 5 packages, got %d.", len(document.Packages))
+		}
+
+		// the document describing eks, then 2 dependencies of eks and 3 of vpc
+		if len(document.Relationships) != 6 || document.Relationships[0].RelatedSPDXElement != "SPDXRef-module-cie-eks-aws-3.0.0" {
+			t.Errorf("Expected 6 relationships from the document to eks, got %+v.", document.Relationships)
+		}
+
+		provider := document.Packages[2]
+		if provider.PrimaryPackagePurpose != "APPLICATION" || provider.Comment != "Version constraints: ~> 5.0; >= 4.0, < 6.0" {
+			t.Errorf("Expected the aws provider with the constraints of both modules, got %+v.", provider)
+		}
+	})
+
+	t.Run("when the module version is missing", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{}}
+
+		_, err := dms.ExportSBOM(context.TODO(), &terrarium.ExportSBOMRequest{Module: &terrarium.Module{Name: "cie/eks/aws"}})
+
+		if err != ModuleVersionRequiredError {
+			t.Errorf("Expected %v, got %v.", ModuleVersionRequiredError, err)
+		}
+	})
+
+	t.Run("when the format is not supported", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{GetItemOuts: sbomGraph(t)}}
+
+		_, err := dms.ExportSBOM(context.TODO(), &terrarium.ExportSBOMRequest{Module: module, Format: terrarium.SBOMFormat(7)})
+
+		if err != UnsupportedSBOMFormatError {
+			t.Errorf("Expected %v, got %v.", UnsupportedSBOMFormatError, err)
+		}
+	})
+
+	t.Run("when GetItem fails", func(t *testing.T) {
+		dms := &DependencyManagerService{Db: &mocks.DynamoDB{GetItemErrors: []error{errors.New("some error")}}}
+
+		_, err := dms.ExportSBOM(context.TODO(), &terrarium.ExportSBOMRequest{Module: module})
+
+		if err != GetModuleDependenciesError {
+			t.Errorf("Expected %v, got %v.", GetModuleDependenciesError, err)
+		}
+	})
+}
+
+// Test_encodeSPDX checks:
+// - if identifiers only hold the characters SPDX allows and stay unique
+func Test_encodeSPDX(t *testing.T) {
+	bom := &billOfMaterials{
+		Root: &sbomComponent{Ref: "module:cie/vpc/aws@1.0.0", Name: "cie/vpc/aws", Version: "1.0.0", DependsOn: []string{"image:a/b", "image:a:b"}},
+		Components: []*sbomComponent{
+			{Ref: "image:a/b", Kind: containerComponent, Name: "a/b"},
+			{Ref: "image:a:b", Kind: containerComponent, Name: "a:b"},
+		},
+	}
+
+	data, err := encodeSPDX(bom, time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), "d9a1f3e2")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v.", err)
+	}
+
+	document := &spdxDocument{}
+	if err := json.Unmarshal(data, document); err != nil {
+		t.Fatalf("Expected a JSON document, got %v.", err)
+	}
+
+	expected := []string{"SPDXRef-module-cie-vpc-aws-1.0.0", "SPDXRef-image-a-b", "SPDXRef-image-a-b-2"}
+	for i, pkg := range document.Packages {
+		if pkg.SPDXID != expected[i] {
+			t.Errorf("Expected %s, got %s.", expected[i], pkg.SPDXID)
+		}
+	}
+
+	if document.CreationInfo.Created != "2024-01-02T10:00:00Z" || document.DocumentNamespace != "urn:uuid:d9a1f3e2" {
+		t.Errorf("Expected the creation time and namespace given, got %s and %s.", document.CreationInfo.Created, document.DocumentNamespace)
+	}
+}
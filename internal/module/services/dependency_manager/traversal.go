@@ -0,0 +1,134 @@
+package dependency_manager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+// graphNode is a module reached while walking a dependency graph.
+type graphNode struct {
+	Module       *terrarium.Module
+	Depth        int
+	Dependencies []*terrarium.Module
+	// Cycles holds the dependencies leading back to a module on the path to this node
+	Cycles    []*terrarium.Module
+	Images    map[string]*terrarium.ContainerImageDetails
+	Providers []*terrarium.ProviderRequirement
+	// Requirements holds the dependencies registered with a version constraint
+	Requirements []*terrarium.ModuleRequirement
+	// Unresolved holds the dependencies whose version constraint no published version meets, which are not followed
+	Unresolved []*terrarium.Module
+}
+
+// fetchNode fills in the dependencies, and any other details, of a node.
+type fetchNode func(ctx context.Context, node *graphNode) error
+
+func moduleKey(module *terrarium.Module) string {
+	return fmt.Sprintf("%s@%s", module.GetName(), module.GetVersion())
+}
+
+// traverse walks the dependency graph of root breadth first and returns its nodes in the order they were reached.
+// Every module is fetched once however many modules depend on it, the modules of a level are fetched with at most
+// concurrency fetches in flight, and dependencies of nodes at maxDepth are not followed unless maxDepth is 0.
+// Dependencies without a version, whose constraint could not be resolved, are recorded as unresolved and not followed.
+func traverse(ctx context.Context, root *terrarium.Module, maxDepth int, concurrency int, fetch fetchNode) ([]*graphNode, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	span := trace.SpanFromContext(ctx)
+
+	visited := map[string]*graphNode{}
+	var nodes []*graphNode
+
+	level := []*graphNode{{Module: root}}
+	visited[moduleKey(root)] = level[0]
+
+	for len(level) > 0 {
+		if err := fetchLevel(ctx, level, concurrency, fetch); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, level...)
+
+		var next []*graphNode
+		for _, node := range level {
+			if maxDepth > 0 && node.Depth >= maxDepth {
+				continue
+			}
+			for _, dependency := range node.Dependencies {
+				if dependency.GetVersion() == "" {
+					node.Unresolved = append(node.Unresolved, dependency)
+					log.Printf("Unresolved dependency %s of %s/%s is not followed.", dependency.GetName(), node.Module.GetName(), node.Module.GetVersion())
+					span.AddEvent("Unresolved dependency", trace.WithAttributes(
+						attribute.String("module.name", node.Module.GetName()),
+						attribute.String("dependency.name", dependency.GetName())))
+					continue
+				}
+				key := moduleKey(dependency)
+				if _, ok := visited[key]; ok {
+					continue
+				}
+				child := &graphNode{Module: dependency, Depth: node.Depth + 1}
+				visited[key] = child
+				next = append(next, child)
+			}
+		}
+		level = next
+	}
+
+	markCycles(nodes[0], visited, map[string]bool{}, map[string]bool{})
+	return nodes, nil
+}
+
+// fetchLevel fetches every node of a level, returning the first error encountered.
+// The first error cancels the fetches still in flight and stops the remaining ones from starting.
+func fetchLevel(ctx context.Context, level []*graphNode, concurrency int, fetch fetchNode) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(concurrency)
+
+	for _, node := range level {
+		if groupCtx.Err() != nil {
+			break
+		}
+		node := node
+		group.Go(func() error {
+			return fetch(groupCtx, node)
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// markCycles walks the fetched graph depth first and records every dependency
+// pointing back to a module on the current path as a cycle.
+func markCycles(node *graphNode, nodes map[string]*graphNode, onPath map[string]bool, done map[string]bool) {
+	key := moduleKey(node.Module)
+	onPath[key] = true
+
+	for _, dependency := range node.Dependencies {
+		dependencyKey := moduleKey(dependency)
+		if onPath[dependencyKey] {
+			node.Cycles = append(node.Cycles, dependency)
+			continue
+		}
+		child, fetched := nodes[dependencyKey]
+		if !fetched || done[dependencyKey] {
+			continue
+		}
+		markCycles(child, nodes, onPath, done)
+	}
+
+	onPath[key] = false
+	done[key] = true
+}
@@ -0,0 +1,391 @@
+package dependency_manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	servicesMocks "github.com/terrariumcloud/terrarium/internal/module/services/mocks"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+// graphDynamoDB answers GetItem from an in-memory dependency graph keyed by "<name>@<version>",
+// so that graphs can be walked concurrently whatever the order of the fetches.
+type graphDynamoDB struct {
+	*mocks.DynamoDB
+	graph map[string][]*terrarium.Module
+	// detected holds the modules found in the source of a module version, with their version constraint
+	detected map[string][]*terrarium.ModuleRequirement
+	images   map[string]map[string]*terrarium.ContainerImageDetails
+	err      error
+
+	mu   sync.Mutex
+	gets map[string]int
+}
+
+func newGraphDynamoDB(graph map[string][]*terrarium.Module) *graphDynamoDB {
+	return &graphDynamoDB{
+		DynamoDB: &mocks.DynamoDB{},
+		graph:    graph,
+		images:   map[string]map[string]*terrarium.ContainerImageDetails{},
+		gets:     map[string]int{},
+	}
+}
+
+func (db *graphDynamoDB) GetItem(_ context.Context, in *dynamodb.GetItemInput, _ ...func(*dynamodb.Options)) (*dynamodb.GetItemOutput, error) {
+	name := in.Key["name"].(*types.AttributeValueMemberS).Value
+	version := in.Key["version"].(*types.AttributeValueMemberS).Value
+	key := fmt.Sprintf("%s@%s", name, version)
+
+	db.mu.Lock()
+	db.gets[*in.TableName+"/"+key]++
+	db.mu.Unlock()
+
+	if db.err != nil {
+		return nil, db.err
+	}
+
+	var item interface{} = ModuleDependencies{Name: name, Version: version, Modules: db.graph[key], Detected: db.detected[key]}
+	if *in.TableName == ContainerDependenciesTableName {
+		item = ContainerDependencies{Name: name, Version: version, Images: db.images[key]}
+	}
+	marshalledItem, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return nil, err
+	}
+	return &dynamodb.GetItemOutput{Item: marshalledItem}, nil
+}
+
+// fetches returns how many times a module was read from a table
+func (db *graphDynamoDB) fetches(table, name string) int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.gets[table+"/"+name+"@1.0.0"]
+}
+
+type collectingModuleDependenciesServer struct {
+	MockRetrieveModuleDependenciesServer
+	Responses []*terrarium.ModuleDependenciesResponse
+}
+
+func (srv *collectingModuleDependenciesServer) Send(res *terrarium.ModuleDependenciesResponse) error {
+	srv.Responses = append(srv.Responses, res)
+	return srv.MockRetrieveModuleDependenciesServer.Send(res)
+}
+
+func mod(name string) *terrarium.Module {
+	return &terrarium.Module{Name: name, Version: "1.0.0"}
+}
+
+func mods(names ...string) []*terrarium.Module {
+	modules := make([]*terrarium.Module, 0, len(names))
+	for _, name := range names {
+		modules = append(modules, mod(name))
+	}
+	return modules
+}
+
+// Test_RetrieveModuleDependenciesGraph checks:
+// - if a module shared by several dependents (diamond) is fetched and sent once
+// - if a cycle terminates and is reported on the module closing it
+// - if graphs wider than 250 modules are walked completely
+// - if dependencies below the maximum depth are not followed
+// - if modules detected with a version range are followed at the newest published version meeting it
+// - if modules detected with a version range that cannot be resolved are listed unresolved and not fetched
+// - if error is returned when a fetch fails while walking the graph concurrently
+func Test_RetrieveModuleDependenciesGraph(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the graph is a diamond", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"a@1.0.0": mods("b", "c"),
+			"b@1.0.0": mods("d"),
+			"c@1.0.0": mods("d"),
+		})
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, MaxConcurrentFetches: 4}
+		srv := &collectingModuleDependenciesServer{}
+
+		err := dms.RetrieveModuleDependencies(&terrarium.RetrieveModuleDependenciesRequest{Module: mod("a")}, srv)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(srv.Responses) != 4 {
+			t.Errorf("Expected 4 responses, got %v.", len(srv.Responses))
+		}
+
+		if db.fetches(ModuleDependenciesTableName, "d") != 1 {
+			t.Errorf("Expected d to be fetched once, got %v.", db.fetches(ModuleDependenciesTableName, "d"))
+		}
+
+		for _, res := range srv.Responses {
+			if len(res.Cycles) != 0 {
+				t.Errorf("Expected no cycles for %v, got %v.", res.Module, res.Cycles)
+			}
+		}
+
+		if last := srv.Responses[len(srv.Responses)-1]; last.Module.GetName() != "d" || last.Depth != 2 {
+			t.Errorf("Expected d at depth 2 to be sent last, got %v.", last)
+		}
+	})
+
+	t.Run("when the graph has a cycle", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"a@1.0.0": mods("b"),
+			"b@1.0.0": mods("c"),
+			"c@1.0.0": mods("a", "d"),
+		})
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, MaxConcurrentFetches: 4}
+		srv := &collectingModuleDependenciesServer{}
+
+		err := dms.RetrieveModuleDependencies(&terrarium.RetrieveModuleDependenciesRequest{Module: mod("a")}, srv)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(srv.Responses) != 4 {
+			t.Fatalf("Expected 4 responses, got %v.", len(srv.Responses))
+		}
+
+		for _, res := range srv.Responses {
+			if res.Module.GetName() == "c" {
+				if len(res.Cycles) != 1 || res.Cycles[0].GetName() != "a" {
+					t.Errorf("Expected cycle back to a on c, got %v.", res.Cycles)
+				}
+			} else if len(res.Cycles) != 0 {
+				t.Errorf("Expected no cycles for %v, got %v.", res.Module, res.Cycles)
+			}
+		}
+	})
+
+	t.Run("when the graph has more than 250 modules", func(t *testing.T) {
+		graph := map[string][]*terrarium.Module{}
+		var names []string
+		for i := 0; i < 300; i++ {
+			name := fmt.Sprintf("leaf-%d", i)
+			names = append(names, name)
+			// every leaf also depends on the next one, so most modules are reached more than once
+			if i < 299 {
+				graph[name+"@1.0.0"] = mods(fmt.Sprintf("leaf-%d", i+1))
+			}
+		}
+		graph["root@1.0.0"] = mods(names...)
+
+		db := newGraphDynamoDB(graph)
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, MaxConcurrentFetches: 16}
+		srv := &collectingModuleDependenciesServer{}
+
+		err := dms.RetrieveModuleDependencies(&terrarium.RetrieveModuleDependenciesRequest{Module: mod("root")}, srv)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(srv.Responses) != 301 {
+			t.Errorf("Expected 301 responses, got %v.", len(srv.Responses))
+		}
+
+		for _, name := range names {
+			if db.fetches(ModuleDependenciesTableName, name) != 1 {
+				t.Fatalf("Expected %s to be fetched once, got %v.", name, db.fetches(ModuleDependenciesTableName, name))
+			}
+		}
+	})
+
+	t.Run("when the maximum depth is reached", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"a@1.0.0": mods("b"),
+			"b@1.0.0": mods("c"),
+			"c@1.0.0": mods("d"),
+		})
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName}
+		srv := &collectingModuleDependenciesServer{}
+
+		err := dms.RetrieveModuleDependencies(&terrarium.RetrieveModuleDependenciesRequest{Module: mod("a"), MaxDepth: 1}, srv)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(srv.Responses) != 2 {
+			t.Fatalf("Expected 2 responses, got %v.", len(srv.Responses))
+		}
+
+		if len(srv.Responses[1].Dependencies) != 1 {
+			t.Errorf("Expected the dependencies of b to be listed, got %v.", srv.Responses[1].Dependencies)
+		}
+
+		if db.fetches(ModuleDependenciesTableName, "c") != 0 {
+			t.Errorf("Expected c not to be fetched, got %v.", db.fetches(ModuleDependenciesTableName, "c"))
+		}
+	})
+
+	t.Run("when a dependency is detected with a version range", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"b@1.2.0": mods("c"),
+		})
+		db.detected = map[string][]*terrarium.ModuleRequirement{
+			"a@1.0.0": {{Name: "b", VersionConstraint: "~> 1.0"}},
+		}
+		versionManager := &servicesMocks.MockVersionManagerClient{
+			ListModuleVersionsResponse: &services.ListModuleVersionsResponse{Versions: []string{"1.0.0", "1.2.0", "2.0.0"}},
+		}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, VersionManager: versionManager}
+		srv := &collectingModuleDependenciesServer{}
+
+		err := dms.RetrieveModuleDependencies(&terrarium.RetrieveModuleDependenciesRequest{Module: mod("a")}, srv)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(srv.Responses) != 3 {
+			t.Fatalf("Expected a, b and c, got %v responses.", len(srv.Responses))
+		}
+
+		if b := srv.Responses[1].Module; b.GetName() != "b" || b.GetVersion() != "1.2.0" {
+			t.Errorf("Expected b at 1.2.0, got %v.", b)
+		}
+
+		if c := srv.Responses[2].Module; c.GetName() != "c" || srv.Responses[2].Depth != 2 {
+			t.Errorf("Expected c at depth 2, got %v.", c)
+		}
+	})
+
+	t.Run("when a version range cannot be resolved", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{})
+		db.detected = map[string][]*terrarium.ModuleRequirement{
+			"a@1.0.0": {{Name: "b", VersionConstraint: "~> 1.0"}},
+		}
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName}
+		srv := &collectingModuleDependenciesServer{}
+
+		err := dms.RetrieveModuleDependencies(&terrarium.RetrieveModuleDependenciesRequest{Module: mod("a")}, srv)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(srv.Responses) != 1 {
+			t.Fatalf("Expected only a, got %v responses.", len(srv.Responses))
+		}
+
+		if deps := srv.Responses[0].Dependencies; len(deps) != 1 || deps[0].GetName() != "b" || deps[0].GetVersion() != "" {
+			t.Errorf("Expected b to be listed without a version, got %v.", deps)
+		}
+
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		if db.gets[ModuleDependenciesTableName+"/b@"] != 0 {
+			t.Errorf("Expected b not to be fetched, got %v.", db.gets[ModuleDependenciesTableName+"/b@"])
+		}
+	})
+
+	t.Run("when a fetch fails", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{})
+		db.err = errors.New("some error")
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, MaxConcurrentFetches: 4}
+		srv := &collectingModuleDependenciesServer{}
+
+		err := dms.RetrieveModuleDependencies(&terrarium.RetrieveModuleDependenciesRequest{Module: mod("a")}, srv)
+
+		if err != GetModuleDependenciesError {
+			t.Errorf("Expected %v, got %v", GetModuleDependenciesError, err)
+		}
+
+		if len(srv.Responses) != 0 {
+			t.Errorf("Expected no responses, got %v.", len(srv.Responses))
+		}
+	})
+}
+
+// Test_RetrieveContainerDependenciesGraph checks:
+// - if the images of a module shared by several dependents are fetched and sent once
+// - if a cycle terminates and is reported on the module closing it
+func Test_RetrieveContainerDependenciesGraph(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the graph is a diamond", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"a@1.0.0": mods("b", "c"),
+			"b@1.0.0": mods("d"),
+			"c@1.0.0": mods("d"),
+		})
+		db.images["d@1.0.0"] = registerContainerDependenciesTestData.Images
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName, MaxConcurrentFetches: 4}
+		srv := &MockRetrieveContainerDependenciesServer{}
+
+		err := dms.RetrieveContainerDependencies(&terrarium.RetrieveContainerDependenciesRequestV2{Module: mod("a")}, srv)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if srv.SendInvocations != 4 {
+			t.Errorf("Expected 4 calls to Send, got %v.", srv.SendInvocations)
+		}
+
+		if db.fetches(ContainerDependenciesTableName, "d") != 1 {
+			t.Errorf("Expected images of d to be fetched once, got %v.", db.fetches(ContainerDependenciesTableName, "d"))
+		}
+
+		if last := srv.Responses[len(srv.Responses)-1]; len(last.Dependencies) != 2 {
+			t.Errorf("Expected the images of d, got %v.", last.Dependencies)
+		}
+	})
+
+	t.Run("when the graph has a cycle", func(t *testing.T) {
+		db := newGraphDynamoDB(map[string][]*terrarium.Module{
+			"a@1.0.0": mods("b"),
+			"b@1.0.0": mods("a"),
+		})
+		dms := &DependencyManagerService{Db: db, ModuleTable: ModuleDependenciesTableName, ContainerTable: ContainerDependenciesTableName}
+		srv := &MockRetrieveContainerDependenciesServer{}
+
+		err := dms.RetrieveContainerDependencies(&terrarium.RetrieveContainerDependenciesRequestV2{Module: mod("a")}, srv)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if srv.SendInvocations != 2 {
+			t.Fatalf("Expected 2 calls to Send, got %v.", srv.SendInvocations)
+		}
+
+		if cycles := srv.Responses[1].Cycles; len(cycles) != 1 || cycles[0].GetName() != "a" {
+			t.Errorf("Expected cycle back to a on b, got %v.", cycles)
+		}
+	})
+}
+
+// Test_fetchLevel checks:
+// - if the first error cancels the fetches still in flight
+func Test_fetchLevel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when a fetch fails", func(t *testing.T) {
+		fetchErr := errors.New("some error")
+		level := []*graphNode{{Module: mod("a")}, {Module: mod("b")}, {Module: mod("c")}}
+
+		err := fetchLevel(context.TODO(), level, len(level), func(ctx context.Context, node *graphNode) error {
+			if node.Module.GetName() == "a" {
+				return fetchErr
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		if err != fetchErr {
+			t.Errorf("Expected %v, got %v", fetchErr, err)
+		}
+	})
+}
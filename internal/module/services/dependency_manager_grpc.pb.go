@@ -24,6 +24,14 @@ const (
 	DependencyManager_RegisterContainerDependencies_FullMethodName = "/terrarium.module.services.DependencyManager/RegisterContainerDependencies"
 	DependencyManager_RetrieveContainerDependencies_FullMethodName = "/terrarium.module.services.DependencyManager/RetrieveContainerDependencies"
 	DependencyManager_RetrieveModuleDependencies_FullMethodName    = "/terrarium.module.services.DependencyManager/RetrieveModuleDependencies"
+	DependencyManager_RetrieveDependents_FullMethodName            = "/terrarium.module.services.DependencyManager/RetrieveDependents"
+	DependencyManager_RegisterDetectedDependencies_FullMethodName  = "/terrarium.module.services.DependencyManager/RegisterDetectedDependencies"
+	DependencyManager_SearchContainerImages_FullMethodName         = "/terrarium.module.services.DependencyManager/SearchContainerImages"
+	DependencyManager_ExportSBOM_FullMethodName                    = "/terrarium.module.services.DependencyManager/ExportSBOM"
+	DependencyManager_RetrieveDependencyGraph_FullMethodName       = "/terrarium.module.services.DependencyManager/RetrieveDependencyGraph"
+	DependencyManager_RetrieveProviderDependencies_FullMethodName  = "/terrarium.module.services.DependencyManager/RetrieveProviderDependencies"
+	DependencyManager_RetrieveProviderDependents_FullMethodName    = "/terrarium.module.services.DependencyManager/RetrieveProviderDependents"
+	DependencyManager_UnregisterDependencies_FullMethodName        = "/terrarium.module.services.DependencyManager/UnregisterDependencies"
 )
 
 // DependencyManagerClient is the client API for DependencyManager service.
@@ -34,6 +42,14 @@ type DependencyManagerClient interface {
 	RegisterContainerDependencies(ctx context.Context, in *module.RegisterContainerDependenciesRequest, opts ...grpc.CallOption) (*module.Response, error)
 	RetrieveContainerDependencies(ctx context.Context, in *module.RetrieveContainerDependenciesRequestV2, opts ...grpc.CallOption) (DependencyManager_RetrieveContainerDependenciesClient, error)
 	RetrieveModuleDependencies(ctx context.Context, in *module.RetrieveModuleDependenciesRequest, opts ...grpc.CallOption) (DependencyManager_RetrieveModuleDependenciesClient, error)
+	RetrieveDependents(ctx context.Context, in *module.RetrieveDependentsRequest, opts ...grpc.CallOption) (DependencyManager_RetrieveDependentsClient, error)
+	RegisterDetectedDependencies(ctx context.Context, in *RegisterDetectedDependenciesRequest, opts ...grpc.CallOption) (*module.Response, error)
+	SearchContainerImages(ctx context.Context, in *module.SearchContainerImagesRequest, opts ...grpc.CallOption) (*module.SearchContainerImagesResponse, error)
+	ExportSBOM(ctx context.Context, in *module.ExportSBOMRequest, opts ...grpc.CallOption) (*module.SBOMResponse, error)
+	RetrieveDependencyGraph(ctx context.Context, in *module.RetrieveDependencyGraphRequest, opts ...grpc.CallOption) (*module.DependencyGraph, error)
+	RetrieveProviderDependencies(ctx context.Context, in *module.RetrieveProviderDependenciesRequest, opts ...grpc.CallOption) (*module.ProviderDependenciesResponse, error)
+	RetrieveProviderDependents(ctx context.Context, in *RetrieveProviderDependentsRequest, opts ...grpc.CallOption) (*ProviderDependentsResponse, error)
+	UnregisterDependencies(ctx context.Context, in *UnregisterDependenciesRequest, opts ...grpc.CallOption) (*module.Response, error)
 }
 
 type dependencyManagerClient struct {
@@ -126,6 +142,101 @@ func (x *dependencyManagerRetrieveModuleDependenciesClient) Recv() (*module.Modu
 	return m, nil
 }
 
+func (c *dependencyManagerClient) RetrieveDependents(ctx context.Context, in *module.RetrieveDependentsRequest, opts ...grpc.CallOption) (DependencyManager_RetrieveDependentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DependencyManager_ServiceDesc.Streams[2], DependencyManager_RetrieveDependents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dependencyManagerRetrieveDependentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DependencyManager_RetrieveDependentsClient interface {
+	Recv() (*module.DependentsResponse, error)
+	grpc.ClientStream
+}
+
+type dependencyManagerRetrieveDependentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dependencyManagerRetrieveDependentsClient) Recv() (*module.DependentsResponse, error) {
+	m := new(module.DependentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dependencyManagerClient) RegisterDetectedDependencies(ctx context.Context, in *RegisterDetectedDependenciesRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	out := new(module.Response)
+	err := c.cc.Invoke(ctx, DependencyManager_RegisterDetectedDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyManagerClient) SearchContainerImages(ctx context.Context, in *module.SearchContainerImagesRequest, opts ...grpc.CallOption) (*module.SearchContainerImagesResponse, error) {
+	out := new(module.SearchContainerImagesResponse)
+	err := c.cc.Invoke(ctx, DependencyManager_SearchContainerImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyManagerClient) ExportSBOM(ctx context.Context, in *module.ExportSBOMRequest, opts ...grpc.CallOption) (*module.SBOMResponse, error) {
+	out := new(module.SBOMResponse)
+	err := c.cc.Invoke(ctx, DependencyManager_ExportSBOM_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyManagerClient) RetrieveDependencyGraph(ctx context.Context, in *module.RetrieveDependencyGraphRequest, opts ...grpc.CallOption) (*module.DependencyGraph, error) {
+	out := new(module.DependencyGraph)
+	err := c.cc.Invoke(ctx, DependencyManager_RetrieveDependencyGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyManagerClient) RetrieveProviderDependencies(ctx context.Context, in *module.RetrieveProviderDependenciesRequest, opts ...grpc.CallOption) (*module.ProviderDependenciesResponse, error) {
+	out := new(module.ProviderDependenciesResponse)
+	err := c.cc.Invoke(ctx, DependencyManager_RetrieveProviderDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyManagerClient) RetrieveProviderDependents(ctx context.Context, in *RetrieveProviderDependentsRequest, opts ...grpc.CallOption) (*ProviderDependentsResponse, error) {
+	out := new(ProviderDependentsResponse)
+	err := c.cc.Invoke(ctx, DependencyManager_RetrieveProviderDependents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyManagerClient) UnregisterDependencies(ctx context.Context, in *UnregisterDependenciesRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	out := new(module.Response)
+	err := c.cc.Invoke(ctx, DependencyManager_UnregisterDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DependencyManagerServer is the server API for DependencyManager service.
 // All implementations must embed UnimplementedDependencyManagerServer
 // for forward compatibility
@@ -134,6 +245,14 @@ type DependencyManagerServer interface {
 	RegisterContainerDependencies(context.Context, *module.RegisterContainerDependenciesRequest) (*module.Response, error)
 	RetrieveContainerDependencies(*module.RetrieveContainerDependenciesRequestV2, DependencyManager_RetrieveContainerDependenciesServer) error
 	RetrieveModuleDependencies(*module.RetrieveModuleDependenciesRequest, DependencyManager_RetrieveModuleDependenciesServer) error
+	RetrieveDependents(*module.RetrieveDependentsRequest, DependencyManager_RetrieveDependentsServer) error
+	RegisterDetectedDependencies(context.Context, *RegisterDetectedDependenciesRequest) (*module.Response, error)
+	SearchContainerImages(context.Context, *module.SearchContainerImagesRequest) (*module.SearchContainerImagesResponse, error)
+	ExportSBOM(context.Context, *module.ExportSBOMRequest) (*module.SBOMResponse, error)
+	RetrieveDependencyGraph(context.Context, *module.RetrieveDependencyGraphRequest) (*module.DependencyGraph, error)
+	RetrieveProviderDependencies(context.Context, *module.RetrieveProviderDependenciesRequest) (*module.ProviderDependenciesResponse, error)
+	RetrieveProviderDependents(context.Context, *RetrieveProviderDependentsRequest) (*ProviderDependentsResponse, error)
+	UnregisterDependencies(context.Context, *UnregisterDependenciesRequest) (*module.Response, error)
 	mustEmbedUnimplementedDependencyManagerServer()
 }
 
@@ -153,6 +272,30 @@ func (UnimplementedDependencyManagerServer) RetrieveContainerDependencies(*modul
 func (UnimplementedDependencyManagerServer) RetrieveModuleDependencies(*module.RetrieveModuleDependenciesRequest, DependencyManager_RetrieveModuleDependenciesServer) error {
 	return status.Errorf(codes.Unimplemented, "method RetrieveModuleDependencies not implemented")
 }
+func (UnimplementedDependencyManagerServer) RetrieveDependents(*module.RetrieveDependentsRequest, DependencyManager_RetrieveDependentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method RetrieveDependents not implemented")
+}
+func (UnimplementedDependencyManagerServer) RegisterDetectedDependencies(context.Context, *RegisterDetectedDependenciesRequest) (*module.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDetectedDependencies not implemented")
+}
+func (UnimplementedDependencyManagerServer) SearchContainerImages(context.Context, *module.SearchContainerImagesRequest) (*module.SearchContainerImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchContainerImages not implemented")
+}
+func (UnimplementedDependencyManagerServer) ExportSBOM(context.Context, *module.ExportSBOMRequest) (*module.SBOMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSBOM not implemented")
+}
+func (UnimplementedDependencyManagerServer) RetrieveDependencyGraph(context.Context, *module.RetrieveDependencyGraphRequest) (*module.DependencyGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveDependencyGraph not implemented")
+}
+func (UnimplementedDependencyManagerServer) RetrieveProviderDependencies(context.Context, *module.RetrieveProviderDependenciesRequest) (*module.ProviderDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveProviderDependencies not implemented")
+}
+func (UnimplementedDependencyManagerServer) RetrieveProviderDependents(context.Context, *RetrieveProviderDependentsRequest) (*ProviderDependentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveProviderDependents not implemented")
+}
+func (UnimplementedDependencyManagerServer) UnregisterDependencies(context.Context, *UnregisterDependenciesRequest) (*module.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterDependencies not implemented")
+}
 func (UnimplementedDependencyManagerServer) mustEmbedUnimplementedDependencyManagerServer() {}
 
 // UnsafeDependencyManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -244,6 +387,153 @@ func (x *dependencyManagerRetrieveModuleDependenciesServer) Send(m *module.Modul
 	return x.ServerStream.SendMsg(m)
 }
 
+func _DependencyManager_RetrieveDependents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(module.RetrieveDependentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DependencyManagerServer).RetrieveDependents(m, &dependencyManagerRetrieveDependentsServer{stream})
+}
+
+type DependencyManager_RetrieveDependentsServer interface {
+	Send(*module.DependentsResponse) error
+	grpc.ServerStream
+}
+
+type dependencyManagerRetrieveDependentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dependencyManagerRetrieveDependentsServer) Send(m *module.DependentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DependencyManager_RegisterDetectedDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDetectedDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyManagerServer).RegisterDetectedDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyManager_RegisterDetectedDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyManagerServer).RegisterDetectedDependencies(ctx, req.(*RegisterDetectedDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyManager_SearchContainerImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.SearchContainerImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyManagerServer).SearchContainerImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyManager_SearchContainerImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyManagerServer).SearchContainerImages(ctx, req.(*module.SearchContainerImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyManager_ExportSBOM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.ExportSBOMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyManagerServer).ExportSBOM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyManager_ExportSBOM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyManagerServer).ExportSBOM(ctx, req.(*module.ExportSBOMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyManager_RetrieveDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.RetrieveDependencyGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyManagerServer).RetrieveDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyManager_RetrieveDependencyGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyManagerServer).RetrieveDependencyGraph(ctx, req.(*module.RetrieveDependencyGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyManager_RetrieveProviderDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.RetrieveProviderDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyManagerServer).RetrieveProviderDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyManager_RetrieveProviderDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyManagerServer).RetrieveProviderDependencies(ctx, req.(*module.RetrieveProviderDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyManager_RetrieveProviderDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveProviderDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyManagerServer).RetrieveProviderDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyManager_RetrieveProviderDependents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyManagerServer).RetrieveProviderDependents(ctx, req.(*RetrieveProviderDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyManager_UnregisterDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyManagerServer).UnregisterDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyManager_UnregisterDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyManagerServer).UnregisterDependencies(ctx, req.(*UnregisterDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DependencyManager_ServiceDesc is the grpc.ServiceDesc for DependencyManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +549,34 @@ var DependencyManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterContainerDependencies",
 			Handler:    _DependencyManager_RegisterContainerDependencies_Handler,
 		},
+		{
+			MethodName: "RegisterDetectedDependencies",
+			Handler:    _DependencyManager_RegisterDetectedDependencies_Handler,
+		},
+		{
+			MethodName: "SearchContainerImages",
+			Handler:    _DependencyManager_SearchContainerImages_Handler,
+		},
+		{
+			MethodName: "ExportSBOM",
+			Handler:    _DependencyManager_ExportSBOM_Handler,
+		},
+		{
+			MethodName: "RetrieveDependencyGraph",
+			Handler:    _DependencyManager_RetrieveDependencyGraph_Handler,
+		},
+		{
+			MethodName: "RetrieveProviderDependencies",
+			Handler:    _DependencyManager_RetrieveProviderDependencies_Handler,
+		},
+		{
+			MethodName: "RetrieveProviderDependents",
+			Handler:    _DependencyManager_RetrieveProviderDependents_Handler,
+		},
+		{
+			MethodName: "UnregisterDependencies",
+			Handler:    _DependencyManager_UnregisterDependencies_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -271,6 +589,11 @@ var DependencyManager_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DependencyManager_RetrieveModuleDependencies_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RetrieveDependents",
+			Handler:       _DependencyManager_RetrieveDependents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pb/terrarium/module/services/dependency_manager.proto",
 }
@@ -12,27 +12,65 @@ import (
 
 type MockRegistrarClient struct {
 	moduleServices.RegistrarClient
-	RegisterInvocations int
-	RegisterResponse    *terrariumModule.Response
-	RegisterError       error
+	RegisterInvocations    int
+	RegisterRequest        *terrariumModule.RegisterModuleRequest
+	RegisterResponse       *terrariumModule.Response
+	RegisterError          error
+	GetModuleInvocations   int
+	GetModuleResponse      *moduleServices.GetModuleResponse
+	GetModuleError         error
+	ListModulesInvocations int
+	ListModulesRequest     *moduleServices.ListModulesRequest
+	ListModulesResponse    *moduleServices.ListModulesResponse
+	ListModulesError       error
 }
 
 func (m *MockRegistrarClient) Register(ctx context.Context, in *terrariumModule.RegisterModuleRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
 	m.RegisterInvocations++
+	m.RegisterRequest = in
 	return m.RegisterResponse, m.RegisterError
 }
 
+func (m *MockRegistrarClient) GetModule(ctx context.Context, in *moduleServices.GetModuleRequest, opts ...grpc.CallOption) (*moduleServices.GetModuleResponse, error) {
+	m.GetModuleInvocations++
+	return m.GetModuleResponse, m.GetModuleError
+}
+
+func (m *MockRegistrarClient) ListModules(ctx context.Context, in *moduleServices.ListModulesRequest, opts ...grpc.CallOption) (*moduleServices.ListModulesResponse, error) {
+	m.ListModulesInvocations++
+	m.ListModulesRequest = in
+	return m.ListModulesResponse, m.ListModulesError
+}
+
 type MockVersionManagerClient struct {
 	moduleServices.VersionManagerClient
-	BeginVersionInvocations   int
-	BeginVersionResponse      *terrariumModule.Response
-	BeginVersionError         error
-	PublishVersionInvocations int
-	PublishVersionResponse    *terrariumModule.Response
-	PublishVersionError       error
-	AbortVersionInvocations   int
-	AbortVersionResponse      *terrariumModule.Response
-	AbortVersionError         error
+	BeginVersionInvocations             int
+	BeginVersionResponse                *terrariumModule.Response
+	BeginVersionError                   error
+	PublishVersionInvocations           int
+	PublishVersionResponse              *terrariumModule.Response
+	PublishVersionError                 error
+	AbortVersionInvocations             int
+	AbortVersionResponse                *terrariumModule.Response
+	AbortVersionError                   error
+	SetMaturityInvocations              int
+	SetMaturityResponse                 *terrariumModule.Response
+	SetMaturityError                    error
+	ApproveVersionInvocations           int
+	ApproveVersionContext               context.Context
+	ApproveVersionResponse              *terrariumModule.Response
+	ApproveVersionError                 error
+	RejectVersionInvocations            int
+	RejectVersionResponse               *terrariumModule.Response
+	RejectVersionError                  error
+	ListModuleVersionsInvocations       int
+	ListModuleVersionsRequest           *moduleServices.ListModuleVersionsRequest
+	ListModuleVersionsResponse          *moduleServices.ListModuleVersionsResponse
+	ListModuleVersionsError             error
+	ListLatestModuleVersionsInvocations int
+	ListLatestModuleVersionsRequest     *moduleServices.ListLatestModuleVersionsRequest
+	ListLatestModuleVersionsResponse    *moduleServices.ListLatestModuleVersionsResponse
+	ListLatestModuleVersionsError       error
 }
 
 func (m *MockVersionManagerClient) BeginVersion(ctx context.Context, in *terrariumModule.BeginVersionRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
@@ -50,24 +88,96 @@ func (m *MockVersionManagerClient) AbortVersion(ctx context.Context, in *moduleS
 	return m.AbortVersionResponse, m.AbortVersionError
 }
 
+func (m *MockVersionManagerClient) SetMaturity(ctx context.Context, in *terrariumModule.SetMaturityRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
+	m.SetMaturityInvocations++
+	return m.SetMaturityResponse, m.SetMaturityError
+}
+
+func (m *MockVersionManagerClient) ApproveVersion(ctx context.Context, in *terrariumModule.ReviewVersionRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
+	m.ApproveVersionInvocations++
+	m.ApproveVersionContext = ctx
+	return m.ApproveVersionResponse, m.ApproveVersionError
+}
+
+func (m *MockVersionManagerClient) RejectVersion(ctx context.Context, in *terrariumModule.ReviewVersionRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
+	m.RejectVersionInvocations++
+	return m.RejectVersionResponse, m.RejectVersionError
+}
+
+func (m *MockVersionManagerClient) ListModuleVersions(ctx context.Context, in *moduleServices.ListModuleVersionsRequest, opts ...grpc.CallOption) (*moduleServices.ListModuleVersionsResponse, error) {
+	m.ListModuleVersionsInvocations++
+	m.ListModuleVersionsRequest = in
+	return m.ListModuleVersionsResponse, m.ListModuleVersionsError
+}
+
+func (m *MockVersionManagerClient) ListLatestModuleVersions(ctx context.Context, in *moduleServices.ListLatestModuleVersionsRequest, opts ...grpc.CallOption) (*moduleServices.ListLatestModuleVersionsResponse, error) {
+	m.ListLatestModuleVersionsInvocations++
+	m.ListLatestModuleVersionsRequest = in
+	return m.ListLatestModuleVersionsResponse, m.ListLatestModuleVersionsError
+}
+
 type MockProviderVersionManagerClient struct {
 	providerServices.VersionManagerClient
-	RegisterInvocations       int
-	RegisterResponse          *terrariumProvider.Response
-	RegisterError             error
-	PublishVersionInvocations int
-	PublishVersionResponse    *terrariumProvider.Response
-	PublishVersionError       error
-	AbortVersionInvocations   int
-	AbortVersionResponse      *terrariumProvider.Response
-	AbortVersionError         error
+	RegisterInvocations             int
+	RegisterRequest                 *terrariumProvider.RegisterProviderRequest
+	RegisterResponse                *terrariumProvider.Response
+	RegisterError                   error
+	AddPlatformsInvocations         int
+	AddPlatformsRequest             *terrariumProvider.RegisterProviderRequest
+	AddPlatformsResponse            *terrariumProvider.Response
+	AddPlatformsError               error
+	PublishVersionInvocations       int
+	PublishVersionResponse          *terrariumProvider.Response
+	PublishVersionError             error
+	AbortVersionInvocations         int
+	AbortVersionResponse            *terrariumProvider.Response
+	AbortVersionError               error
+	ApproveVersionInvocations       int
+	ApproveVersionResponse          *terrariumProvider.Response
+	ApproveVersionError             error
+	RejectVersionInvocations        int
+	RejectVersionResponse           *terrariumProvider.Response
+	RejectVersionError              error
+	ListProviderVersionsInvocations int
+	ListProviderVersionsResponse    *providerServices.ProviderVersionsResponse
+	ListProviderVersionsError       error
+	GetVersionDataInvocations       int
+	GetVersionDataRequests          []*providerServices.VersionDataRequest
+	GetVersionDataResponse          *providerServices.PlatformMetadataResponse
+	GetVersionDataError             error
+	ListProvidersInvocations        int
+	ListProvidersResponse           *providerServices.ListProvidersResponse
+	ListProvidersError              error
+}
+
+func (m *MockProviderVersionManagerClient) ListProviders(ctx context.Context, in *providerServices.ListProvidersRequest, opts ...grpc.CallOption) (*providerServices.ListProvidersResponse, error) {
+	m.ListProvidersInvocations++
+	return m.ListProvidersResponse, m.ListProvidersError
+}
+
+func (m *MockProviderVersionManagerClient) ListProviderVersions(ctx context.Context, in *providerServices.ProviderName, opts ...grpc.CallOption) (*providerServices.ProviderVersionsResponse, error) {
+	m.ListProviderVersionsInvocations++
+	return m.ListProviderVersionsResponse, m.ListProviderVersionsError
+}
+
+func (m *MockProviderVersionManagerClient) GetVersionData(ctx context.Context, in *providerServices.VersionDataRequest, opts ...grpc.CallOption) (*providerServices.PlatformMetadataResponse, error) {
+	m.GetVersionDataInvocations++
+	m.GetVersionDataRequests = append(m.GetVersionDataRequests, in)
+	return m.GetVersionDataResponse, m.GetVersionDataError
 }
 
 func (m *MockProviderVersionManagerClient) Register(ctx context.Context, in *terrariumProvider.RegisterProviderRequest, opts ...grpc.CallOption) (*terrariumProvider.Response, error) {
 	m.RegisterInvocations++
+	m.RegisterRequest = in
 	return m.RegisterResponse, m.RegisterError
 }
 
+func (m *MockProviderVersionManagerClient) AddPlatforms(ctx context.Context, in *terrariumProvider.RegisterProviderRequest, opts ...grpc.CallOption) (*terrariumProvider.Response, error) {
+	m.AddPlatformsInvocations++
+	m.AddPlatformsRequest = in
+	return m.AddPlatformsResponse, m.AddPlatformsError
+}
+
 func (m *MockProviderVersionManagerClient) PublishVersion(ctx context.Context, in *providerServices.TerminateVersionRequest, opts ...grpc.CallOption) (*terrariumProvider.Response, error) {
 	m.PublishVersionInvocations++
 	return m.PublishVersionResponse, m.PublishVersionError
@@ -78,6 +188,78 @@ func (m *MockProviderVersionManagerClient) AbortProviderVersion(ctx context.Cont
 	return m.AbortVersionResponse, m.AbortVersionError
 }
 
+func (m *MockProviderVersionManagerClient) ApproveVersion(ctx context.Context, in *terrariumProvider.ReviewProviderVersionRequest, opts ...grpc.CallOption) (*terrariumProvider.Response, error) {
+	m.ApproveVersionInvocations++
+	return m.ApproveVersionResponse, m.ApproveVersionError
+}
+
+func (m *MockProviderVersionManagerClient) RejectVersion(ctx context.Context, in *terrariumProvider.ReviewProviderVersionRequest, opts ...grpc.CallOption) (*terrariumProvider.Response, error) {
+	m.RejectVersionInvocations++
+	return m.RejectVersionResponse, m.RejectVersionError
+}
+
+type MockTagManagerClient struct {
+	moduleServices.TagManagerClient
+	AddTagsInvocations          int
+	AddTagsRequest              *terrariumModule.PublishTagRequest
+	AddTagsResponse             *terrariumModule.Response
+	AddTagsError                error
+	RemoveTagsInvocations       int
+	RemoveTagsRequest           *terrariumModule.PublishTagRequest
+	RemoveTagsResponse          *terrariumModule.Response
+	RemoveTagsError             error
+	GetTagsInvocations          int
+	GetTagsRequest              *terrariumModule.GetTagsRequest
+	GetTagsResponse             *terrariumModule.ModuleTags
+	GetTagsError                error
+	ListModuleTagsInvocations   int
+	ListModuleTagsRequest       *moduleServices.ListModuleTagsRequest
+	ListModuleTagsResponse      *moduleServices.ListModuleTagsResponse
+	ListModuleTagsError         error
+	ListModulesByTagInvocations int
+	ListModulesByTagRequest     *terrariumModule.ListModulesByTagRequest
+	ListModulesByTagResponse    *terrariumModule.ListModulesByTagResponse
+	ListModulesByTagError       error
+	ListTagsInvocations         int
+	ListTagsResponse            *moduleServices.ListTagsResponse
+	ListTagsError               error
+}
+
+func (m *MockTagManagerClient) AddTags(ctx context.Context, in *terrariumModule.PublishTagRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
+	m.AddTagsInvocations++
+	m.AddTagsRequest = in
+	return m.AddTagsResponse, m.AddTagsError
+}
+
+func (m *MockTagManagerClient) RemoveTags(ctx context.Context, in *terrariumModule.PublishTagRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
+	m.RemoveTagsInvocations++
+	m.RemoveTagsRequest = in
+	return m.RemoveTagsResponse, m.RemoveTagsError
+}
+
+func (m *MockTagManagerClient) GetTags(ctx context.Context, in *terrariumModule.GetTagsRequest, opts ...grpc.CallOption) (*terrariumModule.ModuleTags, error) {
+	m.GetTagsInvocations++
+	m.GetTagsRequest = in
+	return m.GetTagsResponse, m.GetTagsError
+}
+
+func (m *MockTagManagerClient) ListModuleTags(ctx context.Context, in *moduleServices.ListModuleTagsRequest, opts ...grpc.CallOption) (*moduleServices.ListModuleTagsResponse, error) {
+	m.ListModuleTagsInvocations++
+	m.ListModuleTagsRequest = in
+	return m.ListModuleTagsResponse, m.ListModuleTagsError
+}
+
+func (m *MockTagManagerClient) ListModulesByTag(ctx context.Context, in *terrariumModule.ListModulesByTagRequest, opts ...grpc.CallOption) (*terrariumModule.ListModulesByTagResponse, error) {
+	m.ListModulesByTagInvocations++
+	m.ListModulesByTagRequest = in
+	return m.ListModulesByTagResponse, m.ListModulesByTagError
+}
+
+func (m *MockTagManagerClient) ListTags(ctx context.Context, in *moduleServices.ListTagsRequest, opts ...grpc.CallOption) (*moduleServices.ListTagsResponse, error) {
+	m.ListTagsInvocations++
+	return m.ListTagsResponse, m.ListTagsError
+}
+
 type MockStorageClient struct {
 	moduleServices.StorageClient
 	UploadSourceZipInvocations   int
@@ -86,6 +268,20 @@ type MockStorageClient struct {
 	DownloadSourceZipInvocations int
 	DownloadSourceZipClient      moduleServices.Storage_DownloadSourceZipClient
 	DownloadSourceZipError       error
+	DeleteSourceZipInvocations   int
+	DeleteSourceZipResponse      *terrariumModule.Response
+	DeleteSourceZipError         error
+	GetModuleDocsInvocations     int
+	GetModuleDocsRequest         *moduleServices.GetModuleDocsRequest
+	GetModuleDocsResponse        *moduleServices.ModuleDocs
+	GetModuleDocsError           error
+	ListSourceFilesInvocations   int
+	ListSourceFilesResponse      *moduleServices.SourceFiles
+	ListSourceFilesError         error
+	GetSourceFileInvocations     int
+	GetSourceFileRequest         *moduleServices.GetSourceFileRequest
+	GetSourceFileResponse        *moduleServices.SourceFile
+	GetSourceFileError           error
 }
 
 func (m *MockStorageClient) UploadSourceZip(ctx context.Context, opts ...grpc.CallOption) (moduleServices.Storage_UploadSourceZipClient, error) {
@@ -98,6 +294,28 @@ func (m *MockStorageClient) DownloadSourceZip(ctx context.Context, in *terrarium
 	return m.DownloadSourceZipClient, m.DownloadSourceZipError
 }
 
+func (m *MockStorageClient) DeleteSourceZip(ctx context.Context, in *moduleServices.DeleteSourceZipRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
+	m.DeleteSourceZipInvocations++
+	return m.DeleteSourceZipResponse, m.DeleteSourceZipError
+}
+
+func (m *MockStorageClient) GetModuleDocs(ctx context.Context, in *moduleServices.GetModuleDocsRequest, opts ...grpc.CallOption) (*moduleServices.ModuleDocs, error) {
+	m.GetModuleDocsInvocations++
+	m.GetModuleDocsRequest = in
+	return m.GetModuleDocsResponse, m.GetModuleDocsError
+}
+
+func (m *MockStorageClient) ListSourceFiles(ctx context.Context, in *moduleServices.ListSourceFilesRequest, opts ...grpc.CallOption) (*moduleServices.SourceFiles, error) {
+	m.ListSourceFilesInvocations++
+	return m.ListSourceFilesResponse, m.ListSourceFilesError
+}
+
+func (m *MockStorageClient) GetSourceFile(ctx context.Context, in *moduleServices.GetSourceFileRequest, opts ...grpc.CallOption) (*moduleServices.SourceFile, error) {
+	m.GetSourceFileInvocations++
+	m.GetSourceFileRequest = in
+	return m.GetSourceFileResponse, m.GetSourceFileError
+}
+
 type MockStorage_UploadSourceZipClient struct {
 	moduleServices.Storage_UploadSourceZipClient
 	CloseAndRecvInvocations int
@@ -157,6 +375,38 @@ type MockDependencyManagerClient struct {
 	RetrieveModuleDependenciesInvocations    int
 	RetrieveModuleDependenciesClient         moduleServices.DependencyManager_RetrieveModuleDependenciesClient
 	RetrieveModuleDependenciesError          error
+	RetrieveDependentsInvocations            int
+	RetrieveDependentsRequest                *terrariumModule.RetrieveDependentsRequest
+	RetrieveDependentsClient                 moduleServices.DependencyManager_RetrieveDependentsClient
+	RetrieveDependentsError                  error
+	RegisterDetectedDependenciesInvocations  int
+	RegisterDetectedDependenciesRequest      *moduleServices.RegisterDetectedDependenciesRequest
+	RegisterDetectedDependenciesResponse     *terrariumModule.Response
+	RegisterDetectedDependenciesError        error
+	SearchContainerImagesInvocations         int
+	SearchContainerImagesRequest             *terrariumModule.SearchContainerImagesRequest
+	SearchContainerImagesResponse            *terrariumModule.SearchContainerImagesResponse
+	SearchContainerImagesError               error
+	ExportSBOMInvocations                    int
+	ExportSBOMRequest                        *terrariumModule.ExportSBOMRequest
+	ExportSBOMResponse                       *terrariumModule.SBOMResponse
+	ExportSBOMError                          error
+	RetrieveDependencyGraphInvocations       int
+	RetrieveDependencyGraphRequest           *terrariumModule.RetrieveDependencyGraphRequest
+	RetrieveDependencyGraphResponse          *terrariumModule.DependencyGraph
+	RetrieveDependencyGraphError             error
+	RetrieveProviderDependenciesInvocations  int
+	RetrieveProviderDependenciesRequest      *terrariumModule.RetrieveProviderDependenciesRequest
+	RetrieveProviderDependenciesResponse     *terrariumModule.ProviderDependenciesResponse
+	RetrieveProviderDependenciesError        error
+	RetrieveProviderDependentsInvocations    int
+	RetrieveProviderDependentsRequest        *moduleServices.RetrieveProviderDependentsRequest
+	RetrieveProviderDependentsResponse       *moduleServices.ProviderDependentsResponse
+	RetrieveProviderDependentsError          error
+	UnregisterDependenciesInvocations        int
+	UnregisterDependenciesRequest            *moduleServices.UnregisterDependenciesRequest
+	UnregisterDependenciesResponse           *terrariumModule.Response
+	UnregisterDependenciesError              error
 }
 
 func (m *MockDependencyManagerClient) RegisterModuleDependencies(ctx context.Context, in *terrariumModule.RegisterModuleDependenciesRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
@@ -178,6 +428,54 @@ func (m *MockDependencyManagerClient) RetrieveModuleDependencies(ctx context.Con
 	return m.RetrieveModuleDependenciesClient, m.RetrieveModuleDependenciesError
 }
 
+func (m *MockDependencyManagerClient) RetrieveDependents(ctx context.Context, in *terrariumModule.RetrieveDependentsRequest, opts ...grpc.CallOption) (moduleServices.DependencyManager_RetrieveDependentsClient, error) {
+	m.RetrieveDependentsInvocations++
+	m.RetrieveDependentsRequest = in
+	return m.RetrieveDependentsClient, m.RetrieveDependentsError
+}
+
+func (m *MockDependencyManagerClient) RegisterDetectedDependencies(ctx context.Context, in *moduleServices.RegisterDetectedDependenciesRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
+	m.RegisterDetectedDependenciesInvocations++
+	m.RegisterDetectedDependenciesRequest = in
+	return m.RegisterDetectedDependenciesResponse, m.RegisterDetectedDependenciesError
+}
+
+func (m *MockDependencyManagerClient) SearchContainerImages(ctx context.Context, in *terrariumModule.SearchContainerImagesRequest, opts ...grpc.CallOption) (*terrariumModule.SearchContainerImagesResponse, error) {
+	m.SearchContainerImagesInvocations++
+	m.SearchContainerImagesRequest = in
+	return m.SearchContainerImagesResponse, m.SearchContainerImagesError
+}
+
+func (m *MockDependencyManagerClient) ExportSBOM(ctx context.Context, in *terrariumModule.ExportSBOMRequest, opts ...grpc.CallOption) (*terrariumModule.SBOMResponse, error) {
+	m.ExportSBOMInvocations++
+	m.ExportSBOMRequest = in
+	return m.ExportSBOMResponse, m.ExportSBOMError
+}
+
+func (m *MockDependencyManagerClient) RetrieveDependencyGraph(ctx context.Context, in *terrariumModule.RetrieveDependencyGraphRequest, opts ...grpc.CallOption) (*terrariumModule.DependencyGraph, error) {
+	m.RetrieveDependencyGraphInvocations++
+	m.RetrieveDependencyGraphRequest = in
+	return m.RetrieveDependencyGraphResponse, m.RetrieveDependencyGraphError
+}
+
+func (m *MockDependencyManagerClient) RetrieveProviderDependencies(ctx context.Context, in *terrariumModule.RetrieveProviderDependenciesRequest, opts ...grpc.CallOption) (*terrariumModule.ProviderDependenciesResponse, error) {
+	m.RetrieveProviderDependenciesInvocations++
+	m.RetrieveProviderDependenciesRequest = in
+	return m.RetrieveProviderDependenciesResponse, m.RetrieveProviderDependenciesError
+}
+
+func (m *MockDependencyManagerClient) RetrieveProviderDependents(ctx context.Context, in *moduleServices.RetrieveProviderDependentsRequest, opts ...grpc.CallOption) (*moduleServices.ProviderDependentsResponse, error) {
+	m.RetrieveProviderDependentsInvocations++
+	m.RetrieveProviderDependentsRequest = in
+	return m.RetrieveProviderDependentsResponse, m.RetrieveProviderDependentsError
+}
+
+func (m *MockDependencyManagerClient) UnregisterDependencies(ctx context.Context, in *moduleServices.UnregisterDependenciesRequest, opts ...grpc.CallOption) (*terrariumModule.Response, error) {
+	m.UnregisterDependenciesInvocations++
+	m.UnregisterDependenciesRequest = in
+	return m.UnregisterDependenciesResponse, m.UnregisterDependenciesError
+}
+
 type MockDependencyManager_RetrieveContainerDependenciesClient struct {
 	moduleServices.DependencyManager_RetrieveContainerDependenciesClient
 	RecvInvocations      int
@@ -215,3 +513,26 @@ func (m *MockDependencyManager_RetrieveModuleDependenciesClient) CloseSend() err
 	m.CloseSendInvocations++
 	return m.CloseSendError
 }
+
+// MockDependencyManager_RetrieveDependentsClient returns the configured responses in order, followed by RecvError
+type MockDependencyManager_RetrieveDependentsClient struct {
+	moduleServices.DependencyManager_RetrieveDependentsClient
+	RecvInvocations      int
+	RecvResponses        []*terrariumModule.DependentsResponse
+	RecvError            error
+	CloseSendInvocations int
+	CloseSendError       error
+}
+
+func (m *MockDependencyManager_RetrieveDependentsClient) Recv() (*terrariumModule.DependentsResponse, error) {
+	m.RecvInvocations++
+	if len(m.RecvResponses) >= m.RecvInvocations {
+		return m.RecvResponses[m.RecvInvocations-1], nil
+	}
+	return nil, m.RecvError
+}
+
+func (m *MockDependencyManager_RetrieveDependentsClient) CloseSend() error {
+	m.CloseSendInvocations++
+	return m.CloseSendError
+}
@@ -93,3 +93,20 @@ func (m *MockConsumer_RetrieveModuleDependenciesServer) Send(res *module.ModuleD
 	m.SendResponse = res
 	return m.SendError
 }
+
+type MockConsumer_RetrieveDependentsServer struct {
+	module.Consumer_RetrieveDependentsServer
+	SendInvocations int
+	SendResponse    *module.DependentsResponse
+	SendError       error
+}
+
+func (m *MockConsumer_RetrieveDependentsServer) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *MockConsumer_RetrieveDependentsServer) Send(res *module.DependentsResponse) error {
+	m.SendInvocations++
+	m.SendResponse = res
+	return m.SendError
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
+	searchServices "github.com/terrariumcloud/terrarium/internal/search/services"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -16,6 +19,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
@@ -48,6 +52,8 @@ type RegistrarService struct {
 	Db     storage.DynamoDBTableCreator
 	Table  string
 	Schema *dynamodb.CreateTableInput
+	// Indexer, when set, is kept up to date with the modules registered
+	Indexer searchServices.IndexerClient
 }
 
 type Module struct {
@@ -98,6 +104,7 @@ func (s *RegistrarService) Register(ctx context.Context, request *terrarium.Regi
 		return nil, ModuleGetError
 	}
 
+	maturity := request.GetMaturity()
 	if res.Item == nil {
 		ms := Module{
 			Name:        request.GetName(),
@@ -127,10 +134,29 @@ func (s *RegistrarService) Register(ctx context.Context, request *terrarium.Regi
 			return nil, ModuleRegisterError
 		}
 	} else {
+		existing := Module{}
+		if err := attributevalue.UnmarshalMap(res.Item, &existing); err != nil {
+			span.RecordError(err)
+			log.Println(err)
+			return nil, MarshalModuleError
+		}
+
 		update := expression.Set(expression.Name("description"), expression.Value(request.GetDescription()))
 		update.Set(expression.Name("source"), expression.Value(request.GetSource()))
-		update.Set(expression.Name("maturity"), expression.Value(request.GetMaturity().String()))
 		update.Set(expression.Name("modified_on"), expression.Value(time.Now().UTC().String()))
+
+		currentMaturity := terrarium.Maturity(terrarium.Maturity_value[existing.Maturity])
+		if IsMaturityTransitionAllowed(currentMaturity, request.GetMaturity()) {
+			update.Set(expression.Name("maturity"), expression.Value(request.GetMaturity().String()))
+		} else {
+			maturity = currentMaturity
+			log.Printf("Keeping maturity %s of %s, transition to %s is not allowed.", currentMaturity, request.GetName(), request.GetMaturity())
+			span.AddEvent("maturity transition ignored", trace.WithAttributes(
+				attribute.String("module.maturity.current", currentMaturity.String()),
+				attribute.String("module.maturity.requested", request.GetMaturity().String()),
+			))
+		}
+
 		expr, err := expression.NewBuilder().WithUpdate(update).Build()
 
 		if err != nil {
@@ -157,10 +183,50 @@ func (s *RegistrarService) Register(ctx context.Context, request *terrarium.Regi
 		}
 	}
 
+	s.indexModule(ctx, request, maturity)
+
 	log.Println("New module registered.")
 	return ModuleRegistered, nil
 }
 
+// indexModule updates the search index with the description and maturity of a module.
+// Failures are recorded but do not fail the registration.
+func (s *RegistrarService) indexModule(ctx context.Context, request *terrarium.RegisterModuleRequest, maturity terrarium.Maturity) {
+	if s.Indexer == nil {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+
+	_, err := s.Indexer.IndexModule(ctx, &searchServices.IndexModuleRequest{
+		Name:        request.GetName(),
+		Description: proto.String(request.GetDescription()),
+		Maturity:    proto.String(maturity.String()),
+	})
+	if err != nil {
+		log.Printf("Failed to index %s: %v", request.GetName(), err)
+		span.RecordError(err)
+	}
+}
+
+// IsMaturityTransitionAllowed reports whether a module or module version can move from one maturity to another.
+// Maturity can always move forward through the lifecycle. Moving backwards is only allowed between the
+// pre-release stages and to reinstate a DEPRECATED module as STABLE; nothing leaves END_OF_LIFE.
+func IsMaturityTransitionAllowed(from, to terrarium.Maturity) bool {
+	switch {
+	case from == to:
+		return true
+	case from == terrarium.Maturity_END_OF_LIFE:
+		return false
+	case to > from:
+		return true
+	case from == terrarium.Maturity_DEPRECATED:
+		return to == terrarium.Maturity_STABLE
+	default:
+		return from < terrarium.Maturity_STABLE
+	}
+}
+
 func unmarshalModule(item map[string]types.AttributeValue) (*services.ModuleMetadata, error) {
 	module := Module{}
 	if err := attributevalue.UnmarshalMap(item, &module); err != nil {
@@ -209,7 +275,7 @@ func (s *RegistrarService) GetModule(ctx context.Context, request *services.GetM
 	}
 
 	if response.Items == nil || len(response.Items) < 1 {
-		return nil, fmt.Errorf("module not found '%v'", request.GetName())
+		return nil, status.Errorf(codes.NotFound, "module not found '%v'", request.GetName())
 	}
 	grpcResponse := services.GetModuleResponse{}
 	if moduleMetadata, err := unmarshalModule(response.Items[0]); err != nil {
@@ -222,32 +288,69 @@ func (s *RegistrarService) GetModule(ctx context.Context, request *services.GetM
 }
 
 // ListModules Retrieve all published modules
+// Modules are filtered by organization, provider, query and names when given. When a page is requested the modules are
+// sorted by name and the page is returned with the offset and cursor of the next one, which are empty on the last page.
 func (s *RegistrarService) ListModules(ctx context.Context, request *services.ListModulesRequest) (*services.ListModulesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.organization", request.GetOrganization()),
+		attribute.String("module.provider", request.GetProvider()),
+		attribute.String("module.query", request.GetQuery()),
+		attribute.String("module.page", request.GetPage().String()),
+	)
 
 	scanQueryInputs := &dynamodb.ScanInput{
 		TableName: aws.String(RegistrarTableName),
 	}
 
-	response, err := s.Db.Scan(ctx, scanQueryInputs)
+	items, err := storage.ScanAll(ctx, s.Db, scanQueryInputs)
 	if err != nil {
 		log.Printf("ScanInput failed: %v", err)
 		return nil, err
 	}
 
 	grpcResponse := services.ListModulesResponse{}
-	if response.Items != nil {
-		for _, item := range response.Items {
-			if moduleMetadata, err := unmarshalModule(item); err != nil {
-				return nil, err
-			} else {
-				grpcResponse.Modules = append(grpcResponse.Modules, moduleMetadata)
-			}
+	for _, item := range items {
+		if moduleMetadata, err := unmarshalModule(item); err != nil {
+			return nil, err
+		} else if moduleMatches(moduleMetadata, request) {
+			grpcResponse.Modules = append(grpcResponse.Modules, moduleMetadata)
 		}
 	}
 
+	grpcResponse.Modules, grpcResponse.Page, err = paging.Paginate(grpcResponse.Modules, moduleName, request.Page)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	return &grpcResponse, nil
 }
 
+// moduleMatches reports whether a module is in the organization and for the provider of a request, whether
+// its name or description contain the query, regardless of case, and whether it is one of the names requested.
+func moduleMatches(module *services.ModuleMetadata, request *services.ListModulesRequest) bool {
+	if len(request.GetNames()) > 0 && !slices.Contains(request.GetNames(), moduleName(module)) {
+		return false
+	}
+	if request.GetOrganization() != "" && !strings.EqualFold(module.GetOrganization(), request.GetOrganization()) {
+		return false
+	}
+	if request.GetProvider() != "" && !strings.EqualFold(module.GetProvider(), request.GetProvider()) {
+		return false
+	}
+	if request.GetQuery() == "" {
+		return true
+	}
+	query := strings.ToLower(request.GetQuery())
+	name := strings.ToLower(moduleName(module))
+	return strings.Contains(name, query) || strings.Contains(strings.ToLower(module.GetDescription()), query)
+}
+
+func moduleName(module *services.ModuleMetadata) string {
+	return fmt.Sprintf("%s/%s/%s", module.GetOrganization(), module.GetName(), module.GetProvider())
+}
+
 // GetModulesSchema returns CreateTableInput
 // that can be used to create table if it does not exist
 func GetModulesSchema(table string) *dynamodb.CreateTableInput {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
+	searchMocks "github.com/terrariumcloud/terrarium/internal/search/services/mocks"
 	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
 	"testing"
 
@@ -17,6 +19,8 @@ import (
 // Test_RegisterModule checks:
 // - if correct response is returned when module is registered
 // - if there was no error when version already exists
+// - if the indexer is given the description and maturity of the module
+// - if the module is registered when the indexer fails
 // - if error was returned when GetItem fails
 // - if error is returned when marshal fails
 // - if error is returned when PutItem fails
@@ -115,6 +119,54 @@ func Test_RegisterModule(t *testing.T) {
 		}
 	})
 
+	t.Run("when the indexer is set", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts: []*dynamodb.GetItemOutput{{}},
+		}
+		indexer := &searchMocks.MockIndexerClient{}
+
+		svc := &RegistrarService{Db: db, Indexer: indexer}
+
+		req := terrarium.RegisterModuleRequest{
+			Name:        "cie/vpc/aws",
+			Description: "test desc",
+			Maturity:    terrarium.Maturity_BETA,
+		}
+
+		_, err := svc.Register(context.TODO(), &req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if indexer.IndexModuleInvocations != 1 {
+			t.Errorf("Expected 1 call to IndexModule, got %d", indexer.IndexModuleInvocations)
+		}
+
+		if indexer.IndexModuleRequest.GetDescription() != "test desc" || indexer.IndexModuleRequest.GetMaturity() != "BETA" {
+			t.Errorf("Expected description and maturity to be indexed, got %v", indexer.IndexModuleRequest)
+		}
+	})
+
+	t.Run("when the indexer fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts: []*dynamodb.GetItemOutput{{}},
+		}
+		indexer := &searchMocks.MockIndexerClient{IndexModuleError: errors.New("some error")}
+
+		svc := &RegistrarService{Db: db, Indexer: indexer}
+
+		res, err := svc.Register(context.TODO(), &terrarium.RegisterModuleRequest{Name: "cie/vpc/aws"})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != ModuleRegistered {
+			t.Errorf("Expected %v, got %v.", ModuleRegistered, res)
+		}
+	})
+
 	t.Run("when GetItem fails", func(t *testing.T) {
 		db := &mocks.DynamoDB{
 			GetItemErrors: []error{errors.New("some error")},
@@ -241,3 +293,168 @@ func Test_RegisterRegistrarWithServer(t *testing.T) {
 		}
 	})
 }
+
+func Test_IsMaturityTransitionAllowed(t *testing.T) {
+	tests := []struct {
+		name string
+		from terrarium.Maturity
+		to   terrarium.Maturity
+		want bool
+	}{
+		{name: "same maturity", from: terrarium.Maturity_STABLE, to: terrarium.Maturity_STABLE, want: true},
+		{name: "forward", from: terrarium.Maturity_BETA, to: terrarium.Maturity_STABLE, want: true},
+		{name: "back within pre-release", from: terrarium.Maturity_BETA, to: terrarium.Maturity_ALPHA, want: true},
+		{name: "stable back to pre-release", from: terrarium.Maturity_STABLE, to: terrarium.Maturity_BETA, want: false},
+		{name: "deprecated reinstated as stable", from: terrarium.Maturity_DEPRECATED, to: terrarium.Maturity_STABLE, want: true},
+		{name: "deprecated back to pre-release", from: terrarium.Maturity_DEPRECATED, to: terrarium.Maturity_IDEA, want: false},
+		{name: "end of life to stable", from: terrarium.Maturity_END_OF_LIFE, to: terrarium.Maturity_STABLE, want: false},
+		{name: "end of life to deprecated", from: terrarium.Maturity_END_OF_LIFE, to: terrarium.Maturity_DEPRECATED, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsMaturityTransitionAllowed(tt.from, tt.to); got != tt.want {
+				t.Errorf("IsMaturityTransitionAllowed(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func makeModuleItem(name, description string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"name":        &types.AttributeValueMemberS{Value: name},
+		"description": &types.AttributeValueMemberS{Value: description},
+	}
+}
+
+// Test_ListModules checks:
+// - if all modules are returned when no page is requested
+// - if modules are filtered by organization, provider and query
+// - if modules are restricted to the names given
+// - if the requested page is returned sorted by name with the next offset
+// - if the page after a cursor is returned with modules of every scanned page
+// - if error is returned when the cursor is invalid
+// - if error is returned when Scan fails
+func Test_ListModules(t *testing.T) {
+	t.Parallel()
+
+	items := []map[string]types.AttributeValue{
+		makeModuleItem("cie/vpc/aws", "Network for workloads"),
+		makeModuleItem("cie/eks/aws", "Kubernetes cluster"),
+		makeModuleItem("cie/vnet/azurerm", "Virtual network"),
+		makeModuleItem("tools/dns/aws", "Hosted zones"),
+	}
+
+	t.Run("when no page is requested", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: &dynamodb.ScanOutput{Items: items}}
+		svc := &RegistrarService{Db: db}
+
+		res, err := svc.ListModules(context.TODO(), &services.ListModulesRequest{})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(res.Modules) != 4 || res.Page != nil {
+			t.Errorf("Expected 4 modules without page, got %v", res)
+		}
+	})
+
+	t.Run("when modules are filtered", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: &dynamodb.ScanOutput{Items: items}}
+		svc := &RegistrarService{Db: db}
+
+		res, err := svc.ListModules(context.TODO(), &services.ListModulesRequest{Organization: "CIE", Provider: "aws", Query: "network"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(res.Modules) != 1 || res.Modules[0].Name != "vpc" {
+			t.Errorf("Expected cie/vpc/aws, got %v", res.Modules)
+		}
+	})
+
+	t.Run("when names are given", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: &dynamodb.ScanOutput{Items: items}}
+		svc := &RegistrarService{Db: db}
+
+		res, err := svc.ListModules(context.TODO(), &services.ListModulesRequest{Names: []string{"cie/eks/aws", "tools/dns/aws", "cie/unknown/aws"}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(res.Modules) != 2 || res.Modules[0].Name != "eks" || res.Modules[1].Name != "dns" {
+			t.Errorf("Expected cie/eks/aws and tools/dns/aws, got %v", res.Modules)
+		}
+	})
+
+	t.Run("when a page is requested", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: &dynamodb.ScanOutput{Items: items}}
+		svc := &RegistrarService{Db: db}
+
+		res, err := svc.ListModules(context.TODO(), &services.ListModulesRequest{Page: &paging.PageInfoRequest{Offset: 1, Count: 2}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(res.Modules) != 2 || res.Modules[0].Name != "vnet" || res.Modules[1].Name != "vpc" {
+			t.Errorf("Expected cie/vnet/azurerm and cie/vpc/aws, got %v", res.Modules)
+		}
+
+		if res.Page.NextOffset != 3 || res.Page.TotalCount != 4 {
+			t.Errorf("Expected next offset 3 of 4, got %v", res.Page)
+		}
+
+		res, _ = svc.ListModules(context.TODO(), &services.ListModulesRequest{Page: &paging.PageInfoRequest{Offset: 3, Count: 2}})
+
+		if len(res.Modules) != 1 || res.Page.NextOffset != 0 {
+			t.Errorf("Expected last page with tools/dns/aws, got %v", res)
+		}
+	})
+
+	t.Run("when a page is requested with a cursor", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOuts: []*dynamodb.ScanOutput{
+			{Items: items[:2], LastEvaluatedKey: items[1]},
+			{Items: items[2:]},
+		}}
+		svc := &RegistrarService{Db: db}
+
+		res, err := svc.ListModules(context.TODO(), &services.ListModulesRequest{Page: &paging.PageInfoRequest{Count: 2, Cursor: paging.EncodeCursor("cie/vnet/azurerm")}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if db.ScanItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to Scan, got %d", db.ScanItemInvocations)
+		}
+
+		if len(res.Modules) != 2 || res.Modules[0].Name != "vpc" || res.Modules[1].Name != "dns" || res.Page.NextCursor != "" {
+			t.Errorf("Expected last page with cie/vpc/aws and tools/dns/aws, got %v", res)
+		}
+	})
+
+	t.Run("when the cursor is invalid", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: &dynamodb.ScanOutput{Items: items}}
+		svc := &RegistrarService{Db: db}
+
+		_, err := svc.ListModules(context.TODO(), &services.ListModulesRequest{Page: &paging.PageInfoRequest{Cursor: "not a cursor!"}})
+
+		if err != paging.InvalidCursorError {
+			t.Errorf("Expected %v, got %v", paging.InvalidCursorError, err)
+		}
+	})
+
+	t.Run("when Scan fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanError: errors.New("some error")}
+		svc := &RegistrarService{Db: db}
+
+		_, err := svc.ListModules(context.TODO(), &services.ListModulesRequest{})
+
+		if err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+}
@@ -46,6 +46,50 @@ func (s storageGrpcClient) DownloadSourceZip(ctx context.Context, in *module.Dow
 	}
 }
 
+func (s storageGrpcClient) DeleteSourceZip(ctx context.Context, in *services.DeleteSourceZipRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(s.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewStorageClient(conn)
+		return client.DeleteSourceZip(ctx, in, opts...)
+	}
+}
+
+func (s storageGrpcClient) GetModuleDocs(ctx context.Context, in *services.GetModuleDocsRequest, opts ...grpc.CallOption) (*services.ModuleDocs, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(s.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewStorageClient(conn)
+		return client.GetModuleDocs(ctx, in, opts...)
+	}
+}
+
+func (s storageGrpcClient) ListSourceFiles(ctx context.Context, in *services.ListSourceFilesRequest, opts ...grpc.CallOption) (*services.SourceFiles, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(s.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewStorageClient(conn)
+		return client.ListSourceFiles(ctx, in, opts...)
+	}
+}
+
+func (s storageGrpcClient) GetSourceFile(ctx context.Context, in *services.GetSourceFileRequest, opts ...grpc.CallOption) (*services.SourceFile, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(s.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewStorageClient(conn)
+		return client.GetSourceFile(ctx, in, opts...)
+	}
+}
+
 type uploadSourceZipClient struct {
 	conn   *grpc.ClientConn
 	client services.Storage_UploadSourceZipClient
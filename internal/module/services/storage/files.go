@@ -0,0 +1,287 @@
+package storage
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultMaxSourceFileSize int64 = 1024 * 1024 // 1 MB
+
+	// sniffSize is how much of a file is read to tell whether it is text or binary
+	sniffSize = 8000
+)
+
+var (
+	// MaxSourceFileSize is the size of the largest file whose content is returned by GetSourceFile
+	MaxSourceFileSize = DefaultMaxSourceFileSize
+
+	ListSourceFilesError    = status.Error(codes.Unknown, "Failed to list source files.")
+	GetSourceFileError      = status.Error(codes.Unknown, "Failed to get source file.")
+	ReadSourceFilesError    = status.Error(codes.Unknown, "Failed to read source files from source zip.")
+	SourceFileNotFoundError = status.Error(codes.NotFound, "Source file not found.")
+	SourceFileTooLargeError = status.Error(codes.FailedPrecondition, "Source file is too large to be displayed.")
+)
+
+// sourceFileEntry is a file of the index stored next to the archive of a module version. Its offset, compressed
+// size and method allow the content of the file to be read from the archive with a ranged read.
+type sourceFileEntry struct {
+	Path           string `json:"path"`
+	Size           uint64 `json:"size"`
+	Binary         bool   `json:"binary"`
+	Offset         int64  `json:"offset"`
+	CompressedSize uint64 `json:"compressed_size"`
+	Method         uint16 `json:"method"`
+}
+
+// filesFilename returns the key of the file index stored next to the archive of a module version.
+func filesFilename(module *terrarium.Module) string {
+	return fmt.Sprintf("%s/%s.files.json", module.GetName(), module.GetVersion())
+}
+
+// storeSourceFiles indexes the files of the archive of a module version and stores the index next to the archive.
+// Failures to store the index are recorded, the index being read again from the archive when requested.
+func (s *StorageService) storeSourceFiles(ctx context.Context, module *terrarium.Module, reader *zip.Reader) ([]*sourceFileEntry, error) {
+	span := trace.SpanFromContext(ctx)
+
+	entries, err := indexSourceFiles(reader)
+	if err != nil {
+		log.Printf("Failed to index the files of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+		span.RecordError(err)
+		return nil, ReadSourceFilesError
+	}
+
+	data, err := json.Marshal(entries)
+	if err != nil {
+		log.Printf("Failed to marshal the files of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+		span.RecordError(err)
+		return entries, nil
+	}
+
+	if _, err := s.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(BucketName),
+		Key:    aws.String(filesFilename(module)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		log.Printf("Failed to store the files of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+		span.RecordError(err)
+	}
+	return entries, nil
+}
+
+// getSourceFiles returns the file index of a module version. Versions uploaded before their index was stored have
+// it read from their archive.
+func (s *StorageService) getSourceFiles(ctx context.Context, module *terrarium.Module) ([]*sourceFileEntry, error) {
+	out, err := s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(BucketName),
+		Key:    aws.String(filesFilename(module)),
+	})
+	if err == nil {
+		defer func() { _ = out.Body.Close() }()
+		var entries []*sourceFileEntry
+		if err := json.NewDecoder(out.Body).Decode(&entries); err != nil {
+			log.Println(err)
+			return nil, ListSourceFilesError
+		}
+		return entries, nil
+	}
+
+	var noSuchKey *types.NoSuchKey
+	if !errors.As(err, &noSuchKey) {
+		log.Println(err)
+		return nil, ListSourceFilesError
+	}
+
+	archive, err := s.getSourceZip(ctx, module)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		log.Printf("Failed to index the files of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+		return nil, ReadSourceFilesError
+	}
+	return s.storeSourceFiles(ctx, module, reader)
+}
+
+// ListSourceFiles returns the files of the archive of a module version, sorted by path.
+func (s *StorageService) ListSourceFiles(ctx context.Context, request *services.ListSourceFilesRequest) (*services.SourceFiles, error) {
+	log.Println("Listing source files.")
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+	)
+
+	entries, err := s.getSourceFiles(ctx, request.GetModule())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	files := &services.SourceFiles{Files: make([]*services.SourceFile, 0, len(entries))}
+	for _, entry := range entries {
+		files.Files = append(files.Files, &services.SourceFile{Path: entry.Path, Size: entry.Size, Binary: entry.Binary})
+	}
+	return files, nil
+}
+
+// GetSourceFile returns a file of the archive of a module version, read with a ranged read of the archive. The content
+// of binary files is not returned and files larger than MaxSourceFileSize are refused.
+func (s *StorageService) GetSourceFile(ctx context.Context, request *services.GetSourceFileRequest) (*services.SourceFile, error) {
+	log.Println("Getting source file.")
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.String("file.path", request.GetPath()),
+	)
+
+	entries, err := s.getSourceFiles(ctx, request.GetModule())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	name := path.Clean(strings.TrimPrefix(request.GetPath(), "/"))
+	index := sort.Search(len(entries), func(i int) bool { return entries[i].Path >= name })
+	if index == len(entries) || entries[index].Path != name {
+		span.RecordError(SourceFileNotFoundError)
+		return nil, SourceFileNotFoundError
+	}
+	entry := entries[index]
+
+	file := &services.SourceFile{Path: entry.Path, Size: entry.Size, Binary: entry.Binary}
+	if entry.Binary || entry.Size == 0 {
+		return file, nil
+	}
+	if entry.Size > uint64(MaxSourceFileSize) {
+		span.RecordError(SourceFileTooLargeError)
+		return nil, SourceFileTooLargeError
+	}
+
+	file.Content, err = s.readSourceFile(ctx, request.GetModule(), entry)
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, GetSourceFileError
+	}
+	return file, nil
+}
+
+// readSourceFile reads the content of a file from the archive of a module version, fetching only its bytes.
+func (s *StorageService) readSourceFile(ctx context.Context, module *terrarium.Module, entry *sourceFileEntry) ([]byte, error) {
+	out, err := s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(BucketName),
+		Key:    aws.String(fmt.Sprintf("%s/%s.zip", module.GetName(), module.GetVersion())),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", entry.Offset, entry.Offset+int64(entry.CompressedSize)-1)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = out.Body.Close() }()
+
+	var reader io.Reader = io.LimitReader(out.Body, int64(entry.CompressedSize))
+	switch entry.Method {
+	case zip.Store:
+	case zip.Deflate:
+		decompressor := flate.NewReader(reader)
+		defer func() { _ = decompressor.Close() }()
+		reader = decompressor
+	default:
+		return nil, fmt.Errorf("unsupported compression method %d of %s", entry.Method, entry.Path)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(reader, int64(entry.Size)))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(content)) != entry.Size {
+		return nil, fmt.Errorf("read %d bytes of %s, expected %d", len(content), entry.Path, entry.Size)
+	}
+	return content, nil
+}
+
+// indexSourceFiles lists the files of an archive, sorted by path, telling text files from binary ones.
+func indexSourceFiles(reader *zip.Reader) ([]*sourceFileEntry, error) {
+	entries := make([]*sourceFileEntry, 0, len(reader.File))
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		offset, err := file.DataOffset()
+		if err != nil {
+			return nil, err
+		}
+		binary, err := isBinaryFile(file)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &sourceFileEntry{
+			Path:           path.Clean(file.Name),
+			Size:           file.UncompressedSize64,
+			Binary:         binary,
+			Offset:         offset,
+			CompressedSize: file.CompressedSize64,
+			Method:         file.Method,
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	return entries, nil
+}
+
+func isBinaryFile(file *zip.File) (bool, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	head, err := io.ReadAll(io.LimitReader(rc, sniffSize))
+	if err != nil {
+		return false, err
+	}
+	return isBinary(head, uint64(len(head)) < file.UncompressedSize64), nil
+}
+
+// isBinary reports whether the start of a file holds a NUL byte or is not UTF-8. A character cut at the end of a
+// truncated start is not held against the file.
+func isBinary(head []byte, truncated bool) bool {
+	if bytes.IndexByte(head, 0) >= 0 {
+		return true
+	}
+	if truncated {
+		for i := len(head) - 1; i >= 0 && i >= len(head)-utf8.UTFMax; i-- {
+			if utf8.RuneStart(head[i]) {
+				if !utf8.FullRune(head[i:]) {
+					head = head[:i]
+				}
+				break
+			}
+		}
+	}
+	return !utf8.Valid(head)
+}
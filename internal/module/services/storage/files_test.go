@@ -0,0 +1,245 @@
+package storage
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	mocks2 "github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// sourceArchive returns an archive holding a compressed Terraform file, a stored binary file and a submodule.
+func sourceArchive(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	files := []struct {
+		name    string
+		method  uint16
+		content []byte
+	}{
+		{"main.tf", zip.Deflate, []byte(strings.Repeat("resource \"null_resource\" \"this\" {}\n", 10))},
+		{"logo.png", zip.Store, []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a, 0x00}},
+		{"modules/subnets/", zip.Store, nil},
+		{"modules/subnets/main.tf", zip.Deflate, []byte("variable \"cidr\" {}\n")},
+	}
+	for _, file := range files {
+		w, err := writer.CreateHeader(&zip.FileHeader{Name: file.name, Method: file.method})
+		if err != nil {
+			t.Fatalf("Failed to create %s: %v", file.name, err)
+		}
+		if _, err := w.Write(file.content); err != nil {
+			t.Fatalf("Failed to write %s: %v", file.name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func objectOf(data []byte) *s3.GetObjectOutput {
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}
+}
+
+// Test_ListSourceFiles checks:
+// - if the stored file index is returned
+// - if the files are indexed from the archive and the index stored when it is missing
+// - if NotFound is returned when the archive is missing too
+// - if error is returned when GetObject fails
+func Test_ListSourceFiles(t *testing.T) {
+	t.Parallel()
+
+	req := &services.ListSourceFilesRequest{Module: &terrarium.Module{Name: "cie/vpc/aws", Version: "1.0.0"}}
+	want := []*services.SourceFile{
+		{Path: "logo.png", Size: 9, Binary: true},
+		{Path: "main.tf", Size: 350},
+		{Path: "modules/subnets/main.tf", Size: 19},
+	}
+
+	t.Run("when the file index is stored", func(t *testing.T) {
+		index, _ := json.Marshal([]*sourceFileEntry{{Path: "main.tf", Size: 12, Offset: 35, CompressedSize: 12}})
+		s3Client := &mocks2.S3{GetObjectOut: objectOf(index)}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.ListSourceFiles(context.TODO(), req)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.Filename != "cie/vpc/aws/1.0.0.files.json" {
+			t.Errorf("Expected the file index to be read, got %v", s3Client.Filename)
+		}
+
+		if !reflect.DeepEqual(res.GetFiles(), []*services.SourceFile{{Path: "main.tf", Size: 12}}) {
+			t.Errorf("Expected the stored files, got %v", res.GetFiles())
+		}
+	})
+
+	t.Run("when the file index is missing", func(t *testing.T) {
+		s3Client := &mocks2.S3{
+			GetObjectOuts:   []*s3.GetObjectOutput{nil, objectOf(sourceArchive(t))},
+			GetObjectErrors: []error{&types.NoSuchKey{}},
+		}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.ListSourceFiles(context.TODO(), req)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.PutObjectInvocations != 1 || s3Client.Filename != "cie/vpc/aws/1.0.0.files.json" {
+			t.Errorf("Expected the file index to be stored, got %v calls to PutObject for %v", s3Client.PutObjectInvocations, s3Client.Filename)
+		}
+
+		if !reflect.DeepEqual(res.GetFiles(), want) {
+			t.Errorf("Expected %v, got %v", want, res.GetFiles())
+		}
+	})
+
+	t.Run("when the archive is missing", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectError: &types.NoSuchKey{}}
+
+		svc := &StorageService{Client: s3Client}
+
+		_, err := svc.ListSourceFiles(context.TODO(), req)
+
+		if err != SourceZipNotFoundError {
+			t.Errorf("Expected %v, got %v.", SourceZipNotFoundError, err)
+		}
+	})
+
+	t.Run("when GetObject fails", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectError: errors.New("some error")}
+
+		svc := &StorageService{Client: s3Client}
+
+		_, err := svc.ListSourceFiles(context.TODO(), req)
+
+		if err != ListSourceFilesError {
+			t.Errorf("Expected %v, got %v.", ListSourceFilesError, err)
+		}
+	})
+}
+
+// Test_GetSourceFile checks:
+// - if the content of a compressed text file is read with a ranged read of the archive
+// - if the content of a binary file is not returned
+// - if NotFound is returned for a path missing from the archive
+// - if error is returned for a file larger than MaxSourceFileSize
+func Test_GetSourceFile(t *testing.T) {
+	t.Parallel()
+
+	module := &terrarium.Module{Name: "cie/vpc/aws", Version: "1.0.0"}
+	archive := sourceArchive(t)
+	reader, _ := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	entries, err := indexSourceFiles(reader)
+	if err != nil {
+		t.Fatalf("Failed to index archive: %v", err)
+	}
+	index, _ := json.Marshal(entries)
+
+	t.Run("when the file is text", func(t *testing.T) {
+		entry := entries[2]
+		data := archive[entry.Offset : entry.Offset+int64(entry.CompressedSize)]
+		s3Client := &mocks2.S3{GetObjectOuts: []*s3.GetObjectOutput{objectOf(index), objectOf(data)}}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.GetSourceFile(context.TODO(), &services.GetSourceFileRequest{Module: module, Path: "/modules/subnets/main.tf"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.Filename != "cie/vpc/aws/1.0.0.zip" || len(s3Client.Ranges) != 1 {
+			t.Errorf("Expected a ranged read of the archive, got %v for %v", s3Client.Ranges, s3Client.Filename)
+		}
+
+		if string(res.GetContent()) != "variable \"cidr\" {}\n" || res.GetBinary() {
+			t.Errorf("Expected the content of the file, got %v", res)
+		}
+	})
+
+	t.Run("when the file is binary", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectOut: objectOf(index)}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.GetSourceFile(context.TODO(), &services.GetSourceFileRequest{Module: module, Path: "logo.png"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.GetObjectInvocations != 1 {
+			t.Errorf("Expected 1 call to GetObject, got %v", s3Client.GetObjectInvocations)
+		}
+
+		if !res.GetBinary() || res.GetContent() != nil {
+			t.Errorf("Expected a binary file without content, got %v", res)
+		}
+	})
+
+	t.Run("when the file is missing", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectOut: objectOf(index)}
+
+		svc := &StorageService{Client: s3Client}
+
+		_, err := svc.GetSourceFile(context.TODO(), &services.GetSourceFileRequest{Module: module, Path: "modules/subnets"})
+
+		if err != SourceFileNotFoundError {
+			t.Errorf("Expected %v, got %v.", SourceFileNotFoundError, err)
+		}
+	})
+
+	t.Run("when the file is too large", func(t *testing.T) {
+		large, _ := json.Marshal([]*sourceFileEntry{{Path: "main.tf", Size: uint64(MaxSourceFileSize) + 1}})
+		s3Client := &mocks2.S3{GetObjectOut: objectOf(large)}
+
+		svc := &StorageService{Client: s3Client}
+
+		_, err := svc.GetSourceFile(context.TODO(), &services.GetSourceFileRequest{Module: module, Path: "main.tf"})
+
+		if err != SourceFileTooLargeError {
+			t.Errorf("Expected %v, got %v.", SourceFileTooLargeError, err)
+		}
+	})
+}
+
+func Test_isBinary(t *testing.T) {
+	tests := []struct {
+		name      string
+		head      []byte
+		truncated bool
+		want      bool
+	}{
+		{"text", []byte("variable \"name\" {}\n"), false, false},
+		{"NUL byte", []byte("ab\x00cd"), false, true},
+		{"invalid UTF-8", []byte{'a', 0xff, 'b'}, false, true},
+		{"character cut by truncation", []byte("caf\xc3"), true, false},
+		{"character cut at the end of the file", []byte("caf\xc3"), false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isBinary(tt.head, tt.truncated); got != tt.want {
+				t.Errorf("isBinary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package storage
 
 import (
+	"archive/zip"
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"github.com/terrariumcloud/terrarium/internal/common/tfconfig"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -14,10 +18,12 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const (
@@ -30,8 +36,11 @@ var (
 	BucketName             = DefaultBucketName
 	StorageServiceEndpoint = DefaultStorageServiceDefaultEndpoint
 	ChunkSize              = DefaultChunkSize
+	// RegistryHostname is the host modules of this registry are sourced from, such as "terrarium.example.com"
+	RegistryHostname = ""
 
 	SourceZipUploaded = &terrarium.Response{Message: "Source zip uploaded successfully."}
+	SourceZipDeleted  = &terrarium.Response{Message: "Source zip deleted successfully."}
 
 	BucketInitializationError = status.Error(codes.Unknown, "Failed to initialize bucket for storage.")
 	UploadSourceZipError      = status.Error(codes.Unknown, "Failed to upload source zip.")
@@ -39,6 +48,10 @@ var (
 	DownloadSourceZipError    = status.Error(codes.Unknown, "Failed to download source zip.")
 	SendSourceZipError        = status.Error(codes.Unknown, "Failed to send source zip.")
 	ContentLenghtError        = status.Error(codes.Unknown, "Failed to read correct content lenght.")
+	DeleteSourceZipError      = status.Error(codes.Unknown, "Failed to delete source zip.")
+	GetModuleDocsError        = status.Error(codes.Unknown, "Failed to get module docs.")
+	SourceZipNotFoundError    = status.Error(codes.NotFound, "Source zip not found.")
+	ReadModuleDocsError       = status.Error(codes.Unknown, "Failed to read module docs from source zip.")
 )
 
 type StorageService struct {
@@ -46,6 +59,8 @@ type StorageService struct {
 	Client     storage.AWSS3BucketClient
 	BucketName string
 	Region     string
+	// DependencyManager, when set, is given the dependencies found in the Terraform files of uploaded archives
+	DependencyManager services.DependencyManagerClient
 }
 
 // Registers StorageService with grpc server
@@ -65,6 +80,7 @@ func (s *StorageService) UploadSourceZip(server services.Storage_UploadSourceZip
 	log.Println("Uploading source zip.")
 	zip := []byte{}
 	var filename string
+	var module *terrarium.Module
 	ctx := server.Context()
 	span := trace.SpanFromContext(ctx)
 	for {
@@ -76,6 +92,7 @@ func (s *StorageService) UploadSourceZip(server services.Storage_UploadSourceZip
 
 		if filename == "" && req != nil {
 			filename = fmt.Sprintf("%s/%s.zip", req.Module.GetName(), req.Module.GetVersion())
+			module = req.GetModule()
 		}
 
 		if err == io.EOF {
@@ -94,6 +111,9 @@ func (s *StorageService) UploadSourceZip(server services.Storage_UploadSourceZip
 			}
 
 			log.Println("Source zip uploaded successfully.")
+			if module != nil {
+				s.readUploadedZip(ctx, module, zip)
+			}
 			return server.SendAndClose(SourceZipUploaded)
 		}
 
@@ -107,6 +127,223 @@ func (s *StorageService) UploadSourceZip(server services.Storage_UploadSourceZip
 	}
 }
 
+// readUploadedZip reads an uploaded archive once to register the dependencies found in its Terraform files, and to
+// store its documentation and file index. Failures do not fail the upload.
+func (s *StorageService) readUploadedZip(ctx context.Context, module *terrarium.Module, data []byte) {
+	reader, archive, err := readSourceZip(data)
+	if err != nil {
+		log.Printf("Failed to read Terraform files of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+		trace.SpanFromContext(ctx).RecordError(err)
+		return
+	}
+	s.registerDetectedDependencies(ctx, module, archive.Module())
+	_, _ = s.storeModuleDocs(ctx, module, archive)
+	_, _ = s.storeSourceFiles(ctx, module, reader)
+}
+
+// readSourceZip opens the archive of a module version and reads its Terraform files.
+func readSourceZip(data []byte) (*zip.Reader, *tfconfig.Archive, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, err
+	}
+	archive, err := tfconfig.ReadZip(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reader, archive, nil
+}
+
+// registerDetectedDependencies extracts the modules of this registry and the providers required by the Terraform files
+// of an uploaded archive and registers them with the dependency manager. Failures do not fail the upload.
+func (s *StorageService) registerDetectedDependencies(ctx context.Context, module *terrarium.Module, config *tfconfig.Module) {
+	if s.DependencyManager == nil {
+		return
+	}
+	span := trace.SpanFromContext(ctx)
+
+	for _, err := range config.Errors {
+		log.Printf("Skipped Terraform file of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+	}
+
+	request := &services.RegisterDetectedDependenciesRequest{Module: module}
+	for _, call := range config.ModuleCalls {
+		if name, ok := call.RegistryModule(RegistryHostname); ok {
+			request.Modules = append(request.Modules, &terrarium.ModuleRequirement{Name: name, VersionConstraint: call.Version})
+		}
+	}
+	for _, provider := range config.RequiredProviders {
+		request.Providers = append(request.Providers, &terrarium.ProviderRequirement{
+			Name:               provider.Name,
+			Source:             provider.Source,
+			VersionConstraints: provider.VersionConstraints,
+		})
+	}
+
+	if _, err := s.DependencyManager.RegisterDetectedDependencies(ctx, request); err != nil {
+		log.Printf("Failed to register detected dependencies of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+		span.RecordError(err)
+		return
+	}
+	span.AddEvent("Detected dependencies registered", trace.WithAttributes(
+		attribute.Int("detected.modules", len(request.Modules)),
+		attribute.Int("detected.providers", len(request.Providers)),
+	))
+}
+
+// docsFilename returns the key of the documentation stored next to the archive of a module version.
+func docsFilename(module *terrarium.Module) string {
+	return fmt.Sprintf("%s/%s.docs.json", module.GetName(), module.GetVersion())
+}
+
+// storeModuleDocs reads the documentation of a module version from its archive and stores it next to the archive.
+// Failures to store the documentation are recorded, the documentation being read again from the archive when
+// requested.
+func (s *StorageService) storeModuleDocs(ctx context.Context, module *terrarium.Module, archive *tfconfig.Archive) (*services.ModuleDocs, error) {
+	span := trace.SpanFromContext(ctx)
+
+	config, err := archive.Docs()
+	if err != nil {
+		log.Printf("Failed to read the docs of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+		span.RecordError(err)
+		return nil, ReadModuleDocsError
+	}
+	docs := moduleDocs(config)
+
+	data, err := protojson.Marshal(docs)
+	if err != nil {
+		log.Printf("Failed to marshal the docs of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+		span.RecordError(err)
+		return docs, nil
+	}
+
+	if _, err := s.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(BucketName),
+		Key:    aws.String(docsFilename(module)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		log.Printf("Failed to store the docs of %s/%s: %v", module.GetName(), module.GetVersion(), err)
+		span.RecordError(err)
+	}
+	return docs, nil
+}
+
+// GetModuleDocs returns the documentation of a module version. Versions uploaded before their documentation was
+// stored have it read from their archive.
+func (s *StorageService) GetModuleDocs(ctx context.Context, request *services.GetModuleDocsRequest) (*services.ModuleDocs, error) {
+	log.Println("Getting module docs.")
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+	)
+
+	out, err := s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(BucketName),
+		Key:    aws.String(docsFilename(request.GetModule())),
+	})
+	if err == nil {
+		defer func() { _ = out.Body.Close() }()
+		data, err := io.ReadAll(out.Body)
+		if err != nil {
+			span.RecordError(err)
+			log.Println(err)
+			return nil, GetModuleDocsError
+		}
+
+		docs := &services.ModuleDocs{}
+		if err := protojson.Unmarshal(data, docs); err != nil {
+			span.RecordError(err)
+			log.Println(err)
+			return nil, GetModuleDocsError
+		}
+		return docs, nil
+	}
+
+	var noSuchKey *types.NoSuchKey
+	if !errors.As(err, &noSuchKey) {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, GetModuleDocsError
+	}
+
+	zip, err := s.getSourceZip(ctx, request.GetModule())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	_, archive, err := readSourceZip(zip)
+	if err != nil {
+		log.Printf("Failed to read the docs of %s: %v", request.GetModule().GetName(), err)
+		span.RecordError(err)
+		return nil, ReadModuleDocsError
+	}
+	return s.storeModuleDocs(ctx, request.GetModule(), archive)
+}
+
+// getSourceZip returns the archive of a module version, which is read at once to extract what was not stored on upload.
+func (s *StorageService) getSourceZip(ctx context.Context, module *terrarium.Module) ([]byte, error) {
+	out, err := s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(BucketName),
+		Key:    aws.String(fmt.Sprintf("%s/%s.zip", module.GetName(), module.GetVersion())),
+	})
+	if err != nil {
+		log.Println(err)
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, SourceZipNotFoundError
+		}
+		return nil, DownloadSourceZipError
+	}
+	defer func() { _ = out.Body.Close() }()
+
+	zip, err := io.ReadAll(out.Body)
+	if err != nil {
+		log.Println(err)
+		return nil, DownloadSourceZipError
+	}
+	return zip, nil
+}
+
+// moduleDocs converts the documentation read from Terraform files.
+func moduleDocs(config *tfconfig.Docs) *services.ModuleDocs {
+	docs := &services.ModuleDocs{Path: config.Path, Readme: config.Readme}
+	for _, variable := range config.Variables {
+		docs.Inputs = append(docs.Inputs, &services.ModuleInput{
+			Name:        variable.Name,
+			Type:        variable.Type,
+			Default:     variable.Default,
+			Description: variable.Description,
+			Sensitive:   variable.Sensitive,
+			Required:    variable.Required,
+		})
+	}
+	for _, output := range config.Outputs {
+		docs.Outputs = append(docs.Outputs, &services.ModuleOutput{Name: output.Name, Description: output.Description, Sensitive: output.Sensitive})
+	}
+	for _, provider := range config.RequiredProviders {
+		docs.RequiredProviders = append(docs.RequiredProviders, &terrarium.ProviderRequirement{
+			Name:               provider.Name,
+			Source:             provider.Source,
+			VersionConstraints: provider.VersionConstraints,
+		})
+	}
+	for _, resource := range config.Resources {
+		docs.Resources = append(docs.Resources, &services.ModuleResource{Mode: resource.Mode, Type: resource.Type, Name: resource.Name})
+	}
+	for _, call := range config.ModuleCalls {
+		docs.ModuleCalls = append(docs.ModuleCalls, &services.ModuleCall{Name: call.Name, Source: call.Source, Version: call.Version})
+	}
+	for _, submodule := range config.Submodules {
+		docs.Submodules = append(docs.Submodules, moduleDocs(submodule))
+	}
+	for _, example := range config.Examples {
+		docs.Examples = append(docs.Examples, moduleDocs(example))
+	}
+	return docs
+}
+
 // Download Source Zip from storage
 func (s *StorageService) DownloadSourceZip(request *terrarium.DownloadSourceZipRequest, server services.Storage_DownloadSourceZipServer) error {
 	log.Println("Downloading source zip.")
@@ -127,6 +364,10 @@ func (s *StorageService) DownloadSourceZip(request *terrarium.DownloadSourceZipR
 	if err != nil {
 		span.RecordError(err)
 		log.Println(err)
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return SourceZipNotFoundError
+		}
 		return DownloadSourceZipError
 	}
 
@@ -163,3 +404,32 @@ func (s *StorageService) DownloadSourceZip(request *terrarium.DownloadSourceZipR
 		return ContentLenghtError
 	}
 }
+
+// Delete Source Zip and the docs and file index read from it from storage
+func (s *StorageService) DeleteSourceZip(ctx context.Context, request *services.DeleteSourceZipRequest) (*terrarium.Response, error) {
+	log.Println("Deleting source zip.")
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+	)
+	filename := fmt.Sprintf("%s/%s.zip", request.GetModule().GetName(), request.GetModule().GetVersion())
+
+	// the docs and the file index go first so that they can always be read again from the archive
+	keys := []string{docsFilename(request.GetModule()), filesFilename(request.GetModule()), filename}
+	for _, key := range keys {
+		in := &s3.DeleteObjectInput{
+			Bucket: aws.String(BucketName),
+			Key:    aws.String(key),
+		}
+
+		if _, err := s.Client.DeleteObject(ctx, in); err != nil {
+			span.RecordError(err)
+			log.Println(err)
+			return nil, DeleteSourceZipError
+		}
+	}
+
+	log.Println("Source zip deleted.")
+	return SourceZipDeleted, nil
+}
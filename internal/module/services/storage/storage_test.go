@@ -1,15 +1,22 @@
 package storage
 
 import (
+	"archive/zip"
 	"bytes"
+	"context"
 	"errors"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
 	"github.com/terrariumcloud/terrarium/internal/module/services/mocks"
 	mocks2 "github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	"io"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type ClosingBuffer struct {
@@ -20,6 +27,23 @@ func (cb *ClosingBuffer) Close() error {
 	return nil
 }
 
+func zipOf(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	w, err := writer.Create(name)
+	if err != nil {
+		t.Fatalf("Failed to create %s: %v", name, err)
+	}
+	if _, err := w.Write([]byte(content)); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
 // Test_RegisterStorageWithServer checks:
 // - if there was no error with bucket init
 // - if error was returned when bucket init fails
@@ -76,6 +100,9 @@ func Test_RegisterStorageWithServer(t *testing.T) {
 
 // Test_UploadSourceZip checks:
 // - if correct response is returned when source zip is uploaded
+// - if the modules of this registry and the providers used by the archive are registered as detected dependencies
+// - if the upload succeeds when detected dependencies cannot be registered
+// - if the docs and the file index read from the archive are stored next to it
 // - if error is returned when PutObject fails
 // - if error is returned when Recv fails
 func Test_UploadSourceZip(t *testing.T) {
@@ -116,6 +143,108 @@ func Test_UploadSourceZip(t *testing.T) {
 		}
 	})
 
+	t.Run("when the archive holds Terraform files", func(t *testing.T) {
+		s3Client := &mocks2.S3{}
+
+		svc := &StorageService{Client: s3Client}
+
+		req := &terrarium.UploadSourceZipRequest{
+			Module:       &terrarium.Module{Name: "cie/vpc/aws", Version: "1.0.0"},
+			ZipDataChunk: zipOf(t, "variables.tf", "variable \"name\" {}\n"),
+		}
+
+		mus := &mocks.MockUploadSourceZipServer{RecvRequest: req, RecvMaxInvocations: 2}
+
+		err := svc.UploadSourceZip(mus)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.PutObjectInvocations != 3 {
+			t.Errorf("Expected 3 calls to PutObject, got %v", s3Client.PutObjectInvocations)
+		}
+
+		if s3Client.Filename != "cie/vpc/aws/1.0.0.files.json" {
+			t.Errorf("Expected the docs and the file index to be stored, got %v", s3Client.Filename)
+		}
+	})
+
+	t.Run("when the archive uses modules of this registry", func(t *testing.T) {
+		RegistryHostname = "terrarium.example.com"
+		defer func() { RegistryHostname = "" }()
+
+		dependencyManager := &mocks.MockDependencyManagerClient{}
+		svc := &StorageService{Client: &mocks2.S3{}, DependencyManager: dependencyManager}
+
+		req := &terrarium.UploadSourceZipRequest{
+			Module: &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"},
+			ZipDataChunk: zipOf(t, "main.tf", `
+terraform {
+  required_providers {
+    aws = { source = "hashicorp/aws", version = "~> 5.0" }
+  }
+}
+
+module "vpc" {
+  source  = "terrarium.example.com/cie/vpc/aws"
+  version = "~> 1.2"
+}
+
+module "consul" {
+  source = "hashicorp/consul/aws"
+}
+`),
+		}
+
+		mus := &mocks.MockUploadSourceZipServer{RecvRequest: req, RecvMaxInvocations: 2}
+
+		err := svc.UploadSourceZip(mus)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if dependencyManager.RegisterDetectedDependenciesInvocations != 1 {
+			t.Fatalf("Expected 1 call to RegisterDetectedDependencies, got %v", dependencyManager.RegisterDetectedDependenciesInvocations)
+		}
+
+		detected := dependencyManager.RegisterDetectedDependenciesRequest
+		if detected.GetModule() != req.Module {
+			t.Errorf("Expected dependencies of %v, got %v.", req.Module, detected.GetModule())
+		}
+
+		if len(detected.GetModules()) != 1 || detected.GetModules()[0].GetName() != "cie/vpc/aws" || detected.GetModules()[0].GetVersionConstraint() != "~> 1.2" {
+			t.Errorf("Expected cie/vpc/aws ~> 1.2, got %v.", detected.GetModules())
+		}
+
+		if len(detected.GetProviders()) != 1 || detected.GetProviders()[0].GetSource() != "hashicorp/aws" {
+			t.Errorf("Expected hashicorp/aws, got %v.", detected.GetProviders())
+		}
+	})
+
+	t.Run("when detected dependencies cannot be registered", func(t *testing.T) {
+		dependencyManager := &mocks.MockDependencyManagerClient{RegisterDetectedDependenciesError: errors.New("some error")}
+		svc := &StorageService{Client: &mocks2.S3{}, DependencyManager: dependencyManager}
+
+		req := &terrarium.UploadSourceZipRequest{
+			Module:       &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"},
+			ZipDataChunk: zipOf(t, "main.tf", "module \"vpc\" {\n  source = \"./modules/vpc\"\n}\n"),
+		}
+
+		mus := &mocks.MockUploadSourceZipServer{RecvRequest: req, RecvMaxInvocations: 2}
+
+		err := svc.UploadSourceZip(mus)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if mus.SendAndCloseResponse != SourceZipUploaded {
+			t.Errorf("Expected %v, got %v.", SourceZipUploaded, mus.SendAndCloseResponse)
+		}
+	})
+
 	t.Run("when PutObject fails", func(t *testing.T) {
 		s3Client := &mocks2.S3{PutObjectError: errors.New("some error")}
 
@@ -180,6 +309,7 @@ func Test_UploadSourceZip(t *testing.T) {
 // Test_DownloadSourceZip checks:
 // - if correct response is returned when source zip is downloaded
 // - if error is returned when GetObject fails
+// - if NotFound is returned when the source zip is missing
 // - if error is returned when Send fails
 // - if error is returned when wrong content lenght is read
 func Test_DownloadSourceZip(t *testing.T) {
@@ -246,6 +376,28 @@ func Test_DownloadSourceZip(t *testing.T) {
 		}
 	})
 
+	t.Run("when the source zip is missing", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectError: &types.NoSuchKey{}}
+
+		svc := &StorageService{Client: s3Client}
+
+		mds := &mocks.MockDownloadSourceZipServer{}
+
+		req := &terrarium.DownloadSourceZipRequest{
+			Module: &terrarium.Module{Name: "Test", Version: "v1"},
+		}
+
+		err := svc.DownloadSourceZip(req, mds)
+
+		if mds.SendInvocations != 0 {
+			t.Errorf("Expected 0 call to Sends, got %v", mds.SendInvocations)
+		}
+
+		if err != SourceZipNotFoundError {
+			t.Errorf("Expected %v, got %v.", SourceZipNotFoundError, err)
+		}
+	})
+
 	t.Run("when Send fails", func(t *testing.T) {
 		var length int64 = 1000
 		buf := &ClosingBuffer{bytes.NewBuffer(make([]byte, length))}
@@ -305,3 +457,146 @@ func Test_DownloadSourceZip(t *testing.T) {
 		}
 	})
 }
+
+// Test_GetModuleDocs checks:
+// - if stored docs are returned
+// - if docs are read from the archive and stored when they are missing
+// - if NotFound is returned when the archive is missing too
+// - if error is returned when GetObject fails
+func Test_GetModuleDocs(t *testing.T) {
+	t.Parallel()
+
+	req := &services.GetModuleDocsRequest{Module: &terrarium.Module{Name: "cie/vpc/aws", Version: "1.0.0"}}
+
+	t.Run("when docs are stored", func(t *testing.T) {
+		stored, _ := protojson.Marshal(&services.ModuleDocs{Path: ".", Inputs: []*services.ModuleInput{{Name: "name", Required: true}}})
+		s3Client := &mocks2.S3{GetObjectOut: &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(stored))}}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.GetModuleDocs(context.TODO(), req)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.Filename != "cie/vpc/aws/1.0.0.docs.json" {
+			t.Errorf("Expected the docs to be read, got %v", s3Client.Filename)
+		}
+
+		if len(res.GetInputs()) != 1 || res.GetInputs()[0].GetName() != "name" {
+			t.Errorf("Expected the stored docs, got %v", res)
+		}
+	})
+
+	t.Run("when docs are missing", func(t *testing.T) {
+		archive := zipOf(t, "outputs.tf", "output \"id\" {\n  value = 1\n}\n")
+		s3Client := &mocks2.S3{
+			GetObjectOuts:   []*s3.GetObjectOutput{nil, {Body: io.NopCloser(bytes.NewReader(archive))}},
+			GetObjectErrors: []error{&types.NoSuchKey{}},
+		}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.GetModuleDocs(context.TODO(), req)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.GetObjectInvocations != 2 {
+			t.Errorf("Expected 2 calls to GetObject, got %v", s3Client.GetObjectInvocations)
+		}
+
+		if s3Client.PutObjectInvocations != 1 || s3Client.Filename != "cie/vpc/aws/1.0.0.docs.json" {
+			t.Errorf("Expected the docs to be stored, got %v calls to PutObject for %v", s3Client.PutObjectInvocations, s3Client.Filename)
+		}
+
+		if len(res.GetOutputs()) != 1 || res.GetOutputs()[0].GetName() != "id" {
+			t.Errorf("Expected the docs of the archive, got %v", res)
+		}
+	})
+
+	t.Run("when the archive is missing", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectError: &types.NoSuchKey{}}
+
+		svc := &StorageService{Client: s3Client}
+
+		_, err := svc.GetModuleDocs(context.TODO(), req)
+
+		if err != SourceZipNotFoundError {
+			t.Errorf("Expected %v, got %v.", SourceZipNotFoundError, err)
+		}
+	})
+
+	t.Run("when GetObject fails", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectError: errors.New("some error")}
+
+		svc := &StorageService{Client: s3Client}
+
+		_, err := svc.GetModuleDocs(context.TODO(), req)
+
+		if err != GetModuleDocsError {
+			t.Errorf("Expected %v, got %v.", GetModuleDocsError, err)
+		}
+
+		if s3Client.GetObjectInvocations != 1 {
+			t.Errorf("Expected 1 call to GetObject, got %v", s3Client.GetObjectInvocations)
+		}
+	})
+}
+
+// Test_DeleteSourceZip checks:
+// - if correct response is returned when source zip, its docs and its file index are deleted
+// - if error is returned when DeleteObject fails
+func Test_DeleteSourceZip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when source zip is deleted", func(t *testing.T) {
+		s3Client := &mocks2.S3{}
+
+		svc := &StorageService{Client: s3Client}
+
+		req := &services.DeleteSourceZipRequest{
+			Module: &terrarium.Module{Name: "Test", Version: "v1"},
+		}
+
+		res, err := svc.DeleteSourceZip(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.DeleteObjectInvocations != 3 {
+			t.Errorf("Expected 3 calls to DeleteObject, got %v", s3Client.DeleteObjectInvocations)
+		}
+
+		if !reflect.DeepEqual(s3Client.DeletedKeys, []string{"Test/v1.docs.json", "Test/v1.files.json", "Test/v1.zip"}) {
+			t.Errorf("Expected the docs, the file index and Test/v1.zip to be deleted, got %v", s3Client.DeletedKeys)
+		}
+
+		if res != SourceZipDeleted {
+			t.Errorf("Expected %v, got %v.", SourceZipDeleted, res)
+		}
+	})
+
+	t.Run("when DeleteObject fails", func(t *testing.T) {
+		s3Client := &mocks2.S3{DeleteObjectError: errors.New("some error")}
+
+		svc := &StorageService{Client: s3Client}
+
+		req := &services.DeleteSourceZipRequest{
+			Module: &terrarium.Module{Name: "Test", Version: "v1"},
+		}
+
+		res, err := svc.DeleteSourceZip(context.TODO(), req)
+
+		if res != nil {
+			t.Errorf("Expected no response, got %v", res)
+		}
+
+		if err != DeleteSourceZipError {
+			t.Errorf("Expected %v, got %v.", DeleteSourceZipError, err)
+		}
+	})
+}
@@ -22,6 +22,10 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Storage_UploadSourceZip_FullMethodName   = "/terrarium.module.services.Storage/UploadSourceZip"
 	Storage_DownloadSourceZip_FullMethodName = "/terrarium.module.services.Storage/DownloadSourceZip"
+	Storage_DeleteSourceZip_FullMethodName   = "/terrarium.module.services.Storage/DeleteSourceZip"
+	Storage_GetModuleDocs_FullMethodName     = "/terrarium.module.services.Storage/GetModuleDocs"
+	Storage_ListSourceFiles_FullMethodName   = "/terrarium.module.services.Storage/ListSourceFiles"
+	Storage_GetSourceFile_FullMethodName     = "/terrarium.module.services.Storage/GetSourceFile"
 )
 
 // StorageClient is the client API for Storage service.
@@ -30,6 +34,10 @@ const (
 type StorageClient interface {
 	UploadSourceZip(ctx context.Context, opts ...grpc.CallOption) (Storage_UploadSourceZipClient, error)
 	DownloadSourceZip(ctx context.Context, in *module.DownloadSourceZipRequest, opts ...grpc.CallOption) (Storage_DownloadSourceZipClient, error)
+	DeleteSourceZip(ctx context.Context, in *DeleteSourceZipRequest, opts ...grpc.CallOption) (*module.Response, error)
+	GetModuleDocs(ctx context.Context, in *GetModuleDocsRequest, opts ...grpc.CallOption) (*ModuleDocs, error)
+	ListSourceFiles(ctx context.Context, in *ListSourceFilesRequest, opts ...grpc.CallOption) (*SourceFiles, error)
+	GetSourceFile(ctx context.Context, in *GetSourceFileRequest, opts ...grpc.CallOption) (*SourceFile, error)
 }
 
 type storageClient struct {
@@ -106,12 +114,52 @@ func (x *storageDownloadSourceZipClient) Recv() (*module.SourceZipResponse, erro
 	return m, nil
 }
 
+func (c *storageClient) DeleteSourceZip(ctx context.Context, in *DeleteSourceZipRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	out := new(module.Response)
+	err := c.cc.Invoke(ctx, Storage_DeleteSourceZip_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetModuleDocs(ctx context.Context, in *GetModuleDocsRequest, opts ...grpc.CallOption) (*ModuleDocs, error) {
+	out := new(ModuleDocs)
+	err := c.cc.Invoke(ctx, Storage_GetModuleDocs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) ListSourceFiles(ctx context.Context, in *ListSourceFilesRequest, opts ...grpc.CallOption) (*SourceFiles, error) {
+	out := new(SourceFiles)
+	err := c.cc.Invoke(ctx, Storage_ListSourceFiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetSourceFile(ctx context.Context, in *GetSourceFileRequest, opts ...grpc.CallOption) (*SourceFile, error) {
+	out := new(SourceFile)
+	err := c.cc.Invoke(ctx, Storage_GetSourceFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageServer is the server API for Storage service.
 // All implementations must embed UnimplementedStorageServer
 // for forward compatibility
 type StorageServer interface {
 	UploadSourceZip(Storage_UploadSourceZipServer) error
 	DownloadSourceZip(*module.DownloadSourceZipRequest, Storage_DownloadSourceZipServer) error
+	DeleteSourceZip(context.Context, *DeleteSourceZipRequest) (*module.Response, error)
+	GetModuleDocs(context.Context, *GetModuleDocsRequest) (*ModuleDocs, error)
+	ListSourceFiles(context.Context, *ListSourceFilesRequest) (*SourceFiles, error)
+	GetSourceFile(context.Context, *GetSourceFileRequest) (*SourceFile, error)
 	mustEmbedUnimplementedStorageServer()
 }
 
@@ -125,6 +173,18 @@ func (UnimplementedStorageServer) UploadSourceZip(Storage_UploadSourceZipServer)
 func (UnimplementedStorageServer) DownloadSourceZip(*module.DownloadSourceZipRequest, Storage_DownloadSourceZipServer) error {
 	return status.Errorf(codes.Unimplemented, "method DownloadSourceZip not implemented")
 }
+func (UnimplementedStorageServer) DeleteSourceZip(context.Context, *DeleteSourceZipRequest) (*module.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSourceZip not implemented")
+}
+func (UnimplementedStorageServer) GetModuleDocs(context.Context, *GetModuleDocsRequest) (*ModuleDocs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModuleDocs not implemented")
+}
+func (UnimplementedStorageServer) ListSourceFiles(context.Context, *ListSourceFilesRequest) (*SourceFiles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSourceFiles not implemented")
+}
+func (UnimplementedStorageServer) GetSourceFile(context.Context, *GetSourceFileRequest) (*SourceFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSourceFile not implemented")
+}
 func (UnimplementedStorageServer) mustEmbedUnimplementedStorageServer() {}
 
 // UnsafeStorageServer may be embedded to opt out of forward compatibility for this service.
@@ -185,13 +245,102 @@ func (x *storageDownloadSourceZipServer) Send(m *module.SourceZipResponse) error
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Storage_DeleteSourceZip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSourceZipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).DeleteSourceZip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Storage_DeleteSourceZip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).DeleteSourceZip(ctx, req.(*DeleteSourceZipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetModuleDocs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleDocsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetModuleDocs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Storage_GetModuleDocs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetModuleDocs(ctx, req.(*GetModuleDocsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_ListSourceFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSourceFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).ListSourceFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Storage_ListSourceFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).ListSourceFiles(ctx, req.(*ListSourceFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetSourceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSourceFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetSourceFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Storage_GetSourceFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetSourceFile(ctx, req.(*GetSourceFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Storage_ServiceDesc is the grpc.ServiceDesc for Storage service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Storage_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "terrarium.module.services.Storage",
 	HandlerType: (*StorageServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteSourceZip",
+			Handler:    _Storage_DeleteSourceZip_Handler,
+		},
+		{
+			MethodName: "GetModuleDocs",
+			Handler:    _Storage_GetModuleDocs_Handler,
+		},
+		{
+			MethodName: "ListSourceFiles",
+			Handler:    _Storage_ListSourceFiles_Handler,
+		},
+		{
+			MethodName: "GetSourceFile",
+			Handler:    _Storage_GetSourceFile_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "UploadSourceZip",
@@ -26,3 +26,69 @@ func (t tagManagerGrpcClient) PublishTag(ctx context.Context, in *module.Publish
 		return client.PublishTag(ctx, in, opts...)
 	}
 }
+
+func (t tagManagerGrpcClient) AddTags(ctx context.Context, in *module.PublishTagRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(t.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewTagManagerClient(conn)
+		return client.AddTags(ctx, in, opts...)
+	}
+}
+
+func (t tagManagerGrpcClient) RemoveTags(ctx context.Context, in *module.PublishTagRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(t.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewTagManagerClient(conn)
+		return client.RemoveTags(ctx, in, opts...)
+	}
+}
+
+func (t tagManagerGrpcClient) GetTags(ctx context.Context, in *module.GetTagsRequest, opts ...grpc.CallOption) (*module.ModuleTags, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(t.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewTagManagerClient(conn)
+		return client.GetTags(ctx, in, opts...)
+	}
+}
+
+func (t tagManagerGrpcClient) ListModuleTags(ctx context.Context, in *services.ListModuleTagsRequest, opts ...grpc.CallOption) (*services.ListModuleTagsResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(t.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewTagManagerClient(conn)
+		return client.ListModuleTags(ctx, in, opts...)
+	}
+}
+
+func (t tagManagerGrpcClient) ListModulesByTag(ctx context.Context, in *module.ListModulesByTagRequest, opts ...grpc.CallOption) (*module.ListModulesByTagResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(t.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewTagManagerClient(conn)
+		return client.ListModulesByTag(ctx, in, opts...)
+	}
+}
+
+func (t tagManagerGrpcClient) ListTags(ctx context.Context, in *services.ListTagsRequest, opts ...grpc.CallOption) (*services.ListTagsResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(t.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewTagManagerClient(conn)
+		return client.ListTags(ctx, in, opts...)
+	}
+}
@@ -2,9 +2,16 @@ package tag_manager
 
 import (
 	"context"
+	"errors"
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
 	"github.com/terrariumcloud/terrarium/internal/module/services/registrar"
+	searchServices "github.com/terrariumcloud/terrarium/internal/search/services"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/terrariumcloud/terrarium/internal/storage"
@@ -23,25 +30,45 @@ import (
 
 const (
 	DefaultTagTableName       = "terrarium-module-tags"
+	DefaultTagIndexTableName  = "terrarium-module-tag-index"
 	DefaultTagManagerEndpoint = "tag_manager:3001"
 )
 
 var (
 	TagTableName                      = DefaultTagTableName
+	TagIndexTableName                 = DefaultTagIndexTableName
 	TagManagerEndpoint                = DefaultTagManagerEndpoint
 	TagPublished                      = &terrarium.Response{Message: "Tag published."}
+	TagsAdded                         = &terrarium.Response{Message: "Tags added."}
+	TagsRemoved                       = &terrarium.Response{Message: "Tags removed."}
 	ModuleTagTableInitializationError = status.Error(codes.Unknown, "Failed to initialize table for tags.")
+	TagIndexTableInitializationError  = status.Error(codes.Unknown, "Failed to initialize table for the tag index.")
 	MarshalModuleTagError             = status.Error(codes.Unknown, "Failed to marshal module tags for Dynamodb.")
 	PublishModuleTagError             = status.Error(codes.Unknown, "Failed to publish module tag.")
 	UpdateModuleTagError              = status.Error(codes.Unknown, "Failed to update module tag.")
 	ConnectToTagManagerError          = status.Error(codes.Unknown, "Failed to connect to TagManager service.")
+	GetModuleTagsError                = status.Error(codes.Unknown, "Failed to get module tags.")
+	UpdateTagIndexError               = status.Error(codes.Unknown, "Failed to update the tag index.")
+	QueryTagIndexError                = status.Error(codes.Unknown, "Failed to query the tag index.")
+	TagsRequiredError                 = status.Error(codes.InvalidArgument, "At least one tag is required.")
+	TagRequiredError                  = status.Error(codes.InvalidArgument, "Tag is required.")
+	TagsConflictError                 = status.Error(codes.Aborted, "Module tags were modified concurrently, they were not updated.")
 )
 
+// maxTagUpdateAttempts is how many times the tags of a module are read and written when they are modified
+// concurrently.
+const maxTagUpdateAttempts = 5
+
 type TagManagerService struct {
 	services.UnimplementedTagManagerServer
 	Db     storage.DynamoDBTableCreator
 	Table  string
 	Schema *dynamodb.CreateTableInput
+	// IndexTable holds an entry for each tag of each module, to find the modules having a tag
+	IndexTable  string
+	IndexSchema *dynamodb.CreateTableInput
+	// Indexer, when set, is kept up to date with the tags published
+	Indexer searchServices.IndexerClient
 }
 
 type ModuleTag struct {
@@ -51,6 +78,12 @@ type ModuleTag struct {
 	ModifiedOn string   `json:"modified_on" bson:"modified_on" dynamodbav:"modified_on"`
 }
 
+// TagIndexEntry indexes a module under one of its tags.
+type TagIndexEntry struct {
+	Tag  string `json:"tag" bson:"tag" dynamodbav:"tag"`
+	Name string `json:"name" bson:"name" dynamodbav:"name"`
+}
+
 // RegisterWithServer registers TagManagerService with grpc server
 func (s *TagManagerService) RegisterWithServer(grpcServer grpc.ServiceRegistrar) error {
 	if err := storage.InitializeDynamoDb(s.Table, s.Schema, s.Db); err != nil {
@@ -58,36 +91,294 @@ func (s *TagManagerService) RegisterWithServer(grpcServer grpc.ServiceRegistrar)
 		return ModuleTagTableInitializationError
 	}
 
+	if err := storage.InitializeDynamoDb(s.IndexTable, s.IndexSchema, s.Db); err != nil {
+		log.Println(err)
+		return TagIndexTableInitializationError
+	}
+
 	services.RegisterTagManagerServer(grpcServer, s)
 
 	return nil
 }
 
+// PublishTag replaces the tags of a module.
 func (s *TagManagerService) PublishTag(ctx context.Context, request *terrarium.PublishTagRequest) (*terrarium.Response, error) {
 	log.Println("Publish module tag.")
 
-	name, err := attributevalue.Marshal(request.GetName())
+	tags := normalizeTags(request.GetTags())
+	err := s.updateTags(ctx, request.GetName(), func(current []string) ([]string, bool) {
+		return tags, true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("Module tags published.")
+	return TagPublished, nil
+}
+
+// AddTags adds tags to the ones of a module, keeping the tags it already has.
+func (s *TagManagerService) AddTags(ctx context.Context, request *terrarium.PublishTagRequest) (*terrarium.Response, error) {
+	log.Println("Add module tags.")
+
+	added := normalizeTags(request.GetTags())
+	if len(added) == 0 {
+		return nil, TagsRequiredError
+	}
+
+	err := s.updateTags(ctx, request.GetName(), func(current []string) ([]string, bool) {
+		return normalizeTags(append(append([]string{}, current...), added...)), true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("Module tags added.")
+	return TagsAdded, nil
+}
+
+// RemoveTags removes tags from the ones of a module. Tags the module does not have are ignored.
+func (s *TagManagerService) RemoveTags(ctx context.Context, request *terrarium.PublishTagRequest) (*terrarium.Response, error) {
+	log.Println("Remove module tags.")
+
+	removed := normalizeTags(request.GetTags())
+	if len(removed) == 0 {
+		return nil, TagsRequiredError
+	}
+
+	err := s.updateTags(ctx, request.GetName(), func(current []string) ([]string, bool) {
+		if current == nil {
+			return nil, false
+		}
+		tags := []string{}
+		for _, tag := range current {
+			if !contains(removed, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		return tags, true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("Module tags removed.")
+	return TagsRemoved, nil
+}
+
+// GetTags returns the tags of a module, none when no tags were ever published for it.
+func (s *TagManagerService) GetTags(ctx context.Context, request *terrarium.GetTagsRequest) (*terrarium.ModuleTags, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("module.name", request.GetName()))
+
+	current, err := s.getModuleTag(ctx, request.GetName(), false)
 	if err != nil {
 		log.Println(err)
-		return nil, registrar.ModuleGetError
+		span.RecordError(err)
+		return nil, GetModuleTagsError
 	}
 
-	res, err := s.Db.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: aws.String(TagTableName),
-		Key: map[string]types.AttributeValue{
-			"name": name,
+	response := &terrarium.ModuleTags{Name: request.GetName()}
+	if current != nil {
+		response.Tags = current.Tags
+	}
+	return response, nil
+}
+
+// ListModuleTags returns the tags of several modules, in the order of their names.
+func (s *TagManagerService) ListModuleTags(ctx context.Context, request *services.ListModuleTagsRequest) (*services.ListModuleTagsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	response := &services.ListModuleTagsResponse{}
+	for _, name := range request.GetNames() {
+		current, err := s.getModuleTag(ctx, name, false)
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, GetModuleTagsError
+		}
+
+		moduleTags := &terrarium.ModuleTags{Name: name}
+		if current != nil {
+			moduleTags.Tags = current.Tags
+		}
+		response.Modules = append(response.Modules, moduleTags)
+	}
+	return response, nil
+}
+
+// ListModulesByTag returns the names of the modules having a tag, sorted by name.
+func (s *TagManagerService) ListModulesByTag(ctx context.Context, request *terrarium.ListModulesByTagRequest) (*terrarium.ListModulesByTagResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("module.tag", request.GetTag()))
+
+	tag := strings.TrimSpace(request.GetTag())
+	if tag == "" {
+		return nil, TagRequiredError
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(s.IndexTable),
+		KeyConditionExpression: aws.String("#tag = :tag"),
+		ExpressionAttributeNames: map[string]string{
+			"#tag": "tag",
 		},
-	})
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":tag": &types.AttributeValueMemberS{Value: tag},
+		},
+	}
+
+	response := &terrarium.ListModulesByTagResponse{Tag: tag}
+	for {
+		out, err := s.Db.Query(ctx, input)
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, QueryTagIndexError
+		}
+
+		var entries []TagIndexEntry
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &entries); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, QueryTagIndexError
+		}
+		for _, entry := range entries {
+			response.Modules = append(response.Modules, entry.Name)
+		}
+
+		if len(out.LastEvaluatedKey) == 0 {
+			break
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+
+	sort.Strings(response.Modules)
+	return response, nil
+}
+
+// ListTags returns the tags in use with the number of modules having each of them, sorted by tag.
+func (s *TagManagerService) ListTags(ctx context.Context, request *services.ListTagsRequest) (*services.ListTagsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("tag.page", request.GetPage().String()))
 
+	items, err := storage.ScanAll(ctx, s.Db, &dynamodb.ScanInput{TableName: aws.String(s.IndexTable)})
 	if err != nil {
 		log.Println(err)
-		return nil, registrar.ModuleGetError
+		span.RecordError(err)
+		return nil, QueryTagIndexError
 	}
 
+	var entries []TagIndexEntry
+	if err := attributevalue.UnmarshalListOfMaps(items, &entries); err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, QueryTagIndexError
+	}
+
+	counts := map[string]uint64{}
+	for _, entry := range entries {
+		counts[entry.Tag]++
+	}
+
+	response := &services.ListTagsResponse{}
+	for tag, count := range counts {
+		response.Tags = append(response.Tags, &services.TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(response.Tags, func(i, j int) bool { return response.Tags[i].GetTag() < response.Tags[j].GetTag() })
+
+	response.Tags, response.Page, err = paging.Paginate(response.Tags, (*services.TagCount).GetTag, request.GetPage())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return response, nil
+}
+
+// getModuleTag returns the tags stored for a module, or nil when there are none. They are read with strong consistency
+// when consistent is set.
+func (s *TagManagerService) getModuleTag(ctx context.Context, name string, consistent bool) (*ModuleTag, error) {
+	key, err := attributevalue.Marshal(name)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.Db.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(s.Table),
+		Key: map[string]types.AttributeValue{
+			"name": key,
+		},
+		ConsistentRead: aws.Bool(consistent),
+	})
+	if err != nil {
+		return nil, err
+	}
 	if res.Item == nil {
+		return nil, nil
+	}
+
+	moduleTag := &ModuleTag{}
+	if err := attributevalue.UnmarshalMap(res.Item, moduleTag); err != nil {
+		return nil, err
+	}
+	return moduleTag, nil
+}
+
+// updateTags applies a change to the tags of a module, which returns false when there is nothing to write, then brings
+// the tag index and the search index up to date with them. The tags are only written when they were not modified since
+// they were read, and are read again otherwise, so that concurrent changes are not lost.
+func (s *TagManagerService) updateTags(ctx context.Context, name string, change func(current []string) ([]string, bool)) error {
+	span := trace.SpanFromContext(ctx)
+
+	for attempt := 0; attempt < maxTagUpdateAttempts; attempt++ {
+		current, err := s.getModuleTag(ctx, name, true)
+		if err != nil {
+			log.Println(err)
+			return registrar.ModuleGetError
+		}
+
+		var previous []string
+		if current != nil {
+			previous = current.Tags
+		}
+		tags, ok := change(previous)
+		if !ok {
+			return nil
+		}
+
+		err = s.saveTags(ctx, name, current, tags)
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			span.AddEvent("Module tags modified concurrently, updating them again.")
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := s.updateTagIndex(ctx, name, previous, tags); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return UpdateTagIndexError
+		}
+
+		s.indexTags(ctx, name, tags)
+		return nil
+	}
+
+	span.RecordError(TagsConflictError)
+	return TagsConflictError
+}
+
+// saveTags stores the tags of a module, replacing the current ones, unless they were modified since they were read.
+// The failed condition is returned as is when they were.
+func (s *TagManagerService) saveTags(ctx context.Context, name string, current *ModuleTag, tags []string) error {
+	var conditionFailed *types.ConditionalCheckFailedException
+
+	if current == nil {
 		ms := ModuleTag{
-			Name:       request.GetName(),
-			Tags:       request.GetTags(),
+			Name:       name,
+			Tags:       tags,
 			CreatedOn:  time.Now().UTC().String(),
 			ModifiedOn: time.Now().UTC().String(),
 		}
@@ -95,48 +386,138 @@ func (s *TagManagerService) PublishTag(ctx context.Context, request *terrarium.P
 		av, err := attributevalue.MarshalMap(ms)
 		if err != nil {
 			log.Println(err)
-			return nil, MarshalModuleTagError
+			return MarshalModuleTagError
 		}
 
 		in := &dynamodb.PutItemInput{
-			Item:      av,
-			TableName: aws.String(TagTableName),
+			Item:                av,
+			TableName:           aws.String(s.Table),
+			ConditionExpression: aws.String("attribute_not_exists(#name)"),
+			ExpressionAttributeNames: map[string]string{
+				"#name": "name",
+			},
 		}
 
 		if _, err = s.Db.PutItem(ctx, in); err != nil {
+			if errors.As(err, &conditionFailed) {
+				return err
+			}
 			log.Println(err)
-			return nil, PublishModuleTagError
+			return PublishModuleTagError
 		}
-	} else {
-		update := expression.Set(expression.Name("tags"), expression.Value(request.GetTags()))
-		update.Set(expression.Name("modified_on"), expression.Value(time.Now().UTC().String()))
-		expr, err := expression.NewBuilder().WithUpdate(update).Build()
+		return nil
+	}
+
+	key, err := attributevalue.Marshal(name)
+	if err != nil {
+		log.Println(err)
+		return registrar.ModuleGetError
+	}
+
+	unmodified := expression.Name("modified_on").AttributeNotExists()
+	if current.ModifiedOn != "" {
+		unmodified = expression.Name("modified_on").Equal(expression.Value(current.ModifiedOn))
+	}
 
+	update := expression.Set(expression.Name("tags"), expression.Value(tags))
+	update.Set(expression.Name("modified_on"), expression.Value(time.Now().UTC().String()))
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(unmodified).Build()
+
+	if err != nil {
+		log.Println(err)
+		return registrar.ExpressionBuildError
+	}
+
+	in := &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.Table),
+		Key: map[string]types.AttributeValue{
+			"name": key,
+		},
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
+	}
+
+	if _, err = s.Db.UpdateItem(ctx, in); err != nil {
+		if errors.As(err, &conditionFailed) {
+			return err
+		}
+		log.Println(err)
+		return UpdateModuleTagError
+	}
+	return nil
+}
+
+// updateTagIndex indexes a module under all of its tags and removes it from the ones it no longer has. Every tag is
+// written again so that publishing the same tags repairs an index left behind by a failed update.
+func (s *TagManagerService) updateTagIndex(ctx context.Context, name string, previous []string, tags []string) error {
+	for _, tag := range tags {
+		av, err := attributevalue.MarshalMap(TagIndexEntry{Tag: tag, Name: name})
 		if err != nil {
-			log.Println(err)
-			return nil, registrar.ExpressionBuildError
+			return err
+		}
+		if _, err := s.Db.PutItem(ctx, &dynamodb.PutItemInput{
+			Item:      av,
+			TableName: aws.String(s.IndexTable),
+		}); err != nil {
+			return err
 		}
+	}
 
-		in := &dynamodb.UpdateItemInput{
-			TableName: aws.String(TagTableName),
+	for _, tag := range previous {
+		if contains(tags, tag) {
+			continue
+		}
+		if _, err := s.Db.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+			TableName: aws.String(s.IndexTable),
 			Key: map[string]types.AttributeValue{
-				"name": name,
+				"tag":  &types.AttributeValueMemberS{Value: tag},
+				"name": &types.AttributeValueMemberS{Value: name},
 			},
-			ExpressionAttributeNames:  expr.Names(),
-			ExpressionAttributeValues: expr.Values(),
-			UpdateExpression:          expr.Update(),
+		}); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		_, err = s.Db.UpdateItem(ctx, in)
+// indexTags updates the search index with the tags of a module.
+// Failures are recorded but do not fail the publication.
+func (s *TagManagerService) indexTags(ctx context.Context, name string, tags []string) {
+	if s.Indexer == nil {
+		return
+	}
 
-		if err != nil {
-			log.Println(err)
-			return nil, UpdateModuleTagError
+	_, err := s.Indexer.IndexModule(ctx, &searchServices.IndexModuleRequest{
+		Name: name,
+		Tags: &searchServices.Tags{Values: tags},
+	})
+	if err != nil {
+		log.Printf("Failed to index the tags of %s: %v", name, err)
+		trace.SpanFromContext(ctx).RecordError(err)
+	}
+}
+
+// normalizeTags trims the tags and drops the empty and repeated ones, keeping their order.
+func normalizeTags(tags []string) []string {
+	normalized := []string{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !contains(normalized, tag) {
+			normalized = append(normalized, tag)
 		}
 	}
+	return normalized
+}
 
-	log.Println("Module tags published.")
-	return TagPublished, nil
+func contains(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
 }
 
 // GetTagsSchema returns CreateTableInput that can be used to create table if it does not exist
@@ -158,3 +539,31 @@ func GetTagsSchema(table string) *dynamodb.CreateTableInput {
 		BillingMode: types.BillingModePayPerRequest,
 	}
 }
+
+// GetTagIndexSchema returns CreateTableInput that can be used to create the tag index table if it does not exist
+func GetTagIndexSchema(table string) *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("tag"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("name"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("tag"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("name"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		TableName:   aws.String(table),
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
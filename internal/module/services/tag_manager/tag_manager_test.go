@@ -6,7 +6,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
+	searchMocks "github.com/terrariumcloud/terrarium/internal/search/services/mocks"
 	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
@@ -32,8 +34,8 @@ func Test_RegisterTagManagerWithServer(t *testing.T) {
 			t.Errorf("Expected no error, got %v.", err)
 		}
 
-		if db.DescribeTableInvocations != 1 {
-			t.Errorf("Expected 1 call to DescribeTable, got %v.", db.DescribeTableInvocations)
+		if db.DescribeTableInvocations != 2 {
+			t.Errorf("Expected 2 calls to DescribeTable, got %v.", db.DescribeTableInvocations)
 		}
 
 		if db.CreateTableInvocations != 0 {
@@ -68,6 +70,30 @@ func Test_RegisterTagManagerWithServer(t *testing.T) {
 			t.Errorf("Expected 1 calls to CreateTable, got %v.", db.CreateTableInvocations)
 		}
 	})
+
+	t.Run("when index Table initialization fails", func(t *testing.T) {
+
+		db := &mocks.DynamoDB{
+			DescribeTableErrors: []error{nil, errors.New("some error")},
+			CreateTableError:    errors.New("some error"),
+		}
+
+		tm := &TagManagerService{
+			Db: db,
+		}
+
+		s := grpc.NewServer(*new([]grpc.ServerOption)...)
+
+		err := tm.RegisterWithServer(s)
+
+		if err != TagIndexTableInitializationError {
+			t.Errorf("Expected %v, got %v.", TagIndexTableInitializationError, err)
+		}
+
+		if db.DescribeTableInvocations != 2 {
+			t.Errorf("Expected 2 calls to DescribeTable, got %v.", db.DescribeTableInvocations)
+		}
+	})
 }
 
 func Test_PublishTag(t *testing.T) {
@@ -98,6 +124,39 @@ func Test_PublishTag(t *testing.T) {
 		}
 	})
 
+	t.Run("when the indexer is set", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts: []*dynamodb.GetItemOutput{{}},
+		}
+		indexer := &searchMocks.MockIndexerClient{IndexModuleError: errors.New("some error")}
+
+		svc := &TagManagerService{Db: db, Indexer: indexer}
+
+		listOfTags := []string{"eks"}
+		req := terrarium.PublishTagRequest{
+			Name: "test",
+			Tags: listOfTags,
+		}
+
+		res, err := svc.PublishTag(context.TODO(), &req)
+
+		if err != nil {
+			t.Errorf("Expected %v, got %v.", nil, err)
+		}
+
+		if res != TagPublished {
+			t.Errorf("Expected %v, got %v.", TagPublished, res)
+		}
+
+		if indexer.IndexModuleInvocations != 1 {
+			t.Errorf("Expected 1 call to IndexModule, got %d", indexer.IndexModuleInvocations)
+		}
+
+		if !reflect.DeepEqual(indexer.IndexModuleRequest.GetTags().GetValues(), listOfTags) {
+			t.Errorf("Expected tags %v to be indexed, got %v", listOfTags, indexer.IndexModuleRequest.GetTags())
+		}
+	})
+
 	t.Run("when UpdateItem is successful", func(t *testing.T) {
 		name := "test"
 		tagsList := []string{"eks"}
@@ -174,3 +233,364 @@ func Test_PublishTag(t *testing.T) {
 		}
 	})
 }
+
+func moduleTagItem(t *testing.T, name string, tags ...string) map[string]types.AttributeValue {
+	t.Helper()
+	item, err := attributevalue.MarshalMap(ModuleTag{Name: name, Tags: tags})
+	if err != nil {
+		t.Fatalf("Failed to marshal module tags: %v", err)
+	}
+	return item
+}
+
+// Test_PublishTagIndex checks:
+// - if the module is indexed under all of its tags and removed from the ones it no longer has
+// - if error is returned when the tag index cannot be updated
+func Test_PublishTagIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when tags are replaced", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts: []*dynamodb.GetItemOutput{{Item: moduleTagItem(t, "cie/vpc/aws", "network", "legacy")}},
+		}
+
+		svc := &TagManagerService{Db: db}
+
+		_, err := svc.PublishTag(context.TODO(), &terrarium.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{" network ", "aws", "network"}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.PutItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+
+		if db.DeleteItemInvocations != 1 {
+			t.Errorf("Expected 1 call to DeleteItem, got %v.", db.DeleteItemInvocations)
+		}
+	})
+
+	t.Run("when the tag index cannot be updated", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts:  []*dynamodb.GetItemOutput{{Item: moduleTagItem(t, "cie/vpc/aws")}},
+			PutItemError: errors.New("some error"),
+		}
+
+		svc := &TagManagerService{Db: db}
+
+		_, err := svc.PublishTag(context.TODO(), &terrarium.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{"network"}})
+
+		if err != UpdateTagIndexError {
+			t.Errorf("Expected %v, got %v.", UpdateTagIndexError, err)
+		}
+	})
+}
+
+// conflictingDynamoDB fails the first conditional updates as if the tags were modified concurrently.
+type conflictingDynamoDB struct {
+	*mocks.DynamoDB
+	conflicts int
+}
+
+func (db *conflictingDynamoDB) UpdateItem(ctx context.Context, in *dynamodb.UpdateItemInput, opts ...func(*dynamodb.Options)) (*dynamodb.UpdateItemOutput, error) {
+	if db.conflicts > 0 {
+		db.conflicts--
+		db.UpdateItemInvocations++
+		return nil, &types.ConditionalCheckFailedException{}
+	}
+	return db.DynamoDB.UpdateItem(ctx, in, opts...)
+}
+
+// Test_AddTags checks:
+// - if the tags are added to the ones of the module, on the condition that they were not modified
+// - if the tags are read again when they were modified concurrently
+// - if error is returned when the tags keep being modified concurrently
+// - if error is returned when no tag is given
+func Test_AddTags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when tags are added", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts: []*dynamodb.GetItemOutput{{Item: moduleTagItem(t, "cie/vpc/aws", "network")}},
+		}
+		indexer := &searchMocks.MockIndexerClient{}
+
+		svc := &TagManagerService{Db: db, Indexer: indexer}
+
+		res, err := svc.AddTags(context.TODO(), &terrarium.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{"aws", "network"}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if res != TagsAdded {
+			t.Errorf("Expected %v, got %v.", TagsAdded, res)
+		}
+
+		if db.UpdateItemInvocations != 1 || db.PutItemInvocations != 2 {
+			t.Errorf("Expected the tags to be updated and indexed, got %v calls to UpdateItem and %v to PutItem.", db.UpdateItemInvocations, db.PutItemInvocations)
+		}
+
+		want := []string{"network", "aws"}
+		if !reflect.DeepEqual(indexer.IndexModuleRequest.GetTags().GetValues(), want) {
+			t.Errorf("Expected tags %v to be indexed, got %v", want, indexer.IndexModuleRequest.GetTags())
+		}
+
+		if db.UpdateItemIn.ConditionExpression == nil {
+			t.Errorf("Expected the update to be conditional.")
+		}
+	})
+
+	t.Run("when the tags are modified concurrently", func(t *testing.T) {
+		db := &conflictingDynamoDB{DynamoDB: &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{
+			{Item: moduleTagItem(t, "cie/vpc/aws", "network")},
+			{Item: moduleTagItem(t, "cie/vpc/aws", "network", "legacy")},
+		}}, conflicts: 1}
+		indexer := &searchMocks.MockIndexerClient{}
+
+		svc := &TagManagerService{Db: db, Indexer: indexer}
+
+		_, err := svc.AddTags(context.TODO(), &terrarium.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{"aws"}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.GetItemInvocations != 2 || db.UpdateItemInvocations != 2 {
+			t.Errorf("Expected the tags to be read and written twice, got %v and %v.", db.GetItemInvocations, db.UpdateItemInvocations)
+		}
+
+		want := []string{"network", "legacy", "aws"}
+		if !reflect.DeepEqual(indexer.IndexModuleRequest.GetTags().GetValues(), want) {
+			t.Errorf("Expected tags %v to be indexed, got %v", want, indexer.IndexModuleRequest.GetTags())
+		}
+	})
+
+	t.Run("when the tags keep being modified concurrently", func(t *testing.T) {
+		db := &conflictingDynamoDB{DynamoDB: &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{
+			{Item: moduleTagItem(t, "cie/vpc/aws", "network")},
+			{Item: moduleTagItem(t, "cie/vpc/aws", "network")},
+			{Item: moduleTagItem(t, "cie/vpc/aws", "network")},
+			{Item: moduleTagItem(t, "cie/vpc/aws", "network")},
+			{Item: moduleTagItem(t, "cie/vpc/aws", "network")},
+		}}, conflicts: maxTagUpdateAttempts}
+
+		svc := &TagManagerService{Db: db}
+
+		_, err := svc.AddTags(context.TODO(), &terrarium.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{"aws"}})
+
+		if err != TagsConflictError {
+			t.Errorf("Expected %v, got %v.", TagsConflictError, err)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected the tag index not to be updated, got %v calls to PutItem.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when no tag is given", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &TagManagerService{Db: db}
+
+		_, err := svc.AddTags(context.TODO(), &terrarium.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{" "}})
+
+		if err != TagsRequiredError {
+			t.Errorf("Expected %v, got %v.", TagsRequiredError, err)
+		}
+
+		if db.GetItemInvocations != 0 {
+			t.Errorf("Expected no call to GetItem, got %v.", db.GetItemInvocations)
+		}
+	})
+}
+
+// Test_RemoveTags checks:
+// - if the tags are removed from the module and the tag index
+// - if nothing is written when the module has no tags
+func Test_RemoveTags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when tags are removed", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts: []*dynamodb.GetItemOutput{{Item: moduleTagItem(t, "cie/vpc/aws", "network", "aws")}},
+		}
+
+		svc := &TagManagerService{Db: db}
+
+		res, err := svc.RemoveTags(context.TODO(), &terrarium.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{"aws", "unknown"}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if res != TagsRemoved {
+			t.Errorf("Expected %v, got %v.", TagsRemoved, res)
+		}
+
+		if db.DeleteItemInvocations != 1 {
+			t.Errorf("Expected 1 call to DeleteItem, got %v.", db.DeleteItemInvocations)
+		}
+	})
+
+	t.Run("when the module has no tags", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{{}}}
+
+		svc := &TagManagerService{Db: db}
+
+		res, err := svc.RemoveTags(context.TODO(), &terrarium.PublishTagRequest{Name: "cie/vpc/aws", Tags: []string{"aws"}})
+
+		if err != nil || res != TagsRemoved {
+			t.Errorf("Expected %v, got %v and %v.", TagsRemoved, res, err)
+		}
+
+		if db.PutItemInvocations != 0 || db.UpdateItemInvocations != 0 || db.DeleteItemInvocations != 0 {
+			t.Errorf("Expected nothing to be written.")
+		}
+	})
+}
+
+// Test_GetTags checks:
+// - if the tags of the module are returned
+// - if no tags are returned for a module without tags
+// - if error is returned when GetItem fails
+func Test_GetTags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the module has tags", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts: []*dynamodb.GetItemOutput{{Item: moduleTagItem(t, "cie/vpc/aws", "network")}},
+		}
+
+		svc := &TagManagerService{Db: db}
+
+		res, err := svc.GetTags(context.TODO(), &terrarium.GetTagsRequest{Name: "cie/vpc/aws"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if !reflect.DeepEqual(res.GetTags(), []string{"network"}) {
+			t.Errorf("Expected the tags of the module, got %v", res.GetTags())
+		}
+	})
+
+	t.Run("when the module has no tags", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{{}}}
+
+		svc := &TagManagerService{Db: db}
+
+		res, err := svc.GetTags(context.TODO(), &terrarium.GetTagsRequest{Name: "cie/vpc/aws"})
+
+		if err != nil || len(res.GetTags()) != 0 {
+			t.Errorf("Expected no tags, got %v and %v.", res, err)
+		}
+	})
+
+	t.Run("when GetItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemErrors: []error{errors.New("some error")}}
+
+		svc := &TagManagerService{Db: db}
+
+		_, err := svc.GetTags(context.TODO(), &terrarium.GetTagsRequest{Name: "cie/vpc/aws"})
+
+		if err != GetModuleTagsError {
+			t.Errorf("Expected %v, got %v.", GetModuleTagsError, err)
+		}
+	})
+}
+
+// Test_ListModulesByTag checks:
+// - if the modules having the tag are returned sorted by name, across pages of the index of the service
+// - if error is returned when no tag is given
+func Test_ListModulesByTag(t *testing.T) {
+	t.Parallel()
+
+	entry := func(name string) map[string]types.AttributeValue {
+		item, _ := attributevalue.MarshalMap(TagIndexEntry{Tag: "network", Name: name})
+		return item
+	}
+
+	t.Run("when modules have the tag", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			QueryOuts: []*dynamodb.QueryOutput{
+				{Items: []map[string]types.AttributeValue{entry("cie/vpc/aws")}, LastEvaluatedKey: entry("cie/vpc/aws")},
+				{Items: []map[string]types.AttributeValue{entry("cie/peering/aws")}},
+			},
+		}
+
+		svc := &TagManagerService{Db: db, IndexTable: "tag-index"}
+
+		res, err := svc.ListModulesByTag(context.TODO(), &terrarium.ListModulesByTagRequest{Tag: "network"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.TableName != "tag-index" {
+			t.Errorf("Expected the index table of the service to be queried, got %v.", db.TableName)
+		}
+
+		want := []string{"cie/peering/aws", "cie/vpc/aws"}
+		if !reflect.DeepEqual(res.GetModules(), want) {
+			t.Errorf("Expected %v, got %v", want, res.GetModules())
+		}
+	})
+
+	t.Run("when no tag is given", func(t *testing.T) {
+		svc := &TagManagerService{Db: &mocks.DynamoDB{}}
+
+		_, err := svc.ListModulesByTag(context.TODO(), &terrarium.ListModulesByTagRequest{})
+
+		if err != TagRequiredError {
+			t.Errorf("Expected %v, got %v.", TagRequiredError, err)
+		}
+	})
+}
+
+// Test_ListTags checks:
+// - if the tags in use are counted and sorted by tag
+// - if error is returned when Scan fails
+func Test_ListTags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when tags are in use", func(t *testing.T) {
+		var items []map[string]types.AttributeValue
+		for _, entry := range []TagIndexEntry{{"network", "cie/vpc/aws"}, {"aws", "cie/vpc/aws"}, {"network", "cie/peering/aws"}} {
+			item, _ := attributevalue.MarshalMap(entry)
+			items = append(items, item)
+		}
+		db := &mocks.DynamoDB{ScanOut: &dynamodb.ScanOutput{Items: items}}
+
+		svc := &TagManagerService{Db: db}
+
+		res, err := svc.ListTags(context.TODO(), &services.ListTagsRequest{})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		want := []*services.TagCount{{Tag: "aws", Count: 1}, {Tag: "network", Count: 2}}
+		if len(res.GetTags()) != len(want) {
+			t.Fatalf("Expected %v, got %v", want, res.GetTags())
+		}
+		for i := range want {
+			if res.GetTags()[i].GetTag() != want[i].GetTag() || res.GetTags()[i].GetCount() != want[i].GetCount() {
+				t.Errorf("Expected %v, got %v", want[i], res.GetTags()[i])
+			}
+		}
+	})
+
+	t.Run("when Scan fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanError: errors.New("some error")}
+
+		svc := &TagManagerService{Db: db}
+
+		_, err := svc.ListTags(context.TODO(), &services.ListTagsRequest{})
+
+		if err != QueryTagIndexError {
+			t.Errorf("Expected %v, got %v.", QueryTagIndexError, err)
+		}
+	})
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
-// - protoc             v3.21.12
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
 // source: pb/terrarium/module/services/tag_manager.proto
 
 package services
@@ -19,11 +19,27 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+const (
+	TagManager_PublishTag_FullMethodName       = "/terrarium.module.services.TagManager/PublishTag"
+	TagManager_AddTags_FullMethodName          = "/terrarium.module.services.TagManager/AddTags"
+	TagManager_RemoveTags_FullMethodName       = "/terrarium.module.services.TagManager/RemoveTags"
+	TagManager_GetTags_FullMethodName          = "/terrarium.module.services.TagManager/GetTags"
+	TagManager_ListModuleTags_FullMethodName   = "/terrarium.module.services.TagManager/ListModuleTags"
+	TagManager_ListModulesByTag_FullMethodName = "/terrarium.module.services.TagManager/ListModulesByTag"
+	TagManager_ListTags_FullMethodName         = "/terrarium.module.services.TagManager/ListTags"
+)
+
 // TagManagerClient is the client API for TagManager service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TagManagerClient interface {
 	PublishTag(ctx context.Context, in *module.PublishTagRequest, opts ...grpc.CallOption) (*module.Response, error)
+	AddTags(ctx context.Context, in *module.PublishTagRequest, opts ...grpc.CallOption) (*module.Response, error)
+	RemoveTags(ctx context.Context, in *module.PublishTagRequest, opts ...grpc.CallOption) (*module.Response, error)
+	GetTags(ctx context.Context, in *module.GetTagsRequest, opts ...grpc.CallOption) (*module.ModuleTags, error)
+	ListModuleTags(ctx context.Context, in *ListModuleTagsRequest, opts ...grpc.CallOption) (*ListModuleTagsResponse, error)
+	ListModulesByTag(ctx context.Context, in *module.ListModulesByTagRequest, opts ...grpc.CallOption) (*module.ListModulesByTagResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
 }
 
 type tagManagerClient struct {
@@ -36,7 +52,61 @@ func NewTagManagerClient(cc grpc.ClientConnInterface) TagManagerClient {
 
 func (c *tagManagerClient) PublishTag(ctx context.Context, in *module.PublishTagRequest, opts ...grpc.CallOption) (*module.Response, error) {
 	out := new(module.Response)
-	err := c.cc.Invoke(ctx, "/terrarium.module.services.TagManager/PublishTag", in, out, opts...)
+	err := c.cc.Invoke(ctx, TagManager_PublishTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagManagerClient) AddTags(ctx context.Context, in *module.PublishTagRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	out := new(module.Response)
+	err := c.cc.Invoke(ctx, TagManager_AddTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagManagerClient) RemoveTags(ctx context.Context, in *module.PublishTagRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	out := new(module.Response)
+	err := c.cc.Invoke(ctx, TagManager_RemoveTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagManagerClient) GetTags(ctx context.Context, in *module.GetTagsRequest, opts ...grpc.CallOption) (*module.ModuleTags, error) {
+	out := new(module.ModuleTags)
+	err := c.cc.Invoke(ctx, TagManager_GetTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagManagerClient) ListModuleTags(ctx context.Context, in *ListModuleTagsRequest, opts ...grpc.CallOption) (*ListModuleTagsResponse, error) {
+	out := new(ListModuleTagsResponse)
+	err := c.cc.Invoke(ctx, TagManager_ListModuleTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagManagerClient) ListModulesByTag(ctx context.Context, in *module.ListModulesByTagRequest, opts ...grpc.CallOption) (*module.ListModulesByTagResponse, error) {
+	out := new(module.ListModulesByTagResponse)
+	err := c.cc.Invoke(ctx, TagManager_ListModulesByTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagManagerClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, TagManager_ListTags_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +118,12 @@ func (c *tagManagerClient) PublishTag(ctx context.Context, in *module.PublishTag
 // for forward compatibility
 type TagManagerServer interface {
 	PublishTag(context.Context, *module.PublishTagRequest) (*module.Response, error)
+	AddTags(context.Context, *module.PublishTagRequest) (*module.Response, error)
+	RemoveTags(context.Context, *module.PublishTagRequest) (*module.Response, error)
+	GetTags(context.Context, *module.GetTagsRequest) (*module.ModuleTags, error)
+	ListModuleTags(context.Context, *ListModuleTagsRequest) (*ListModuleTagsResponse, error)
+	ListModulesByTag(context.Context, *module.ListModulesByTagRequest) (*module.ListModulesByTagResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
 	mustEmbedUnimplementedTagManagerServer()
 }
 
@@ -58,6 +134,24 @@ type UnimplementedTagManagerServer struct {
 func (UnimplementedTagManagerServer) PublishTag(context.Context, *module.PublishTagRequest) (*module.Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishTag not implemented")
 }
+func (UnimplementedTagManagerServer) AddTags(context.Context, *module.PublishTagRequest) (*module.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTags not implemented")
+}
+func (UnimplementedTagManagerServer) RemoveTags(context.Context, *module.PublishTagRequest) (*module.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTags not implemented")
+}
+func (UnimplementedTagManagerServer) GetTags(context.Context, *module.GetTagsRequest) (*module.ModuleTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedTagManagerServer) ListModuleTags(context.Context, *ListModuleTagsRequest) (*ListModuleTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModuleTags not implemented")
+}
+func (UnimplementedTagManagerServer) ListModulesByTag(context.Context, *module.ListModulesByTagRequest) (*module.ListModulesByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModulesByTag not implemented")
+}
+func (UnimplementedTagManagerServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
 func (UnimplementedTagManagerServer) mustEmbedUnimplementedTagManagerServer() {}
 
 // UnsafeTagManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -81,7 +175,7 @@ func _TagManager_PublishTag_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.module.services.TagManager/PublishTag",
+		FullMethod: TagManager_PublishTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(TagManagerServer).PublishTag(ctx, req.(*module.PublishTagRequest))
@@ -89,6 +183,114 @@ func _TagManager_PublishTag_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagManager_AddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.PublishTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagManagerServer).AddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagManager_AddTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagManagerServer).AddTags(ctx, req.(*module.PublishTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagManager_RemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.PublishTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagManagerServer).RemoveTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagManager_RemoveTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagManagerServer).RemoveTags(ctx, req.(*module.PublishTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagManager_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.GetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagManagerServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagManager_GetTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagManagerServer).GetTags(ctx, req.(*module.GetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagManager_ListModuleTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModuleTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagManagerServer).ListModuleTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagManager_ListModuleTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagManagerServer).ListModuleTags(ctx, req.(*ListModuleTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagManager_ListModulesByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.ListModulesByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagManagerServer).ListModulesByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagManager_ListModulesByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagManagerServer).ListModulesByTag(ctx, req.(*module.ListModulesByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagManager_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagManagerServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagManager_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagManagerServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TagManager_ServiceDesc is the grpc.ServiceDesc for TagManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -100,6 +302,30 @@ var TagManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishTag",
 			Handler:    _TagManager_PublishTag_Handler,
 		},
+		{
+			MethodName: "AddTags",
+			Handler:    _TagManager_AddTags_Handler,
+		},
+		{
+			MethodName: "RemoveTags",
+			Handler:    _TagManager_RemoveTags_Handler,
+		},
+		{
+			MethodName: "GetTags",
+			Handler:    _TagManager_GetTags_Handler,
+		},
+		{
+			MethodName: "ListModuleTags",
+			Handler:    _TagManager_ListModuleTags_Handler,
+		},
+		{
+			MethodName: "ListModulesByTag",
+			Handler:    _TagManager_ListModulesByTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _TagManager_ListTags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb/terrarium/module/services/tag_manager.proto",
@@ -59,3 +59,58 @@ func (v versionManagerGrpcClient) ListModuleVersions(ctx context.Context, in *se
 		return client.ListModuleVersions(ctx, in, opts...)
 	}
 }
+
+func (v versionManagerGrpcClient) ListLatestModuleVersions(ctx context.Context, in *services.ListLatestModuleVersionsRequest, opts ...grpc.CallOption) (*services.ListLatestModuleVersionsResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.ListLatestModuleVersions(ctx, in, opts...)
+	}
+}
+
+func (v versionManagerGrpcClient) SetMaturity(ctx context.Context, in *module.SetMaturityRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.SetMaturity(ctx, in, opts...)
+	}
+}
+
+func (v versionManagerGrpcClient) ApproveVersion(ctx context.Context, in *module.ReviewVersionRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.ApproveVersion(ctx, in, opts...)
+	}
+}
+
+func (v versionManagerGrpcClient) RejectVersion(ctx context.Context, in *module.ReviewVersionRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.RejectVersion(ctx, in, opts...)
+	}
+}
+
+func (v versionManagerGrpcClient) ListPendingVersions(ctx context.Context, in *services.ListPendingVersionsRequest, opts ...grpc.CallOption) (*services.ListPendingVersionsResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.ListPendingVersions(ctx, in, opts...)
+	}
+}
@@ -2,19 +2,28 @@ package version_manager
 
 import (
 	"context"
+	"errors"
 	"log"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	releasePkg "github.com/terrariumcloud/terrarium/pkg/terrarium/release"
 
+	"github.com/terrariumcloud/terrarium/internal/common/approval"
+	"github.com/terrariumcloud/terrarium/internal/common/audit"
+	"github.com/terrariumcloud/terrarium/internal/common/identity"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
+	"github.com/terrariumcloud/terrarium/internal/module/services/registrar"
 	releaseSvc "github.com/terrariumcloud/terrarium/internal/release/services"
+	searchServices "github.com/terrariumcloud/terrarium/internal/search/services"
 	"github.com/terrariumcloud/terrarium/internal/storage"
 	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/apparentlymart/go-versions/versions"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -23,44 +32,107 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	DefaultVersionsTableName      = "terrarium-module-versions"
 	DefaultVersionManagerEndpoint = "version_manager:3001"
+	DefaultDraftTTL               = 24 * time.Hour
+	DefaultDraftReaperInterval    = time.Hour
+
+	// maxModulesPerScan is the number of operands DynamoDB allows in the IN comparison of a filter
+	maxModulesPerScan = 100
 )
 
 var (
 	VersionsTableName      = DefaultVersionsTableName
 	VersionManagerEndpoint = DefaultVersionManagerEndpoint
-
-	VersionCreated   = &terrarium.Response{Message: "Version created."}
-	VersionPublished = &terrarium.Response{Message: "Version published."}
-	VersionAborted   = &terrarium.Response{Message: "Version aborted."}
+	DraftTTL               = DefaultDraftTTL
+	DraftReaperInterval    = DefaultDraftReaperInterval
+	ApprovalOrganizations  []string
+
+	VersionCreated         = &terrarium.Response{Message: "Version created."}
+	VersionPublished       = &terrarium.Response{Message: "Version published."}
+	VersionAborted         = &terrarium.Response{Message: "Version aborted."}
+	MaturityUpdated        = &terrarium.Response{Message: "Version maturity updated."}
+	VersionPendingApproval = &terrarium.Response{Message: "Version submitted for approval."}
+	VersionApproved        = &terrarium.Response{Message: "Version approved."}
+	VersionRejected        = &terrarium.Response{Message: "Version rejected."}
 
 	ModuleVersionsTableInitializationError = status.Error(codes.Unknown, "Failed to initialize table for module versions.")
 	MarshalModuleVersionError              = status.Error(codes.Unknown, "Failed to marshal module version.")
 	CreateModuleVersionError               = status.Error(codes.Unknown, "Failed to create module version.")
 	AbortModuleVersionError                = status.Error(codes.Unknown, "Failed to abort module version.")
 	PublishModuleVersionError              = status.Error(codes.Unknown, "Failed to publish module version.")
+	ListDraftVersionsError                 = status.Error(codes.Unknown, "Failed to list draft module versions.")
+	ModuleVersionGetError                  = status.Error(codes.Unknown, "Failed to retrieve module version.")
+	ModuleVersionNotFoundError             = status.Error(codes.NotFound, "Module version not found.")
+	MaturityTransitionError                = status.Error(codes.FailedPrecondition, "Maturity transition is not allowed.")
+	SetMaturityError                       = status.Error(codes.Unknown, "Failed to update module version maturity.")
+	SubmitterRequiredError                 = status.Error(codes.Unauthenticated, "Publishing a version that requires approval requires an authenticated caller.")
+	VersionAlreadyPublishedError           = status.Error(codes.FailedPrecondition, "Module version is already published.")
+	ReviewerRequiredError                  = status.Error(codes.Unauthenticated, "Reviewing a version requires an authenticated caller.")
+	SelfReviewError                        = status.Error(codes.PermissionDenied, "Module version cannot be reviewed by its submitter.")
+	VersionNotPendingApprovalError         = status.Error(codes.FailedPrecondition, "Module version is not pending approval.")
+	ReviewModuleVersionError               = status.Error(codes.Unknown, "Failed to review module version.")
+	ListPendingVersionsError               = status.Error(codes.Unknown, "Failed to list module versions pending approval.")
+	ListLatestModuleVersionsError          = status.Error(codes.Unknown, "Failed to list the latest module versions.")
 	DevelopmentVersion                     = versions.MustParseVersion("0.0.0")
 )
 
+var (
+	meter = otel.Meter("github.com/terrariumcloud/terrarium/internal/module/services/version_manager")
+
+	draftsReaped, _      = meter.Int64Counter("terrarium.module.drafts.reaped", metric.WithDescription("Number of abandoned draft module versions removed."))
+	draftReapFailures, _ = meter.Int64Counter("terrarium.module.drafts.reap_failures", metric.WithDescription("Number of abandoned draft module versions that failed to be removed."))
+)
+
 type VersionManagerService struct {
 	services.UnimplementedVersionManagerServer
 	Db             storage.DynamoDBTableCreator
 	Table          string
 	Schema         *dynamodb.CreateTableInput
 	ReleaseService releaseSvc.PublisherClient
+	StorageService services.StorageClient
+	DraftTTL       time.Duration
+	ReaperInterval time.Duration
+	Approval       approval.Policy
+	// Indexer, when set, is kept up to date with the versions published
+	Indexer searchServices.IndexerClient
+	// DependencyManager, when set, has the dependencies recorded for reaped drafts removed
+	DependencyManager services.DependencyManagerClient
+	// Identity lists the callers trusted to pass on the identity of submitters and reviewers
+	Identity *identity.Trust
+
+	// stopReaper cancels the context of the draft reaper started with the service, and reaping tracks the reaper
+	stopReaper context.CancelFunc
+	reaping    sync.WaitGroup
 }
 
 type ModuleVersion struct {
-	Name        string `json:"name" bson:"name" dynamodbav:"name"`
-	Version     string `json:"version" bson:"version" dynamodbav:"version"`
-	CreatedOn   string `json:"created_on" bson:"created_on" dynamodbav:"created_on"`
-	PublishedOn string `json:"published_on" bson:"published_on" dynamodbav:"published_on"`
+	Name            string           `json:"name" bson:"name" dynamodbav:"name"`
+	Version         string           `json:"version" bson:"version" dynamodbav:"version"`
+	CreatedOn       string           `json:"created_on" bson:"created_on" dynamodbav:"created_on"`
+	PublishedOn     string           `json:"published_on" bson:"published_on" dynamodbav:"published_on"`
+	Maturity        string           `json:"maturity,omitempty" bson:"maturity,omitempty" dynamodbav:"maturity,omitempty"`
+	MaturityHistory []MaturityChange `json:"maturity_history,omitempty" bson:"maturity_history,omitempty" dynamodbav:"maturity_history,omitempty"`
+	ApprovalStatus  string           `json:"approval_status,omitempty" bson:"approval_status,omitempty" dynamodbav:"approval_status,omitempty"`
+	SubmittedOn     string           `json:"submitted_on,omitempty" bson:"submitted_on,omitempty" dynamodbav:"submitted_on,omitempty"`
+	SubmittedBy     string           `json:"submitted_by,omitempty" bson:"submitted_by,omitempty" dynamodbav:"submitted_by,omitempty"`
+	ReviewedBy      string           `json:"reviewed_by,omitempty" bson:"reviewed_by,omitempty" dynamodbav:"reviewed_by,omitempty"`
+	ReviewedOn      string           `json:"reviewed_on,omitempty" bson:"reviewed_on,omitempty" dynamodbav:"reviewed_on,omitempty"`
+	ReviewComment   string           `json:"review_comment,omitempty" bson:"review_comment,omitempty" dynamodbav:"review_comment,omitempty"`
+}
+
+type MaturityChange struct {
+	From      string `json:"from" bson:"from" dynamodbav:"from"`
+	To        string `json:"to" bson:"to" dynamodbav:"to"`
+	Reason    string `json:"reason,omitempty" bson:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	ChangedOn string `json:"changed_on" bson:"changed_on" dynamodbav:"changed_on"`
 }
 
 // RegisterWithServer Registers VersionManagerService with grpc server
@@ -71,6 +143,16 @@ func (s *VersionManagerService) RegisterWithServer(grpcServer grpc.ServiceRegist
 	}
 	services.RegisterVersionManagerServer(grpcServer, s)
 
+	if s.DraftTTL > 0 && s.ReaperInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		s.stopReaper = cancel
+		s.reaping.Add(1)
+		go func() {
+			defer s.reaping.Done()
+			s.StartDraftReaper(ctx)
+		}()
+	}
+
 	return nil
 }
 
@@ -88,6 +170,9 @@ func (s *VersionManagerService) BeginVersion(ctx context.Context, request *terra
 		Version:   request.Module.GetVersion(),
 		CreatedOn: time.Now().UTC().String(),
 	}
+	if request.Maturity != nil {
+		mv.Maturity = request.GetMaturity().String()
+	}
 
 	av, err := attributevalue.MarshalMap(mv)
 
@@ -161,6 +246,8 @@ func (s *VersionManagerService) AbortVersion(ctx context.Context, request *servi
 
 // PublishVersion Updates Module Version to published with Version Manager service
 // And publishes a release.
+// When the organization of the module requires approval the version is only submitted for approval,
+// it is published once approved with ApproveVersion.
 func (s *VersionManagerService) PublishVersion(ctx context.Context, request *services.TerminateVersionRequest) (*terrarium.Response, error) {
 	log.Println("Publishing module version.")
 
@@ -176,6 +263,10 @@ func (s *VersionManagerService) PublishVersion(ctx context.Context, request *ser
 		return nil, PublishModuleVersionError
 	}
 
+	if s.Approval.Required(request.Module.GetName()) {
+		return s.submitForApproval(ctx, moduleKey)
+	}
+
 	publishOn, err := attributevalue.Marshal(time.Now().UTC().String())
 	if err != nil {
 		span.RecordError(err)
@@ -198,38 +289,303 @@ func (s *VersionManagerService) PublishVersion(ctx context.Context, request *ser
 		return nil, PublishModuleVersionError
 	}
 
-	// PUBLISH RELEASE
-	parsedVersion, err := versions.ParseVersion(strings.ReplaceAll(request.Module.GetVersion(), "v", ""))
+	if err := s.publishRelease(ctx, request.GetModule()); err != nil {
+		return nil, err
+	}
+	s.indexVersion(ctx, request.GetModule())
+
+	log.Println("Module version published.")
+	return VersionPublished, nil
+}
+
+// publishRelease announces a published module version to the release service.
+// Failures to reach the release service are recorded but do not fail the publication.
+func (s *VersionManagerService) publishRelease(ctx context.Context, module *terrarium.Module) error {
+	span := trace.SpanFromContext(ctx)
+
+	parsedVersion, err := versions.ParseVersion(strings.ReplaceAll(module.GetVersion(), "v", ""))
 	if err != nil {
 		span.RecordError(err)
-		return nil, err
+		return err
 	}
 
 	if parsedVersion.GreaterThan(DevelopmentVersion) && s.ReleaseService != nil {
-		moduleAddress := strings.Split(request.Module.GetName(), "/")
+		moduleAddress := strings.Split(module.GetName(), "/")
 		orgName := moduleAddress[0]
 
 		if _, err := s.ReleaseService.Publish(ctx, &releasePkg.PublishRequest{
-			Name:         request.Module.GetName(),
-			Version:      request.Module.GetVersion(),
+			Name:         module.GetName(),
+			Version:      module.GetVersion(),
 			Type:         "module",
 			Organization: orgName,
 		}); err != nil {
 			span.RecordError(err)
 		}
 	}
+	return nil
+}
+
+// indexVersion updates the search index with a published module version.
+// Failures are recorded but do not fail the publication.
+func (s *VersionManagerService) indexVersion(ctx context.Context, module *terrarium.Module) {
+	if s.Indexer == nil {
+		return
+	}
 
-	log.Println("Module version published.")
-	return VersionPublished, nil
+	if _, err := s.Indexer.IndexModule(ctx, &searchServices.IndexModuleRequest{
+		Name:    module.GetName(),
+		Version: proto.String(module.GetVersion()),
+	}); err != nil {
+		log.Printf("Failed to index %s %s: %v", module.GetName(), module.GetVersion(), err)
+		trace.SpanFromContext(ctx).RecordError(err)
+	}
+}
+
+// submitForApproval marks a module version as pending approval instead of publishing it.
+// The caller submitting the version must be known and is recorded so that they cannot review it.
+func (s *VersionManagerService) submitForApproval(ctx context.Context, moduleKey map[string]types.AttributeValue) (*terrarium.Response, error) {
+	span := trace.SpanFromContext(ctx)
+
+	submitter := s.Identity.FromIncomingContext(ctx)
+	if submitter == "" {
+		span.RecordError(SubmitterRequiredError)
+		return nil, SubmitterRequiredError
+	}
+
+	update := expression.Set(expression.Name("approval_status"), expression.Value(approval.StatusPending))
+	update.Set(expression.Name("submitted_on"), expression.Value(time.Now().UTC().String()))
+	update.Set(expression.Name("submitted_by"), expression.Value(submitter))
+
+	condition := expression.And(
+		expression.Name("name").AttributeExists(),
+		expression.Or(
+			expression.Name("published_on").AttributeNotExists(),
+			expression.Name("published_on").Equal(expression.Value(""))),
+	)
+
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, PublishModuleVersionError
+	}
+
+	in := &dynamodb.UpdateItemInput{
+		Key:                                 moduleKey,
+		TableName:                           aws.String(VersionsTableName),
+		ExpressionAttributeNames:            expr.Names(),
+		ExpressionAttributeValues:           expr.Values(),
+		UpdateExpression:                    expr.Update(),
+		ConditionExpression:                 expr.Condition(),
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	}
+
+	if _, err := s.Db.UpdateItem(ctx, in); err != nil {
+		span.RecordError(err)
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			// The version is either missing or was published already.
+			if len(conditionFailed.Item) == 0 {
+				return nil, ModuleVersionNotFoundError
+			}
+			return nil, VersionAlreadyPublishedError
+		}
+		log.Println(err)
+		return nil, PublishModuleVersionError
+	}
+
+	span.AddEvent("Module version submitted for approval.")
+	log.Println("Module version submitted for approval.")
+	return VersionPendingApproval, nil
+}
+
+// ApproveVersion publishes a module version that is pending approval and publishes a release.
+func (s *VersionManagerService) ApproveVersion(ctx context.Context, request *terrarium.ReviewVersionRequest) (*terrarium.Response, error) {
+	log.Println("Approving module version.")
+
+	if err := s.reviewVersion(ctx, request, approval.StatusApproved); err != nil {
+		return nil, err
+	}
+
+	if err := s.publishRelease(ctx, request.GetModule()); err != nil {
+		return nil, err
+	}
+	s.indexVersion(ctx, request.GetModule())
+
+	log.Println("Module version approved.")
+	return VersionApproved, nil
+}
+
+// RejectVersion rejects a module version that is pending approval.
+// Rejected versions are never published and are removed by the draft reaper.
+func (s *VersionManagerService) RejectVersion(ctx context.Context, request *terrarium.ReviewVersionRequest) (*terrarium.Response, error) {
+	log.Println("Rejecting module version.")
+
+	if err := s.reviewVersion(ctx, request, approval.StatusRejected); err != nil {
+		return nil, err
+	}
+
+	log.Println("Module version rejected.")
+	return VersionRejected, nil
+}
+
+// reviewVersion records the decision of the caller on a version pending approval.
+// Approved versions get their publish date set. Versions cannot be reviewed by the caller who submitted them.
+func (s *VersionManagerService) reviewVersion(ctx context.Context, request *terrarium.ReviewVersionRequest, decision string) error {
+	reviewer := s.Identity.FromIncomingContext(ctx)
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.String("approval.reviewer", reviewer),
+		attribute.String("approval.decision", decision),
+	)
+
+	if reviewer == "" {
+		return ReviewerRequiredError
+	}
+
+	moduleKey, err := s.GetModuleKey(request.GetModule())
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return ReviewModuleVersionError
+	}
+
+	reviewedOn := time.Now().UTC().String()
+	update := expression.Set(expression.Name("approval_status"), expression.Value(decision))
+	update.Set(expression.Name("reviewed_by"), expression.Value(reviewer))
+	update.Set(expression.Name("reviewed_on"), expression.Value(reviewedOn))
+	update.Set(expression.Name("review_comment"), expression.Value(request.GetComment()))
+	if decision == approval.StatusApproved {
+		update.Set(expression.Name("published_on"), expression.Value(reviewedOn))
+	}
+	condition := expression.And(
+		expression.Name("approval_status").Equal(expression.Value(approval.StatusPending)),
+		expression.Or(
+			expression.Name("submitted_by").AttributeNotExists(),
+			expression.Name("submitted_by").NotEqual(expression.Value(reviewer))))
+
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return ReviewModuleVersionError
+	}
+
+	in := &dynamodb.UpdateItemInput{
+		Key:                                 moduleKey,
+		TableName:                           aws.String(VersionsTableName),
+		ConditionExpression:                 expr.Condition(),
+		ExpressionAttributeNames:            expr.Names(),
+		ExpressionAttributeValues:           expr.Values(),
+		UpdateExpression:                    expr.Update(),
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	}
+
+	if _, err := s.Db.UpdateItem(ctx, in); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			return reviewConditionError(conditionFailed)
+		}
+		return ReviewModuleVersionError
+	}
+
+	audit.Record(ctx, reviewer, "module.version."+strings.ToLower(decision),
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.String("approval.comment", request.GetComment()),
+	)
+	return nil
+}
+
+// reviewConditionError tells apart, from the version as it was when the review was refused, a version reviewed by
+// its submitter from a version that is not pending approval.
+func reviewConditionError(conditionFailed *types.ConditionalCheckFailedException) error {
+	moduleVersion := ModuleVersion{}
+	if err := attributevalue.UnmarshalMap(conditionFailed.Item, &moduleVersion); err == nil && moduleVersion.ApprovalStatus == approval.StatusPending {
+		return SelfReviewError
+	}
+	return VersionNotPendingApprovalError
+}
+
+// ListPendingVersions lists the module versions waiting for approval, sorted by module name and version.
+func (s *VersionManagerService) ListPendingVersions(ctx context.Context, request *services.ListPendingVersionsRequest) (*services.ListPendingVersionsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.organization", request.GetOrganization()),
+	)
+
+	filter := expression.Name("approval_status").Equal(expression.Value(approval.StatusPending))
+	if request.GetOrganization() != "" {
+		filter = filter.And(expression.Name("name").BeginsWith(request.GetOrganization() + "/"))
+	}
+	projection := expression.NamesList(expression.Name("name"), expression.Name("version"), expression.Name("submitted_on"))
+	expr, err := expression.NewBuilder().WithProjection(projection).WithFilter(filter).Build()
+	if err != nil {
+		span.RecordError(err)
+		log.Printf("Expression Builder failed creation: %v", err)
+		return nil, ListPendingVersionsError
+	}
+
+	scanQueryInputs := &dynamodb.ScanInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		FilterExpression:          expr.Filter(),
+		ProjectionExpression:      expr.Projection(),
+		TableName:                 aws.String(VersionsTableName),
+	}
+
+	grpcResponse := &services.ListPendingVersionsResponse{}
+	for {
+		response, err := s.Db.Scan(ctx, scanQueryInputs)
+		if err != nil {
+			span.RecordError(err)
+			log.Printf("ScanInput failed: %v", err)
+			return nil, ListPendingVersionsError
+		}
+
+		for _, item := range response.Items {
+			moduleVersion := ModuleVersion{}
+			if err := attributevalue.UnmarshalMap(item, &moduleVersion); err != nil {
+				span.RecordError(err)
+				log.Printf("UnmarshalMap failed: %v", err)
+				return nil, ListPendingVersionsError
+			}
+			grpcResponse.Versions = append(grpcResponse.Versions, &services.PendingVersion{
+				Name:        moduleVersion.Name,
+				Version:     moduleVersion.Version,
+				SubmittedOn: moduleVersion.SubmittedOn,
+			})
+		}
+
+		if len(response.LastEvaluatedKey) == 0 {
+			break
+		}
+		scanQueryInputs.ExclusiveStartKey = response.LastEvaluatedKey
+	}
+
+	sort.Slice(grpcResponse.Versions, func(i, j int) bool {
+		if grpcResponse.Versions[i].Name != grpcResponse.Versions[j].Name {
+			return grpcResponse.Versions[i].Name < grpcResponse.Versions[j].Name
+		}
+		return grpcResponse.Versions[i].Version < grpcResponse.Versions[j].Version
+	})
+	return grpcResponse, nil
 }
 
 // ListModuleVersions Retrieve all versions of a given module and return an array of versions.
-// Only versions that have been published should be reported
+// Only versions that have been published, and approved when subject to approval, should be reported
 func (s *VersionManagerService) ListModuleVersions(ctx context.Context, request *services.ListModuleVersionsRequest) (*services.ListModuleVersionsResponse, error) {
-	projection := expression.NamesList(expression.Name("version"))
+	projection := expression.NamesList(expression.Name("version"), expression.Name("maturity"))
 	filter := expression.And(
 		expression.Name("name").Equal(expression.Value(request.Module)),
-		expression.Name("published_on").AttributeExists())
+		expression.Name("published_on").AttributeExists(),
+		expression.Or(
+			expression.Name("approval_status").AttributeNotExists(),
+			expression.Name("approval_status").Equal(expression.Value(approval.StatusApproved))))
 	expr, err := expression.NewBuilder().WithProjection(projection).WithFilter(filter).Build()
 	if err != nil {
 		log.Printf("Expression Builder failed creation: %v", err)
@@ -244,21 +600,25 @@ func (s *VersionManagerService) ListModuleVersions(ctx context.Context, request
 		TableName:                 aws.String(VersionsTableName),
 	}
 
-	response, err := s.Db.Scan(ctx, scanQueryInputs)
+	items, err := storage.ScanAll(ctx, s.Db, scanQueryInputs)
 	if err != nil {
 		log.Printf("ScanInput failed: %v", err)
 		return nil, err
 	}
 
 	grpcResponse := services.ListModuleVersionsResponse{}
-	if response.Items != nil {
-		for _, item := range response.Items {
-			moduleVersion := ModuleVersion{}
-			if err3 := attributevalue.UnmarshalMap(item, &moduleVersion); err3 != nil {
-				log.Printf("UnmarshalMap failed: %v", err3)
-				return nil, err3
+	for _, item := range items {
+		moduleVersion := ModuleVersion{}
+		if err3 := attributevalue.UnmarshalMap(item, &moduleVersion); err3 != nil {
+			log.Printf("UnmarshalMap failed: %v", err3)
+			return nil, err3
+		}
+		grpcResponse.Versions = append(grpcResponse.Versions, moduleVersion.Version)
+		if moduleVersion.Maturity != "" {
+			if grpcResponse.Maturities == nil {
+				grpcResponse.Maturities = map[string]terrarium.Maturity{}
 			}
-			grpcResponse.Versions = append(grpcResponse.Versions, moduleVersion.Version)
+			grpcResponse.Maturities[moduleVersion.Version] = terrarium.Maturity(terrarium.Maturity_value[moduleVersion.Maturity])
 		}
 	}
 	var semverList versions.List
@@ -283,6 +643,344 @@ func (s *VersionManagerService) ListModuleVersions(ctx context.Context, request
 	return &grpcResponse, nil
 }
 
+// ListLatestModuleVersions returns the latest published version of each of the requested modules, looking them up
+// with a scan per maxModulesPerScan modules rather than a call per module.
+// Modules without a published version, and approved when subject to approval, are left out of the response.
+func (s *VersionManagerService) ListLatestModuleVersions(ctx context.Context, request *services.ListLatestModuleVersionsRequest) (*services.ListLatestModuleVersionsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.Int("module.count", len(request.GetModules())),
+	)
+
+	latest := map[string]versions.Version{}
+	modules := request.GetModules()
+	for start := 0; start < len(modules); start += maxModulesPerScan {
+		end := min(start+maxModulesPerScan, len(modules))
+		if err := s.collectLatestVersions(ctx, modules[start:end], latest); err != nil {
+			span.RecordError(err)
+			return nil, ListLatestModuleVersionsError
+		}
+	}
+
+	grpcResponse := &services.ListLatestModuleVersionsResponse{Versions: map[string]string{}}
+	for name, version := range latest {
+		grpcResponse.Versions[name] = version.String()
+	}
+	return grpcResponse, nil
+}
+
+// collectLatestVersions scans the published versions of the given modules and keeps the latest of each in latest.
+func (s *VersionManagerService) collectLatestVersions(ctx context.Context, modules []string, latest map[string]versions.Version) error {
+	names := make([]expression.OperandBuilder, 0, len(modules))
+	for _, module := range modules {
+		names = append(names, expression.Value(module))
+	}
+
+	projection := expression.NamesList(expression.Name("name"), expression.Name("version"))
+	filter := expression.And(
+		expression.Name("name").In(names[0], names[1:]...),
+		expression.Name("published_on").AttributeExists(),
+		expression.Or(
+			expression.Name("approval_status").AttributeNotExists(),
+			expression.Name("approval_status").Equal(expression.Value(approval.StatusApproved))))
+	expr, err := expression.NewBuilder().WithProjection(projection).WithFilter(filter).Build()
+	if err != nil {
+		log.Printf("Expression Builder failed creation: %v", err)
+		return err
+	}
+
+	items, err := storage.ScanAll(ctx, s.Db, &dynamodb.ScanInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		FilterExpression:          expr.Filter(),
+		ProjectionExpression:      expr.Projection(),
+		TableName:                 aws.String(VersionsTableName),
+	})
+	if err != nil {
+		log.Printf("ScanInput failed: %v", err)
+		return err
+	}
+
+	for _, item := range items {
+		moduleVersion := ModuleVersion{}
+		if err := attributevalue.UnmarshalMap(item, &moduleVersion); err != nil {
+			log.Printf("UnmarshalMap failed: %v", err)
+			return err
+		}
+		version, err := versions.ParseVersion(moduleVersion.Version)
+		if err != nil {
+			log.Printf("Skipping invalid semantic version: %v", moduleVersion.Version)
+			continue
+		}
+		if current, ok := latest[moduleVersion.Name]; !ok || version.GreaterThan(current) {
+			latest[moduleVersion.Name] = version
+		}
+	}
+	return nil
+}
+
+// SetMaturity changes the maturity of a module version when the lifecycle allows the transition
+// and appends the change to the maturity history of the version.
+func (s *VersionManagerService) SetMaturity(ctx context.Context, request *terrarium.SetMaturityRequest) (*terrarium.Response, error) {
+	log.Println("Setting module version maturity.")
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.name", request.GetModule().GetName()),
+		attribute.String("module.version", request.GetModule().GetVersion()),
+		attribute.String("module.maturity", request.GetMaturity().String()),
+	)
+
+	moduleKey, err := s.GetModuleKey(request.GetModule())
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, ModuleVersionGetError
+	}
+
+	res, err := s.Db.GetItem(ctx, &dynamodb.GetItemInput{
+		Key:       moduleKey,
+		TableName: aws.String(VersionsTableName),
+	})
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, ModuleVersionGetError
+	}
+
+	if res.Item == nil {
+		return nil, ModuleVersionNotFoundError
+	}
+
+	mv := ModuleVersion{}
+	if err := attributevalue.UnmarshalMap(res.Item, &mv); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, ModuleVersionGetError
+	}
+
+	if mv.Maturity != "" {
+		current := terrarium.Maturity(terrarium.Maturity_value[mv.Maturity])
+		if !registrar.IsMaturityTransitionAllowed(current, request.GetMaturity()) {
+			log.Printf("Maturity transition from %s to %s is not allowed.", current, request.GetMaturity())
+			return nil, MaturityTransitionError
+		}
+	}
+
+	change := MaturityChange{
+		From:      mv.Maturity,
+		To:        request.GetMaturity().String(),
+		Reason:    request.GetReason(),
+		ChangedOn: time.Now().UTC().String(),
+	}
+
+	update := expression.Set(expression.Name("maturity"), expression.Value(change.To))
+	update.Set(expression.Name("maturity_history"), expression.ListAppend(
+		expression.IfNotExists(expression.Name("maturity_history"), expression.Value([]MaturityChange{})),
+		expression.Value([]MaturityChange{change})))
+
+	expr, err := expression.NewBuilder().WithUpdate(update).Build()
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, SetMaturityError
+	}
+
+	in := &dynamodb.UpdateItemInput{
+		Key:                       moduleKey,
+		TableName:                 aws.String(VersionsTableName),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		UpdateExpression:          expr.Update(),
+	}
+
+	if _, err := s.Db.UpdateItem(ctx, in); err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, SetMaturityError
+	}
+
+	log.Println("Module version maturity updated.")
+	return MaturityUpdated, nil
+}
+
+// Close stops the draft reaper started with the service, waiting for the drafts being reaped.
+func (s *VersionManagerService) Close() error {
+	if s.stopReaper != nil {
+		s.stopReaper()
+	}
+	s.reaping.Wait()
+	return nil
+}
+
+// StartDraftReaper periodically removes draft versions that were never published
+// until the context is cancelled.
+func (s *VersionManagerService) StartDraftReaper(ctx context.Context) {
+	log.Printf("Reaping draft module versions older than %s every %s.", s.DraftTTL, s.ReaperInterval)
+	ticker := time.NewTicker(s.ReaperInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ReapDrafts(ctx, time.Now().UTC()); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReapDrafts removes every draft version created more than DraftTTL before now,
+// together with its source zip and recorded dependencies, and returns the number of versions reaped.
+// Drafts that fail to be removed are left in place to be retried on the next run.
+func (s *VersionManagerService) ReapDrafts(ctx context.Context, now time.Time) (int, error) {
+	drafts, err := s.listDraftVersions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	reaped := 0
+	for _, draft := range drafts {
+		createdOn, err := time.Parse(storage.TimeFormatLayout, draft.CreatedOn)
+		if err != nil {
+			log.Printf("Skipping draft %s@%s with invalid creation time: %v", draft.Name, draft.Version, err)
+			continue
+		}
+
+		age := now.Sub(createdOn)
+		if age < s.DraftTTL {
+			continue
+		}
+
+		removed, err := s.reapDraft(ctx, &terrarium.Module{Name: draft.Name, Version: draft.Version}, age)
+		if err != nil {
+			draftReapFailures.Add(ctx, 1)
+			continue
+		}
+		if removed {
+			reaped++
+		}
+	}
+
+	if reaped > 0 {
+		log.Printf("Reaped %d draft module versions.", reaped)
+	}
+	return reaped, nil
+}
+
+// reapDraft removes a draft version, reporting whether it was still a draft. The version is removed first, on the
+// condition that it was neither published nor submitted for approval since it was listed, so that the source zip
+// and dependencies of a version that is no longer a draft are kept.
+func (s *VersionManagerService) reapDraft(ctx context.Context, module *terrarium.Module, age time.Duration) (bool, error) {
+	removed, err := s.deleteDraft(ctx, module)
+	if err != nil || !removed {
+		return false, err
+	}
+
+	if s.StorageService != nil {
+		if _, err := s.StorageService.DeleteSourceZip(ctx, &services.DeleteSourceZipRequest{Module: module}); err != nil {
+			log.Printf("Failed to delete source zip for draft %s@%s: %v", module.GetName(), module.GetVersion(), err)
+			return true, err
+		}
+	}
+
+	if s.DependencyManager != nil {
+		if _, err := s.DependencyManager.UnregisterDependencies(ctx, &services.UnregisterDependenciesRequest{Module: module}); err != nil {
+			log.Printf("Failed to unregister dependencies for draft %s@%s: %v", module.GetName(), module.GetVersion(), err)
+			return true, err
+		}
+	}
+
+	draftsReaped.Add(ctx, 1)
+	audit.Record(ctx, audit.SystemActor, "module.version.reaped",
+		attribute.String("module.name", module.GetName()),
+		attribute.String("module.version", module.GetVersion()),
+		attribute.String("draft.age", age.Round(time.Second).String()),
+	)
+	return true, nil
+}
+
+// deleteDraft deletes a version on the condition that it is still a draft, reporting whether it was.
+func (s *VersionManagerService) deleteDraft(ctx context.Context, module *terrarium.Module) (bool, error) {
+	moduleKey, err := s.GetModuleKey(module)
+	if err != nil {
+		log.Println(err)
+		return false, AbortModuleVersionError
+	}
+
+	expr, err := expression.NewBuilder().WithCondition(draftCondition()).Build()
+	if err != nil {
+		log.Printf("Expression Builder failed creation: %v", err)
+		return false, AbortModuleVersionError
+	}
+
+	in := &dynamodb.DeleteItemInput{
+		Key:                       moduleKey,
+		TableName:                 aws.String(VersionsTableName),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+	if _, err := s.Db.DeleteItem(ctx, in); err != nil {
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			log.Printf("Draft %s@%s is no longer a draft, keeping it.", module.GetName(), module.GetVersion())
+			return false, nil
+		}
+		log.Printf("Failed to delete draft %s@%s: %v", module.GetName(), module.GetVersion(), err)
+		return false, AbortModuleVersionError
+	}
+	return true, nil
+}
+
+// draftCondition matches the versions without a publish date that are not waiting for approval.
+func draftCondition() expression.ConditionBuilder {
+	return expression.And(
+		expression.Or(
+			expression.Name("published_on").AttributeNotExists(),
+			expression.Name("published_on").Equal(expression.Value(""))),
+		expression.Or(
+			expression.Name("approval_status").AttributeNotExists(),
+			expression.Name("approval_status").NotEqual(expression.Value(approval.StatusPending))))
+}
+
+// listDraftVersions scans the whole table for versions without a publish date
+// Versions waiting for approval are not drafts and are left alone.
+func (s *VersionManagerService) listDraftVersions(ctx context.Context) ([]ModuleVersion, error) {
+	expr, err := expression.NewBuilder().WithFilter(draftCondition()).Build()
+	if err != nil {
+		log.Printf("Expression Builder failed creation: %v", err)
+		return nil, ListDraftVersionsError
+	}
+
+	scanQueryInputs := &dynamodb.ScanInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		FilterExpression:          expr.Filter(),
+		TableName:                 aws.String(VersionsTableName),
+	}
+
+	items, err := storage.ScanAll(ctx, s.Db, scanQueryInputs)
+	if err != nil {
+		log.Printf("ScanInput failed: %v", err)
+		return nil, ListDraftVersionsError
+	}
+
+	var drafts []ModuleVersion
+	for _, item := range items {
+		moduleVersion := ModuleVersion{}
+		if err := attributevalue.UnmarshalMap(item, &moduleVersion); err != nil {
+			log.Printf("UnmarshalMap failed: %v", err)
+			return nil, ListDraftVersionsError
+		}
+		drafts = append(drafts, moduleVersion)
+	}
+	return drafts, nil
+}
+
 // GetModuleVersionsSchema returns CreateTableInput that can be used to create table if it does not exist
 func GetModuleVersionsSchema(table string) *dynamodb.CreateTableInput {
 	return &dynamodb.CreateTableInput{
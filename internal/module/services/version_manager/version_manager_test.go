@@ -3,20 +3,31 @@ package version_manager
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/common/approval"
+	"github.com/terrariumcloud/terrarium/internal/common/identity"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
+	moduleMocks "github.com/terrariumcloud/terrarium/internal/module/services/mocks"
+	releaseMocks "github.com/terrariumcloud/terrarium/internal/release/services/mocks"
+	searchMocks "github.com/terrariumcloud/terrarium/internal/search/services/mocks"
 	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
 	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // Test_RegisterVersionManagerWithServer checks:
 // - if there was no error with table init
+// - if the draft reaper started with the service is stopped when it is closed
 // - if error is returned when Table initialization fails
 func Test_RegisterVersionManagerWithServer(t *testing.T) {
 	t.Parallel()
@@ -43,6 +54,24 @@ func Test_RegisterVersionManagerWithServer(t *testing.T) {
 		}
 	})
 
+	t.Run("when the draft reaper is enabled", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		vms := &VersionManagerService{Db: db, DraftTTL: time.Hour, ReaperInterval: time.Hour}
+
+		if err := vms.RegisterWithServer(grpc.NewServer()); err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if err := vms.Close(); err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if db.ScanItemInvocations != 1 {
+			t.Errorf("Expected the drafts to be reaped once before the reaper stopped, got %v.", db.ScanItemInvocations)
+		}
+	})
+
 	t.Run("when Table initialization fails", func(t *testing.T) {
 		db := &mocks.DynamoDB{
 			DescribeTableErrors: []error{errors.New("some error")},
@@ -191,6 +220,7 @@ func Test_AbortVersion(t *testing.T) {
 
 // Test_PublishVersion checks:
 // - if correct response is returned when version is published
+// - if the version is indexed and published when the indexer fails
 // - if error is returned when UpdateItem fails
 func Test_PublishVersion(t *testing.T) {
 	t.Parallel()
@@ -221,6 +251,33 @@ func Test_PublishVersion(t *testing.T) {
 		}
 	})
 
+	t.Run("when the indexer fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		indexer := &searchMocks.MockIndexerClient{IndexModuleError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db, Indexer: indexer}
+
+		req := &services.TerminateVersionRequest{Module: &terrarium.Module{Name: "cie/test/aws", Version: "1.0.0"}}
+
+		res, err := svc.PublishVersion(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != VersionPublished {
+			t.Errorf("Expected %v, got %v.", VersionPublished, res)
+		}
+
+		if indexer.IndexModuleInvocations != 1 {
+			t.Errorf("Expected 1 call to IndexModule, got %v", indexer.IndexModuleInvocations)
+		}
+
+		if indexer.IndexModuleRequest.GetVersion() != "1.0.0" {
+			t.Errorf("Expected version 1.0.0 to be indexed, got %v", indexer.IndexModuleRequest.GetVersion())
+		}
+	})
+
 	t.Run("when UpdateItem fails", func(t *testing.T) {
 		db := &mocks.DynamoDB{UpdateItemError: errors.New("some error")}
 
@@ -248,8 +305,355 @@ func Test_PublishVersion(t *testing.T) {
 	})
 }
 
+// Test_PublishVersionWithApproval checks:
+// - if the version is submitted for approval, with its submitter, when the organization requires approval
+// - if no release is published while the version is pending approval
+// - if error is returned when the submitter is not authenticated
+// - if error is returned when the version is already published or does not exist
+// - if the version is published directly for other organizations
+func Test_PublishVersionWithApproval(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when organization requires approval", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		releaseService := &releaseMocks.MockPublisherClient{}
+
+		svc := &VersionManagerService{Db: db, ReleaseService: releaseService, Approval: approval.Policy{Organizations: []string{"cie"}}, Identity: proxy}
+
+		req := &services.TerminateVersionRequest{Module: &terrarium.Module{Name: "cie/test/aws", Version: "1.0.0"}}
+
+		res, err := svc.PublishVersion(callerContext("john"), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != VersionPendingApproval {
+			t.Errorf("Expected %v, got %v.", VersionPendingApproval, res)
+		}
+
+		if db.UpdateItemInvocations != 1 {
+			t.Errorf("Expected 1 call to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+
+		if !hasValue(db.UpdateItemIn.ExpressionAttributeValues, "john") {
+			t.Errorf("Expected john to be recorded as submitter, got %v", db.UpdateItemIn.ExpressionAttributeValues)
+		}
+
+		if releaseService.PublishInvocations != 0 {
+			t.Errorf("Expected 0 calls to Publish, got %v", releaseService.PublishInvocations)
+		}
+	})
+
+	t.Run("when the submitter is not authenticated", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &VersionManagerService{Db: db, Approval: approval.Policy{Organizations: []string{"cie"}}, Identity: proxy}
+
+		req := &services.TerminateVersionRequest{Module: &terrarium.Module{Name: "cie/test/aws", Version: "1.0.0"}}
+
+		_, err := svc.PublishVersion(context.TODO(), req)
+
+		if err != SubmitterRequiredError {
+			t.Errorf("Expected %v, got %v.", SubmitterRequiredError, err)
+		}
+
+		if db.UpdateItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+	})
+
+	t.Run("when the version is already published", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: &types.ConditionalCheckFailedException{Item: map[string]types.AttributeValue{
+			"name":         &types.AttributeValueMemberS{Value: "cie/test/aws"},
+			"published_on": &types.AttributeValueMemberS{Value: "2024-01-01"},
+		}}}
+
+		svc := &VersionManagerService{Db: db, Approval: approval.Policy{Organizations: []string{"cie"}}, Identity: proxy}
+
+		req := &services.TerminateVersionRequest{Module: &terrarium.Module{Name: "cie/test/aws", Version: "1.0.0"}}
+
+		_, err := svc.PublishVersion(callerContext("john"), req)
+
+		if err != VersionAlreadyPublishedError {
+			t.Errorf("Expected %v, got %v.", VersionAlreadyPublishedError, err)
+		}
+
+		if db.UpdateItemIn.ConditionExpression == nil {
+			t.Errorf("Expected the update to be conditional.")
+		}
+	})
+
+	t.Run("when the version does not exist", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: &types.ConditionalCheckFailedException{}}
+
+		svc := &VersionManagerService{Db: db, Approval: approval.Policy{Organizations: []string{"cie"}}, Identity: proxy}
+
+		req := &services.TerminateVersionRequest{Module: &terrarium.Module{Name: "cie/test/aws", Version: "1.0.0"}}
+
+		_, err := svc.PublishVersion(callerContext("john"), req)
+
+		if err != ModuleVersionNotFoundError {
+			t.Errorf("Expected %v, got %v.", ModuleVersionNotFoundError, err)
+		}
+	})
+
+	t.Run("when organization does not require approval", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		releaseService := &releaseMocks.MockPublisherClient{}
+
+		svc := &VersionManagerService{Db: db, ReleaseService: releaseService, Approval: approval.Policy{Organizations: []string{"cie"}}, Identity: proxy}
+
+		req := &services.TerminateVersionRequest{Module: &terrarium.Module{Name: "other/test/aws", Version: "1.0.0"}}
+
+		res, err := svc.PublishVersion(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != VersionPublished {
+			t.Errorf("Expected %v, got %v.", VersionPublished, res)
+		}
+
+		if releaseService.PublishInvocations != 1 {
+			t.Errorf("Expected 1 call to Publish, got %v", releaseService.PublishInvocations)
+		}
+	})
+}
+
+// proxy trusts the gateway the requests of callerContext are made from.
+var proxy, _ = identity.NewTrust([]string{"10.0.0.1"})
+
+// callerContext returns a context of a gRPC request made by the gateway for caller.
+func callerContext(caller string) context.Context {
+	ctx := peer.NewContext(context.TODO(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(identity.MetadataKey, caller))
+}
+
+// hasValue reports whether value is one of the values of an expression.
+func hasValue(values map[string]types.AttributeValue, value string) bool {
+	for _, v := range values {
+		if s, ok := v.(*types.AttributeValueMemberS); ok && s.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Test_ReviewVersion checks:
+// - if an approved version is published and a release is published
+// - if a rejected version does not publish a release
+// - if error is returned when the caller is not authenticated
+// - if error is returned when the identity is not sent by a trusted proxy
+// - if error is returned when the caller submitted the version
+// - if error is returned when the version is not pending approval
+// - if error is returned when UpdateItem fails
+func Test_ReviewVersion(t *testing.T) {
+	t.Parallel()
+
+	module := &terrarium.Module{Name: "cie/test/aws", Version: "1.0.0"}
+
+	t.Run("when version is approved", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		releaseService := &releaseMocks.MockPublisherClient{}
+
+		svc := &VersionManagerService{Db: db, ReleaseService: releaseService, Identity: proxy}
+
+		res, err := svc.ApproveVersion(callerContext("jane"), &terrarium.ReviewVersionRequest{Module: module})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != VersionApproved {
+			t.Errorf("Expected %v, got %v.", VersionApproved, res)
+		}
+
+		if db.UpdateItemInvocations != 1 {
+			t.Errorf("Expected 1 call to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+
+		if releaseService.PublishInvocations != 1 {
+			t.Errorf("Expected 1 call to Publish, got %v", releaseService.PublishInvocations)
+		}
+	})
+
+	t.Run("when version is rejected", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		releaseService := &releaseMocks.MockPublisherClient{}
+
+		svc := &VersionManagerService{Db: db, ReleaseService: releaseService, Identity: proxy}
+
+		res, err := svc.RejectVersion(callerContext("jane"), &terrarium.ReviewVersionRequest{Module: module, Comment: "Missing changelog"})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != VersionRejected {
+			t.Errorf("Expected %v, got %v.", VersionRejected, res)
+		}
+
+		if releaseService.PublishInvocations != 0 {
+			t.Errorf("Expected 0 calls to Publish, got %v", releaseService.PublishInvocations)
+		}
+	})
+
+	t.Run("when the caller is not authenticated", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &VersionManagerService{Db: db, Identity: proxy}
+
+		_, err := svc.ApproveVersion(context.TODO(), &terrarium.ReviewVersionRequest{Module: module})
+
+		if err != ReviewerRequiredError {
+			t.Errorf("Expected %v, got %v.", ReviewerRequiredError, err)
+		}
+
+		if db.UpdateItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+	})
+
+	t.Run("when the identity is not sent by a trusted proxy", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &VersionManagerService{Db: db, Identity: proxy}
+
+		ctx := peer.NewContext(context.TODO(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 50000}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(identity.MetadataKey, "jane"))
+
+		_, err := svc.ApproveVersion(ctx, &terrarium.ReviewVersionRequest{Module: module})
+
+		if err != ReviewerRequiredError {
+			t.Errorf("Expected %v, got %v.", ReviewerRequiredError, err)
+		}
+
+		if db.UpdateItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+	})
+
+	t.Run("when the caller submitted the version", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: &types.ConditionalCheckFailedException{Item: map[string]types.AttributeValue{
+			"approval_status": &types.AttributeValueMemberS{Value: approval.StatusPending},
+			"submitted_by":    &types.AttributeValueMemberS{Value: "jane"},
+		}}}
+		releaseService := &releaseMocks.MockPublisherClient{}
+
+		svc := &VersionManagerService{Db: db, ReleaseService: releaseService, Identity: proxy}
+
+		_, err := svc.ApproveVersion(callerContext("jane"), &terrarium.ReviewVersionRequest{Module: module})
+
+		if err != SelfReviewError {
+			t.Errorf("Expected %v, got %v.", SelfReviewError, err)
+		}
+
+		if releaseService.PublishInvocations != 0 {
+			t.Errorf("Expected 0 calls to Publish, got %v", releaseService.PublishInvocations)
+		}
+	})
+
+	t.Run("when version is not pending approval", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: &types.ConditionalCheckFailedException{}}
+		releaseService := &releaseMocks.MockPublisherClient{}
+
+		svc := &VersionManagerService{Db: db, ReleaseService: releaseService, Identity: proxy}
+
+		_, err := svc.ApproveVersion(callerContext("jane"), &terrarium.ReviewVersionRequest{Module: module})
+
+		if err != VersionNotPendingApprovalError {
+			t.Errorf("Expected %v, got %v.", VersionNotPendingApprovalError, err)
+		}
+
+		if releaseService.PublishInvocations != 0 {
+			t.Errorf("Expected 0 calls to Publish, got %v", releaseService.PublishInvocations)
+		}
+	})
+
+	t.Run("when UpdateItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db, Identity: proxy}
+
+		_, err := svc.RejectVersion(callerContext("jane"), &terrarium.ReviewVersionRequest{Module: module})
+
+		if err != ReviewModuleVersionError {
+			t.Errorf("Expected %v, got %v.", ReviewModuleVersionError, err)
+		}
+	})
+}
+
+// Test_ListPendingVersions checks:
+// - if versions pending approval are returned sorted across scan pages
+// - if error is returned when Scan fails
+func Test_ListPendingVersions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when versions are pending approval", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOuts: []*dynamodb.ScanOutput{
+				{
+					Items: []map[string]types.AttributeValue{
+						{
+							"name":         &types.AttributeValueMemberS{Value: "cie/vpc/aws"},
+							"version":      &types.AttributeValueMemberS{Value: "2.0.0"},
+							"submitted_on": &types.AttributeValueMemberS{Value: "2024-01-02 10:00:00 +0000 UTC"},
+						},
+					},
+					LastEvaluatedKey: map[string]types.AttributeValue{
+						"name": &types.AttributeValueMemberS{Value: "cie/vpc/aws"},
+					},
+				},
+				{
+					Items: []map[string]types.AttributeValue{
+						{
+							"name":    &types.AttributeValueMemberS{Value: "cie/db/aws"},
+							"version": &types.AttributeValueMemberS{Value: "1.0.0"},
+						},
+					},
+				},
+			},
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.ListPendingVersions(context.TODO(), &services.ListPendingVersionsRequest{Organization: "cie"})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if db.ScanItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to Scan, got %v", db.ScanItemInvocations)
+		}
+
+		if len(res.GetVersions()) != 2 {
+			t.Fatalf("Expected 2 versions, got %v", len(res.GetVersions()))
+		}
+
+		if res.GetVersions()[0].GetName() != "cie/db/aws" || res.GetVersions()[1].GetSubmittedOn() != "2024-01-02 10:00:00 +0000 UTC" {
+			t.Errorf("Unexpected pending versions %v", res.GetVersions())
+		}
+	})
+
+	t.Run("when Scan fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.ListPendingVersions(context.TODO(), &services.ListPendingVersionsRequest{})
+
+		if err != ListPendingVersionsError {
+			t.Errorf("Expected %v, got %v.", ListPendingVersionsError, err)
+		}
+	})
+}
+
 // Test_ListModuleVersions checks:
 // - if correct response is returned when versions are fetched
+// - if the versions of every page of the scan are returned
 func Test_ListModuleVersions(t *testing.T) {
 	t.Parallel()
 
@@ -304,4 +708,416 @@ func Test_ListModuleVersions(t *testing.T) {
 
 	})
 
+	t.Run("when versions span several pages", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOuts: []*dynamodb.ScanOutput{
+				{
+					Items: []map[string]types.AttributeValue{
+						{"version": &types.AttributeValueMemberS{Value: "1.0.0"}},
+					},
+					LastEvaluatedKey: map[string]types.AttributeValue{
+						"name":    &types.AttributeValueMemberS{Value: "dummy"},
+						"version": &types.AttributeValueMemberS{Value: "1.0.0"},
+					},
+				},
+				{
+					Items: []map[string]types.AttributeValue{
+						{"version": &types.AttributeValueMemberS{Value: "2.0.0"}},
+					},
+				},
+			},
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.ListModuleVersions(context.TODO(), &services.ListModuleVersionsRequest{Module: "dummy"})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if db.ScanItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to Scan, got %v", db.ScanItemInvocations)
+		}
+
+		expectedVersions := []string{"1.0.0", "2.0.0"}
+		if !reflect.DeepEqual(res.Versions, expectedVersions) {
+			t.Errorf("Versions do not match, got %v, want %v", res.Versions, expectedVersions)
+		}
+	})
+}
+
+// Test_ListLatestModuleVersions checks:
+// - if the latest version of each module is returned
+// - if a scan is made per 100 modules
+// - if error is returned when Scan fails
+func Test_ListLatestModuleVersions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when modules have versions", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOut: &dynamodb.ScanOutput{
+				Items: []map[string]types.AttributeValue{
+					{"name": &types.AttributeValueMemberS{Value: "org/vpc/aws"}, "version": &types.AttributeValueMemberS{Value: "1.10.0"}},
+					{"name": &types.AttributeValueMemberS{Value: "org/vpc/aws"}, "version": &types.AttributeValueMemberS{Value: "1.2.0"}},
+					{"name": &types.AttributeValueMemberS{Value: "org/eks/aws"}, "version": &types.AttributeValueMemberS{Value: "0.1.0"}},
+					{"name": &types.AttributeValueMemberS{Value: "org/eks/aws"}, "version": &types.AttributeValueMemberS{Value: "not a version"}},
+				},
+			},
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.ListLatestModuleVersions(context.TODO(), &services.ListLatestModuleVersionsRequest{Modules: []string{"org/vpc/aws", "org/eks/aws", "org/rds/aws"}})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		expected := map[string]string{"org/vpc/aws": "1.10.0", "org/eks/aws": "0.1.0"}
+		if !reflect.DeepEqual(res.Versions, expected) {
+			t.Errorf("Versions do not match, got %v, want %v", res.Versions, expected)
+		}
+
+		if db.ScanItemInvocations != 1 {
+			t.Errorf("Expected 1 call to Scan, got %v", db.ScanItemInvocations)
+		}
+	})
+
+	t.Run("when more modules than a scan allows are requested", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: &dynamodb.ScanOutput{}}
+		modules := make([]string, 150)
+		for i := range modules {
+			modules[i] = fmt.Sprintf("org/module-%d/aws", i)
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.ListLatestModuleVersions(context.TODO(), &services.ListLatestModuleVersionsRequest{Modules: modules})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if db.ScanItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to Scan, got %v", db.ScanItemInvocations)
+		}
+	})
+
+	t.Run("when Scan fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.ListLatestModuleVersions(context.TODO(), &services.ListLatestModuleVersionsRequest{Modules: []string{"org/vpc/aws"}})
+
+		if err != ListLatestModuleVersionsError {
+			t.Errorf("Expected %v, got %v.", ListLatestModuleVersionsError, err)
+		}
+	})
+}
+
+func draftVersionItem(name, version string, createdOn time.Time) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"name":       &types.AttributeValueMemberS{Value: name},
+		"version":    &types.AttributeValueMemberS{Value: version},
+		"created_on": &types.AttributeValueMemberS{Value: createdOn.String()},
+	}
+}
+
+// Test_ReapDrafts checks:
+// - if only drafts older than the TTL are removed with their source zip and dependencies
+// - if every page of the scan is processed
+// - if the source zip of a draft published since it was listed is kept
+// - if a draft whose source zip fails to be deleted is not counted as reaped
+// - if error is returned when Scan fails
+func Test_ReapDrafts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("when stale drafts are found across pages", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOuts: []*dynamodb.ScanOutput{
+				{
+					Items: []map[string]types.AttributeValue{
+						draftVersionItem("org/stale/aws", "1.0.0", now.Add(-48*time.Hour)),
+						draftVersionItem("org/fresh/aws", "1.0.0", now.Add(-time.Hour)),
+					},
+					LastEvaluatedKey: map[string]types.AttributeValue{
+						"name":    &types.AttributeValueMemberS{Value: "org/fresh/aws"},
+						"version": &types.AttributeValueMemberS{Value: "1.0.0"},
+					},
+				},
+				{
+					Items: []map[string]types.AttributeValue{
+						draftVersionItem("org/other/aws", "2.0.0", now.Add(-25*time.Hour)),
+						{
+							"name":       &types.AttributeValueMemberS{Value: "org/broken/aws"},
+							"version":    &types.AttributeValueMemberS{Value: "1.0.0"},
+							"created_on": &types.AttributeValueMemberS{Value: "not a date"},
+						},
+					},
+				},
+			},
+		}
+		storageClient := &moduleMocks.MockStorageClient{}
+		dependencyManager := &moduleMocks.MockDependencyManagerClient{}
+
+		svc := &VersionManagerService{Db: db, StorageService: storageClient, DependencyManager: dependencyManager, DraftTTL: 24 * time.Hour}
+
+		reaped, err := svc.ReapDrafts(context.TODO(), now)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if reaped != 2 {
+			t.Errorf("Expected 2 drafts to be reaped, got %v", reaped)
+		}
+
+		if db.ScanItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to Scan, got %v", db.ScanItemInvocations)
+		}
+
+		if storageClient.DeleteSourceZipInvocations != 2 {
+			t.Errorf("Expected 2 calls to DeleteSourceZip, got %v", storageClient.DeleteSourceZipInvocations)
+		}
+
+		if db.DeleteItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to DeleteItem, got %v", db.DeleteItemInvocations)
+		}
+
+		if dependencyManager.UnregisterDependenciesInvocations != 2 {
+			t.Errorf("Expected 2 calls to UnregisterDependencies, got %v", dependencyManager.UnregisterDependenciesInvocations)
+		}
+	})
+
+	t.Run("when the draft was published since it was listed", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOut: &dynamodb.ScanOutput{
+				Items: []map[string]types.AttributeValue{
+					draftVersionItem("org/stale/aws", "1.0.0", now.Add(-48*time.Hour)),
+				},
+			},
+			DeleteItemError: &types.ConditionalCheckFailedException{},
+		}
+		storageClient := &moduleMocks.MockStorageClient{}
+		dependencyManager := &moduleMocks.MockDependencyManagerClient{}
+
+		svc := &VersionManagerService{Db: db, StorageService: storageClient, DependencyManager: dependencyManager, DraftTTL: 24 * time.Hour}
+
+		reaped, err := svc.ReapDrafts(context.TODO(), now)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if reaped != 0 {
+			t.Errorf("Expected no drafts to be reaped, got %v", reaped)
+		}
+
+		if storageClient.DeleteSourceZipInvocations != 0 || dependencyManager.UnregisterDependenciesInvocations != 0 {
+			t.Errorf("Expected the source zip and dependencies to be kept, got %v and %v calls",
+				storageClient.DeleteSourceZipInvocations, dependencyManager.UnregisterDependenciesInvocations)
+		}
+	})
+
+	t.Run("when DeleteSourceZip fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOut: &dynamodb.ScanOutput{
+				Items: []map[string]types.AttributeValue{
+					draftVersionItem("org/stale/aws", "1.0.0", now.Add(-48*time.Hour)),
+				},
+			},
+		}
+		storageClient := &moduleMocks.MockStorageClient{DeleteSourceZipError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db, StorageService: storageClient, DraftTTL: 24 * time.Hour}
+
+		reaped, err := svc.ReapDrafts(context.TODO(), now)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if reaped != 0 {
+			t.Errorf("Expected no drafts to be reaped, got %v", reaped)
+		}
+
+		if db.DeleteItemInvocations != 1 {
+			t.Errorf("Expected the draft to be deleted before its source zip, got %v calls to DeleteItem", db.DeleteItemInvocations)
+		}
+	})
+
+	t.Run("when Scan fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db, DraftTTL: 24 * time.Hour}
+
+		reaped, err := svc.ReapDrafts(context.TODO(), now)
+
+		if err != ListDraftVersionsError {
+			t.Errorf("Expected %v, got %v.", ListDraftVersionsError, err)
+		}
+
+		if reaped != 0 {
+			t.Errorf("Expected no drafts to be reaped, got %v", reaped)
+		}
+	})
+}
+
+// Test_SetMaturity checks:
+// - if maturity is updated when the transition is allowed
+// - if maturity can be set on a version without one
+// - if error is returned when the transition is not allowed
+// - if error is returned when the version does not exist
+// - if error is returned when GetItem fails
+// - if error is returned when UpdateItem fails
+func Test_SetMaturity(t *testing.T) {
+	t.Parallel()
+
+	versionItem := func(maturity string) *dynamodb.GetItemOutput {
+		item := map[string]types.AttributeValue{
+			"name":    &types.AttributeValueMemberS{Value: "org/name/aws"},
+			"version": &types.AttributeValueMemberS{Value: "1.0.0"},
+		}
+		if maturity != "" {
+			item["maturity"] = &types.AttributeValueMemberS{Value: maturity}
+		}
+		return &dynamodb.GetItemOutput{Item: item}
+	}
+	req := &terrarium.SetMaturityRequest{
+		Module:   &terrarium.Module{Name: "org/name/aws", Version: "1.0.0"},
+		Maturity: terrarium.Maturity_DEPRECATED,
+		Reason:   "Superseded by 2.0.0",
+	}
+
+	t.Run("when transition is allowed", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{versionItem("STABLE")}}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.SetMaturity(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if db.UpdateItemInvocations != 1 {
+			t.Errorf("Expected 1 call to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+
+		if res != MaturityUpdated {
+			t.Errorf("Expected %v, got %v.", MaturityUpdated, res)
+		}
+	})
+
+	t.Run("when version has no maturity", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{versionItem("")}}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.SetMaturity(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if db.UpdateItemInvocations != 1 {
+			t.Errorf("Expected 1 call to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+	})
+
+	t.Run("when transition is not allowed", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{versionItem("END_OF_LIFE")}}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.SetMaturity(context.TODO(), req)
+
+		if res != nil {
+			t.Errorf("Expected no response, got %v", res)
+		}
+
+		if db.UpdateItemInvocations != 0 {
+			t.Errorf("Expected no calls to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+
+		if err != MaturityTransitionError {
+			t.Errorf("Expected %v, got %v.", MaturityTransitionError, err)
+		}
+	})
+
+	t.Run("when version does not exist", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{{}}}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.SetMaturity(context.TODO(), req)
+
+		if err != ModuleVersionNotFoundError {
+			t.Errorf("Expected %v, got %v.", ModuleVersionNotFoundError, err)
+		}
+	})
+
+	t.Run("when GetItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemErrors: []error{errors.New("some error")}}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.SetMaturity(context.TODO(), req)
+
+		if err != ModuleVersionGetError {
+			t.Errorf("Expected %v, got %v.", ModuleVersionGetError, err)
+		}
+	})
+
+	t.Run("when UpdateItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts:     []*dynamodb.GetItemOutput{versionItem("STABLE")},
+			UpdateItemError: errors.New("some error"),
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.SetMaturity(context.TODO(), req)
+
+		if err != SetMaturityError {
+			t.Errorf("Expected %v, got %v.", SetMaturityError, err)
+		}
+	})
+}
+
+// Test_ListModuleVersionsMaturities checks:
+// - if the maturity of versions that have one is reported
+func Test_ListModuleVersionsMaturities(t *testing.T) {
+	t.Parallel()
+
+	db := &mocks.DynamoDB{
+		ScanOut: &dynamodb.ScanOutput{
+			Items: []map[string]types.AttributeValue{
+				{
+					"version":  &types.AttributeValueMemberS{Value: "1.0.0"},
+					"maturity": &types.AttributeValueMemberS{Value: "DEPRECATED"},
+				},
+				{
+					"version": &types.AttributeValueMemberS{Value: "1.1.0"},
+				},
+			},
+		},
+	}
+
+	svc := &VersionManagerService{Db: db}
+
+	res, err := svc.ListModuleVersions(context.TODO(), &services.ListModuleVersionsRequest{Module: "org/name/aws"})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	expected := map[string]terrarium.Maturity{"1.0.0": terrarium.Maturity_DEPRECATED}
+	if !reflect.DeepEqual(res.Maturities, expected) {
+		t.Errorf("Maturities do not match, got %v, want %v", res.Maturities, expected)
+	}
 }
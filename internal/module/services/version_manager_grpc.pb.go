@@ -20,10 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	VersionManager_BeginVersion_FullMethodName       = "/terrarium.module.services.VersionManager/BeginVersion"
-	VersionManager_AbortVersion_FullMethodName       = "/terrarium.module.services.VersionManager/AbortVersion"
-	VersionManager_PublishVersion_FullMethodName     = "/terrarium.module.services.VersionManager/PublishVersion"
-	VersionManager_ListModuleVersions_FullMethodName = "/terrarium.module.services.VersionManager/ListModuleVersions"
+	VersionManager_BeginVersion_FullMethodName             = "/terrarium.module.services.VersionManager/BeginVersion"
+	VersionManager_AbortVersion_FullMethodName             = "/terrarium.module.services.VersionManager/AbortVersion"
+	VersionManager_PublishVersion_FullMethodName           = "/terrarium.module.services.VersionManager/PublishVersion"
+	VersionManager_ListModuleVersions_FullMethodName       = "/terrarium.module.services.VersionManager/ListModuleVersions"
+	VersionManager_ListLatestModuleVersions_FullMethodName = "/terrarium.module.services.VersionManager/ListLatestModuleVersions"
+	VersionManager_SetMaturity_FullMethodName              = "/terrarium.module.services.VersionManager/SetMaturity"
+	VersionManager_ApproveVersion_FullMethodName           = "/terrarium.module.services.VersionManager/ApproveVersion"
+	VersionManager_RejectVersion_FullMethodName            = "/terrarium.module.services.VersionManager/RejectVersion"
+	VersionManager_ListPendingVersions_FullMethodName      = "/terrarium.module.services.VersionManager/ListPendingVersions"
 )
 
 // VersionManagerClient is the client API for VersionManager service.
@@ -34,6 +39,11 @@ type VersionManagerClient interface {
 	AbortVersion(ctx context.Context, in *TerminateVersionRequest, opts ...grpc.CallOption) (*module.Response, error)
 	PublishVersion(ctx context.Context, in *TerminateVersionRequest, opts ...grpc.CallOption) (*module.Response, error)
 	ListModuleVersions(ctx context.Context, in *ListModuleVersionsRequest, opts ...grpc.CallOption) (*ListModuleVersionsResponse, error)
+	ListLatestModuleVersions(ctx context.Context, in *ListLatestModuleVersionsRequest, opts ...grpc.CallOption) (*ListLatestModuleVersionsResponse, error)
+	SetMaturity(ctx context.Context, in *module.SetMaturityRequest, opts ...grpc.CallOption) (*module.Response, error)
+	ApproveVersion(ctx context.Context, in *module.ReviewVersionRequest, opts ...grpc.CallOption) (*module.Response, error)
+	RejectVersion(ctx context.Context, in *module.ReviewVersionRequest, opts ...grpc.CallOption) (*module.Response, error)
+	ListPendingVersions(ctx context.Context, in *ListPendingVersionsRequest, opts ...grpc.CallOption) (*ListPendingVersionsResponse, error)
 }
 
 type versionManagerClient struct {
@@ -80,6 +90,51 @@ func (c *versionManagerClient) ListModuleVersions(ctx context.Context, in *ListM
 	return out, nil
 }
 
+func (c *versionManagerClient) ListLatestModuleVersions(ctx context.Context, in *ListLatestModuleVersionsRequest, opts ...grpc.CallOption) (*ListLatestModuleVersionsResponse, error) {
+	out := new(ListLatestModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, VersionManager_ListLatestModuleVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionManagerClient) SetMaturity(ctx context.Context, in *module.SetMaturityRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	out := new(module.Response)
+	err := c.cc.Invoke(ctx, VersionManager_SetMaturity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionManagerClient) ApproveVersion(ctx context.Context, in *module.ReviewVersionRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	out := new(module.Response)
+	err := c.cc.Invoke(ctx, VersionManager_ApproveVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionManagerClient) RejectVersion(ctx context.Context, in *module.ReviewVersionRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	out := new(module.Response)
+	err := c.cc.Invoke(ctx, VersionManager_RejectVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionManagerClient) ListPendingVersions(ctx context.Context, in *ListPendingVersionsRequest, opts ...grpc.CallOption) (*ListPendingVersionsResponse, error) {
+	out := new(ListPendingVersionsResponse)
+	err := c.cc.Invoke(ctx, VersionManager_ListPendingVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VersionManagerServer is the server API for VersionManager service.
 // All implementations must embed UnimplementedVersionManagerServer
 // for forward compatibility
@@ -88,6 +143,11 @@ type VersionManagerServer interface {
 	AbortVersion(context.Context, *TerminateVersionRequest) (*module.Response, error)
 	PublishVersion(context.Context, *TerminateVersionRequest) (*module.Response, error)
 	ListModuleVersions(context.Context, *ListModuleVersionsRequest) (*ListModuleVersionsResponse, error)
+	ListLatestModuleVersions(context.Context, *ListLatestModuleVersionsRequest) (*ListLatestModuleVersionsResponse, error)
+	SetMaturity(context.Context, *module.SetMaturityRequest) (*module.Response, error)
+	ApproveVersion(context.Context, *module.ReviewVersionRequest) (*module.Response, error)
+	RejectVersion(context.Context, *module.ReviewVersionRequest) (*module.Response, error)
+	ListPendingVersions(context.Context, *ListPendingVersionsRequest) (*ListPendingVersionsResponse, error)
 	mustEmbedUnimplementedVersionManagerServer()
 }
 
@@ -107,6 +167,21 @@ func (UnimplementedVersionManagerServer) PublishVersion(context.Context, *Termin
 func (UnimplementedVersionManagerServer) ListModuleVersions(context.Context, *ListModuleVersionsRequest) (*ListModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListModuleVersions not implemented")
 }
+func (UnimplementedVersionManagerServer) ListLatestModuleVersions(context.Context, *ListLatestModuleVersionsRequest) (*ListLatestModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLatestModuleVersions not implemented")
+}
+func (UnimplementedVersionManagerServer) SetMaturity(context.Context, *module.SetMaturityRequest) (*module.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaturity not implemented")
+}
+func (UnimplementedVersionManagerServer) ApproveVersion(context.Context, *module.ReviewVersionRequest) (*module.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveVersion not implemented")
+}
+func (UnimplementedVersionManagerServer) RejectVersion(context.Context, *module.ReviewVersionRequest) (*module.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectVersion not implemented")
+}
+func (UnimplementedVersionManagerServer) ListPendingVersions(context.Context, *ListPendingVersionsRequest) (*ListPendingVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingVersions not implemented")
+}
 func (UnimplementedVersionManagerServer) mustEmbedUnimplementedVersionManagerServer() {}
 
 // UnsafeVersionManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +267,96 @@ func _VersionManager_ListModuleVersions_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VersionManager_ListLatestModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLatestModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).ListLatestModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_ListLatestModuleVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).ListLatestModuleVersions(ctx, req.(*ListLatestModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionManager_SetMaturity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.SetMaturityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).SetMaturity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_SetMaturity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).SetMaturity(ctx, req.(*module.SetMaturityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionManager_ApproveVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.ReviewVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).ApproveVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_ApproveVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).ApproveVersion(ctx, req.(*module.ReviewVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionManager_RejectVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(module.ReviewVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).RejectVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_RejectVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).RejectVersion(ctx, req.(*module.ReviewVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionManager_ListPendingVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).ListPendingVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_ListPendingVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).ListPendingVersions(ctx, req.(*ListPendingVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VersionManager_ServiceDesc is the grpc.ServiceDesc for VersionManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -215,6 +380,26 @@ var VersionManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListModuleVersions",
 			Handler:    _VersionManager_ListModuleVersions_Handler,
 		},
+		{
+			MethodName: "ListLatestModuleVersions",
+			Handler:    _VersionManager_ListLatestModuleVersions_Handler,
+		},
+		{
+			MethodName: "SetMaturity",
+			Handler:    _VersionManager_SetMaturity_Handler,
+		},
+		{
+			MethodName: "ApproveVersion",
+			Handler:    _VersionManager_ApproveVersion_Handler,
+		},
+		{
+			MethodName: "RejectVersion",
+			Handler:    _VersionManager_RejectVersion_Handler,
+		},
+		{
+			MethodName: "ListPendingVersions",
+			Handler:    _VersionManager_ListPendingVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb/terrarium/module/services/version_manager.proto",
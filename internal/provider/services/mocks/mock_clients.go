@@ -29,6 +29,13 @@ type MockProviderStorageClient struct {
 	UploadShasumSignatureInvocations   int
 	UploadShasumSignatureClient        providerServices.Storage_UploadShasumSignatureClient
 	UploadShasumSignatureError         error
+	DeleteProviderVersionInvocations   int
+	DeleteProviderVersionResponse      *terrariumProvider.Response
+	DeleteProviderVersionError         error
+	GetArchiveHashInvocations          int
+	GetArchiveHashRequests             []*providerServices.DownloadSourceZipRequest
+	GetArchiveHashResponse             *providerServices.ArchiveHashResponse
+	GetArchiveHashError                error
 }
 
 func (m *MockProviderStorageClient) DownloadProviderSourceZip(ctx context.Context, in *providerServices.DownloadSourceZipRequest, opts ...grpc.CallOption) (providerServices.Storage_DownloadProviderSourceZipClient, error) {
@@ -61,6 +68,17 @@ func (m *MockProviderStorageClient) UploadShasumSignature(ctx context.Context, o
 	return m.UploadShasumSignatureClient, m.UploadShasumSignatureError
 }
 
+func (m *MockProviderStorageClient) DeleteProviderVersion(ctx context.Context, in *providerServices.DeleteProviderVersionRequest, opts ...grpc.CallOption) (*terrariumProvider.Response, error) {
+	m.DeleteProviderVersionInvocations++
+	return m.DeleteProviderVersionResponse, m.DeleteProviderVersionError
+}
+
+func (m *MockProviderStorageClient) GetArchiveHash(ctx context.Context, in *providerServices.DownloadSourceZipRequest, opts ...grpc.CallOption) (*providerServices.ArchiveHashResponse, error) {
+	m.GetArchiveHashInvocations++
+	m.GetArchiveHashRequests = append(m.GetArchiveHashRequests, in)
+	return m.GetArchiveHashResponse, m.GetArchiveHashError
+}
+
 type MockStorage_DownloadProviderSourceZipClient struct {
 	providerServices.Storage_DownloadProviderSourceZipClient
 	RecvInvocations      int
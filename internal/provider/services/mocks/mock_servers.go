@@ -163,3 +163,37 @@ func (mus *MockUploadShasumSignatureServer) Recv() (*provider.UploadShasumReques
 	}
 	return mus.RecvRequest, mus.RecvError
 }
+
+type MockProviderConsumer_DownloadProviderBinaryZipServer struct {
+	provider.ProviderConsumer_DownloadProviderBinaryZipServer
+	SendInvocations int
+	SendResponse    *provider.ProviderBinaryZipResponse
+	SendError       error
+}
+
+func (m *MockProviderConsumer_DownloadProviderBinaryZipServer) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *MockProviderConsumer_DownloadProviderBinaryZipServer) Send(res *provider.ProviderBinaryZipResponse) error {
+	m.SendInvocations++
+	m.SendResponse = res
+	return m.SendError
+}
+
+type MockProviderConsumer_DownloadShasumServer struct {
+	provider.ProviderConsumer_DownloadShasumServer
+	SendInvocations int
+	SendResponse    *provider.ShasumResponse
+	SendError       error
+}
+
+func (m *MockProviderConsumer_DownloadShasumServer) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *MockProviderConsumer_DownloadShasumServer) Send(res *provider.ShasumResponse) error {
+	m.SendInvocations++
+	m.SendResponse = res
+	return m.SendError
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
-// 	protoc        v3.12.4
+// 	protoc-gen-go v1.35.1
+// 	protoc        v4.24.4
 // source: pb/terrarium/provider/services/storage.proto
 
 package services
@@ -34,11 +34,9 @@ type ProviderRequest struct {
 
 func (x *ProviderRequest) Reset() {
 	*x = ProviderRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ProviderRequest) String() string {
@@ -49,7 +47,7 @@ func (*ProviderRequest) ProtoMessage() {}
 
 func (x *ProviderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -102,11 +100,9 @@ type DownloadSourceZipRequest struct {
 
 func (x *DownloadSourceZipRequest) Reset() {
 	*x = DownloadSourceZipRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DownloadSourceZipRequest) String() string {
@@ -117,7 +113,7 @@ func (*DownloadSourceZipRequest) ProtoMessage() {}
 
 func (x *DownloadSourceZipRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -149,11 +145,9 @@ type SourceZipResponse struct {
 
 func (x *SourceZipResponse) Reset() {
 	*x = SourceZipResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SourceZipResponse) String() string {
@@ -164,7 +158,7 @@ func (*SourceZipResponse) ProtoMessage() {}
 
 func (x *SourceZipResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -196,11 +190,9 @@ type DownloadShasumRequest struct {
 
 func (x *DownloadShasumRequest) Reset() {
 	*x = DownloadShasumRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DownloadShasumRequest) String() string {
@@ -211,7 +203,7 @@ func (*DownloadShasumRequest) ProtoMessage() {}
 
 func (x *DownloadShasumRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -243,11 +235,9 @@ type DownloadShasumResponse struct {
 
 func (x *DownloadShasumResponse) Reset() {
 	*x = DownloadShasumResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DownloadShasumResponse) String() string {
@@ -258,7 +248,7 @@ func (*DownloadShasumResponse) ProtoMessage() {}
 
 func (x *DownloadShasumResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -280,6 +270,97 @@ func (x *DownloadShasumResponse) GetShasumDataChunk() []byte {
 	return nil
 }
 
+type DeleteProviderVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider *provider.Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (x *DeleteProviderVersionRequest) Reset() {
+	*x = DeleteProviderVersionRequest{}
+	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProviderVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProviderVersionRequest) ProtoMessage() {}
+
+func (x *DeleteProviderVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProviderVersionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderVersionRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_provider_services_storage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteProviderVersionRequest) GetProvider() *provider.Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+// Hash of the content of a provider archive, in the h1: format Terraform records in its dependency lock file.
+type ArchiveHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *ArchiveHashResponse) Reset() {
+	*x = ArchiveHashResponse{}
+	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveHashResponse) ProtoMessage() {}
+
+func (x *ArchiveHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_provider_services_storage_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveHashResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveHashResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_provider_services_storage_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ArchiveHashResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
 var File_pb_terrarium_provider_services_storage_proto protoreflect.FileDescriptor
 
 var file_pb_terrarium_provider_services_storage_proto_rawDesc = []byte{
@@ -316,56 +397,79 @@ var file_pb_terrarium_provider_services_storage_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x5f, 0x64,
 	0x61, 0x74, 0x61, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
 	0x0f, 0x73, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x32, 0xca, 0x05, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x86, 0x01, 0x0a,
-	0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x12, 0x35, 0x2e, 0x74, 0x65, 0x72,
-	0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7d, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x12, 0x32, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
-	0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68,
-	0x61, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x74, 0x65,
-	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x86, 0x01, 0x0a, 0x17, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x32, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x22, 0x58, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x13, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x32, 0xbb, 0x07, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x86, 0x01, 0x0a, 0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x12,
+	0x35, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7d, 0x0a, 0x0e, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x12, 0x32, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
 	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
-	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6f, 0x0a,
-	0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42,
-	0x69, 0x6e, 0x61, 0x72, 0x79, 0x5a, 0x69, 0x70, 0x12, 0x32, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
-	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x5a, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x86, 0x01, 0x0a, 0x17, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73,
+	0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x74, 0x65, 0x72, 0x72,
+	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x6f, 0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5a, 0x69, 0x70, 0x12, 0x32, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5a, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x28, 0x01, 0x12, 0x59, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61,
+	0x73, 0x75, 0x6d, 0x12, 0x27, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x68, 0x61, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74,
 	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x59,
-	0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x12, 0x27,
-	0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
-	0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x62, 0x0a, 0x15, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x27, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68,
-	0x61, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x62,
+	0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x68, 0x61, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x28, 0x01, 0x12, 0x72, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x74, 0x65,
 	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x42, 0x40, 0x5a,
-	0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x72, 0x72,
-	0x61, 0x72, 0x69, 0x75, 0x6d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61,
-	0x72, 0x69, 0x75, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x35, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -380,38 +484,45 @@ func file_pb_terrarium_provider_services_storage_proto_rawDescGZIP() []byte {
 	return file_pb_terrarium_provider_services_storage_proto_rawDescData
 }
 
-var file_pb_terrarium_provider_services_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_pb_terrarium_provider_services_storage_proto_goTypes = []interface{}{
+var file_pb_terrarium_provider_services_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pb_terrarium_provider_services_storage_proto_goTypes = []any{
 	(*ProviderRequest)(nil),                         // 0: terrarium.provider.services.ProviderRequest
 	(*DownloadSourceZipRequest)(nil),                // 1: terrarium.provider.services.DownloadSourceZipRequest
 	(*SourceZipResponse)(nil),                       // 2: terrarium.provider.services.SourceZipResponse
 	(*DownloadShasumRequest)(nil),                   // 3: terrarium.provider.services.DownloadShasumRequest
 	(*DownloadShasumResponse)(nil),                  // 4: terrarium.provider.services.DownloadShasumResponse
-	(*provider.Provider)(nil),                       // 5: terrarium.provider.Provider
-	(*provider.UploadProviderBinaryZipRequest)(nil), // 6: terrarium.provider.UploadProviderBinaryZipRequest
-	(*provider.UploadShasumRequest)(nil),            // 7: terrarium.provider.UploadShasumRequest
-	(*provider.Response)(nil),                       // 8: terrarium.provider.Response
+	(*DeleteProviderVersionRequest)(nil),            // 5: terrarium.provider.services.DeleteProviderVersionRequest
+	(*ArchiveHashResponse)(nil),                     // 6: terrarium.provider.services.ArchiveHashResponse
+	(*provider.Provider)(nil),                       // 7: terrarium.provider.Provider
+	(*provider.UploadProviderBinaryZipRequest)(nil), // 8: terrarium.provider.UploadProviderBinaryZipRequest
+	(*provider.UploadShasumRequest)(nil),            // 9: terrarium.provider.UploadShasumRequest
+	(*provider.Response)(nil),                       // 10: terrarium.provider.Response
 }
 var file_pb_terrarium_provider_services_storage_proto_depIdxs = []int32{
-	0, // 0: terrarium.provider.services.DownloadSourceZipRequest.provider:type_name -> terrarium.provider.services.ProviderRequest
-	5, // 1: terrarium.provider.services.DownloadShasumRequest.provider:type_name -> terrarium.provider.Provider
-	1, // 2: terrarium.provider.services.Storage.DownloadProviderSourceZip:input_type -> terrarium.provider.services.DownloadSourceZipRequest
-	3, // 3: terrarium.provider.services.Storage.DownloadShasum:input_type -> terrarium.provider.services.DownloadShasumRequest
-	3, // 4: terrarium.provider.services.Storage.DownloadShasumSignature:input_type -> terrarium.provider.services.DownloadShasumRequest
-	6, // 5: terrarium.provider.services.Storage.UploadProviderBinaryZip:input_type -> terrarium.provider.UploadProviderBinaryZipRequest
-	7, // 6: terrarium.provider.services.Storage.UploadShasum:input_type -> terrarium.provider.UploadShasumRequest
-	7, // 7: terrarium.provider.services.Storage.UploadShasumSignature:input_type -> terrarium.provider.UploadShasumRequest
-	2, // 8: terrarium.provider.services.Storage.DownloadProviderSourceZip:output_type -> terrarium.provider.services.SourceZipResponse
-	4, // 9: terrarium.provider.services.Storage.DownloadShasum:output_type -> terrarium.provider.services.DownloadShasumResponse
-	4, // 10: terrarium.provider.services.Storage.DownloadShasumSignature:output_type -> terrarium.provider.services.DownloadShasumResponse
-	8, // 11: terrarium.provider.services.Storage.UploadProviderBinaryZip:output_type -> terrarium.provider.Response
-	8, // 12: terrarium.provider.services.Storage.UploadShasum:output_type -> terrarium.provider.Response
-	8, // 13: terrarium.provider.services.Storage.UploadShasumSignature:output_type -> terrarium.provider.Response
-	8, // [8:14] is the sub-list for method output_type
-	2, // [2:8] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: terrarium.provider.services.DownloadSourceZipRequest.provider:type_name -> terrarium.provider.services.ProviderRequest
+	7,  // 1: terrarium.provider.services.DownloadShasumRequest.provider:type_name -> terrarium.provider.Provider
+	7,  // 2: terrarium.provider.services.DeleteProviderVersionRequest.provider:type_name -> terrarium.provider.Provider
+	1,  // 3: terrarium.provider.services.Storage.DownloadProviderSourceZip:input_type -> terrarium.provider.services.DownloadSourceZipRequest
+	3,  // 4: terrarium.provider.services.Storage.DownloadShasum:input_type -> terrarium.provider.services.DownloadShasumRequest
+	3,  // 5: terrarium.provider.services.Storage.DownloadShasumSignature:input_type -> terrarium.provider.services.DownloadShasumRequest
+	8,  // 6: terrarium.provider.services.Storage.UploadProviderBinaryZip:input_type -> terrarium.provider.UploadProviderBinaryZipRequest
+	9,  // 7: terrarium.provider.services.Storage.UploadShasum:input_type -> terrarium.provider.UploadShasumRequest
+	9,  // 8: terrarium.provider.services.Storage.UploadShasumSignature:input_type -> terrarium.provider.UploadShasumRequest
+	5,  // 9: terrarium.provider.services.Storage.DeleteProviderVersion:input_type -> terrarium.provider.services.DeleteProviderVersionRequest
+	1,  // 10: terrarium.provider.services.Storage.GetArchiveHash:input_type -> terrarium.provider.services.DownloadSourceZipRequest
+	2,  // 11: terrarium.provider.services.Storage.DownloadProviderSourceZip:output_type -> terrarium.provider.services.SourceZipResponse
+	4,  // 12: terrarium.provider.services.Storage.DownloadShasum:output_type -> terrarium.provider.services.DownloadShasumResponse
+	4,  // 13: terrarium.provider.services.Storage.DownloadShasumSignature:output_type -> terrarium.provider.services.DownloadShasumResponse
+	10, // 14: terrarium.provider.services.Storage.UploadProviderBinaryZip:output_type -> terrarium.provider.Response
+	10, // 15: terrarium.provider.services.Storage.UploadShasum:output_type -> terrarium.provider.Response
+	10, // 16: terrarium.provider.services.Storage.UploadShasumSignature:output_type -> terrarium.provider.Response
+	10, // 17: terrarium.provider.services.Storage.DeleteProviderVersion:output_type -> terrarium.provider.Response
+	6,  // 18: terrarium.provider.services.Storage.GetArchiveHash:output_type -> terrarium.provider.services.ArchiveHashResponse
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_pb_terrarium_provider_services_storage_proto_init() }
@@ -419,75 +530,13 @@ func file_pb_terrarium_provider_services_storage_proto_init() {
 	if File_pb_terrarium_provider_services_storage_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pb_terrarium_provider_services_storage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_provider_services_storage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadSourceZipRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_provider_services_storage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SourceZipResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_provider_services_storage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadShasumRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_provider_services_storage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadShasumResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_terrarium_provider_services_storage_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
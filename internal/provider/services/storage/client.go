@@ -108,6 +108,28 @@ func (s storageGrpcClient) UploadShasumSignature(ctx context.Context, opts ...gr
 	}
 }
 
+func (s storageGrpcClient) DeleteProviderVersion(ctx context.Context, in *services.DeleteProviderVersionRequest, opts ...grpc.CallOption) (*provider.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(s.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewStorageClient(conn)
+		return client.DeleteProviderVersion(ctx, in, opts...)
+	}
+}
+
+func (s storageGrpcClient) GetArchiveHash(ctx context.Context, in *services.DownloadSourceZipRequest, opts ...grpc.CallOption) (*services.ArchiveHashResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(s.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewStorageClient(conn)
+		return client.GetArchiveHash(ctx, in, opts...)
+	}
+}
+
 type downloadSourceZipClient struct {
 	conn   *grpc.ClientConn
 	client services.Storage_DownloadProviderSourceZipClient
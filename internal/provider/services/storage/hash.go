@@ -0,0 +1,179 @@
+package storage
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/terrariumcloud/terrarium/internal/provider/services"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// archiveHashSuffix is appended to the key of an archive to store its hash next to it
+	archiveHashSuffix = ".h1"
+	archiveHashScheme = "h1:"
+)
+
+var (
+	GetArchiveHashError  = status.Error(codes.Unknown, "Failed to get archive hash.")
+	HashArchiveError     = status.Error(codes.Unknown, "Failed to hash archive.")
+	ArchiveNotFoundError = status.Error(codes.NotFound, "Provider archive not found.")
+)
+
+// archiveLocation returns the key of the archive of a provider version for a platform.
+func archiveLocation(provider *services.ProviderRequest) string {
+	name := provider.GetName()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	filename := fmt.Sprintf("terraform-provider-%s_%s_%s_%s.zip", name, provider.GetVersion(), provider.GetOs(), provider.GetArch())
+	return ResolveS3Locations(provider.GetName(), provider.GetVersion(), filename)
+}
+
+// storeArchiveHash hashes an archive and stores the hash next to it. Failures are recorded, the hash being computed
+// again from the archive when requested.
+func (s *StorageService) storeArchiveHash(ctx context.Context, location string, archive []byte) (string, error) {
+	span := trace.SpanFromContext(ctx)
+
+	hash, err := hashArchive(archive)
+	if err != nil {
+		log.Printf("Failed to hash %s: %v", location, err)
+		span.RecordError(err)
+		return "", HashArchiveError
+	}
+
+	if _, err := s.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(BucketName),
+		Key:    aws.String(location + archiveHashSuffix),
+		Body:   strings.NewReader(hash),
+	}); err != nil {
+		log.Printf("Failed to store the hash of %s: %v", location, err)
+		span.RecordError(err)
+	}
+	return hash, nil
+}
+
+// GetArchiveHash returns the h1: hash of the archive of a provider version for a platform. Archives uploaded before
+// their hash was stored are hashed when requested.
+func (s *StorageService) GetArchiveHash(ctx context.Context, request *services.DownloadSourceZipRequest) (*services.ArchiveHashResponse, error) {
+	log.Println("Getting archive hash.")
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.name", request.GetProvider().GetName()),
+		attribute.String("provider.version", request.GetProvider().GetVersion()),
+		attribute.String("provider.os", request.GetProvider().GetOs()),
+		attribute.String("provider.arch", request.GetProvider().GetArch()),
+	)
+
+	location := archiveLocation(request.GetProvider())
+	var noSuchKey *types.NoSuchKey
+
+	out, err := s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(BucketName),
+		Key:    aws.String(location + archiveHashSuffix),
+	})
+	if err == nil {
+		defer func() { _ = out.Body.Close() }()
+		hash, err := io.ReadAll(out.Body)
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, GetArchiveHashError
+		}
+		return &services.ArchiveHashResponse{Hash: string(hash)}, nil
+	}
+	if !errors.As(err, &noSuchKey) {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, GetArchiveHashError
+	}
+
+	out, err = s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(BucketName),
+		Key:    aws.String(location),
+	})
+	if err != nil {
+		span.RecordError(err)
+		if errors.As(err, &noSuchKey) {
+			return nil, ArchiveNotFoundError
+		}
+		log.Println(err)
+		return nil, DownloadSourceZipError
+	}
+	defer func() { _ = out.Body.Close() }()
+
+	archive, err := io.ReadAll(out.Body)
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, DownloadSourceZipError
+	}
+
+	hash, err := s.storeArchiveHash(ctx, location, archive)
+	if err != nil {
+		return nil, err
+	}
+	return &services.ArchiveHashResponse{Hash: hash}, nil
+}
+
+// hashArchive returns the h1: hash of the files of an archive, as Terraform computes it over the unpacked package:
+// the SHA-256 of the sorted lines holding the SHA-256 and the path of each file.
+func hashArchive(archive []byte) (string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return "", err
+	}
+
+	files := make([]*zip.File, 0, len(reader.File))
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		if strings.Contains(file.Name, "\n") {
+			return "", fmt.Errorf("file name %q holds a newline", file.Name)
+		}
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+
+	summary := sha256.New()
+	for _, file := range files {
+		sum, err := hashFile(file)
+		if err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(summary, "%x  %s\n", sum, file.Name)
+	}
+	return archiveHashScheme + base64.StdEncoding.EncodeToString(summary.Sum(nil)), nil
+}
+
+func hashFile(file *zip.File) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, rc); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/terrariumcloud/terrarium/internal/provider/services"
+	mocks2 "github.com/terrariumcloud/terrarium/internal/storage/mocks"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const providerArchiveHash = "h1:op5RepFqqPlct0b5Ys52ky99KwvMK2dJSDUYTTVDvUo="
+
+// providerArchive returns the archive of a provider binary and its license, whose hash is providerArchiveHash.
+func providerArchive(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"terraform-provider-test_v1.0.0", "#!/bin/sh\necho provider\n"},
+		{"LICENSE", "MIT\n"},
+	}
+	for _, file := range files {
+		w, err := writer.Create(file.name)
+		if err != nil {
+			t.Fatalf("Failed to create %s: %v", file.name, err)
+		}
+		if _, err := w.Write([]byte(file.content)); err != nil {
+			t.Fatalf("Failed to write %s: %v", file.name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func objectOf(data []byte) *s3.GetObjectOutput {
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}
+}
+
+// Test_GetArchiveHash checks:
+// - if the stored hash is returned
+// - if the archive is hashed and the hash stored when it is missing
+// - if NotFound is returned when the archive is missing too
+// - if error is returned when GetObject fails
+func Test_GetArchiveHash(t *testing.T) {
+	t.Parallel()
+
+	req := &services.DownloadSourceZipRequest{Provider: &services.ProviderRequest{Name: "cie/test", Version: "1.0.0", Os: "linux", Arch: "amd64"}}
+
+	t.Run("when the hash is stored", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectOut: objectOf([]byte(providerArchiveHash))}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.GetArchiveHash(context.TODO(), req)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.Filename != "cie/test/1.0.0/terraform-provider-test_1.0.0_linux_amd64.zip.h1" {
+			t.Errorf("Expected the stored hash to be read, got %v", s3Client.Filename)
+		}
+
+		if res.GetHash() != providerArchiveHash {
+			t.Errorf("Expected %v, got %v", providerArchiveHash, res.GetHash())
+		}
+	})
+
+	t.Run("when the hash is missing", func(t *testing.T) {
+		s3Client := &mocks2.S3{
+			GetObjectOuts:   []*s3.GetObjectOutput{nil, objectOf(providerArchive(t))},
+			GetObjectErrors: []error{&types.NoSuchKey{}},
+		}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.GetArchiveHash(context.TODO(), req)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.PutObjectInvocations != 1 || !strings.HasSuffix(s3Client.Filename, ".zip.h1") {
+			t.Errorf("Expected the hash to be stored, got %v calls to PutObject for %v", s3Client.PutObjectInvocations, s3Client.Filename)
+		}
+
+		if res.GetHash() != providerArchiveHash {
+			t.Errorf("Expected %v, got %v", providerArchiveHash, res.GetHash())
+		}
+	})
+
+	t.Run("when the archive is missing", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectError: &types.NoSuchKey{}}
+
+		svc := &StorageService{Client: s3Client}
+
+		_, err := svc.GetArchiveHash(context.TODO(), req)
+
+		if err != ArchiveNotFoundError {
+			t.Errorf("Expected %v, got %v.", ArchiveNotFoundError, err)
+		}
+	})
+
+	t.Run("when GetObject fails", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectError: errors.New("some error")}
+
+		svc := &StorageService{Client: s3Client}
+
+		_, err := svc.GetArchiveHash(context.TODO(), req)
+
+		if err != GetArchiveHashError {
+			t.Errorf("Expected %v, got %v.", GetArchiveHashError, err)
+		}
+	})
+}
+
+func Test_hashArchive(t *testing.T) {
+	got, err := hashArchive(providerArchive(t))
+	if err != nil {
+		t.Fatalf("hashArchive() error = %v", err)
+	}
+	if got != providerArchiveHash {
+		t.Errorf("hashArchive() = %v, want %v", got, providerArchiveHash)
+	}
+
+	if _, err := hashArchive(make([]byte, 100)); err == nil {
+		t.Errorf("hashArchive() expected an error for an invalid archive")
+	}
+}
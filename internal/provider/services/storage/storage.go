@@ -2,6 +2,8 @@ package storage
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -36,18 +39,21 @@ var (
 	BinaryZipUploaded = &terrarium.Response{Message: "Binary zip uploaded successfully."}
 	ShasumUploaded    = &terrarium.Response{Message: "Shasum file uploaded successfully."}
 	ShasumSigUploaded = &terrarium.Response{Message: "Shasum signature uploaded successfully."}
+	VersionDeleted    = &terrarium.Response{Message: "Provider version artifacts deleted successfully."}
 
 	BucketInitializationError = status.Error(codes.Unknown, "Failed to initialize bucket for storage.")
 	DownloadSourceZipError    = status.Error(codes.Unknown, "Failed to download source zip.")
 	SendSourceZipError        = status.Error(codes.Unknown, "Failed to send source zip.")
 	SendShasumError           = status.Error(codes.Unknown, "Failed to send shasum file.")
 	DownloadShasumError       = status.Error(codes.Unknown, "Failed to download shasum.")
+	ShasumNotFoundError       = status.Error(codes.NotFound, "Shasum not found.")
 	UploadBinaryZipError      = status.Error(codes.Unknown, "Failed to upload binary zip.")
 	ReceiveBinaryZipError     = status.Error(codes.Unknown, "Failed to receive binary zip.")
 	UploadShasumError         = status.Error(codes.Unknown, "Failed to upload shasum file.")
 	ReceiveShasumError        = status.Error(codes.Unknown, "Failed to receive shasum file.")
 	UploadShasumSigError      = status.Error(codes.Unknown, "Failed to upload shasum signature file.")
 	ReceiveShasumSigError     = status.Error(codes.Unknown, "Failed to receive shasum signature file.")
+	DeleteVersionError        = status.Error(codes.Unknown, "Failed to delete provider version artifacts.")
 )
 
 type StorageService struct {
@@ -96,6 +102,10 @@ func (s *StorageService) DownloadProviderSourceZip(request *services.DownloadSou
 	if err != nil {
 		span.RecordError(err)
 		log.Println("Error downloading source zip for provider binary", err)
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return ArchiveNotFoundError
+		}
 		return DownloadSourceZipError
 	}
 
@@ -150,6 +160,10 @@ func (s *StorageService) DownloadShasum(request *services.DownloadShasumRequest,
 	if err != nil {
 		span.RecordError(err)
 		log.Println("Error downloading shasum file", err)
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return ShasumNotFoundError
+		}
 		return DownloadShasumError
 	}
 
@@ -204,6 +218,10 @@ func (s *StorageService) DownloadShasumSignature(request *services.DownloadShasu
 	if err != nil {
 		span.RecordError(err)
 		log.Println("Error downloading shasum signature file", err)
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return ShasumNotFoundError
+		}
 		return DownloadShasumError
 	}
 
@@ -279,6 +297,9 @@ func (s *StorageService) UploadProviderBinaryZip(server services.Storage_UploadP
 				return UploadBinaryZipError
 			}
 
+			// The archive is stored even when it cannot be hashed, its hash being left out of the network mirror.
+			_, _ = s.storeArchiveHash(ctx, fileLocation, binary_zip)
+
 			log.Println("Binary zip uploaded successfully.")
 			return server.SendAndClose(BinaryZipUploaded)
 		}
@@ -399,3 +420,47 @@ func (s *StorageService) UploadShasumSignature(server services.Storage_UploadSha
 		shasum_sig = append(shasum_sig, req.ShasumDataChunk...)
 	}
 }
+
+// Delete all artifacts stored for a Provider Version
+func (s *StorageService) DeleteProviderVersion(ctx context.Context, request *services.DeleteProviderVersionRequest) (*terrarium.Response, error) {
+	log.Println("Deleting provider version artifacts.")
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.name", request.GetProvider().GetName()),
+		attribute.String("provider.version", request.GetProvider().GetVersion()),
+	)
+
+	in := &s3.ListObjectsV2Input{
+		Bucket: aws.String(BucketName),
+		Prefix: aws.String(ResolveS3Locations(request.GetProvider().GetName(), request.GetProvider().GetVersion(), "")),
+	}
+
+	for {
+		out, err := s.Client.ListObjectsV2(ctx, in)
+		if err != nil {
+			span.RecordError(err)
+			log.Println(err)
+			return nil, DeleteVersionError
+		}
+
+		for _, object := range out.Contents {
+			if _, err := s.Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(BucketName),
+				Key:    object.Key,
+			}); err != nil {
+				span.RecordError(err)
+				log.Println(err)
+				return nil, DeleteVersionError
+			}
+		}
+
+		if !out.IsTruncated {
+			break
+		}
+		in.ContinuationToken = out.NextContinuationToken
+	}
+
+	log.Println("Provider version artifacts deleted.")
+	return VersionDeleted, nil
+}
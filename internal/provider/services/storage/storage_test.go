@@ -2,11 +2,14 @@ package storage
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	mocks2 "github.com/terrariumcloud/terrarium/internal/storage/mocks"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	terrarium "github.com/terrariumcloud/terrarium/internal/provider/services"
 	"github.com/terrariumcloud/terrarium/internal/provider/services/mocks"
 	"github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
@@ -78,6 +81,7 @@ func Test_RegisterStorageWithServer(t *testing.T) {
 // Test_DownloadProviderSourceZip checks:
 // - if correct response is returned when source zip is downloaded
 // - if error is returned when GetObject fails
+// - if NotFound is returned when the archive is missing
 // - if error is returned when Send fails
 func Test_DownloadProviderSourceZip(t *testing.T) {
 	t.Parallel()
@@ -117,6 +121,28 @@ func Test_DownloadProviderSourceZip(t *testing.T) {
 		}
 	})
 
+	t.Run("when the archive is missing", func(t *testing.T) {
+		s3Client := &mocks2.S3{GetObjectError: &types.NoSuchKey{}}
+
+		svc := &StorageService{Client: s3Client}
+
+		mds := &mocks.MockDownloadProviderSourceZipServer{}
+
+		req := &terrarium.DownloadSourceZipRequest{
+			Provider: &terrarium.ProviderRequest{Name: "TestOrg/TestProvider", Version: "v1", Os: "linux", Arch: "amd64"},
+		}
+
+		err := svc.DownloadProviderSourceZip(req, mds)
+
+		if mds.SendInvocations != 0 {
+			t.Errorf("Expected 0 call to Send, got %v", mds.SendInvocations)
+		}
+
+		if err != ArchiveNotFoundError {
+			t.Errorf("Expected %v, got %v.", ArchiveNotFoundError, err)
+		}
+	})
+
 	t.Run("when GetObject fails", func(t *testing.T) {
 		s3Client := &mocks2.S3{GetObjectError: errors.New("some error")}
 
@@ -371,6 +397,7 @@ func Test_DownloadShasumSignature(t *testing.T) {
 
 // Test_UploadProviderBinaryZip checks:
 // - if correct response is returned when the binary zip is uploaded
+// - if the hash of a valid archive is stored next to it
 // - if error is returned when PutObject fails
 // - if error is returned when Recv fails
 func Test_UploadProviderBinaryZip(t *testing.T) {
@@ -416,6 +443,38 @@ func Test_UploadProviderBinaryZip(t *testing.T) {
 		}
 	})
 
+	t.Run("when binary zip is a valid archive", func(t *testing.T) {
+		s3Client := &mocks2.S3{}
+
+		svc := &StorageService{Client: s3Client}
+
+		req := &provider.UploadProviderBinaryZipRequest{
+			Provider:     &provider.Provider{Name: "TestOrg/TestProvider", Version: "v1"},
+			Os:           "linux",
+			Arch:         "amd64",
+			ZipDataChunk: providerArchive(t),
+		}
+
+		mus := &mocks.MockUploadProviderBinaryZipServer{
+			RecvRequest:        req,
+			RecvMaxInvocations: 2,
+		}
+
+		err := svc.UploadProviderBinaryZip(mus)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.PutObjectInvocations != 2 {
+			t.Errorf("Expected 2 calls to PutObject, got %v", s3Client.PutObjectInvocations)
+		}
+
+		if s3Client.Filename != "TestOrg/TestProvider/v1/terraform-provider-TestProvider_v1_linux_amd64.zip.h1" {
+			t.Errorf("Expected the hash of the archive to be stored, got %v", s3Client.Filename)
+		}
+	})
+
 	t.Run("when PutObject fails", func(t *testing.T) {
 		s3Client := &mocks2.S3{PutObjectError: errors.New("some error")}
 
@@ -699,3 +758,81 @@ func Test_UploadShasumSignature(t *testing.T) {
 		}
 	})
 }
+
+// Test_DeleteProviderVersion checks:
+// - if every artifact under the provider version prefix is deleted
+// - if error is returned when ListObjectsV2 fails
+// - if error is returned when DeleteObject fails
+func Test_DeleteProviderVersion(t *testing.T) {
+	t.Parallel()
+
+	listOut := &s3.ListObjectsV2Output{
+		Contents: []types.Object{
+			{Key: aws.String("test-org/test-provider/1.0.0/terraform-provider-test-provider_1.0.0_linux_amd64.zip")},
+			{Key: aws.String("test-org/test-provider/1.0.0/terraform-provider-test-provider_1.0.0_SHA256SUMS")},
+		},
+	}
+	req := &terrarium.DeleteProviderVersionRequest{
+		Provider: &provider.Provider{Name: "test-org/test-provider", Version: "1.0.0"},
+	}
+
+	t.Run("when provider version artifacts are deleted", func(t *testing.T) {
+		s3Client := &mocks2.S3{ListObjectsOut: listOut}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.DeleteProviderVersion(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if s3Client.Prefix != "test-org/test-provider/1.0.0/" {
+			t.Errorf("Expected prefix test-org/test-provider/1.0.0/, got %v", s3Client.Prefix)
+		}
+
+		if s3Client.DeleteObjectInvocations != 2 {
+			t.Errorf("Expected 2 calls to DeleteObject, got %v", s3Client.DeleteObjectInvocations)
+		}
+
+		if res != VersionDeleted {
+			t.Errorf("Expected %v, got %v.", VersionDeleted, res)
+		}
+	})
+
+	t.Run("when ListObjectsV2 fails", func(t *testing.T) {
+		s3Client := &mocks2.S3{ListObjectsError: errors.New("some error")}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.DeleteProviderVersion(context.TODO(), req)
+
+		if res != nil {
+			t.Errorf("Expected no response, got %v", res)
+		}
+
+		if s3Client.DeleteObjectInvocations != 0 {
+			t.Errorf("Expected no calls to DeleteObject, got %v", s3Client.DeleteObjectInvocations)
+		}
+
+		if err != DeleteVersionError {
+			t.Errorf("Expected %v, got %v.", DeleteVersionError, err)
+		}
+	})
+
+	t.Run("when DeleteObject fails", func(t *testing.T) {
+		s3Client := &mocks2.S3{ListObjectsOut: listOut, DeleteObjectError: errors.New("some error")}
+
+		svc := &StorageService{Client: s3Client}
+
+		res, err := svc.DeleteProviderVersion(context.TODO(), req)
+
+		if res != nil {
+			t.Errorf("Expected no response, got %v", res)
+		}
+
+		if err != DeleteVersionError {
+			t.Errorf("Expected %v, got %v.", DeleteVersionError, err)
+		}
+	})
+}
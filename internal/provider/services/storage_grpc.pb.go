@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
-// - protoc             v3.12.4
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
 // source: pb/terrarium/provider/services/storage.proto
 
 package services
@@ -19,6 +19,17 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+const (
+	Storage_DownloadProviderSourceZip_FullMethodName = "/terrarium.provider.services.Storage/DownloadProviderSourceZip"
+	Storage_DownloadShasum_FullMethodName            = "/terrarium.provider.services.Storage/DownloadShasum"
+	Storage_DownloadShasumSignature_FullMethodName   = "/terrarium.provider.services.Storage/DownloadShasumSignature"
+	Storage_UploadProviderBinaryZip_FullMethodName   = "/terrarium.provider.services.Storage/UploadProviderBinaryZip"
+	Storage_UploadShasum_FullMethodName              = "/terrarium.provider.services.Storage/UploadShasum"
+	Storage_UploadShasumSignature_FullMethodName     = "/terrarium.provider.services.Storage/UploadShasumSignature"
+	Storage_DeleteProviderVersion_FullMethodName     = "/terrarium.provider.services.Storage/DeleteProviderVersion"
+	Storage_GetArchiveHash_FullMethodName            = "/terrarium.provider.services.Storage/GetArchiveHash"
+)
+
 // StorageClient is the client API for Storage service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -29,6 +40,8 @@ type StorageClient interface {
 	UploadProviderBinaryZip(ctx context.Context, opts ...grpc.CallOption) (Storage_UploadProviderBinaryZipClient, error)
 	UploadShasum(ctx context.Context, opts ...grpc.CallOption) (Storage_UploadShasumClient, error)
 	UploadShasumSignature(ctx context.Context, opts ...grpc.CallOption) (Storage_UploadShasumSignatureClient, error)
+	DeleteProviderVersion(ctx context.Context, in *DeleteProviderVersionRequest, opts ...grpc.CallOption) (*provider.Response, error)
+	GetArchiveHash(ctx context.Context, in *DownloadSourceZipRequest, opts ...grpc.CallOption) (*ArchiveHashResponse, error)
 }
 
 type storageClient struct {
@@ -40,7 +53,7 @@ func NewStorageClient(cc grpc.ClientConnInterface) StorageClient {
 }
 
 func (c *storageClient) DownloadProviderSourceZip(ctx context.Context, in *DownloadSourceZipRequest, opts ...grpc.CallOption) (Storage_DownloadProviderSourceZipClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[0], "/terrarium.provider.services.Storage/DownloadProviderSourceZip", opts...)
+	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[0], Storage_DownloadProviderSourceZip_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +85,7 @@ func (x *storageDownloadProviderSourceZipClient) Recv() (*SourceZipResponse, err
 }
 
 func (c *storageClient) DownloadShasum(ctx context.Context, in *DownloadShasumRequest, opts ...grpc.CallOption) (Storage_DownloadShasumClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[1], "/terrarium.provider.services.Storage/DownloadShasum", opts...)
+	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[1], Storage_DownloadShasum_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +117,7 @@ func (x *storageDownloadShasumClient) Recv() (*DownloadShasumResponse, error) {
 }
 
 func (c *storageClient) DownloadShasumSignature(ctx context.Context, in *DownloadShasumRequest, opts ...grpc.CallOption) (Storage_DownloadShasumSignatureClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[2], "/terrarium.provider.services.Storage/DownloadShasumSignature", opts...)
+	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[2], Storage_DownloadShasumSignature_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +149,7 @@ func (x *storageDownloadShasumSignatureClient) Recv() (*DownloadShasumResponse,
 }
 
 func (c *storageClient) UploadProviderBinaryZip(ctx context.Context, opts ...grpc.CallOption) (Storage_UploadProviderBinaryZipClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[3], "/terrarium.provider.services.Storage/UploadProviderBinaryZip", opts...)
+	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[3], Storage_UploadProviderBinaryZip_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +183,7 @@ func (x *storageUploadProviderBinaryZipClient) CloseAndRecv() (*provider.Respons
 }
 
 func (c *storageClient) UploadShasum(ctx context.Context, opts ...grpc.CallOption) (Storage_UploadShasumClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[4], "/terrarium.provider.services.Storage/UploadShasum", opts...)
+	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[4], Storage_UploadShasum_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +217,7 @@ func (x *storageUploadShasumClient) CloseAndRecv() (*provider.Response, error) {
 }
 
 func (c *storageClient) UploadShasumSignature(ctx context.Context, opts ...grpc.CallOption) (Storage_UploadShasumSignatureClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[5], "/terrarium.provider.services.Storage/UploadShasumSignature", opts...)
+	stream, err := c.cc.NewStream(ctx, &Storage_ServiceDesc.Streams[5], Storage_UploadShasumSignature_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +250,24 @@ func (x *storageUploadShasumSignatureClient) CloseAndRecv() (*provider.Response,
 	return m, nil
 }
 
+func (c *storageClient) DeleteProviderVersion(ctx context.Context, in *DeleteProviderVersionRequest, opts ...grpc.CallOption) (*provider.Response, error) {
+	out := new(provider.Response)
+	err := c.cc.Invoke(ctx, Storage_DeleteProviderVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetArchiveHash(ctx context.Context, in *DownloadSourceZipRequest, opts ...grpc.CallOption) (*ArchiveHashResponse, error) {
+	out := new(ArchiveHashResponse)
+	err := c.cc.Invoke(ctx, Storage_GetArchiveHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageServer is the server API for Storage service.
 // All implementations must embed UnimplementedStorageServer
 // for forward compatibility
@@ -247,6 +278,8 @@ type StorageServer interface {
 	UploadProviderBinaryZip(Storage_UploadProviderBinaryZipServer) error
 	UploadShasum(Storage_UploadShasumServer) error
 	UploadShasumSignature(Storage_UploadShasumSignatureServer) error
+	DeleteProviderVersion(context.Context, *DeleteProviderVersionRequest) (*provider.Response, error)
+	GetArchiveHash(context.Context, *DownloadSourceZipRequest) (*ArchiveHashResponse, error)
 	mustEmbedUnimplementedStorageServer()
 }
 
@@ -272,6 +305,12 @@ func (UnimplementedStorageServer) UploadShasum(Storage_UploadShasumServer) error
 func (UnimplementedStorageServer) UploadShasumSignature(Storage_UploadShasumSignatureServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadShasumSignature not implemented")
 }
+func (UnimplementedStorageServer) DeleteProviderVersion(context.Context, *DeleteProviderVersionRequest) (*provider.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProviderVersion not implemented")
+}
+func (UnimplementedStorageServer) GetArchiveHash(context.Context, *DownloadSourceZipRequest) (*ArchiveHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchiveHash not implemented")
+}
 func (UnimplementedStorageServer) mustEmbedUnimplementedStorageServer() {}
 
 // UnsafeStorageServer may be embedded to opt out of forward compatibility for this service.
@@ -426,13 +465,58 @@ func (x *storageUploadShasumSignatureServer) Recv() (*provider.UploadShasumReque
 	return m, nil
 }
 
+func _Storage_DeleteProviderVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProviderVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).DeleteProviderVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Storage_DeleteProviderVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).DeleteProviderVersion(ctx, req.(*DeleteProviderVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetArchiveHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadSourceZipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetArchiveHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Storage_GetArchiveHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetArchiveHash(ctx, req.(*DownloadSourceZipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Storage_ServiceDesc is the grpc.ServiceDesc for Storage service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Storage_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "terrarium.provider.services.Storage",
 	HandlerType: (*StorageServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteProviderVersion",
+			Handler:    _Storage_DeleteProviderVersion_Handler,
+		},
+		{
+			MethodName: "GetArchiveHash",
+			Handler:    _Storage_GetArchiveHash_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "DownloadProviderSourceZip",
@@ -71,6 +71,17 @@ func (v versionManagerGrpcClient) Register(ctx context.Context, in *terrarium.Re
 	}
 }
 
+func (v versionManagerGrpcClient) AddPlatforms(ctx context.Context, in *terrarium.RegisterProviderRequest, opts ...grpc.CallOption) (*terrarium.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.AddPlatforms(ctx, in, opts...)
+	}
+}
+
 func (v versionManagerGrpcClient) PublishVersion(ctx context.Context, in *services.TerminateVersionRequest, opts ...grpc.CallOption) (*terrarium.Response, error) {
 	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
 		return nil, err
@@ -92,3 +103,47 @@ func (v versionManagerGrpcClient) AbortProviderVersion(ctx context.Context, in *
 		return client.AbortProviderVersion(ctx, in, opts...)
 	}
 }
+
+func (v versionManagerGrpcClient) DeprecateProvider(ctx context.Context, in *services.DeprecateProviderRequest, opts ...grpc.CallOption) (*terrarium.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.DeprecateProvider(ctx, in, opts...)
+	}
+}
+
+func (v versionManagerGrpcClient) ApproveVersion(ctx context.Context, in *terrarium.ReviewProviderVersionRequest, opts ...grpc.CallOption) (*terrarium.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.ApproveVersion(ctx, in, opts...)
+	}
+}
+
+func (v versionManagerGrpcClient) RejectVersion(ctx context.Context, in *terrarium.ReviewProviderVersionRequest, opts ...grpc.CallOption) (*terrarium.Response, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.RejectVersion(ctx, in, opts...)
+	}
+}
+
+func (v versionManagerGrpcClient) ListPendingVersions(ctx context.Context, in *services.ListPendingVersionsRequest, opts ...grpc.CallOption) (*services.ListPendingVersionsResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(v.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewVersionManagerClient(conn)
+		return client.ListPendingVersions(ctx, in, opts...)
+	}
+}
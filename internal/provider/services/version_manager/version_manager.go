@@ -2,18 +2,29 @@ package version_manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/terrariumcloud/terrarium/internal/common/approval"
+	"github.com/terrariumcloud/terrarium/internal/common/audit"
+	"github.com/terrariumcloud/terrarium/internal/common/identity"
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
 	"github.com/terrariumcloud/terrarium/internal/provider/services"
+	releaseSvc "github.com/terrariumcloud/terrarium/internal/release/services"
+	searchServices "github.com/terrariumcloud/terrarium/internal/search/services"
 	"github.com/terrariumcloud/terrarium/internal/storage"
 	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
+	releasePkg "github.com/terrariumcloud/terrarium/pkg/terrarium/release"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/apparentlymart/go-versions/versions"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -22,22 +33,37 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	DefaultProviderVersionsTableName      = "terrarium-providers"
 	DefaultProviderVersionManagerEndpoint = "provider_version_manager:3001"
+	DefaultDraftTTL                       = 24 * time.Hour
+	DefaultDraftReaperInterval            = time.Hour
 )
 
 var (
 	VersionsTableName      = DefaultProviderVersionsTableName
 	VersionManagerEndpoint = DefaultProviderVersionManagerEndpoint
-
-	ProviderRegistered = &terrarium.Response{Message: "Provider registered successfully."}
-	VersionPublished   = &terrarium.Response{Message: "Version published."}
-	VersionAborted     = &terrarium.Response{Message: "Version aborted."}
+	DraftTTL               = DefaultDraftTTL
+	DraftReaperInterval    = DefaultDraftReaperInterval
+	ApprovalOrganizations  []string
+	BrowseUrl              = ""
+
+	ProviderRegistered     = &terrarium.Response{Message: "Provider registered successfully."}
+	VersionPublished       = &terrarium.Response{Message: "Version published."}
+	VersionAborted         = &terrarium.Response{Message: "Version aborted."}
+	ProviderDeprecated     = &terrarium.Response{Message: "Provider deprecation updated."}
+	VersionPendingApproval = &terrarium.Response{Message: "Version submitted for approval."}
+	VersionApproved        = &terrarium.Response{Message: "Version approved."}
+	VersionRejected        = &terrarium.Response{Message: "Version rejected."}
+
+	DeprecatedMaturityWarning = "No longer recommended for use."
+	EndOfLifeMaturityWarning  = "End of life, no longer maintained."
 
 	ProviderVersionsTableInitializationError = status.Error(codes.Unknown, "Failed to initialize table for provider versions.")
 	AbortProviderVersionError                = status.Error(codes.Unknown, "Failed to abort provider version.")
@@ -47,26 +73,65 @@ var (
 	ProviderRegisterError                    = status.Error(codes.Unknown, "Failed to register provider.")
 	ExpressionBuildError                     = status.Error(codes.Unknown, "Failed to build update expression.")
 	MarshalProviderError                     = status.Error(codes.Unknown, "Failed to marshal provider.")
+	ListDraftVersionsError                   = status.Error(codes.Unknown, "Failed to list draft provider versions.")
+	ProviderNotFoundError                    = status.Error(codes.NotFound, "Provider version not found.")
+	DeprecateProviderError                   = status.Error(codes.Unknown, "Failed to update provider deprecation.")
+	SubmitterRequiredError                   = status.Error(codes.Unauthenticated, "Publishing a version that requires approval requires an authenticated caller.")
+	VersionAlreadyPublishedError             = status.Error(codes.FailedPrecondition, "Provider version is already published.")
+	ReviewerRequiredError                    = status.Error(codes.Unauthenticated, "Reviewing a version requires an authenticated caller.")
+	SelfReviewError                          = status.Error(codes.PermissionDenied, "Provider version cannot be reviewed by its submitter.")
+	VersionNotPendingApprovalError           = status.Error(codes.FailedPrecondition, "Provider version is not pending approval.")
+	ReviewProviderVersionError               = status.Error(codes.Unknown, "Failed to review provider version.")
+	ListPendingVersionsError                 = status.Error(codes.Unknown, "Failed to list provider versions pending approval.")
+	AddPlatformsConflictError                = status.Error(codes.Aborted, "Provider version was modified concurrently, platforms were not added.")
+	DevelopmentVersion                       = versions.MustParseVersion("0.0.0")
+)
+
+var (
+	meter = otel.Meter("github.com/terrariumcloud/terrarium/internal/provider/services/version_manager")
+
+	draftsReaped, _      = meter.Int64Counter("terrarium.provider.drafts.reaped", metric.WithDescription("Number of abandoned draft provider versions removed."))
+	draftReapFailures, _ = meter.Int64Counter("terrarium.provider.drafts.reap_failures", metric.WithDescription("Number of abandoned draft provider versions that failed to be removed."))
 )
 
 type VersionManagerService struct {
 	services.UnimplementedVersionManagerServer
-	Db     storage.DynamoDBTableCreator
-	Table  string
-	Schema *dynamodb.CreateTableInput
+	Db             storage.DynamoDBTableCreator
+	Table          string
+	Schema         *dynamodb.CreateTableInput
+	ReleaseService releaseSvc.PublisherClient
+	StorageService services.StorageClient
+	DraftTTL       time.Duration
+	ReaperInterval time.Duration
+	Approval       approval.Policy
+	// Indexer, when set, is kept up to date with the providers registered and the versions published
+	Indexer searchServices.IndexerClient
+	// Identity lists the callers trusted to pass on the identity of submitters and reviewers
+	Identity *identity.Trust
+
+	// stopReaper cancels the context of the draft reaper started with the service, and reaping tracks the reaper
+	stopReaper context.CancelFunc
+	reaping    sync.WaitGroup
 }
 
 type Provider struct {
-	Name          string                    `json:"name" bson:"name" dynamodbav:"name"`
-	Version       string                    `json:"version" bson:"version" dynamodbav:"version"`
-	Protocols     []string                  `json:"protocols" bson:"protocols" dynamodbav:"protocols"`
-	Platforms     []*terrarium.PlatformItem `json:"platforms" bson:"platforms" dynamodbav:"platforms"`
-	Description   string                    `json:"description" bson:"description" dynamodbav:"description"`
-	SourceRepoUrl string                    `json:"source_repo_url" bson:"source_repo_url" dynamodbav:"source_repo_url"`
-	Maturity      string                    `json:"maturity" bson:"maturity" dynamodbav:"maturity"`
-	CreatedOn     string                    `json:"created_on" bson:"created_on" dynamodbav:"created_on"`
-	ModifiedOn    string                    `json:"modified_on,omitempty" bson:"modified_on,omitempty" dynamodbav:"modified_on,omitempty"`
-	PublishedOn   string                    `json:"published_on,omitempty" bson:"published_on,omitempty" dynamodbav:"published_on,omitempty"`
+	Name           string                    `json:"name" bson:"name" dynamodbav:"name"`
+	Version        string                    `json:"version" bson:"version" dynamodbav:"version"`
+	Protocols      []string                  `json:"protocols" bson:"protocols" dynamodbav:"protocols"`
+	Platforms      []*terrarium.PlatformItem `json:"platforms" bson:"platforms" dynamodbav:"platforms"`
+	Description    string                    `json:"description" bson:"description" dynamodbav:"description"`
+	SourceRepoUrl  string                    `json:"source_repo_url" bson:"source_repo_url" dynamodbav:"source_repo_url"`
+	Maturity       string                    `json:"maturity" bson:"maturity" dynamodbav:"maturity"`
+	CreatedOn      string                    `json:"created_on" bson:"created_on" dynamodbav:"created_on"`
+	ModifiedOn     string                    `json:"modified_on,omitempty" bson:"modified_on,omitempty" dynamodbav:"modified_on,omitempty"`
+	PublishedOn    string                    `json:"published_on,omitempty" bson:"published_on,omitempty" dynamodbav:"published_on,omitempty"`
+	Deprecation    string                    `json:"deprecation,omitempty" bson:"deprecation,omitempty" dynamodbav:"deprecation,omitempty"`
+	ApprovalStatus string                    `json:"approval_status,omitempty" bson:"approval_status,omitempty" dynamodbav:"approval_status,omitempty"`
+	SubmittedOn    string                    `json:"submitted_on,omitempty" bson:"submitted_on,omitempty" dynamodbav:"submitted_on,omitempty"`
+	SubmittedBy    string                    `json:"submitted_by,omitempty" bson:"submitted_by,omitempty" dynamodbav:"submitted_by,omitempty"`
+	ReviewedBy     string                    `json:"reviewed_by,omitempty" bson:"reviewed_by,omitempty" dynamodbav:"reviewed_by,omitempty"`
+	ReviewedOn     string                    `json:"reviewed_on,omitempty" bson:"reviewed_on,omitempty" dynamodbav:"reviewed_on,omitempty"`
+	ReviewComment  string                    `json:"review_comment,omitempty" bson:"review_comment,omitempty" dynamodbav:"review_comment,omitempty"`
 }
 
 // RegisterWithServer Registers VersionManagerService with grpc server
@@ -77,6 +142,16 @@ func (s *VersionManagerService) RegisterWithServer(grpcServer grpc.ServiceRegist
 	}
 	services.RegisterVersionManagerServer(grpcServer, s)
 
+	if s.DraftTTL > 0 && s.ReaperInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		s.stopReaper = cancel
+		s.reaping.Add(1)
+		go func() {
+			defer s.reaping.Done()
+			s.StartDraftReaper(ctx)
+		}()
+	}
+
 	return nil
 }
 
@@ -141,6 +216,9 @@ func (s *VersionManagerService) AbortProviderVersion(ctx context.Context, reques
 }
 
 // PublishVersion Updates Provider Version to published with Version Manager service
+// And publishes a release.
+// When the organization of the provider requires approval the version is only submitted for approval,
+// it is published once approved with ApproveVersion.
 func (s *VersionManagerService) PublishVersion(ctx context.Context, request *services.TerminateVersionRequest) (*terrarium.Response, error) {
 	log.Println("Publishing provider version.")
 
@@ -157,6 +235,10 @@ func (s *VersionManagerService) PublishVersion(ctx context.Context, request *ser
 		return nil, PublishProviderVersionError
 	}
 
+	if s.Approval.Required(request.Provider.GetName()) {
+		return s.submitForApproval(ctx, providerKey)
+	}
+
 	publishOn, err := attributevalue.Marshal(time.Now().UTC().String())
 	if err != nil {
 		span.RecordError(err)
@@ -171,18 +253,321 @@ func (s *VersionManagerService) PublishVersion(ctx context.Context, request *ser
 		Key:              providerKey,
 		TableName:        aws.String(VersionsTableName),
 		UpdateExpression: aws.String("set published_on = :published_on"),
+		ReturnValues:     types.ReturnValueAllNew,
 	}
 
-	if _, err := s.Db.UpdateItem(ctx, in); err != nil {
+	out, err := s.Db.UpdateItem(ctx, in)
+	if err != nil {
 		span.RecordError(err)
 		log.Println(err)
 		return nil, PublishProviderVersionError
 	}
 
+	if err := s.publishRelease(ctx, request.GetProvider(), out); err != nil {
+		return nil, err
+	}
+	s.indexProvider(ctx, &searchServices.IndexProviderRequest{
+		Name:    request.GetProvider().GetName(),
+		Version: proto.String(request.GetProvider().GetVersion()),
+	})
+
 	log.Println("Provider version published.")
 	return VersionPublished, nil
 }
 
+// publishRelease announces a published provider version to the release service,
+// using the attributes of the updated version for its description and links.
+// Failures to reach the release service are recorded but do not fail the publication.
+func (s *VersionManagerService) publishRelease(ctx context.Context, provider *terrarium.Provider, out *dynamodb.UpdateItemOutput) error {
+	span := trace.SpanFromContext(ctx)
+
+	parsedVersion, err := versions.ParseVersion(strings.ReplaceAll(provider.GetVersion(), "v", ""))
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if !parsedVersion.GreaterThan(DevelopmentVersion) || s.ReleaseService == nil {
+		return nil
+	}
+
+	published := Provider{}
+	if out != nil {
+		if err := attributevalue.UnmarshalMap(out.Attributes, &published); err != nil {
+			span.RecordError(err)
+			log.Println(err)
+		}
+	}
+
+	var links []*releasePkg.Link
+	if published.SourceRepoUrl != "" {
+		links = append(links, &releasePkg.Link{Title: "Source", Url: published.SourceRepoUrl})
+	}
+	if BrowseUrl != "" {
+		links = append(links, &releasePkg.Link{
+			Title: "Documentation",
+			Url:   fmt.Sprintf("%s/terraform-providers/%s/description", strings.TrimSuffix(BrowseUrl, "/"), provider.GetName()),
+		})
+	}
+
+	providerAddress := strings.Split(provider.GetName(), "/")
+	orgName := providerAddress[0]
+
+	if _, err := s.ReleaseService.Publish(ctx, &releasePkg.PublishRequest{
+		Name:         provider.GetName(),
+		Version:      provider.GetVersion(),
+		Type:         "provider",
+		Organization: orgName,
+		Description:  published.Description,
+		Links:        links,
+	}); err != nil {
+		span.RecordError(err)
+	}
+	return nil
+}
+
+// submitForApproval marks a provider version as pending approval instead of publishing it.
+// The caller submitting the version must be known and is recorded so that they cannot review it.
+func (s *VersionManagerService) submitForApproval(ctx context.Context, providerKey map[string]types.AttributeValue) (*terrarium.Response, error) {
+	span := trace.SpanFromContext(ctx)
+
+	submitter := s.Identity.FromIncomingContext(ctx)
+	if submitter == "" {
+		span.RecordError(SubmitterRequiredError)
+		return nil, SubmitterRequiredError
+	}
+
+	update := expression.Set(expression.Name("approval_status"), expression.Value(approval.StatusPending))
+	update.Set(expression.Name("submitted_on"), expression.Value(time.Now().UTC().String()))
+	update.Set(expression.Name("submitted_by"), expression.Value(submitter))
+
+	condition := expression.And(
+		expression.Name("name").AttributeExists(),
+		expression.Or(
+			expression.Name("published_on").AttributeNotExists(),
+			expression.Name("published_on").Equal(expression.Value(""))),
+	)
+
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, PublishProviderVersionError
+	}
+
+	in := &dynamodb.UpdateItemInput{
+		Key:                                 providerKey,
+		TableName:                           aws.String(VersionsTableName),
+		ExpressionAttributeNames:            expr.Names(),
+		ExpressionAttributeValues:           expr.Values(),
+		UpdateExpression:                    expr.Update(),
+		ConditionExpression:                 expr.Condition(),
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	}
+
+	if _, err := s.Db.UpdateItem(ctx, in); err != nil {
+		span.RecordError(err)
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			// The version is either missing or was published already.
+			if len(conditionFailed.Item) == 0 {
+				return nil, ProviderNotFoundError
+			}
+			return nil, VersionAlreadyPublishedError
+		}
+		log.Println(err)
+		return nil, PublishProviderVersionError
+	}
+
+	span.AddEvent("Provider version submitted for approval.")
+	log.Println("Provider version submitted for approval.")
+	return VersionPendingApproval, nil
+}
+
+// ApproveVersion publishes a provider version that is pending approval and publishes a release.
+func (s *VersionManagerService) ApproveVersion(ctx context.Context, request *terrarium.ReviewProviderVersionRequest) (*terrarium.Response, error) {
+	log.Println("Approving provider version.")
+
+	out, err := s.reviewVersion(ctx, request, approval.StatusApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.publishRelease(ctx, request.GetProvider(), out); err != nil {
+		return nil, err
+	}
+	s.indexProvider(ctx, &searchServices.IndexProviderRequest{
+		Name:    request.GetProvider().GetName(),
+		Version: proto.String(request.GetProvider().GetVersion()),
+	})
+
+	log.Println("Provider version approved.")
+	return VersionApproved, nil
+}
+
+// RejectVersion rejects a provider version that is pending approval.
+// Rejected versions are never published and are removed by the draft reaper.
+func (s *VersionManagerService) RejectVersion(ctx context.Context, request *terrarium.ReviewProviderVersionRequest) (*terrarium.Response, error) {
+	log.Println("Rejecting provider version.")
+
+	if _, err := s.reviewVersion(ctx, request, approval.StatusRejected); err != nil {
+		return nil, err
+	}
+
+	log.Println("Provider version rejected.")
+	return VersionRejected, nil
+}
+
+// reviewVersion records the decision of the caller on a version pending approval.
+// Approved versions get their publish date set. The updated version is returned.
+// Versions cannot be reviewed by the caller who submitted them.
+func (s *VersionManagerService) reviewVersion(ctx context.Context, request *terrarium.ReviewProviderVersionRequest, decision string) (*dynamodb.UpdateItemOutput, error) {
+	reviewer := s.Identity.FromIncomingContext(ctx)
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.name", request.GetProvider().GetName()),
+		attribute.String("provider.version", request.GetProvider().GetVersion()),
+		attribute.String("approval.reviewer", reviewer),
+		attribute.String("approval.decision", decision),
+	)
+
+	if reviewer == "" {
+		return nil, ReviewerRequiredError
+	}
+
+	providerKey, err := s.GetProviderKey(request.GetProvider())
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, ReviewProviderVersionError
+	}
+
+	reviewedOn := time.Now().UTC().String()
+	update := expression.Set(expression.Name("approval_status"), expression.Value(decision))
+	update.Set(expression.Name("reviewed_by"), expression.Value(reviewer))
+	update.Set(expression.Name("reviewed_on"), expression.Value(reviewedOn))
+	update.Set(expression.Name("review_comment"), expression.Value(request.GetComment()))
+	if decision == approval.StatusApproved {
+		update.Set(expression.Name("published_on"), expression.Value(reviewedOn))
+	}
+	condition := expression.And(
+		expression.Name("approval_status").Equal(expression.Value(approval.StatusPending)),
+		expression.Or(
+			expression.Name("submitted_by").AttributeNotExists(),
+			expression.Name("submitted_by").NotEqual(expression.Value(reviewer))))
+
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, ReviewProviderVersionError
+	}
+
+	in := &dynamodb.UpdateItemInput{
+		Key:                                 providerKey,
+		TableName:                           aws.String(VersionsTableName),
+		ConditionExpression:                 expr.Condition(),
+		ExpressionAttributeNames:            expr.Names(),
+		ExpressionAttributeValues:           expr.Values(),
+		UpdateExpression:                    expr.Update(),
+		ReturnValues:                        types.ReturnValueAllNew,
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	}
+
+	out, err := s.Db.UpdateItem(ctx, in)
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		var conditionErr *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionErr) {
+			return nil, reviewConditionError(conditionErr)
+		}
+		return nil, ReviewProviderVersionError
+	}
+
+	audit.Record(ctx, reviewer, "provider.version."+strings.ToLower(decision),
+		attribute.String("provider.name", request.GetProvider().GetName()),
+		attribute.String("provider.version", request.GetProvider().GetVersion()),
+		attribute.String("approval.comment", request.GetComment()),
+	)
+	return out, nil
+}
+
+// reviewConditionError tells apart, from the version as it was when the review was refused, a version reviewed by
+// its submitter from a version that is not pending approval.
+func reviewConditionError(conditionErr *types.ConditionalCheckFailedException) error {
+	provider := Provider{}
+	if err := attributevalue.UnmarshalMap(conditionErr.Item, &provider); err == nil && provider.ApprovalStatus == approval.StatusPending {
+		return SelfReviewError
+	}
+	return VersionNotPendingApprovalError
+}
+
+// ListPendingVersions lists the provider versions waiting for approval, sorted by provider name and version.
+func (s *VersionManagerService) ListPendingVersions(ctx context.Context, request *services.ListPendingVersionsRequest) (*services.ListPendingVersionsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.organization", request.GetOrganization()),
+	)
+
+	filter := expression.Name("approval_status").Equal(expression.Value(approval.StatusPending))
+	if request.GetOrganization() != "" {
+		filter = filter.And(expression.Name("name").BeginsWith(request.GetOrganization() + "/"))
+	}
+	projection := expression.NamesList(expression.Name("name"), expression.Name("version"), expression.Name("submitted_on"))
+	expr, err := expression.NewBuilder().WithProjection(projection).WithFilter(filter).Build()
+	if err != nil {
+		span.RecordError(err)
+		log.Printf("Expression Builder failed creation: %v", err)
+		return nil, ListPendingVersionsError
+	}
+
+	scanQueryInputs := &dynamodb.ScanInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		FilterExpression:          expr.Filter(),
+		ProjectionExpression:      expr.Projection(),
+		TableName:                 aws.String(VersionsTableName),
+	}
+
+	grpcResponse := &services.ListPendingVersionsResponse{}
+	for {
+		response, err := s.Db.Scan(ctx, scanQueryInputs)
+		if err != nil {
+			span.RecordError(err)
+			log.Printf("ScanInput failed: %v", err)
+			return nil, ListPendingVersionsError
+		}
+
+		for _, item := range response.Items {
+			provider := Provider{}
+			if err := attributevalue.UnmarshalMap(item, &provider); err != nil {
+				span.RecordError(err)
+				log.Printf("UnmarshalMap failed: %v", err)
+				return nil, ListPendingVersionsError
+			}
+			grpcResponse.Versions = append(grpcResponse.Versions, &services.PendingVersion{
+				Name:        provider.Name,
+				Version:     provider.Version,
+				SubmittedOn: provider.SubmittedOn,
+			})
+		}
+
+		if len(response.LastEvaluatedKey) == 0 {
+			break
+		}
+		scanQueryInputs.ExclusiveStartKey = response.LastEvaluatedKey
+	}
+
+	sort.Slice(grpcResponse.Versions, func(i, j int) bool {
+		if grpcResponse.Versions[i].Name != grpcResponse.Versions[j].Name {
+			return grpcResponse.Versions[i].Name < grpcResponse.Versions[j].Name
+		}
+		return grpcResponse.Versions[i].Version < grpcResponse.Versions[j].Version
+	})
+	return grpcResponse, nil
+}
+
 // Register new Provider in Terrarium
 func (s *VersionManagerService) Register(ctx context.Context, request *terrarium.RegisterProviderRequest) (*terrarium.Response, error) {
 	log.Println("Registering new provider.")
@@ -284,12 +669,178 @@ func (s *VersionManagerService) Register(ctx context.Context, request *terrarium
 		}
 	}
 
+	s.indexProvider(ctx, &searchServices.IndexProviderRequest{
+		Name:        request.GetName(),
+		Description: proto.String(request.GetDescription()),
+		Maturity:    proto.String(request.GetMaturity().String()),
+	})
+
 	log.Println("New provider registered.")
 	return ProviderRegistered, nil
 }
 
+// maxAddPlatformsAttempts is how many times the platforms of a provider version are read and written when the version
+// is modified concurrently.
+const maxAddPlatformsAttempts = 5
+
+// AddPlatforms registers a provider version like Register, adding the platforms of the request to the ones the version
+// is already registered with instead of replacing them. A platform already registered is replaced.
+// The platforms are only written when the version was not modified since they were read, and read again otherwise,
+// so that platforms added concurrently are all kept.
+func (s *VersionManagerService) AddPlatforms(ctx context.Context, request *terrarium.RegisterProviderRequest) (*terrarium.Response, error) {
+	log.Println("Adding provider platforms.")
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.name", request.GetName()),
+		attribute.String("provider.version", request.GetVersion()),
+	)
+
+	providerKey, err := s.GetProviderKey(&terrarium.Provider{Name: request.GetName(), Version: request.GetVersion()})
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, ProviderGetError
+	}
+
+	for attempt := 0; attempt < maxAddPlatformsAttempts; attempt++ {
+		res, err := s.Db.GetItem(ctx, &dynamodb.GetItemInput{
+			TableName:      aws.String(VersionsTableName),
+			Key:            providerKey,
+			ConsistentRead: aws.Bool(true),
+		})
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, ProviderGetError
+		}
+
+		if res == nil || res.Item == nil {
+			err = s.createProviderVersion(ctx, request)
+		} else {
+			registered := Provider{}
+			if err := attributevalue.UnmarshalMap(res.Item, &registered); err != nil {
+				log.Println(err)
+				span.RecordError(err)
+				return nil, ProviderGetError
+			}
+			err = s.updatePlatforms(ctx, providerKey, &registered, request)
+		}
+
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			span.AddEvent("Provider version modified concurrently, adding platforms again.")
+			continue
+		}
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, ProviderRegisterError
+		}
+
+		s.indexProvider(ctx, &searchServices.IndexProviderRequest{
+			Name:        request.GetName(),
+			Description: proto.String(request.GetDescription()),
+		})
+		log.Println("Provider platforms added.")
+		return ProviderRegistered, nil
+	}
+
+	span.RecordError(AddPlatformsConflictError)
+	return nil, AddPlatformsConflictError
+}
+
+// createProviderVersion puts a new provider version, unless it was created concurrently.
+func (s *VersionManagerService) createProviderVersion(ctx context.Context, request *terrarium.RegisterProviderRequest) error {
+	provider := Provider{
+		Name:          request.GetName(),
+		Version:       request.GetVersion(),
+		Protocols:     request.GetProtocols(),
+		Platforms:     request.GetPlatforms(),
+		Description:   request.GetDescription(),
+		SourceRepoUrl: request.GetSourceRepoUrl(),
+		Maturity:      request.GetMaturity().String(),
+		CreatedOn:     time.Now().UTC().String(),
+	}
+
+	providerItem, err := attributevalue.MarshalMap(provider)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Db.PutItem(ctx, &dynamodb.PutItemInput{
+		Item:                providerItem,
+		TableName:           aws.String(VersionsTableName),
+		ConditionExpression: aws.String("attribute_not_exists(#name)"),
+		ExpressionAttributeNames: map[string]string{
+			"#name": "name",
+		},
+	})
+	return err
+}
+
+// updatePlatforms writes the platforms of a registered provider version merged with the platforms of the request,
+// unless the version was modified since it was read.
+func (s *VersionManagerService) updatePlatforms(ctx context.Context, providerKey map[string]types.AttributeValue, registered *Provider, request *terrarium.RegisterProviderRequest) error {
+	platforms := append([]*terrarium.PlatformItem{}, request.GetPlatforms()...)
+	for _, platform := range registered.Platforms {
+		if !containsPlatform(request.GetPlatforms(), platform) {
+			platforms = append(platforms, platform)
+		}
+	}
+
+	update := expression.Set(expression.Name("platforms"), expression.Value(platforms))
+	update.Set(expression.Name("protocols"), expression.Value(request.GetProtocols()))
+	update.Set(expression.Name("description"), expression.Value(request.GetDescription()))
+	update.Set(expression.Name("source_repo_url"), expression.Value(request.GetSourceRepoUrl()))
+	update.Set(expression.Name("modified_on"), expression.Value(time.Now().UTC().String()))
+
+	unmodified := expression.Name("modified_on").AttributeNotExists()
+	if registered.ModifiedOn != "" {
+		unmodified = expression.Name("modified_on").Equal(expression.Value(registered.ModifiedOn))
+	}
+
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(unmodified).Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Db.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(VersionsTableName),
+		Key:                       providerKey,
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
+	})
+	return err
+}
+
+// containsPlatform reports whether a platform of the same os and arch is listed.
+func containsPlatform(platforms []*terrarium.PlatformItem, platform *terrarium.PlatformItem) bool {
+	for _, listed := range platforms {
+		if listed.GetOs() == platform.GetOs() && listed.GetArch() == platform.GetArch() {
+			return true
+		}
+	}
+	return false
+}
+
+// indexProvider updates the search index with a provider.
+// Failures are recorded but do not fail the call.
+func (s *VersionManagerService) indexProvider(ctx context.Context, request *searchServices.IndexProviderRequest) {
+	if s.Indexer == nil {
+		return
+	}
+
+	if _, err := s.Indexer.IndexProvider(ctx, request); err != nil {
+		log.Printf("Failed to index %s: %v", request.GetName(), err)
+		trace.SpanFromContext(ctx).RecordError(err)
+	}
+}
+
 // ListProviderVersions Retrieve all versions of a given provider and return an array of versionItems.
-// Only versions that have been published should be reported.
+// Only versions that have been published, and approved when subject to approval, should be reported.
 func (s *VersionManagerService) ListProviderVersions(ctx context.Context, request *services.ProviderName) (*services.ProviderVersionsResponse, error) {
 
 	span := trace.SpanFromContext(ctx)
@@ -299,8 +850,12 @@ func (s *VersionManagerService) ListProviderVersions(ctx context.Context, reques
 
 	filter := expression.And(
 		expression.Name("name").Equal(expression.Value(request.GetProvider())),
-		expression.Name("published_on").AttributeExists())
-	projection := expression.NamesList(expression.Name("version"), expression.Name("protocols"), expression.Name("platforms"))
+		expression.Name("published_on").AttributeExists(),
+		expression.Or(
+			expression.Name("approval_status").AttributeNotExists(),
+			expression.Name("approval_status").Equal(expression.Value(approval.StatusApproved))))
+	projection := expression.NamesList(expression.Name("version"), expression.Name("protocols"), expression.Name("platforms"),
+		expression.Name("maturity"), expression.Name("deprecation"))
 
 	expr, err := expression.NewBuilder().WithFilter(filter).WithProjection(projection).Build()
 	if err != nil {
@@ -317,7 +872,7 @@ func (s *VersionManagerService) ListProviderVersions(ctx context.Context, reques
 		FilterExpression:          expr.Filter(),
 	}
 
-	response, err := s.Db.Scan(ctx, scanInputs)
+	items, err := storage.ScanAll(ctx, s.Db, scanInputs)
 	if err != nil {
 		span.RecordError(err)
 		log.Printf("ScanInput failed: %v", err)
@@ -326,16 +881,14 @@ func (s *VersionManagerService) ListProviderVersions(ctx context.Context, reques
 
 	grpcResponse := services.ProviderVersionsResponse{}
 
-	if response.Items != nil {
-		for _, item := range response.Items {
-			versionItem, err := unmarshalProviderVersionItem(item)
-			if err != nil {
-				span.RecordError(err)
-				log.Printf("UnmarshalMap failed: %v", err)
-				return nil, err
-			}
-			grpcResponse.Versions = append(grpcResponse.Versions, versionItem)
+	for _, item := range items {
+		versionItem, err := unmarshalProviderVersionItem(item)
+		if err != nil {
+			span.RecordError(err)
+			log.Printf("UnmarshalMap failed: %v", err)
+			return nil, err
 		}
+		grpcResponse.Versions = append(grpcResponse.Versions, versionItem)
 	}
 
 	// Validate and sort semantic versions
@@ -363,10 +916,164 @@ func (s *VersionManagerService) ListProviderVersions(ctx context.Context, reques
 		}
 	}
 	grpcResponse.Versions = sortedVersions
+	grpcResponse.Warnings = deprecationWarnings(request.GetProvider(), sortedVersions)
 
 	return &grpcResponse, nil
 }
 
+// DeprecateProvider sets, or clears when the message is empty, the deprecation message of a provider version.
+// When no version is given every registered version of the provider is updated.
+func (s *VersionManagerService) DeprecateProvider(ctx context.Context, request *services.DeprecateProviderRequest) (*terrarium.Response, error) {
+	log.Println("Updating provider deprecation.")
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.name", request.GetProvider().GetName()),
+		attribute.String("provider.version", request.GetProvider().GetVersion()),
+	)
+
+	providerVersions := []string{request.GetProvider().GetVersion()}
+	if request.GetProvider().GetVersion() == "" {
+		var err error
+		if providerVersions, err = s.listVersions(ctx, request.GetProvider().GetName()); err != nil {
+			span.RecordError(err)
+			log.Println(err)
+			return nil, ProviderGetError
+		}
+		if len(providerVersions) == 0 {
+			return nil, ProviderNotFoundError
+		}
+	}
+
+	var update expression.UpdateBuilder
+	if request.GetMessage() == "" {
+		update = expression.Remove(expression.Name("deprecation"))
+	} else {
+		update = expression.Set(expression.Name("deprecation"), expression.Value(request.GetMessage()))
+	}
+	condition := expression.Name("name").AttributeExists()
+
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		span.RecordError(err)
+		log.Println(err)
+		return nil, ExpressionBuildError
+	}
+
+	for _, providerVersion := range providerVersions {
+		providerKey, err := s.GetProviderKey(&terrarium.Provider{Name: request.GetProvider().GetName(), Version: providerVersion})
+		if err != nil {
+			span.RecordError(err)
+			log.Println(err)
+			return nil, DeprecateProviderError
+		}
+
+		in := &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(VersionsTableName),
+			Key:                       providerKey,
+			ConditionExpression:       expr.Condition(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+			UpdateExpression:          expr.Update(),
+		}
+
+		if _, err := s.Db.UpdateItem(ctx, in); err != nil {
+			span.RecordError(err)
+			log.Println(err)
+			var conditionErr *types.ConditionalCheckFailedException
+			if errors.As(err, &conditionErr) {
+				return nil, ProviderNotFoundError
+			}
+			return nil, DeprecateProviderError
+		}
+	}
+
+	log.Println("Provider deprecation updated.")
+	return ProviderDeprecated, nil
+}
+
+// listVersions returns every registered version of a provider, published or not.
+func (s *VersionManagerService) listVersions(ctx context.Context, name string) ([]string, error) {
+	filter := expression.Name("name").Equal(expression.Value(name))
+	projection := expression.NamesList(expression.Name("version"))
+	expr, err := expression.NewBuilder().WithFilter(filter).WithProjection(projection).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	scanInputs := &dynamodb.ScanInput{
+		TableName:                 aws.String(VersionsTableName),
+		ProjectionExpression:      expr.Projection(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		FilterExpression:          expr.Filter(),
+	}
+
+	var providerVersions []string
+	for {
+		response, err := s.Db.Scan(ctx, scanInputs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			provider := Provider{}
+			if err := attributevalue.UnmarshalMap(item, &provider); err != nil {
+				return nil, err
+			}
+			providerVersions = append(providerVersions, provider.Version)
+		}
+
+		if len(response.LastEvaluatedKey) == 0 {
+			return providerVersions, nil
+		}
+		scanInputs.ExclusiveStartKey = response.LastEvaluatedKey
+	}
+}
+
+// deprecationWarnings builds the warnings reported to terraform for the deprecated versions of a provider.
+// A single warning is returned when every version shares the same deprecation.
+func deprecationWarnings(name string, versionItems []*services.VersionItem) []string {
+	if len(versionItems) == 0 {
+		return nil
+	}
+
+	shared := versionItems[0].GetDeprecation()
+	for _, versionItem := range versionItems {
+		if versionItem.GetDeprecation() != shared {
+			shared = ""
+			break
+		}
+	}
+	if shared != "" {
+		return []string{fmt.Sprintf("All versions of %s are deprecated: %s", name, shared)}
+	}
+
+	var warnings []string
+	for _, versionItem := range versionItems {
+		if versionItem.GetDeprecation() != "" {
+			warnings = append(warnings, fmt.Sprintf("%s %s is deprecated: %s", name, versionItem.GetVersion(), versionItem.GetDeprecation()))
+		}
+	}
+	return warnings
+}
+
+// versionDeprecation returns the explicit deprecation message of a provider version,
+// falling back to a default message for DEPRECATED and END_OF_LIFE maturity.
+func versionDeprecation(provider Provider) string {
+	if provider.Deprecation != "" {
+		return provider.Deprecation
+	}
+
+	switch provider.Maturity {
+	case terrarium.Maturity_DEPRECATED.String():
+		return DeprecatedMaturityWarning
+	case terrarium.Maturity_END_OF_LIFE.String():
+		return EndOfLifeMaturityWarning
+	}
+	return ""
+}
+
 func (s *VersionManagerService) GetVersionData(ctx context.Context, request *services.VersionDataRequest) (*services.PlatformMetadataResponse, error) {
 
 	span := trace.SpanFromContext(ctx)
@@ -416,6 +1123,9 @@ func (s *VersionManagerService) GetVersionData(ctx context.Context, request *ser
 func (s *VersionManagerService) ListProviders(ctx context.Context, request *services.ListProvidersRequest) (*services.ListProvidersResponse, error) {
 
 	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("provider.page", request.GetPage().String()),
+	)
 
 	var providersList []*services.ListProviderItem
 	// Initialize a map to store providers uniquely
@@ -436,37 +1146,46 @@ func (s *VersionManagerService) ListProviders(ctx context.Context, request *serv
 		ExpressionAttributeNames: expr.Names(),
 	}
 
-	response, err := s.Db.Scan(ctx, scanInputs)
+	items, err := storage.ScanAll(ctx, s.Db, scanInputs)
 	if err != nil {
 		span.RecordError(err)
 		log.Printf("ScanInput failed: %v", err)
 		return nil, err
 	}
 
-	if response.Items != nil {
-		for _, item := range response.Items {
-			if providerMetadata, err := unmarshalProvider(item); err != nil {
-				span.RecordError(err)
-				return nil, err
-			} else {
-				key := providerMetadata.Name
-				// Check if the provider already exists in the map
-				if _, exists := uniqueProviders[key]; !exists {
-					// Add the provider to the map if it doesn't exist
-					uniqueProviders[key] = providerMetadata
-					providersList = append(providersList, providerMetadata)
-				}
+	for _, item := range items {
+		if providerMetadata, err := unmarshalProvider(item); err != nil {
+			span.RecordError(err)
+			return nil, err
+		} else {
+			key := providerMetadata.Name
+			// Check if the provider already exists in the map
+			if _, exists := uniqueProviders[key]; !exists {
+				// Add the provider to the map if it doesn't exist
+				uniqueProviders[key] = providerMetadata
+				providersList = append(providersList, providerMetadata)
 			}
 		}
 	}
 
+	providersList, page, err := paging.Paginate(providersList, providerItemName, request.Page)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	grpcResponse := services.ListProvidersResponse{
 		Providers: providersList,
+		Page:      page,
 	}
 
 	return &grpcResponse, nil
 }
 
+func providerItemName(provider *services.ListProviderItem) string {
+	return fmt.Sprintf("%s/%s", provider.GetOrganization(), provider.GetName())
+}
+
 func (s *VersionManagerService) GetProvider(ctx context.Context, request *services.ProviderName) (*services.GetProviderResponse, error) {
 
 	span := trace.SpanFromContext(ctx)
@@ -491,14 +1210,14 @@ func (s *VersionManagerService) GetProvider(ctx context.Context, request *servic
 		TableName:                 aws.String(VersionsTableName),
 	}
 
-	response, err := s.Db.Scan(ctx, scanInputs)
+	items, err := storage.ScanAll(ctx, s.Db, scanInputs)
 	if err != nil {
 		span.RecordError(err)
 		log.Printf("ScanInput failed: %v", err)
 		return nil, err
 	}
 
-	if response.Items == nil || len(response.Items) < 1 {
+	if len(items) < 1 {
 		err := fmt.Errorf("provider not found '%v'", request.GetProvider())
 		span.RecordError(err)
 		return nil, err
@@ -506,7 +1225,7 @@ func (s *VersionManagerService) GetProvider(ctx context.Context, request *servic
 
 	grpcResponse := services.GetProviderResponse{}
 
-	if providerMetadata, err := unmarshalProvider(response.Items[0]); err != nil {
+	if providerMetadata, err := unmarshalProvider(items[0]); err != nil {
 		span.RecordError(err)
 		return nil, err
 	} else {
@@ -592,14 +1311,187 @@ func unmarshalProviderVersionItem(item map[string]types.AttributeValue) (*servic
 	}
 
 	result := services.VersionItem{
-		Version:   provider.Version,
-		Protocols: provider.Protocols,
-		Platforms: platforms,
+		Version:     provider.Version,
+		Protocols:   provider.Protocols,
+		Platforms:   platforms,
+		Deprecation: versionDeprecation(provider),
 	}
 
 	return &result, nil
 }
 
+// Close stops the draft reaper started with the service, waiting for the drafts being reaped.
+func (s *VersionManagerService) Close() error {
+	if s.stopReaper != nil {
+		s.stopReaper()
+	}
+	s.reaping.Wait()
+	return nil
+}
+
+// StartDraftReaper periodically removes draft provider versions that were never published
+// until the context is cancelled.
+func (s *VersionManagerService) StartDraftReaper(ctx context.Context) {
+	log.Printf("Reaping draft provider versions older than %s every %s.", s.DraftTTL, s.ReaperInterval)
+	ticker := time.NewTicker(s.ReaperInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ReapDrafts(ctx, time.Now().UTC()); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReapDrafts removes every draft provider version created more than DraftTTL before now,
+// together with its stored artifacts, and returns the number of versions reaped.
+// Drafts that fail to be removed are left in place to be retried on the next run.
+func (s *VersionManagerService) ReapDrafts(ctx context.Context, now time.Time) (int, error) {
+	drafts, err := s.listDraftVersions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	reaped := 0
+	for _, draft := range drafts {
+		createdOn, err := time.Parse(storage.TimeFormatLayout, draft.CreatedOn)
+		if err != nil {
+			log.Printf("Skipping draft %s@%s with invalid creation time: %v", draft.Name, draft.Version, err)
+			continue
+		}
+
+		age := now.Sub(createdOn)
+		if age < s.DraftTTL {
+			continue
+		}
+
+		removed, err := s.reapDraft(ctx, &terrarium.Provider{Name: draft.Name, Version: draft.Version}, age)
+		if err != nil {
+			draftReapFailures.Add(ctx, 1)
+			continue
+		}
+		if removed {
+			reaped++
+		}
+	}
+
+	if reaped > 0 {
+		log.Printf("Reaped %d draft provider versions.", reaped)
+	}
+	return reaped, nil
+}
+
+// reapDraft removes a draft provider version, reporting whether it was still a draft. The version is removed first,
+// on the condition that it was neither published nor submitted for approval since it was listed, so that the
+// artifacts of a version that is no longer a draft are kept.
+func (s *VersionManagerService) reapDraft(ctx context.Context, provider *terrarium.Provider, age time.Duration) (bool, error) {
+	removed, err := s.deleteDraft(ctx, provider)
+	if err != nil || !removed {
+		return false, err
+	}
+
+	if s.StorageService != nil {
+		if _, err := s.StorageService.DeleteProviderVersion(ctx, &services.DeleteProviderVersionRequest{Provider: provider}); err != nil {
+			log.Printf("Failed to delete artifacts for draft %s@%s: %v", provider.GetName(), provider.GetVersion(), err)
+			return true, err
+		}
+	}
+
+	draftsReaped.Add(ctx, 1)
+	audit.Record(ctx, audit.SystemActor, "provider.version.reaped",
+		attribute.String("provider.name", provider.GetName()),
+		attribute.String("provider.version", provider.GetVersion()),
+		attribute.String("draft.age", age.Round(time.Second).String()),
+	)
+	return true, nil
+}
+
+// deleteDraft deletes a provider version on the condition that it is still a draft, reporting whether it was.
+func (s *VersionManagerService) deleteDraft(ctx context.Context, provider *terrarium.Provider) (bool, error) {
+	providerKey, err := s.GetProviderKey(provider)
+	if err != nil {
+		log.Println(err)
+		return false, AbortProviderVersionError
+	}
+
+	expr, err := expression.NewBuilder().WithCondition(draftCondition()).Build()
+	if err != nil {
+		log.Printf("Expression Builder failed creation: %v", err)
+		return false, AbortProviderVersionError
+	}
+
+	in := &dynamodb.DeleteItemInput{
+		Key:                       providerKey,
+		TableName:                 aws.String(VersionsTableName),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+	if _, err := s.Db.DeleteItem(ctx, in); err != nil {
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			log.Printf("Draft %s@%s is no longer a draft, keeping it.", provider.GetName(), provider.GetVersion())
+			return false, nil
+		}
+		log.Printf("Failed to delete draft %s@%s: %v", provider.GetName(), provider.GetVersion(), err)
+		return false, AbortProviderVersionError
+	}
+	return true, nil
+}
+
+// draftCondition matches the provider versions without a publish date that are not waiting for approval.
+func draftCondition() expression.ConditionBuilder {
+	return expression.And(
+		expression.Or(
+			expression.Name("published_on").AttributeNotExists(),
+			expression.Name("published_on").Equal(expression.Value(""))),
+		expression.Or(
+			expression.Name("approval_status").AttributeNotExists(),
+			expression.Name("approval_status").NotEqual(expression.Value(approval.StatusPending))))
+}
+
+// listDraftVersions scans the whole table for provider versions without a publish date
+// Versions waiting for approval are not drafts and are left alone.
+func (s *VersionManagerService) listDraftVersions(ctx context.Context) ([]Provider, error) {
+	projection := expression.NamesList(expression.Name("name"), expression.Name("version"), expression.Name("created_on"))
+	expr, err := expression.NewBuilder().WithProjection(projection).WithFilter(draftCondition()).Build()
+	if err != nil {
+		log.Printf("Expression Builder failed creation: %v", err)
+		return nil, ListDraftVersionsError
+	}
+
+	scanQueryInputs := &dynamodb.ScanInput{
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		FilterExpression:          expr.Filter(),
+		ProjectionExpression:      expr.Projection(),
+		TableName:                 aws.String(VersionsTableName),
+	}
+
+	items, err := storage.ScanAll(ctx, s.Db, scanQueryInputs)
+	if err != nil {
+		log.Printf("ScanInput failed: %v", err)
+		return nil, ListDraftVersionsError
+	}
+
+	var drafts []Provider
+	for _, item := range items {
+		provider := Provider{}
+		if err := attributevalue.UnmarshalMap(item, &provider); err != nil {
+			log.Printf("UnmarshalMap failed: %v", err)
+			return nil, ListDraftVersionsError
+		}
+		drafts = append(drafts, provider)
+	}
+	return drafts, nil
+}
+
 // GetProviderVersionsSchema returns CreateTableInput that can be used to create table if it does not exist
 func GetProviderVersionsSchema(table string) *dynamodb.CreateTableInput {
 	return &dynamodb.CreateTableInput{
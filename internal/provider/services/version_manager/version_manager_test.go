@@ -4,21 +4,35 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/common/approval"
+	"github.com/terrariumcloud/terrarium/internal/common/identity"
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
 	"github.com/terrariumcloud/terrarium/internal/provider/services"
+	providerMocks "github.com/terrariumcloud/terrarium/internal/provider/services/mocks"
+	releaseMocks "github.com/terrariumcloud/terrarium/internal/release/services/mocks"
+	searchMocks "github.com/terrariumcloud/terrarium/internal/search/services/mocks"
 	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
 	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/release"
+	"google.golang.org/protobuf/proto"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // Test_RegisterProvider checks:
 // - if correct response is returned when Provider is registered
 // - if there was no error when version already exists
+// - if the indexer is given the description and maturity of the provider
 // - if error was returned when GetItem fails
 // - if error is returned when marshal fails
 // - if error is returned when PutItem fails
@@ -207,6 +221,40 @@ func Test_RegisterProvider(t *testing.T) {
 		}
 	})
 
+	t.Run("when the indexer is set", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts: []*dynamodb.GetItemOutput{{}},
+		}
+		indexer := &searchMocks.MockIndexerClient{IndexProviderError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db, Indexer: indexer}
+
+		req := &terrarium.RegisterProviderRequest{
+			Name:        "test-org/test-provider",
+			Version:     "1.0.0",
+			Description: "test provider",
+			Maturity:    terrarium.Maturity_BETA,
+		}
+
+		res, err := svc.Register(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != ProviderRegistered {
+			t.Errorf("Expected %v, got %v.", ProviderRegistered, res)
+		}
+
+		if indexer.IndexProviderInvocations != 1 {
+			t.Errorf("Expected 1 call to IndexProvider, got %d", indexer.IndexProviderInvocations)
+		}
+
+		if indexer.IndexProviderRequest.GetDescription() != "test provider" || indexer.IndexProviderRequest.GetMaturity() != "BETA" || indexer.IndexProviderRequest.Version != nil {
+			t.Errorf("Expected description and maturity to be indexed without the version, got %v", indexer.IndexProviderRequest)
+		}
+	})
+
 	t.Run("when GetItem fails", func(t *testing.T) {
 		db := &mocks.DynamoDB{
 			GetItemErrors: []error{errors.New("some error")},
@@ -363,8 +411,136 @@ func Test_RegisterProvider(t *testing.T) {
 	})
 }
 
+// hasValue reports whether value is one of the values of an expression.
+func hasValue(values map[string]types.AttributeValue, value string) bool {
+	for _, v := range values {
+		if s, ok := v.(*types.AttributeValueMemberS); ok && s.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// conflictingDynamoDB fails the first conditional writes as if the item was modified concurrently.
+type conflictingDynamoDB struct {
+	*mocks.DynamoDB
+	conflicts int
+}
+
+func (db *conflictingDynamoDB) UpdateItem(ctx context.Context, in *dynamodb.UpdateItemInput, opts ...func(*dynamodb.Options)) (*dynamodb.UpdateItemOutput, error) {
+	if db.conflicts > 0 {
+		db.conflicts--
+		db.UpdateItemInvocations++
+		return nil, &types.ConditionalCheckFailedException{}
+	}
+	return db.DynamoDB.UpdateItem(ctx, in, opts...)
+}
+
+// Test_AddPlatforms checks:
+// - if a new version is created with its platforms, unless it was created concurrently
+// - if the platforms are added to the registered ones, replacing the platform of the same os and arch
+// - if the platforms are read again when the version was modified concurrently
+// - if error is returned when the version keeps being modified concurrently
+func Test_AddPlatforms(t *testing.T) {
+	t.Parallel()
+
+	registered := func(modifiedOn string, platforms ...*terrarium.PlatformItem) *dynamodb.GetItemOutput {
+		item, _ := attributevalue.MarshalMap(Provider{Name: "cie/test", Version: "1.0.0", Platforms: platforms, ModifiedOn: modifiedOn})
+		return &dynamodb.GetItemOutput{Item: item}
+	}
+	request := &terrarium.RegisterProviderRequest{
+		Name:      "cie/test",
+		Version:   "1.0.0",
+		Platforms: []*terrarium.PlatformItem{{Os: "linux", Arch: "amd64", Shasum: "new"}},
+	}
+
+	t.Run("when the version is new", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{{}}}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.AddPlatforms(context.TODO(), request)
+
+		if err != nil || res != ProviderRegistered {
+			t.Errorf("Expected %v, got %v and %v.", ProviderRegistered, res, err)
+		}
+
+		if db.PutItemInvocations != 1 {
+			t.Errorf("Expected 1 call to PutItem, got %v", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when the version has platforms", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{registered("2024-01-01",
+			&terrarium.PlatformItem{Os: "linux", Arch: "amd64", Shasum: "old"},
+			&terrarium.PlatformItem{Os: "darwin", Arch: "arm64"})}}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.AddPlatforms(context.TODO(), request)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		var platforms []*terrarium.PlatformItem
+		for _, value := range db.UpdateItemIn.ExpressionAttributeValues {
+			if list, ok := value.(*types.AttributeValueMemberL); ok {
+				_ = attributevalue.Unmarshal(list, &platforms)
+			}
+		}
+		if len(platforms) != 2 || platforms[0].GetShasum() != "new" || platforms[1].GetOs() != "darwin" {
+			t.Errorf("Expected linux_amd64 to be replaced next to darwin_arm64, got %v.", platforms)
+		}
+
+		if !hasValue(db.UpdateItemIn.ExpressionAttributeValues, "2024-01-01") {
+			t.Errorf("Expected the update to be conditional on the version read, got %v.", db.UpdateItemIn.ExpressionAttributeValues)
+		}
+	})
+
+	t.Run("when the version is modified concurrently", func(t *testing.T) {
+		db := &conflictingDynamoDB{DynamoDB: &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{
+			registered("2024-01-01"),
+			registered("2024-01-02", &terrarium.PlatformItem{Os: "darwin", Arch: "arm64"}),
+		}}, conflicts: 1}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.AddPlatforms(context.TODO(), request)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.GetItemInvocations != 2 || db.UpdateItemInvocations != 2 {
+			t.Errorf("Expected the version to be read and written twice, got %v and %v.", db.GetItemInvocations, db.UpdateItemInvocations)
+		}
+
+		if !hasValue(db.UpdateItemIn.ExpressionAttributeValues, "2024-01-02") {
+			t.Errorf("Expected the update to be conditional on the version read again, got %v.", db.UpdateItemIn.ExpressionAttributeValues)
+		}
+	})
+
+	t.Run("when the version keeps being modified concurrently", func(t *testing.T) {
+		outs := make([]*dynamodb.GetItemOutput, maxAddPlatformsAttempts)
+		for i := range outs {
+			outs[i] = registered("2024-01-01")
+		}
+		db := &conflictingDynamoDB{DynamoDB: &mocks.DynamoDB{GetItemOuts: outs}, conflicts: maxAddPlatformsAttempts}
+
+		svc := &VersionManagerService{Db: db}
+
+		_, err := svc.AddPlatforms(context.TODO(), request)
+
+		if err != AddPlatformsConflictError {
+			t.Errorf("Expected %v, got %v.", AddPlatformsConflictError, err)
+		}
+	})
+}
+
 // Test_RegisterVersionManagerWithServer checks:
 // - if there was no error with table init
+// - if the draft reaper started with the service is stopped when it is closed
 // - if error is returned when Table initialization fails
 func Test_RegisterVersionManagerWithServer(t *testing.T) {
 	t.Parallel()
@@ -391,6 +567,24 @@ func Test_RegisterVersionManagerWithServer(t *testing.T) {
 		}
 	})
 
+	t.Run("when the draft reaper is enabled", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		vms := &VersionManagerService{Db: db, DraftTTL: time.Hour, ReaperInterval: time.Hour}
+
+		if err := vms.RegisterWithServer(grpc.NewServer()); err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if err := vms.Close(); err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if db.ScanItemInvocations != 1 {
+			t.Errorf("Expected the drafts to be reaped once before the reaper stopped, got %v.", db.ScanItemInvocations)
+		}
+	})
+
 	t.Run("when Table initialization fails", func(t *testing.T) {
 		db := &mocks.DynamoDB{
 			DescribeTableErrors: []error{errors.New("some error")},
@@ -480,6 +674,8 @@ func Test_AbortProvider(t *testing.T) {
 
 // Test_PublishVersion checks:
 // - if correct response is returned when version is published
+// - if a provider release is published with its description and source link
+// - if no release is published for development versions
 // - if error is returned when UpdateItem fails
 func Test_PublishVersion(t *testing.T) {
 	t.Parallel()
@@ -510,6 +706,61 @@ func Test_PublishVersion(t *testing.T) {
 		}
 	})
 
+	t.Run("when release is published", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			UpdateItemOut: &dynamodb.UpdateItemOutput{
+				Attributes: map[string]types.AttributeValue{
+					"name":            &types.AttributeValueMemberS{Value: "test-org/test-provider2"},
+					"version":         &types.AttributeValueMemberS{Value: "2.0.0"},
+					"description":     &types.AttributeValueMemberS{Value: "Test provider"},
+					"source_repo_url": &types.AttributeValueMemberS{Value: "https://github.com/test-org/test-provider2"},
+				},
+			},
+		}
+		releaseService := &releaseMocks.MockPublisherClient{}
+
+		svc := &VersionManagerService{Db: db, ReleaseService: releaseService}
+
+		req := &services.TerminateVersionRequest{Provider: &terrarium.Provider{Name: "test-org/test-provider2", Version: "2.0.0"}}
+
+		if _, err := svc.PublishVersion(context.TODO(), req); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if releaseService.PublishInvocations != 1 {
+			t.Fatalf("Expected 1 call to Publish, got %v", releaseService.PublishInvocations)
+		}
+
+		expected := &release.PublishRequest{
+			Type:         "provider",
+			Organization: "test-org",
+			Name:         "test-org/test-provider2",
+			Version:      "2.0.0",
+			Description:  "Test provider",
+			Links:        []*release.Link{{Title: "Source", Url: "https://github.com/test-org/test-provider2"}},
+		}
+		if !proto.Equal(releaseService.PublishRequest, expected) {
+			t.Errorf("Expected %v, got %v.", expected, releaseService.PublishRequest)
+		}
+	})
+
+	t.Run("when development version is published", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		releaseService := &releaseMocks.MockPublisherClient{}
+
+		svc := &VersionManagerService{Db: db, ReleaseService: releaseService}
+
+		req := &services.TerminateVersionRequest{Provider: &terrarium.Provider{Name: "test-org/test-provider2", Version: "0.0.0"}}
+
+		if _, err := svc.PublishVersion(context.TODO(), req); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if releaseService.PublishInvocations != 0 {
+			t.Errorf("Expected 0 calls to Publish, got %v", releaseService.PublishInvocations)
+		}
+	})
+
 	t.Run("when UpdateItem fails", func(t *testing.T) {
 		db := &mocks.DynamoDB{UpdateItemError: errors.New("some error")}
 
@@ -537,6 +788,180 @@ func Test_PublishVersion(t *testing.T) {
 	})
 }
 
+// proxy trusts the gateway the requests of callerContext are made from.
+var proxy, _ = identity.NewTrust([]string{"10.0.0.1"})
+
+// callerContext returns a context of a gRPC request made by the gateway for caller.
+func callerContext(caller string) context.Context {
+	ctx := peer.NewContext(context.TODO(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(identity.MetadataKey, caller))
+}
+
+// Test_ReviewVersion checks:
+// - if the version is submitted for approval, with its submitter, when the organization requires approval
+// - if error is returned when the submitter is not authenticated
+// - if error is returned when the version is already published or does not exist
+// - if correct response is returned when version is approved or rejected
+// - if error is returned when the caller is not authenticated
+// - if error is returned when the caller submitted the version
+// - if error is returned when the version is not pending approval
+func Test_ReviewVersion(t *testing.T) {
+	t.Parallel()
+
+	provider := &terrarium.Provider{Name: "cie/test", Version: "1.0.0"}
+
+	t.Run("when organization requires approval", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &VersionManagerService{Db: db, Approval: approval.Policy{Organizations: []string{"cie"}}, Identity: proxy}
+
+		res, err := svc.PublishVersion(callerContext("john"), &services.TerminateVersionRequest{Provider: provider})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != VersionPendingApproval {
+			t.Errorf("Expected %v, got %v.", VersionPendingApproval, res)
+		}
+
+		if db.UpdateItemInvocations != 1 {
+			t.Errorf("Expected 1 call to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+
+		submitter := &types.AttributeValueMemberS{Value: "john"}
+		found := false
+		for _, value := range db.UpdateItemIn.ExpressionAttributeValues {
+			found = found || reflect.DeepEqual(value, submitter)
+		}
+		if !found {
+			t.Errorf("Expected john to be recorded as submitter, got %v", db.UpdateItemIn.ExpressionAttributeValues)
+		}
+	})
+
+	t.Run("when the submitter is not authenticated", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &VersionManagerService{Db: db, Approval: approval.Policy{Organizations: []string{"cie"}}, Identity: proxy}
+
+		_, err := svc.PublishVersion(context.TODO(), &services.TerminateVersionRequest{Provider: provider})
+
+		if err != SubmitterRequiredError {
+			t.Errorf("Expected %v, got %v.", SubmitterRequiredError, err)
+		}
+
+		if db.UpdateItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+	})
+
+	t.Run("when the version is already published", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: &types.ConditionalCheckFailedException{Item: map[string]types.AttributeValue{
+			"name":         &types.AttributeValueMemberS{Value: "cie/test"},
+			"published_on": &types.AttributeValueMemberS{Value: "2024-01-01"},
+		}}}
+
+		svc := &VersionManagerService{Db: db, Approval: approval.Policy{Organizations: []string{"cie"}}, Identity: proxy}
+
+		_, err := svc.PublishVersion(callerContext("john"), &services.TerminateVersionRequest{Provider: provider})
+
+		if err != VersionAlreadyPublishedError {
+			t.Errorf("Expected %v, got %v.", VersionAlreadyPublishedError, err)
+		}
+	})
+
+	t.Run("when the version does not exist", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: &types.ConditionalCheckFailedException{}}
+
+		svc := &VersionManagerService{Db: db, Approval: approval.Policy{Organizations: []string{"cie"}}, Identity: proxy}
+
+		_, err := svc.PublishVersion(callerContext("john"), &services.TerminateVersionRequest{Provider: provider})
+
+		if err != ProviderNotFoundError {
+			t.Errorf("Expected %v, got %v.", ProviderNotFoundError, err)
+		}
+	})
+
+	t.Run("when version is approved", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &VersionManagerService{Db: db, Identity: proxy}
+
+		res, err := svc.ApproveVersion(callerContext("jane"), &terrarium.ReviewProviderVersionRequest{Provider: provider})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != VersionApproved {
+			t.Errorf("Expected %v, got %v.", VersionApproved, res)
+		}
+
+		if db.UpdateItemInvocations != 1 {
+			t.Errorf("Expected 1 call to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+	})
+
+	t.Run("when version is rejected", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &VersionManagerService{Db: db, Identity: proxy}
+
+		res, err := svc.RejectVersion(callerContext("jane"), &terrarium.ReviewProviderVersionRequest{Provider: provider})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != VersionRejected {
+			t.Errorf("Expected %v, got %v.", VersionRejected, res)
+		}
+	})
+
+	t.Run("when the caller is not authenticated", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &VersionManagerService{Db: db, Identity: proxy}
+
+		_, err := svc.ApproveVersion(context.TODO(), &terrarium.ReviewProviderVersionRequest{Provider: provider})
+
+		if err != ReviewerRequiredError {
+			t.Errorf("Expected %v, got %v.", ReviewerRequiredError, err)
+		}
+
+		if db.UpdateItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+	})
+
+	t.Run("when the caller submitted the version", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: &types.ConditionalCheckFailedException{Item: map[string]types.AttributeValue{
+			"approval_status": &types.AttributeValueMemberS{Value: approval.StatusPending},
+			"submitted_by":    &types.AttributeValueMemberS{Value: "jane"},
+		}}}
+
+		svc := &VersionManagerService{Db: db, Identity: proxy}
+
+		_, err := svc.ApproveVersion(callerContext("jane"), &terrarium.ReviewProviderVersionRequest{Provider: provider})
+
+		if err != SelfReviewError {
+			t.Errorf("Expected %v, got %v.", SelfReviewError, err)
+		}
+	})
+
+	t.Run("when version is not pending approval", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: &types.ConditionalCheckFailedException{}}
+
+		svc := &VersionManagerService{Db: db, Identity: proxy}
+
+		_, err := svc.RejectVersion(callerContext("jane"), &terrarium.ReviewProviderVersionRequest{Provider: provider})
+
+		if err != VersionNotPendingApprovalError {
+			t.Errorf("Expected %v, got %v.", VersionNotPendingApprovalError, err)
+		}
+	})
+}
+
 func Test_GetProvider(t *testing.T) {
 	t.Parallel()
 
@@ -639,4 +1064,366 @@ func Test_ListProviders(t *testing.T) {
 			t.Errorf("Response does not match, got %v, want %v", res, expectedResponse)
 		}
 	})
+
+	t.Run("when a page is requested", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOuts: []*dynamodb.ScanOutput{
+				{
+					Items: []map[string]types.AttributeValue{
+						{"name": &types.AttributeValueMemberS{Value: "test-org/zeta"}},
+						{"name": &types.AttributeValueMemberS{Value: "test-org/alpha"}},
+					},
+					LastEvaluatedKey: map[string]types.AttributeValue{"name": &types.AttributeValueMemberS{Value: "test-org/alpha"}},
+				},
+				{
+					Items: []map[string]types.AttributeValue{
+						{"name": &types.AttributeValueMemberS{Value: "test-org/beta"}},
+					},
+				},
+			},
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.ListProviders(context.TODO(), &services.ListProvidersRequest{Page: &paging.PageInfoRequest{Count: 2}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(res.Providers) != 2 || res.Providers[0].Name != "alpha" || res.Providers[1].Name != "beta" {
+			t.Errorf("Expected alpha and beta, got %v", res.Providers)
+		}
+
+		if res.Page.TotalCount != 3 || res.Page.NextCursor != paging.EncodeCursor("test-org/beta") {
+			t.Errorf("Expected next page after test-org/beta, got %v", res.Page)
+		}
+	})
+}
+
+// Test_ReapDrafts checks:
+// - if only provider drafts older than the TTL are removed and their artifacts deleted
+// - if a draft is not counted as reaped when deleting its artifacts fails
+// - if the artifacts are kept when the draft was published since it was listed
+// - if error is returned when Scan fails
+func Test_ReapDrafts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	scanOut := &dynamodb.ScanOutput{
+		Items: []map[string]types.AttributeValue{
+			{
+				"name":       &types.AttributeValueMemberS{Value: "test-org/stale"},
+				"version":    &types.AttributeValueMemberS{Value: "1.0.0"},
+				"created_on": &types.AttributeValueMemberS{Value: now.Add(-72 * time.Hour).String()},
+			},
+			{
+				"name":       &types.AttributeValueMemberS{Value: "test-org/fresh"},
+				"version":    &types.AttributeValueMemberS{Value: "1.0.0"},
+				"created_on": &types.AttributeValueMemberS{Value: now.Add(-time.Minute).String()},
+			},
+		},
+	}
+
+	t.Run("when a stale draft is found", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: scanOut}
+		storageClient := &providerMocks.MockProviderStorageClient{}
+
+		svc := &VersionManagerService{Db: db, StorageService: storageClient, DraftTTL: 24 * time.Hour}
+
+		reaped, err := svc.ReapDrafts(context.TODO(), now)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if reaped != 1 {
+			t.Errorf("Expected 1 draft to be reaped, got %v", reaped)
+		}
+
+		if storageClient.DeleteProviderVersionInvocations != 1 {
+			t.Errorf("Expected 1 call to DeleteProviderVersion, got %v", storageClient.DeleteProviderVersionInvocations)
+		}
+
+		if db.DeleteItemInvocations != 1 {
+			t.Errorf("Expected 1 call to DeleteItem, got %v", db.DeleteItemInvocations)
+		}
+	})
+
+	t.Run("when DeleteProviderVersion fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: scanOut}
+		storageClient := &providerMocks.MockProviderStorageClient{DeleteProviderVersionError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db, StorageService: storageClient, DraftTTL: 24 * time.Hour}
+
+		reaped, err := svc.ReapDrafts(context.TODO(), now)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if reaped != 0 {
+			t.Errorf("Expected no drafts to be reaped, got %v", reaped)
+		}
+
+		if db.DeleteItemInvocations != 1 {
+			t.Errorf("Expected 1 call to DeleteItem, got %v", db.DeleteItemInvocations)
+		}
+	})
+
+	t.Run("when the draft was published since it was listed", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOut: scanOut, DeleteItemError: &types.ConditionalCheckFailedException{}}
+		storageClient := &providerMocks.MockProviderStorageClient{}
+
+		svc := &VersionManagerService{Db: db, StorageService: storageClient, DraftTTL: 24 * time.Hour}
+
+		reaped, err := svc.ReapDrafts(context.TODO(), now)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if reaped != 0 {
+			t.Errorf("Expected no drafts to be reaped, got %v", reaped)
+		}
+
+		if storageClient.DeleteProviderVersionInvocations != 0 {
+			t.Errorf("Expected no calls to DeleteProviderVersion, got %v", storageClient.DeleteProviderVersionInvocations)
+		}
+	})
+
+	t.Run("when Scan fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db, DraftTTL: 24 * time.Hour}
+
+		_, err := svc.ReapDrafts(context.TODO(), now)
+
+		if err != ListDraftVersionsError {
+			t.Errorf("Expected %v, got %v.", ListDraftVersionsError, err)
+		}
+	})
+}
+
+// Test_DeprecateProvider checks:
+// - if a single provider version is deprecated
+// - if every version is deprecated when no version is given
+// - if not found error is returned when the version does not exist
+// - if error is returned when UpdateItem fails
+func Test_DeprecateProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when a provider version is deprecated", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		svc := &VersionManagerService{Db: db}
+
+		req := &services.DeprecateProviderRequest{
+			Provider: &terrarium.Provider{Name: "test-org/test-provider", Version: "1.0.0"},
+			Message:  "Use 2.x instead.",
+		}
+
+		res, err := svc.DeprecateProvider(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if db.ScanItemInvocations != 0 {
+			t.Errorf("Expected no calls to Scan, got %v", db.ScanItemInvocations)
+		}
+
+		if db.UpdateItemInvocations != 1 {
+			t.Errorf("Expected 1 call to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+
+		if res != ProviderDeprecated {
+			t.Errorf("Expected %v, got %v.", ProviderDeprecated, res)
+		}
+	})
+
+	t.Run("when the whole provider is deprecated", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOut: &dynamodb.ScanOutput{
+				Items: []map[string]types.AttributeValue{
+					{"version": &types.AttributeValueMemberS{Value: "1.0.0"}},
+					{"version": &types.AttributeValueMemberS{Value: "1.1.0"}},
+				},
+			},
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		req := &services.DeprecateProviderRequest{
+			Provider: &terrarium.Provider{Name: "test-org/test-provider"},
+			Message:  "Moved to test-org/other-provider.",
+		}
+
+		res, err := svc.DeprecateProvider(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if db.UpdateItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to UpdateItem, got %v", db.UpdateItemInvocations)
+		}
+
+		if res != ProviderDeprecated {
+			t.Errorf("Expected %v, got %v.", ProviderDeprecated, res)
+		}
+	})
+
+	t.Run("when the provider version does not exist", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: &types.ConditionalCheckFailedException{}}
+
+		svc := &VersionManagerService{Db: db}
+
+		req := &services.DeprecateProviderRequest{
+			Provider: &terrarium.Provider{Name: "test-org/test-provider", Version: "9.9.9"},
+		}
+
+		res, err := svc.DeprecateProvider(context.TODO(), req)
+
+		if res != nil {
+			t.Errorf("Expected no response, got %v", res)
+		}
+
+		if err != ProviderNotFoundError {
+			t.Errorf("Expected %v, got %v.", ProviderNotFoundError, err)
+		}
+	})
+
+	t.Run("when UpdateItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{UpdateItemError: errors.New("some error")}
+
+		svc := &VersionManagerService{Db: db}
+
+		req := &services.DeprecateProviderRequest{
+			Provider: &terrarium.Provider{Name: "test-org/test-provider", Version: "1.0.0"},
+			Message:  "Use 2.x instead.",
+		}
+
+		res, err := svc.DeprecateProvider(context.TODO(), req)
+
+		if res != nil {
+			t.Errorf("Expected no response, got %v", res)
+		}
+
+		if err != DeprecateProviderError {
+			t.Errorf("Expected %v, got %v.", DeprecateProviderError, err)
+		}
+	})
+}
+
+// Test_ListProviderVersionsWarnings checks:
+// - if explicit deprecations and deprecated maturity are reported as warnings per version
+// - if a single warning is reported when every version shares the same deprecation
+// - if the versions of every page of the scan are reported
+func Test_ListProviderVersionsWarnings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when some versions are deprecated", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOut: &dynamodb.ScanOutput{
+				Items: []map[string]types.AttributeValue{
+					{
+						"version":     &types.AttributeValueMemberS{Value: "1.0.0"},
+						"deprecation": &types.AttributeValueMemberS{Value: "Use 2.x instead."},
+					},
+					{
+						"version":  &types.AttributeValueMemberS{Value: "1.1.0"},
+						"maturity": &types.AttributeValueMemberS{Value: "END_OF_LIFE"},
+					},
+					{
+						"version":  &types.AttributeValueMemberS{Value: "2.0.0"},
+						"maturity": &types.AttributeValueMemberS{Value: "STABLE"},
+					},
+				},
+			},
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.ListProviderVersions(context.TODO(), &services.ProviderName{Provider: "test-org/test-provider"})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		expectedWarnings := []string{
+			"test-org/test-provider 1.0.0 is deprecated: Use 2.x instead.",
+			"test-org/test-provider 1.1.0 is deprecated: " + EndOfLifeMaturityWarning,
+		}
+		if !reflect.DeepEqual(res.Warnings, expectedWarnings) {
+			t.Errorf("Warnings do not match, got %v, want %v", res.Warnings, expectedWarnings)
+		}
+
+		if res.Versions[2].Deprecation != "" {
+			t.Errorf("Expected version 2.0.0 not to be deprecated, got %v", res.Versions[2].Deprecation)
+		}
+	})
+
+	t.Run("when every version is deprecated", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOut: &dynamodb.ScanOutput{
+				Items: []map[string]types.AttributeValue{
+					{
+						"version":  &types.AttributeValueMemberS{Value: "1.0.0"},
+						"maturity": &types.AttributeValueMemberS{Value: "DEPRECATED"},
+					},
+					{
+						"version":  &types.AttributeValueMemberS{Value: "1.1.0"},
+						"maturity": &types.AttributeValueMemberS{Value: "DEPRECATED"},
+					},
+				},
+			},
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.ListProviderVersions(context.TODO(), &services.ProviderName{Provider: "test-org/test-provider"})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		expectedWarnings := []string{"All versions of test-org/test-provider are deprecated: " + DeprecatedMaturityWarning}
+		if !reflect.DeepEqual(res.Warnings, expectedWarnings) {
+			t.Errorf("Warnings do not match, got %v, want %v", res.Warnings, expectedWarnings)
+		}
+	})
+
+	t.Run("when versions span several pages", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			ScanOuts: []*dynamodb.ScanOutput{
+				{
+					Items: []map[string]types.AttributeValue{
+						{"version": &types.AttributeValueMemberS{Value: "1.0.0"}},
+					},
+					LastEvaluatedKey: map[string]types.AttributeValue{
+						"name":    &types.AttributeValueMemberS{Value: "test-org/test-provider"},
+						"version": &types.AttributeValueMemberS{Value: "1.0.0"},
+					},
+				},
+				{
+					Items: []map[string]types.AttributeValue{
+						{"version": &types.AttributeValueMemberS{Value: "2.0.0"}},
+					},
+				},
+			},
+		}
+
+		svc := &VersionManagerService{Db: db}
+
+		res, err := svc.ListProviderVersions(context.TODO(), &services.ProviderName{Provider: "test-org/test-provider"})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if len(res.Versions) != 2 {
+			t.Errorf("Expected 2 versions, got %v", res.Versions)
+		}
+	})
 }
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
-// - protoc             v3.12.4
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
 // source: pb/terrarium/provider/services/version_manager.proto
 
 package services
@@ -19,17 +19,39 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+const (
+	VersionManager_Register_FullMethodName             = "/terrarium.provider.services.VersionManager/Register"
+	VersionManager_AddPlatforms_FullMethodName         = "/terrarium.provider.services.VersionManager/AddPlatforms"
+	VersionManager_ListProviderVersions_FullMethodName = "/terrarium.provider.services.VersionManager/ListProviderVersions"
+	VersionManager_GetVersionData_FullMethodName       = "/terrarium.provider.services.VersionManager/GetVersionData"
+	VersionManager_ListProviders_FullMethodName        = "/terrarium.provider.services.VersionManager/ListProviders"
+	VersionManager_GetProvider_FullMethodName          = "/terrarium.provider.services.VersionManager/GetProvider"
+	VersionManager_PublishVersion_FullMethodName       = "/terrarium.provider.services.VersionManager/PublishVersion"
+	VersionManager_AbortProviderVersion_FullMethodName = "/terrarium.provider.services.VersionManager/AbortProviderVersion"
+	VersionManager_DeprecateProvider_FullMethodName    = "/terrarium.provider.services.VersionManager/DeprecateProvider"
+	VersionManager_ApproveVersion_FullMethodName       = "/terrarium.provider.services.VersionManager/ApproveVersion"
+	VersionManager_RejectVersion_FullMethodName        = "/terrarium.provider.services.VersionManager/RejectVersion"
+	VersionManager_ListPendingVersions_FullMethodName  = "/terrarium.provider.services.VersionManager/ListPendingVersions"
+)
+
 // VersionManagerClient is the client API for VersionManager service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VersionManagerClient interface {
 	Register(ctx context.Context, in *provider.RegisterProviderRequest, opts ...grpc.CallOption) (*provider.Response, error)
+	// AddPlatforms registers a provider version like Register, adding its platforms to the ones already registered
+	// instead of replacing them, without losing the platforms added concurrently.
+	AddPlatforms(ctx context.Context, in *provider.RegisterProviderRequest, opts ...grpc.CallOption) (*provider.Response, error)
 	ListProviderVersions(ctx context.Context, in *ProviderName, opts ...grpc.CallOption) (*ProviderVersionsResponse, error)
 	GetVersionData(ctx context.Context, in *VersionDataRequest, opts ...grpc.CallOption) (*PlatformMetadataResponse, error)
 	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
 	GetProvider(ctx context.Context, in *ProviderName, opts ...grpc.CallOption) (*GetProviderResponse, error)
 	PublishVersion(ctx context.Context, in *TerminateVersionRequest, opts ...grpc.CallOption) (*provider.Response, error)
 	AbortProviderVersion(ctx context.Context, in *TerminateVersionRequest, opts ...grpc.CallOption) (*provider.Response, error)
+	DeprecateProvider(ctx context.Context, in *DeprecateProviderRequest, opts ...grpc.CallOption) (*provider.Response, error)
+	ApproveVersion(ctx context.Context, in *provider.ReviewProviderVersionRequest, opts ...grpc.CallOption) (*provider.Response, error)
+	RejectVersion(ctx context.Context, in *provider.ReviewProviderVersionRequest, opts ...grpc.CallOption) (*provider.Response, error)
+	ListPendingVersions(ctx context.Context, in *ListPendingVersionsRequest, opts ...grpc.CallOption) (*ListPendingVersionsResponse, error)
 }
 
 type versionManagerClient struct {
@@ -42,7 +64,16 @@ func NewVersionManagerClient(cc grpc.ClientConnInterface) VersionManagerClient {
 
 func (c *versionManagerClient) Register(ctx context.Context, in *provider.RegisterProviderRequest, opts ...grpc.CallOption) (*provider.Response, error) {
 	out := new(provider.Response)
-	err := c.cc.Invoke(ctx, "/terrarium.provider.services.VersionManager/Register", in, out, opts...)
+	err := c.cc.Invoke(ctx, VersionManager_Register_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionManagerClient) AddPlatforms(ctx context.Context, in *provider.RegisterProviderRequest, opts ...grpc.CallOption) (*provider.Response, error) {
+	out := new(provider.Response)
+	err := c.cc.Invoke(ctx, VersionManager_AddPlatforms_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +82,7 @@ func (c *versionManagerClient) Register(ctx context.Context, in *provider.Regist
 
 func (c *versionManagerClient) ListProviderVersions(ctx context.Context, in *ProviderName, opts ...grpc.CallOption) (*ProviderVersionsResponse, error) {
 	out := new(ProviderVersionsResponse)
-	err := c.cc.Invoke(ctx, "/terrarium.provider.services.VersionManager/ListProviderVersions", in, out, opts...)
+	err := c.cc.Invoke(ctx, VersionManager_ListProviderVersions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +91,7 @@ func (c *versionManagerClient) ListProviderVersions(ctx context.Context, in *Pro
 
 func (c *versionManagerClient) GetVersionData(ctx context.Context, in *VersionDataRequest, opts ...grpc.CallOption) (*PlatformMetadataResponse, error) {
 	out := new(PlatformMetadataResponse)
-	err := c.cc.Invoke(ctx, "/terrarium.provider.services.VersionManager/GetVersionData", in, out, opts...)
+	err := c.cc.Invoke(ctx, VersionManager_GetVersionData_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +100,7 @@ func (c *versionManagerClient) GetVersionData(ctx context.Context, in *VersionDa
 
 func (c *versionManagerClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
 	out := new(ListProvidersResponse)
-	err := c.cc.Invoke(ctx, "/terrarium.provider.services.VersionManager/ListProviders", in, out, opts...)
+	err := c.cc.Invoke(ctx, VersionManager_ListProviders_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +109,7 @@ func (c *versionManagerClient) ListProviders(ctx context.Context, in *ListProvid
 
 func (c *versionManagerClient) GetProvider(ctx context.Context, in *ProviderName, opts ...grpc.CallOption) (*GetProviderResponse, error) {
 	out := new(GetProviderResponse)
-	err := c.cc.Invoke(ctx, "/terrarium.provider.services.VersionManager/GetProvider", in, out, opts...)
+	err := c.cc.Invoke(ctx, VersionManager_GetProvider_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +118,7 @@ func (c *versionManagerClient) GetProvider(ctx context.Context, in *ProviderName
 
 func (c *versionManagerClient) PublishVersion(ctx context.Context, in *TerminateVersionRequest, opts ...grpc.CallOption) (*provider.Response, error) {
 	out := new(provider.Response)
-	err := c.cc.Invoke(ctx, "/terrarium.provider.services.VersionManager/PublishVersion", in, out, opts...)
+	err := c.cc.Invoke(ctx, VersionManager_PublishVersion_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +127,43 @@ func (c *versionManagerClient) PublishVersion(ctx context.Context, in *Terminate
 
 func (c *versionManagerClient) AbortProviderVersion(ctx context.Context, in *TerminateVersionRequest, opts ...grpc.CallOption) (*provider.Response, error) {
 	out := new(provider.Response)
-	err := c.cc.Invoke(ctx, "/terrarium.provider.services.VersionManager/AbortProviderVersion", in, out, opts...)
+	err := c.cc.Invoke(ctx, VersionManager_AbortProviderVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionManagerClient) DeprecateProvider(ctx context.Context, in *DeprecateProviderRequest, opts ...grpc.CallOption) (*provider.Response, error) {
+	out := new(provider.Response)
+	err := c.cc.Invoke(ctx, VersionManager_DeprecateProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionManagerClient) ApproveVersion(ctx context.Context, in *provider.ReviewProviderVersionRequest, opts ...grpc.CallOption) (*provider.Response, error) {
+	out := new(provider.Response)
+	err := c.cc.Invoke(ctx, VersionManager_ApproveVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionManagerClient) RejectVersion(ctx context.Context, in *provider.ReviewProviderVersionRequest, opts ...grpc.CallOption) (*provider.Response, error) {
+	out := new(provider.Response)
+	err := c.cc.Invoke(ctx, VersionManager_RejectVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionManagerClient) ListPendingVersions(ctx context.Context, in *ListPendingVersionsRequest, opts ...grpc.CallOption) (*ListPendingVersionsResponse, error) {
+	out := new(ListPendingVersionsResponse)
+	err := c.cc.Invoke(ctx, VersionManager_ListPendingVersions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,12 +175,19 @@ func (c *versionManagerClient) AbortProviderVersion(ctx context.Context, in *Ter
 // for forward compatibility
 type VersionManagerServer interface {
 	Register(context.Context, *provider.RegisterProviderRequest) (*provider.Response, error)
+	// AddPlatforms registers a provider version like Register, adding its platforms to the ones already registered
+	// instead of replacing them, without losing the platforms added concurrently.
+	AddPlatforms(context.Context, *provider.RegisterProviderRequest) (*provider.Response, error)
 	ListProviderVersions(context.Context, *ProviderName) (*ProviderVersionsResponse, error)
 	GetVersionData(context.Context, *VersionDataRequest) (*PlatformMetadataResponse, error)
 	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
 	GetProvider(context.Context, *ProviderName) (*GetProviderResponse, error)
 	PublishVersion(context.Context, *TerminateVersionRequest) (*provider.Response, error)
 	AbortProviderVersion(context.Context, *TerminateVersionRequest) (*provider.Response, error)
+	DeprecateProvider(context.Context, *DeprecateProviderRequest) (*provider.Response, error)
+	ApproveVersion(context.Context, *provider.ReviewProviderVersionRequest) (*provider.Response, error)
+	RejectVersion(context.Context, *provider.ReviewProviderVersionRequest) (*provider.Response, error)
+	ListPendingVersions(context.Context, *ListPendingVersionsRequest) (*ListPendingVersionsResponse, error)
 	mustEmbedUnimplementedVersionManagerServer()
 }
 
@@ -124,6 +198,9 @@ type UnimplementedVersionManagerServer struct {
 func (UnimplementedVersionManagerServer) Register(context.Context, *provider.RegisterProviderRequest) (*provider.Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
+func (UnimplementedVersionManagerServer) AddPlatforms(context.Context, *provider.RegisterProviderRequest) (*provider.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlatforms not implemented")
+}
 func (UnimplementedVersionManagerServer) ListProviderVersions(context.Context, *ProviderName) (*ProviderVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProviderVersions not implemented")
 }
@@ -142,6 +219,18 @@ func (UnimplementedVersionManagerServer) PublishVersion(context.Context, *Termin
 func (UnimplementedVersionManagerServer) AbortProviderVersion(context.Context, *TerminateVersionRequest) (*provider.Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AbortProviderVersion not implemented")
 }
+func (UnimplementedVersionManagerServer) DeprecateProvider(context.Context, *DeprecateProviderRequest) (*provider.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateProvider not implemented")
+}
+func (UnimplementedVersionManagerServer) ApproveVersion(context.Context, *provider.ReviewProviderVersionRequest) (*provider.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveVersion not implemented")
+}
+func (UnimplementedVersionManagerServer) RejectVersion(context.Context, *provider.ReviewProviderVersionRequest) (*provider.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectVersion not implemented")
+}
+func (UnimplementedVersionManagerServer) ListPendingVersions(context.Context, *ListPendingVersionsRequest) (*ListPendingVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingVersions not implemented")
+}
 func (UnimplementedVersionManagerServer) mustEmbedUnimplementedVersionManagerServer() {}
 
 // UnsafeVersionManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -165,7 +254,7 @@ func _VersionManager_Register_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.provider.services.VersionManager/Register",
+		FullMethod: VersionManager_Register_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(VersionManagerServer).Register(ctx, req.(*provider.RegisterProviderRequest))
@@ -173,6 +262,24 @@ func _VersionManager_Register_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VersionManager_AddPlatforms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(provider.RegisterProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).AddPlatforms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_AddPlatforms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).AddPlatforms(ctx, req.(*provider.RegisterProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VersionManager_ListProviderVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProviderName)
 	if err := dec(in); err != nil {
@@ -183,7 +290,7 @@ func _VersionManager_ListProviderVersions_Handler(srv interface{}, ctx context.C
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.provider.services.VersionManager/ListProviderVersions",
+		FullMethod: VersionManager_ListProviderVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(VersionManagerServer).ListProviderVersions(ctx, req.(*ProviderName))
@@ -201,7 +308,7 @@ func _VersionManager_GetVersionData_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.provider.services.VersionManager/GetVersionData",
+		FullMethod: VersionManager_GetVersionData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(VersionManagerServer).GetVersionData(ctx, req.(*VersionDataRequest))
@@ -219,7 +326,7 @@ func _VersionManager_ListProviders_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.provider.services.VersionManager/ListProviders",
+		FullMethod: VersionManager_ListProviders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(VersionManagerServer).ListProviders(ctx, req.(*ListProvidersRequest))
@@ -237,7 +344,7 @@ func _VersionManager_GetProvider_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.provider.services.VersionManager/GetProvider",
+		FullMethod: VersionManager_GetProvider_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(VersionManagerServer).GetProvider(ctx, req.(*ProviderName))
@@ -255,7 +362,7 @@ func _VersionManager_PublishVersion_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.provider.services.VersionManager/PublishVersion",
+		FullMethod: VersionManager_PublishVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(VersionManagerServer).PublishVersion(ctx, req.(*TerminateVersionRequest))
@@ -273,7 +380,7 @@ func _VersionManager_AbortProviderVersion_Handler(srv interface{}, ctx context.C
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.provider.services.VersionManager/AbortProviderVersion",
+		FullMethod: VersionManager_AbortProviderVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(VersionManagerServer).AbortProviderVersion(ctx, req.(*TerminateVersionRequest))
@@ -281,6 +388,78 @@ func _VersionManager_AbortProviderVersion_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VersionManager_DeprecateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeprecateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).DeprecateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_DeprecateProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).DeprecateProvider(ctx, req.(*DeprecateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionManager_ApproveVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(provider.ReviewProviderVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).ApproveVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_ApproveVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).ApproveVersion(ctx, req.(*provider.ReviewProviderVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionManager_RejectVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(provider.ReviewProviderVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).RejectVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_RejectVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).RejectVersion(ctx, req.(*provider.ReviewProviderVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionManager_ListPendingVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionManagerServer).ListPendingVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionManager_ListPendingVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionManagerServer).ListPendingVersions(ctx, req.(*ListPendingVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VersionManager_ServiceDesc is the grpc.ServiceDesc for VersionManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -292,6 +471,10 @@ var VersionManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Register",
 			Handler:    _VersionManager_Register_Handler,
 		},
+		{
+			MethodName: "AddPlatforms",
+			Handler:    _VersionManager_AddPlatforms_Handler,
+		},
 		{
 			MethodName: "ListProviderVersions",
 			Handler:    _VersionManager_ListProviderVersions_Handler,
@@ -316,6 +499,22 @@ var VersionManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AbortProviderVersion",
 			Handler:    _VersionManager_AbortProviderVersion_Handler,
 		},
+		{
+			MethodName: "DeprecateProvider",
+			Handler:    _VersionManager_DeprecateProvider_Handler,
+		},
+		{
+			MethodName: "ApproveVersion",
+			Handler:    _VersionManager_ApproveVersion_Handler,
+		},
+		{
+			MethodName: "RejectVersion",
+			Handler:    _VersionManager_RejectVersion_Handler,
+		},
+		{
+			MethodName: "ListPendingVersions",
+			Handler:    _VersionManager_ListPendingVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb/terrarium/provider/services/version_manager.proto",
@@ -12,12 +12,14 @@ import (
 type MockPublisherClient struct {
 	services.PublisherClient
 	PublishInvocations int
+	PublishRequest     *release.PublishRequest
 	PublishResponse    *release.PublishResponse
 	PublishError       error
 }
 
 func (m *MockPublisherClient) Publish(ctx context.Context, in *release.PublishRequest, opts ...grpc.CallOption) (*release.PublishResponse, error) {
 	m.PublishInvocations++
+	m.PublishRequest = in
 	return m.PublishResponse, m.PublishError
 }
 
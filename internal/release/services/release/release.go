@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"math"
 	"net/http"
@@ -18,12 +19,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
 	releaseSvc "github.com/terrariumcloud/terrarium/internal/release/services"
+	usageServices "github.com/terrariumcloud/terrarium/internal/usage/services"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/terrariumcloud/terrarium/internal/storage"
 	"github.com/terrariumcloud/terrarium/pkg/terrarium/release"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
@@ -46,7 +50,7 @@ var (
 	PublishReleaseError = status.Error(codes.Unknown, "Failed to publish release.")
 	ReleaseNotFound     = status.Error(codes.NotFound, "Release not found.")
 
-	TimeFormatLayout     = "2006-01-02 15:04:05.999999999 -0700 MST"
+	TimeFormatLayout     = storage.TimeFormatLayout
 	DefaultMaxAgeSeconds = uint64(86400) // 1 day in seconds
 )
 
@@ -56,6 +60,8 @@ type ReleaseService struct {
 	Db     storage.DynamoDBTableCreator
 	Table  string
 	Schema *dynamodb.CreateTableInput
+	// DependencyTracker, when set, is told about every release so that the deployment units using it are notified
+	DependencyTracker usageServices.DependencyTrackerClient
 }
 
 type Release struct {
@@ -124,9 +130,34 @@ func (s *ReleaseService) Publish(ctx context.Context, request *release.PublishRe
 		span.RecordError(err)
 	}
 
+	s.notifyDependents(ctx, mv)
+
 	return ReleasePublished, nil
 }
 
+// notifyDependents asks the dependency tracker to notify the deployment units using the released module or provider.
+// Failures are recorded but do not fail the release.
+func (s *ReleaseService) notifyDependents(ctx context.Context, published Release) {
+	if s.DependencyTracker == nil {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+
+	_, err := s.DependencyTracker.NotifyRelease(ctx, &usageServices.NotifyReleaseRequest{
+		Dependency: &usage.DeploymentUnit{
+			Type:         published.Type,
+			Organization: published.Organization,
+			Name:         strings.TrimPrefix(published.Name, published.Organization+"/"),
+		},
+		Version: published.Version,
+	})
+	if err != nil {
+		log.Printf("Failed to notify dependents of %s %s: %v", published.Name, published.Version, err)
+		span.RecordError(err)
+	}
+}
+
 // ListReleases retrieves all releases.
 // Only releases that have been published should be reported.
 func (s *ReleaseService) ListReleases(ctx context.Context, request *releaseSvc.ListReleasesRequest) (*releaseSvc.ListReleasesResponse, error) {
@@ -165,33 +196,46 @@ func (s *ReleaseService) ListReleases(ctx context.Context, request *releaseSvc.L
 		TableName:                 aws.String(ReleaseTableName),
 	}
 
-	response, err := s.Db.Scan(ctx, scanQueryInputs)
+	items, err := storage.ScanAll(ctx, s.Db, scanQueryInputs)
 	if err != nil {
 		span.RecordError(err)
 		return nil, err
 	}
 	span.SetAttributes(
-		attribute.Int("release.count", len(response.Items)),
+		attribute.Int("release.count", len(items)),
 	)
 	grpcResponse := releaseSvc.ListReleasesResponse{}
-	if response.Items != nil {
-		for _, item := range response.Items {
+	for _, item := range items {
 
-			Release := &releaseSvc.Release{}
-			if err3 := attributevalue.UnmarshalMap(item, &Release); err3 != nil {
-				span.RecordError(err3)
-				return nil, err3
-			}
-			grpcResponse.Releases = append(grpcResponse.Releases, Release)
+		Release := &releaseSvc.Release{}
+		if err3 := attributevalue.UnmarshalMap(item, &Release); err3 != nil {
+			span.RecordError(err3)
+			return nil, err3
 		}
+		grpcResponse.Releases = append(grpcResponse.Releases, Release)
 	}
 
 	// Sort list of releases based on createdAt field
 	grpcResponse.Releases = sortReleaseList(grpcResponse.Releases)
 
+	grpcResponse.Releases, grpcResponse.Page, err = paging.Paginate(grpcResponse.Releases, releaseKey, request.Page)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	return &grpcResponse, nil
 }
 
+// releaseKey orders releases by creation time like sortReleaseList, telling apart the ones created at the same time.
+func releaseKey(r *releaseSvc.Release) string {
+	var createdAt int64
+	if t, err := time.Parse(TimeFormatLayout, r.CreatedAt); err == nil {
+		createdAt = t.UnixNano()
+	}
+	return fmt.Sprintf("%020d/%s/%s/%s/%s", createdAt, r.Type, r.Organization, r.Name, r.Version)
+}
+
 // Sort a slice of releaseSvc.Release struct, using sort.SliceStable method
 func sortReleaseList(releases []*releaseSvc.Release) []*releaseSvc.Release {
 	sort.SliceStable(releases, func(a, b int) bool {
@@ -279,6 +323,10 @@ func GetDistinctValues(resp []string) []string {
 	return distinctList
 }
 
+func distinctValueKey(value string) string {
+	return value
+}
+
 // ListReleaseTypes is used to retrieve all distinct release types.
 func (s *ReleaseService) ListReleaseTypes(ctx context.Context, request *releaseSvc.ListReleaseTypesRequest) (*releaseSvc.ListReleaseTypesResponse, error) {
 	span := trace.SpanFromContext(ctx)
@@ -294,34 +342,38 @@ func (s *ReleaseService) ListReleaseTypes(ctx context.Context, request *releaseS
 		TableName: aws.String(ReleaseTableName),
 	}
 
-	response, err := s.Db.Scan(ctx, scanQueryInputs)
+	items, err := storage.ScanAll(ctx, s.Db, scanQueryInputs)
 	if err != nil {
 		span.RecordError(err)
 		return nil, err
 	}
 
-	typeValues := make([]string, 0, len(response.Items))
+	typeValues := make([]string, 0, len(items))
 	typeStr := ""
 
-	if response.Items != nil {
-		for _, item := range response.Items {
-			typeAttr, found := item["type"]
-			if !found {
-				log.Println("type attribute not found")
-				continue
-			}
+	for _, item := range items {
+		typeAttr, found := item["type"]
+		if !found {
+			log.Println("type attribute not found")
+			continue
+		}
 
-			if err := attributevalue.Unmarshal(typeAttr, &typeStr); err != nil {
-				span.RecordError(err)
-				log.Printf("Failed to unmarshal types: %v", err)
-				continue
-			}
-			if typeStr != "" {
-				typeValues = append(typeValues, typeStr)
-			}
+		if err := attributevalue.Unmarshal(typeAttr, &typeStr); err != nil {
+			span.RecordError(err)
+			log.Printf("Failed to unmarshal types: %v", err)
+			continue
+		}
+		if typeStr != "" {
+			typeValues = append(typeValues, typeStr)
 		}
 	}
-	grpcResponse := &releaseSvc.ListReleaseTypesResponse{Types: GetDistinctValues(typeValues)}
+
+	releaseTypes, page, err := paging.Paginate(GetDistinctValues(typeValues), distinctValueKey, request.Page)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	grpcResponse := &releaseSvc.ListReleaseTypesResponse{Types: releaseTypes, Page: page}
 	return grpcResponse, nil
 
 }
@@ -338,34 +390,38 @@ func (s *ReleaseService) ListOrganization(ctx context.Context, request *releaseS
 		TableName:            aws.String(ReleaseTableName),
 	}
 
-	response, err := s.Db.Scan(ctx, scanQueryInputs)
+	items, err := storage.ScanAll(ctx, s.Db, scanQueryInputs)
 	if err != nil {
 		span.RecordError(err)
 		return nil, err
 	}
 
-	orgValues := make([]string, 0, len(response.Items))
+	orgValues := make([]string, 0, len(items))
 	orgStr := ""
 
-	if response.Items != nil {
-		for _, item := range response.Items {
-			typeAttr, found := item["organization"]
-			if !found {
-				log.Println("organization attribute not found")
-				continue
-			}
+	for _, item := range items {
+		typeAttr, found := item["organization"]
+		if !found {
+			log.Println("organization attribute not found")
+			continue
+		}
 
-			if err := attributevalue.Unmarshal(typeAttr, &orgStr); err != nil {
-				span.RecordError(err)
-				log.Printf("Failed to unmarshal organizations: %v", err)
-				continue
-			}
-			if orgStr != "" {
-				orgValues = append(orgValues, orgStr)
-			}
+		if err := attributevalue.Unmarshal(typeAttr, &orgStr); err != nil {
+			span.RecordError(err)
+			log.Printf("Failed to unmarshal organizations: %v", err)
+			continue
 		}
+		if orgStr != "" {
+			orgValues = append(orgValues, orgStr)
+		}
+	}
+
+	organizations, page, err := paging.Paginate(GetDistinctValues(orgValues), distinctValueKey, request.Page)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
 	}
-	grpcResponse := &releaseSvc.ListOrganizationResponse{Organizations: GetDistinctValues(orgValues)}
+	grpcResponse := &releaseSvc.ListOrganizationResponse{Organizations: organizations, Page: page}
 	return grpcResponse, nil
 }
 
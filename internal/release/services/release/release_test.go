@@ -18,6 +18,7 @@ import (
 	"github.com/terrariumcloud/terrarium/internal/common/paging"
 	"github.com/terrariumcloud/terrarium/internal/release/services"
 	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	usageMocks "github.com/terrariumcloud/terrarium/internal/usage/services/mocks"
 	"github.com/terrariumcloud/terrarium/pkg/terrarium/release"
 	"google.golang.org/grpc"
 )
@@ -83,6 +84,8 @@ func Test_RegisterReleaseWithServer(t *testing.T) {
 
 // Test_PublishRelease checks:
 // - if correct response is returned when release is published
+// - if the dependency tracker is asked to notify the users of the release
+// - if the release is published when the dependency tracker fails
 // - if error is returned when PutItem fails
 func Test_PublishRelease(t *testing.T) {
 	t.Parallel()
@@ -125,6 +128,54 @@ func Test_PublishRelease(t *testing.T) {
 		}
 	})
 
+	t.Run("when the dependency tracker is set", func(t *testing.T) {
+		tracker := &usageMocks.MockDependencyTrackerClient{}
+
+		svc := &ReleaseService{Db: &mocks.DynamoDB{}, DependencyTracker: tracker}
+
+		req := &release.PublishRequest{
+			Type:         "module",
+			Organization: "cie",
+			Name:         "cie/vpc/aws",
+			Version:      "1.1.0",
+		}
+
+		_, err := svc.Publish(context.TODO(), req)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if tracker.NotifyReleaseInvocations != 1 {
+			t.Fatalf("Expected 1 call to NotifyRelease, got %d", tracker.NotifyReleaseInvocations)
+		}
+
+		dependency := tracker.NotifyReleaseRequest.GetDependency()
+		if dependency.GetType() != "module" || dependency.GetOrganization() != "cie" || dependency.GetName() != "vpc/aws" {
+			t.Errorf("Expected module cie vpc/aws, got %v", dependency)
+		}
+
+		if tracker.NotifyReleaseRequest.GetVersion() != "1.1.0" {
+			t.Errorf("Expected version 1.1.0, got %s", tracker.NotifyReleaseRequest.GetVersion())
+		}
+	})
+
+	t.Run("when the dependency tracker fails", func(t *testing.T) {
+		tracker := &usageMocks.MockDependencyTrackerClient{NotifyReleaseError: errors.New("some error")}
+
+		svc := &ReleaseService{Db: &mocks.DynamoDB{}, DependencyTracker: tracker}
+
+		res, err := svc.Publish(context.TODO(), &release.PublishRequest{Type: "provider", Organization: "cie", Name: "cie/ldap", Version: "0.4.0"})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if res != ReleasePublished {
+			t.Errorf("Expected %v, got %v.", ReleasePublished, res)
+		}
+	})
+
 	t.Run("when PutItem fails", func(t *testing.T) {
 		db := &mocks.DynamoDB{PutItemError: errors.New("some error")}
 
@@ -167,6 +218,7 @@ func Test_PublishRelease(t *testing.T) {
 
 // Test_ListReleases checks:
 // - if correct response is returned when releases are fetched
+// - if releases of every scanned page are walked with cursors from the oldest
 func Test_ListReleases(t *testing.T) {
 	t.Parallel()
 
@@ -230,6 +282,58 @@ func Test_ListReleases(t *testing.T) {
 
 	})
 
+	t.Run("when releases are paged", func(t *testing.T) {
+		makeRelease := func(createdAt, name string) map[string]types.AttributeValue {
+			return map[string]types.AttributeValue{
+				"createdAt":    &types.AttributeValueMemberS{Value: createdAt},
+				"type":         &types.AttributeValueMemberS{Value: "module"},
+				"organization": &types.AttributeValueMemberS{Value: "cie"},
+				"name":         &types.AttributeValueMemberS{Value: name},
+				"version":      &types.AttributeValueMemberS{Value: "1.0.0"},
+			}
+		}
+		scanOuts := func() []*dynamodb.ScanOutput {
+			return []*dynamodb.ScanOutput{
+				{
+					Items:            []map[string]types.AttributeValue{makeRelease("2022-11-17 15:11:35.1 +0000 UTC", "second")},
+					LastEvaluatedKey: map[string]types.AttributeValue{"name": &types.AttributeValueMemberS{Value: "second"}},
+				},
+				{
+					Items: []map[string]types.AttributeValue{
+						makeRelease("2022-11-20 09:00:00 +0000 UTC", "third"),
+						makeRelease("2022-10-01 09:00:00 +0000 UTC", "first"),
+					},
+				},
+			}
+		}
+
+		db := &mocks.DynamoDB{ScanOuts: scanOuts()}
+		svc := &ReleaseService{Db: db}
+
+		res, err := svc.ListReleases(context.TODO(), &services.ListReleasesRequest{Page: &paging.PageInfoRequest{Count: 2}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if db.ScanItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to Scan, got %v", db.ScanItemInvocations)
+		}
+
+		if len(res.Releases) != 2 || res.Releases[0].Name != "first" || res.Releases[1].Name != "second" || res.Page.TotalCount != 3 {
+			t.Fatalf("Expected first and second of 3 releases, got %v", res)
+		}
+
+		db = &mocks.DynamoDB{ScanOuts: scanOuts()}
+		svc = &ReleaseService{Db: db}
+
+		res, _ = svc.ListReleases(context.TODO(), &services.ListReleasesRequest{Page: &paging.PageInfoRequest{Count: 2, Cursor: res.Page.NextCursor}})
+
+		if len(res.Releases) != 1 || res.Releases[0].Name != "third" || res.Page.NextCursor != "" {
+			t.Errorf("Expected last page with third, got %v", res)
+		}
+	})
+
 }
 
 // Test_ListLatestRelease checks:
@@ -341,7 +445,7 @@ func Test_ListLatestRelease(t *testing.T) {
 }
 
 // Test_ListReleaseType checks:
-// - if distinct release types are retrieved
+// - if the requested page of distinct release types is retrieved sorted
 func Test_ListReleaseTypes(t *testing.T) {
 	t.Parallel()
 
@@ -422,18 +526,22 @@ func Test_ListReleaseTypes(t *testing.T) {
 		}
 
 		expectedReleaseTypes := &services.ListReleaseTypesResponse{
-			Types: []string{"module", "bundle"},
+			Types: []string{"module"},
 		}
 
 		if !EqualSlices(res.Types, expectedReleaseTypes.Types) {
 			t.Errorf("Got %v, want %v", res.Types, expectedReleaseTypes.Types)
 		}
+
+		if res.Page.TotalCount != 2 || res.Page.NextCursor != "" {
+			t.Errorf("Expected last page of 2 types, got %v", res.Page)
+		}
 	})
 
 }
 
 // Test_ListOrganization checks:
-// - if distinct organizations are retrieved
+// - if the requested page of distinct organizations is retrieved sorted
 func Test_ListOrganization(t *testing.T) {
 	t.Parallel()
 
@@ -514,12 +622,16 @@ func Test_ListOrganization(t *testing.T) {
 		}
 
 		expectedOrgs := &services.ListOrganizationResponse{
-			Organizations: []string{"cie", "atlas"},
+			Organizations: []string{"cie"},
 		}
 
 		if !EqualSlices(res.Organizations, expectedOrgs.Organizations) {
 			t.Errorf("Got %v, want %v", res.Organizations, expectedOrgs.Organizations)
 		}
+
+		if res.Page.TotalCount != 2 || res.Page.NextCursor != "" {
+			t.Errorf("Expected last page of 2 organizations, got %v", res.Page)
+		}
 	})
 
 }
@@ -1,18 +1,22 @@
 package browse
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/terrariumcloud/terrarium/internal/common/identity"
 	"github.com/terrariumcloud/terrarium/internal/release/services/release"
 	v1 "github.com/terrariumcloud/terrarium/internal/restapi/modules/v1"
 	providersV1 "github.com/terrariumcloud/terrarium/internal/restapi/providers/v1"
@@ -24,15 +28,28 @@ import (
 	providerServices "github.com/terrariumcloud/terrarium/internal/provider/services"
 	releaseServices "github.com/terrariumcloud/terrarium/internal/release/services"
 	"github.com/terrariumcloud/terrarium/internal/restapi"
+	searchServices "github.com/terrariumcloud/terrarium/internal/search/services"
+	usageServices "github.com/terrariumcloud/terrarium/internal/usage/services"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	terrariumProvider "github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
+	terrariumUsage "github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
+	"google.golang.org/grpc/status"
 )
 
 type browseHttpService struct {
+	dependencyManagerClient      services.DependencyManagerClient
+	dependencyTrackerClient      usageServices.DependencyTrackerClient
 	providerVersionManagerClient providerServices.VersionManagerClient
 	registrarClient              services.RegistrarClient
 	versionManagerClient         services.VersionManagerClient
 	releasesClient               releaseServices.BrowseClient
+	indexerClient                searchServices.IndexerClient
+	storageClient                services.StorageClient
+	tagManagerClient             services.TagManagerClient
 	responseHandler              restapi.ResponseHandler
 	errorHandler                 restapi.ErrorHandler
+	// Identity lists the proxies trusted to pass on the identity of callers
+	Identity *identity.Trust
 }
 
 func (h *browseHttpService) GetHttpHandler(mountPath string) http.Handler {
@@ -41,8 +58,8 @@ func (h *browseHttpService) GetHttpHandler(mountPath string) http.Handler {
 	return handlers.CombinedLoggingHandler(os.Stdout, router)
 }
 
-func New(registrarClient services.RegistrarClient, versionManagerClient services.VersionManagerClient, releasesClient releaseServices.BrowseClient, providerVersionManagerClient providerServices.VersionManagerClient) *browseHttpService {
-	return &browseHttpService{registrarClient: registrarClient, versionManagerClient: versionManagerClient, releasesClient: releasesClient, providerVersionManagerClient: providerVersionManagerClient}
+func New(registrarClient services.RegistrarClient, versionManagerClient services.VersionManagerClient, releasesClient releaseServices.BrowseClient, providerVersionManagerClient providerServices.VersionManagerClient, dependencyManagerClient services.DependencyManagerClient, dependencyTrackerClient usageServices.DependencyTrackerClient, indexerClient searchServices.IndexerClient, storageClient services.StorageClient, tagManagerClient services.TagManagerClient) *browseHttpService {
+	return &browseHttpService{registrarClient: registrarClient, versionManagerClient: versionManagerClient, releasesClient: releasesClient, providerVersionManagerClient: providerVersionManagerClient, dependencyManagerClient: dependencyManagerClient, dependencyTrackerClient: dependencyTrackerClient, indexerClient: indexerClient, storageClient: storageClient, tagManagerClient: tagManagerClient}
 }
 
 func (h *browseHttpService) createRouter(mountPath string) *mux.Router {
@@ -54,12 +71,26 @@ func (h *browseHttpService) createRouter(mountPath string) *mux.Router {
 	apiRouter.Use(otelmux.Middleware("browse"))
 	apiRouter.StrictSlash(true)
 	apiRouter.Handle("/modules/{organization_name}/{name}/{provider}", h.getModuleMetadataHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/modules/{organization_name}/{name}/{provider}/dependents", h.getModuleDependentsHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/modules/{organization_name}/{name}/{provider}/{version}/sbom", h.getModuleSBOMHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/modules/{organization_name}/{name}/{provider}/{version}/graph", h.getModuleGraphHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/modules/{organization_name}/{name}/{provider}/{version}/docs", h.getModuleDocsHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/modules/{organization_name}/{name}/{provider}/{version}/files", h.getSourceFilesHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/modules/{organization_name}/{name}/{provider}/{version}/files/{path:.+}", h.getSourceFileHandler()).Methods(http.MethodGet)
 	apiRouter.Handle("/modules", h.getModuleListHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/images", h.getContainerImagesHandler()).Methods(http.MethodGet)
 	apiRouter.Handle("/releases", h.getReleasesHandler()).Methods(http.MethodGet)
 	apiRouter.Handle("/organizations", h.getOrganizationsHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/tags", h.getTagsHandler()).Methods(http.MethodGet)
 	apiRouter.Handle("/types", h.getReleaseTypesHandler()).Methods(http.MethodGet)
 	apiRouter.Handle("/providers", h.getProviderListHandler()).Methods(http.MethodGet)
 	apiRouter.Handle("/providers/{organization_name}/{name}", h.getProviderMetadataHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/providers/{organization_name}/{name}/modules", h.getProviderDependentsHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/approvals", h.getPendingApprovalsHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/search", h.getSearchHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/usage/{type}/{organization_name}/{name:.+}", h.getUsageHandler()).Methods(http.MethodGet)
+	apiRouter.Handle("/modules/{organization_name}/{name}/{provider}/{version}/{decision:approve|reject}", h.reviewModuleVersionHandler()).Methods(http.MethodPost)
+	apiRouter.Handle("/providers/{organization_name}/{name}/{version}/{decision:approve|reject}", h.reviewProviderVersionHandler()).Methods(http.MethodPost)
 	rootRouter.PathPrefix("/").Handler(getFrontendSpaHandler())
 	return rootRouter
 }
@@ -70,26 +101,72 @@ func (h *browseHttpService) healthHandler() http.Handler {
 	})
 }
 
-// GetModuleListHandler will return a list of all published module.
+// GetModuleListHandler will return a list of all published module with their tags,
+// restricted to the modules having the tag given in the query.
 func (h *browseHttpService) getModuleListHandler() http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 
 		ctx := r.Context()
 		span := trace.SpanFromContext(ctx)
 
-		if registrarResponse, err := h.registrarClient.ListModules(r.Context(), &services.ListModulesRequest{}); err != nil {
+		page, err := getPageFromRequest(r)
+		if err != nil {
+			h.errorHandler.Write(rw, err, http.StatusBadRequest)
+			return
+		}
+
+		request := &services.ListModulesRequest{Page: page}
+		if tag := r.URL.Query().Get("tag"); tag != "" {
+			span.SetAttributes(attribute.String("module.tag", tag))
+			tagResponse, err := h.tagManagerClient.ListModulesByTag(ctx, &terrarium.ListModulesByTagRequest{Tag: tag})
+			if err != nil {
+				span.RecordError(err)
+				h.errorHandler.Write(rw, errors.New("failed to retrieve the modules having the tag from backend service"), backendErrorStatusCode(err))
+				return
+			}
+			if len(tagResponse.GetModules()) == 0 {
+				h.responseHandler.Write(rw, createModulesResponse([]*services.ModuleMetadata{}, nil), http.StatusOK)
+				return
+			}
+			request.Names = tagResponse.GetModules()
+		}
+
+		if registrarResponse, err := h.registrarClient.ListModules(r.Context(), request); err != nil {
 			span.RecordError(err)
-			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of modules from backend service"), http.StatusInternalServerError)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of modules from backend service"), backendErrorStatusCode(err))
 			return
 		} else {
-			data, _ := json.Marshal(createModulesResponse(registrarResponse.Modules))
+			h.addModuleTags(ctx, registrarResponse.Modules)
+			data, _ := json.Marshal(createModulesResponse(registrarResponse.Modules, registrarResponse.Page))
 
+			writeNextCursor(rw, registrarResponse.Page)
 			rw.Header().Add("Content-Type", "application/json")
 			_, _ = rw.Write(data)
 		}
 	})
 }
 
+// addModuleTags fills in the tags of the modules listed. Modules are still listed without their tags when the
+// tags cannot be retrieved.
+func (h *browseHttpService) addModuleTags(ctx context.Context, modules []*services.ModuleMetadata) {
+	if len(modules) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(modules))
+	for _, module := range modules {
+		names = append(names, fmt.Sprintf("%s/%s/%s", module.GetOrganization(), module.GetName(), module.GetProvider()))
+	}
+
+	response, err := h.tagManagerClient.ListModuleTags(ctx, &services.ListModuleTagsRequest{Names: names})
+	if err != nil {
+		log.Printf("Failed to retrieve the tags of the modules listed: %v", err)
+		trace.SpanFromContext(ctx).RecordError(err)
+		return
+	}
+	setModuleTags(modules, response.GetModules())
+}
+
 func (h *browseHttpService) getModuleMetadataHandler() http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		moduleName := v1.GetModuleNameFromRequest(r)
@@ -124,11 +201,250 @@ func (h *browseHttpService) getModuleMetadataHandler() http.Handler {
 		}
 		versionResponse.Versions = filteredVersions
 
-		data := createModuleMetadataResponse(registrarResponse.GetModule(), versionResponse.Versions)
+		data := createModuleMetadataResponse(registrarResponse.GetModule(), versionResponse.Versions, versionResponse.GetMaturities())
+		if tagsResponse, err := h.tagManagerClient.GetTags(ctx, &terrarium.GetTagsRequest{Name: moduleName}); err != nil {
+			log.Printf("Failed to retrieve the tags of %s: %v", moduleName, err)
+			span.RecordError(err)
+		} else {
+			data.Tags = append(data.Tags, tagsResponse.GetTags()...)
+		}
 		h.responseHandler.Write(rw, data, http.StatusOK)
 	})
 }
 
+// getModuleDependentsHandler will return the modules depending on a module.
+// The lookup can be restricted to a version or a version range, and made transitive with recursive=true.
+func (h *browseHttpService) getModuleDependentsHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		module := &terrarium.Module{
+			Name:    v1.GetModuleNameFromRequest(r),
+			Version: query.Get("version"),
+		}
+		request := &terrarium.RetrieveDependentsRequest{
+			Module:       module,
+			Recursive:    query.Get("recursive") == "true",
+			VersionRange: query.Get("range"),
+		}
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("module.name", module.GetName()),
+			attribute.String("module.version", module.GetVersion()),
+			attribute.String("module.version_range", request.GetVersionRange()),
+		)
+
+		stream, err := h.dependencyManagerClient.RetrieveDependents(ctx, request)
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the module dependents from backend service"), http.StatusInternalServerError)
+			return
+		}
+
+		var responses []*terrarium.DependentsResponse
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				span.RecordError(err)
+				h.errorHandler.Write(rw, fmt.Errorf("failed to retrieve the module dependents: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+				return
+			}
+			responses = append(responses, res)
+		}
+
+		h.responseHandler.Write(rw, createDependentsResponse(module, responses), http.StatusOK)
+	})
+}
+
+// getModuleSBOMHandler will return the software bill of materials of a module version,
+// as CycloneDX JSON unless format=spdx is given in the query.
+func (h *browseHttpService) getModuleSBOMHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		module := &terrarium.Module{
+			Name:    v1.GetModuleNameFromRequest(r),
+			Version: mux.Vars(r)["version"],
+		}
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("module.name", module.GetName()),
+			attribute.String("module.version", module.GetVersion()),
+		)
+
+		format, ok := parseSBOMFormat(r.URL.Query().Get("format"))
+		if !ok {
+			h.errorHandler.Write(rw, errors.New("unsupported SBOM format, expected cyclonedx or spdx"), http.StatusBadRequest)
+			return
+		}
+
+		response, err := h.dependencyManagerClient.ExportSBOM(ctx, &terrarium.ExportSBOMRequest{Module: module, Format: format})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to export the SBOM: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		rw.Header().Add("Content-Type", response.GetMediaType())
+		_, _ = rw.Write(response.GetDocument())
+	})
+}
+
+// getModuleGraphHandler will return the dependency graph of a module version as nodes and edges,
+// walking at most max_depth levels when given in the query.
+func (h *browseHttpService) getModuleGraphHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		module := &terrarium.Module{
+			Name:    v1.GetModuleNameFromRequest(r),
+			Version: mux.Vars(r)["version"],
+		}
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("module.name", module.GetName()),
+			attribute.String("module.version", module.GetVersion()),
+		)
+
+		var maxDepth int64
+		if depth := r.URL.Query().Get("max_depth"); depth != "" {
+			parsedDepth, err := strconv.ParseInt(depth, 10, 32)
+			if err != nil || parsedDepth < 0 {
+				h.errorHandler.Write(rw, errors.New("max_depth must be a non-negative number"), http.StatusBadRequest)
+				return
+			}
+			maxDepth = parsedDepth
+		}
+
+		graph, err := h.dependencyManagerClient.RetrieveDependencyGraph(ctx, &terrarium.RetrieveDependencyGraphRequest{Module: module, MaxDepth: int32(maxDepth)})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to retrieve the dependency graph: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		h.responseHandler.Write(rw, createDependencyGraphResponse(graph), http.StatusOK)
+	})
+}
+
+// getModuleDocsHandler will return the documentation of a module version read from its source archive: README,
+// inputs, outputs, required providers, resources and module calls, with the same for its submodules and examples.
+func (h *browseHttpService) getModuleDocsHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		module := &terrarium.Module{
+			Name:    v1.GetModuleNameFromRequest(r),
+			Version: mux.Vars(r)["version"],
+		}
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("module.name", module.GetName()),
+			attribute.String("module.version", module.GetVersion()),
+		)
+
+		docs, err := h.storageClient.GetModuleDocs(ctx, &services.GetModuleDocsRequest{Module: module})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to retrieve the module documentation: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		h.responseHandler.Write(rw, createModuleDocsResponse(module, docs), http.StatusOK)
+	})
+}
+
+// getSourceFilesHandler will return the file tree of the source archive of a module version.
+func (h *browseHttpService) getSourceFilesHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		module := &terrarium.Module{
+			Name:    v1.GetModuleNameFromRequest(r),
+			Version: mux.Vars(r)["version"],
+		}
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("module.name", module.GetName()),
+			attribute.String("module.version", module.GetVersion()),
+		)
+
+		response, err := h.storageClient.ListSourceFiles(ctx, &services.ListSourceFilesRequest{Module: module})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to list the module source files: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		h.responseHandler.Write(rw, createSourceFilesResponse(module, response.GetFiles()), http.StatusOK)
+	})
+}
+
+// getSourceFileHandler will return a file of the source archive of a module version, its content being left out
+// when the file is binary.
+func (h *browseHttpService) getSourceFileHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		module := &terrarium.Module{
+			Name:    v1.GetModuleNameFromRequest(r),
+			Version: mux.Vars(r)["version"],
+		}
+		filePath := mux.Vars(r)["path"]
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("module.name", module.GetName()),
+			attribute.String("module.version", module.GetVersion()),
+			attribute.String("file.path", filePath),
+		)
+
+		file, err := h.storageClient.GetSourceFile(ctx, &services.GetSourceFileRequest{Module: module, Path: filePath})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to retrieve the module source file: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		h.responseHandler.Write(rw, createSourceFileResponse(module, file), http.StatusOK)
+	})
+}
+
+// getContainerImagesHandler will return the module versions referencing the container images matching
+// the name, namespace, tag and digest prefix given in the query, grouped by module.
+func (h *browseHttpService) getContainerImagesHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		request := &terrarium.SearchContainerImagesRequest{
+			Name:      query.Get("name"),
+			Namespace: query.Get("namespace"),
+			Tag:       query.Get("tag"),
+			Digest:    query.Get("digest"),
+		}
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("image.name", request.GetName()),
+			attribute.String("image.namespace", request.GetNamespace()),
+			attribute.String("image.tag", request.GetTag()),
+			attribute.String("image.digest", request.GetDigest()),
+		)
+
+		response, err := h.dependencyManagerClient.SearchContainerImages(ctx, request)
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to search container images: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		h.responseHandler.Write(rw, createContainerImagesResponse(response.GetModules()), http.StatusOK)
+	})
+}
+
 // GetReleasesHandler will return a list of all releases published.
 func (h *browseHttpService) getReleasesHandler() http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
@@ -152,17 +468,25 @@ func (h *browseHttpService) getReleasesHandler() http.Handler {
 			attribute.Int64("release.maxAge", MaxAgeSeconds),
 		)
 
+		page, err := getPageFromRequest(r)
+		if err != nil {
+			h.errorHandler.Write(rw, err, http.StatusBadRequest)
+			return
+		}
+
 		response, err := h.releasesClient.ListReleases(r.Context(), &releaseServices.ListReleasesRequest{
 			MaxAgeSeconds: &maxAge,
+			Page:          page,
 		})
 		if err != nil {
 			span.RecordError(err)
-			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of releases from backend service"), http.StatusInternalServerError)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of releases from backend service"), backendErrorStatusCode(err))
 			return
 		}
 
-		data, _ := json.Marshal(createReleaseResponse(response.Releases))
+		data, _ := json.Marshal(createReleaseResponse(response.Releases, response.Page))
 
+		writeNextCursor(rw, response.Page)
 		rw.Header().Add("Content-Type", "application/json")
 		_, _ = rw.Write(data)
 	})
@@ -175,12 +499,19 @@ func (h *browseHttpService) getReleaseTypesHandler() http.Handler {
 		ctx := r.Context()
 		span := trace.SpanFromContext(ctx)
 
-		releaseTypesResponse, err := h.releasesClient.ListReleaseTypes(r.Context(), &releaseServices.ListReleaseTypesRequest{})
+		page, err := getPageFromRequest(r)
+		if err != nil {
+			h.errorHandler.Write(rw, err, http.StatusBadRequest)
+			return
+		}
+
+		releaseTypesResponse, err := h.releasesClient.ListReleaseTypes(r.Context(), &releaseServices.ListReleaseTypesRequest{Page: page})
 		if err != nil {
 			span.RecordError(err)
-			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of release types from backend service"), http.StatusInternalServerError)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of release types from backend service"), backendErrorStatusCode(err))
 			return
 		}
+		writeNextCursor(rw, releaseTypesResponse.Page)
 
 		types := releaseTypesResponse.Types
 		if types == nil {
@@ -200,12 +531,19 @@ func (h *browseHttpService) getOrganizationsHandler() http.Handler {
 		ctx := r.Context()
 		span := trace.SpanFromContext(ctx)
 
-		releaseOrganizationsResponse, err := h.releasesClient.ListOrganization(r.Context(), &releaseServices.ListOrganizationRequest{})
+		page, err := getPageFromRequest(r)
+		if err != nil {
+			h.errorHandler.Write(rw, err, http.StatusBadRequest)
+			return
+		}
+
+		releaseOrganizationsResponse, err := h.releasesClient.ListOrganization(r.Context(), &releaseServices.ListOrganizationRequest{Page: page})
 		if err != nil {
 			span.RecordError(err)
-			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of organizations from backend service"), http.StatusInternalServerError)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of organizations from backend service"), backendErrorStatusCode(err))
 			return
 		}
+		writeNextCursor(rw, releaseOrganizationsResponse.Page)
 		organizations := releaseOrganizationsResponse.Organizations
 		if organizations == nil {
 			organizations = make([]string, 0)
@@ -217,6 +555,31 @@ func (h *browseHttpService) getOrganizationsHandler() http.Handler {
 	})
 }
 
+// getTagsHandler will return the tags in use with the number of modules having each of them.
+func (h *browseHttpService) getTagsHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+
+		page, err := getPageFromRequest(r)
+		if err != nil {
+			h.errorHandler.Write(rw, err, http.StatusBadRequest)
+			return
+		}
+
+		response, err := h.tagManagerClient.ListTags(ctx, &services.ListTagsRequest{Page: page})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of tags from backend service"), backendErrorStatusCode(err))
+			return
+		}
+
+		writeNextCursor(rw, response.GetPage())
+		h.responseHandler.Write(rw, createTagsResponse(response), http.StatusOK)
+	})
+}
+
 // GetProviderListHandler will return a list of all published providers.
 func (h *browseHttpService) getProviderListHandler() http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
@@ -224,13 +587,20 @@ func (h *browseHttpService) getProviderListHandler() http.Handler {
 		ctx := r.Context()
 		span := trace.SpanFromContext(ctx)
 
-		if registrarResponse, err := h.providerVersionManagerClient.ListProviders(ctx, &providerServices.ListProvidersRequest{}); err != nil {
+		page, err := getPageFromRequest(r)
+		if err != nil {
+			h.errorHandler.Write(rw, err, http.StatusBadRequest)
+			return
+		}
+
+		if registrarResponse, err := h.providerVersionManagerClient.ListProviders(ctx, &providerServices.ListProvidersRequest{Page: page}); err != nil {
 			span.RecordError(err)
-			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of providers from backend service"), http.StatusInternalServerError)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of providers from backend service"), backendErrorStatusCode(err))
 			return
 		} else {
-			data, _ := json.Marshal(createProvidersResponse(registrarResponse.Providers))
+			data, _ := json.Marshal(createProvidersResponse(registrarResponse.Providers, registrarResponse.Page))
 
+			writeNextCursor(rw, registrarResponse.Page)
 			rw.Header().Add("Content-Type", "application/json")
 			_, _ = rw.Write(data)
 		}
@@ -271,8 +641,209 @@ func (h *browseHttpService) getProviderMetadataHandler() http.Handler {
 			}
 
 		}
-		data := createProviderMetadataResponse(registrarResponse.GetProvider(), filteredVersions)
+		data := createProviderMetadataResponse(registrarResponse.GetProvider(), filteredVersions, versionResponse.GetWarnings())
 
 		h.responseHandler.Write(rw, data, http.StatusOK)
 	})
 }
+
+// getProviderDependentsHandler will return the hosted module versions requiring a provider, restricted to the ones
+// whose version constraints allow the version given in the query.
+func (h *browseHttpService) getProviderDependentsHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		request := &services.RetrieveProviderDependentsRequest{
+			Provider: providersV1.GetProviderNameFromRequest(r),
+			Version:  r.URL.Query().Get("version"),
+		}
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("provider.name", request.GetProvider()),
+			attribute.String("provider.version", request.GetVersion()),
+		)
+
+		response, err := h.dependencyManagerClient.RetrieveProviderDependents(ctx, request)
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to retrieve the modules requiring the provider: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		h.responseHandler.Write(rw, createProviderDependentsResponse(request, response.GetDependents()), http.StatusOK)
+	})
+}
+
+// getSearchHandler will return the modules and providers matching the query, best matches first,
+// with the organizations, providers, maturities and tags of the results.
+func (h *browseHttpService) getSearchHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		request, err := getSearchRequestFromRequest(r)
+		if err != nil {
+			h.errorHandler.Write(rw, err, http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("search.query", request.GetQuery()),
+		)
+
+		response, err := h.indexerClient.Search(ctx, request)
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to search modules and providers: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		writeNextCursor(rw, response.GetPage())
+		h.responseHandler.Write(rw, createSearchResponse(request.GetQuery(), response), http.StatusOK)
+	})
+}
+
+// getPendingApprovalsHandler will return the module and provider versions waiting for approval,
+// optionally restricted to the organization given in the query.
+func (h *browseHttpService) getPendingApprovalsHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		organization := r.URL.Query().Get("organization")
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("approval.organization", organization),
+		)
+
+		moduleResponse, err := h.versionManagerClient.ListPendingVersions(ctx, &services.ListPendingVersionsRequest{Organization: organization})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of module versions pending approval from backend service"), http.StatusInternalServerError)
+			return
+		}
+
+		providerResponse, err := h.providerVersionManagerClient.ListPendingVersions(ctx, &providerServices.ListPendingVersionsRequest{Organization: organization})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of provider versions pending approval from backend service"), http.StatusInternalServerError)
+			return
+		}
+
+		data := createPendingApprovalsResponse(moduleResponse.GetVersions(), providerResponse.GetVersions())
+		h.responseHandler.Write(rw, data, http.StatusOK)
+	})
+}
+
+// reviewModuleVersionHandler will approve or reject a module version waiting for approval on behalf of the caller.
+func (h *browseHttpService) reviewModuleVersionHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		module := &terrarium.Module{
+			Name:    v1.GetModuleNameFromRequest(r),
+			Version: mux.Vars(r)["version"],
+		}
+		decision := mux.Vars(r)["decision"]
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("module.name", module.GetName()),
+			attribute.String("module.version", module.GetVersion()),
+			attribute.String("approval.decision", decision),
+		)
+
+		review := reviewRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			h.errorHandler.Write(rw, errors.New("invalid review request"), http.StatusBadRequest)
+			return
+		}
+
+		reviewer := h.Identity.FromRequest(r)
+		reviewCtx := identity.NewOutgoingContext(ctx, reviewer)
+		request := &terrarium.ReviewVersionRequest{Module: module, Comment: review.Comment}
+		var err error
+		if decision == "approve" {
+			_, err = h.versionManagerClient.ApproveVersion(reviewCtx, request)
+		} else {
+			_, err = h.versionManagerClient.RejectVersion(reviewCtx, request)
+		}
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to %s the module version: %s", decision, status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		h.responseHandler.Write(rw, createReviewResponse(module.GetName(), module.GetVersion(), decision, reviewer, review), http.StatusOK)
+	})
+}
+
+// reviewProviderVersionHandler will approve or reject a provider version waiting for approval on behalf of the caller.
+func (h *browseHttpService) reviewProviderVersionHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		provider := providersV1.GetVersionedProviderFromRequest(r)
+		decision := mux.Vars(r)["decision"]
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("provider.name", provider.GetName()),
+			attribute.String("provider.version", provider.GetVersion()),
+			attribute.String("approval.decision", decision),
+		)
+
+		review := reviewRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			h.errorHandler.Write(rw, errors.New("invalid review request"), http.StatusBadRequest)
+			return
+		}
+
+		reviewer := h.Identity.FromRequest(r)
+		reviewCtx := identity.NewOutgoingContext(ctx, reviewer)
+		request := &terrariumProvider.ReviewProviderVersionRequest{Provider: provider, Comment: review.Comment}
+		var err error
+		if decision == "approve" {
+			_, err = h.providerVersionManagerClient.ApproveVersion(reviewCtx, request)
+		} else {
+			_, err = h.providerVersionManagerClient.RejectVersion(reviewCtx, request)
+		}
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to %s the provider version: %s", decision, status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		h.responseHandler.Write(rw, createReviewResponse(provider.GetName(), provider.GetVersion(), decision, reviewer, review), http.StatusOK)
+	})
+}
+
+// getUsageHandler will return the deployment unit versions using a module or provider, optionally
+// restricted to the version given in the query. Module names are given as "<name>/<provider>".
+func (h *browseHttpService) getUsageHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		request := &usageServices.ListUsageRequest{
+			Dependency: &terrariumUsage.DeploymentUnit{
+				Type:         vars["type"],
+				Organization: vars["organization_name"],
+				Name:         vars["name"],
+			},
+			Version: r.URL.Query().Get("version"),
+		}
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("dependency.type", request.Dependency.GetType()),
+			attribute.String("dependency.organization", request.Dependency.GetOrganization()),
+			attribute.String("dependency.name", request.Dependency.GetName()),
+			attribute.String("dependency.version", request.GetVersion()),
+		)
+
+		response, err := h.dependencyTrackerClient.ListUsage(ctx, request)
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, fmt.Errorf("failed to retrieve the usage from backend service: %s", status.Convert(err).Message()), backendErrorStatusCode(err))
+			return
+		}
+
+		h.responseHandler.Write(rw, createUsageResponse(response.GetUsages()), http.StatusOK)
+	})
+}
@@ -1,11 +1,24 @@
 package browse
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
 	providerServices "github.com/terrariumcloud/terrarium/internal/provider/services"
 	release "github.com/terrariumcloud/terrarium/internal/release/services"
+	searchServices "github.com/terrariumcloud/terrarium/internal/search/services"
+	usageServices "github.com/terrariumcloud/terrarium/internal/usage/services"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type moduleItem struct {
@@ -16,6 +29,19 @@ type moduleItem struct {
 	SourceUrl    string   `json:"source_url"`
 	Maturity     string   `json:"maturity,omitempty"`
 	Versions     []string `json:"versions,omitempty"`
+	Tags         []string `json:"tags"`
+	// VersionMaturity holds the maturity of every version, falling back to the module maturity
+	VersionMaturity map[string]string `json:"version_maturity,omitempty"`
+}
+
+type tagItem struct {
+	Tag   string `json:"tag"`
+	Count uint64 `json:"count"`
+}
+
+type tagsResponse struct {
+	Tags       []*tagItem `json:"tags"`
+	NextCursor string     `json:"next_cursor,omitempty"`
 }
 
 type providerItem struct {
@@ -25,41 +51,477 @@ type providerItem struct {
 	SourceRepoUrl string   `json:"source_repo_url"`
 	Maturity      string   `json:"maturity,omitempty"`
 	Versions      []string `json:"versions,omitempty"`
+	Warnings      []string `json:"warnings,omitempty"`
+}
+
+type pendingVersionItem struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	SubmittedOn string `json:"submitted_on,omitempty"`
+}
+
+type pendingApprovalsResponse struct {
+	Modules   []*pendingVersionItem `json:"modules"`
+	Providers []*pendingVersionItem `json:"providers"`
+}
+
+type reviewRequest struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+type reviewResponse struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Decision string `json:"decision"`
+	Reviewer string `json:"reviewer"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+type dependentItem struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	DependsOn string `json:"depends_on"`
+}
+
+type dependentsResponse struct {
+	Name       string           `json:"name"`
+	Version    string           `json:"version,omitempty"`
+	Dependents []*dependentItem `json:"dependents"`
+}
+
+type usageItem struct {
+	Type         string `json:"type"`
+	Organization string `json:"organization"`
+	Name         string `json:"name"`
+	Version      string `json:"version"`
+	Uses         string `json:"uses"`
+	ReportedOn   string `json:"reported_on"`
+}
+
+type usageResponse struct {
+	Usage []*usageItem `json:"usage"`
+}
+
+type imageRefItem struct {
+	Arch  string `json:"arch"`
+	Image string `json:"image"`
+}
+
+type imageItem struct {
+	Name      string          `json:"name"`
+	Namespace string          `json:"namespace"`
+	Tag       string          `json:"tag"`
+	Images    []*imageRefItem `json:"images"`
+}
+
+type imageVersionItem struct {
+	Version string       `json:"version"`
+	Images  []*imageItem `json:"images"`
+}
+
+type imageModuleItem struct {
+	Name          string              `json:"name"`
+	LatestVersion string              `json:"latest_version"`
+	Versions      []*imageVersionItem `json:"versions"`
+}
+
+type containerImagesResponse struct {
+	Modules []*imageModuleItem `json:"modules"`
+}
+
+type providerDependentItem struct {
+	Name               string   `json:"name"`
+	Version            string   `json:"version"`
+	Source             string   `json:"source"`
+	VersionConstraints []string `json:"version_constraints"`
+}
+
+type providerDependentsResponse struct {
+	Provider string                   `json:"provider"`
+	Version  string                   `json:"version,omitempty"`
+	Modules  []*providerDependentItem `json:"modules"`
+}
+
+type graphNodeItem struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Depth      int32  `json:"depth"`
+	Maturity   string `json:"maturity,omitempty"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+type graphEdgeItem struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Cycle bool   `json:"cycle,omitempty"`
+}
+
+type dependencyGraphResponse struct {
+	Nodes []*graphNodeItem `json:"nodes"`
+	Edges []*graphEdgeItem `json:"edges"`
 }
 
 type modulesResponse struct {
-	Modules []*services.ModuleMetadata `json:"modules"`
+	Modules    []*services.ModuleMetadata `json:"modules"`
+	NextCursor string                     `json:"next_cursor,omitempty"`
 }
 
 type providersResponse struct {
-	Providers []*providerServices.ListProviderItem `json:"providers"`
+	Providers  []*providerServices.ListProviderItem `json:"providers"`
+	NextCursor string                               `json:"next_cursor,omitempty"`
+}
+
+type searchResultItem struct {
+	Kind         string   `json:"kind"`
+	Name         string   `json:"name"`
+	Organization string   `json:"organization"`
+	Provider     string   `json:"provider"`
+	Description  string   `json:"description,omitempty"`
+	Maturity     string   `json:"maturity,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Version      string   `json:"version,omitempty"`
+	Score        uint64   `json:"score"`
+}
+
+type facetValueItem struct {
+	Value string `json:"value"`
+	Count uint64 `json:"count"`
+}
+
+type facetItem struct {
+	Name   string            `json:"name"`
+	Values []*facetValueItem `json:"values"`
+}
+
+type searchResponse struct {
+	Query      string              `json:"query"`
+	Results    []*searchResultItem `json:"results"`
+	Facets     []*facetItem        `json:"facets"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+}
+
+type moduleInputItem struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+	Sensitive   bool   `json:"sensitive"`
+	Required    bool   `json:"required"`
+}
+
+type moduleOutputItem struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Sensitive   bool   `json:"sensitive"`
+}
+
+type requiredProviderItem struct {
+	Name               string   `json:"name"`
+	Source             string   `json:"source,omitempty"`
+	VersionConstraints []string `json:"version_constraints"`
+}
+
+type moduleResourceItem struct {
+	Mode string `json:"mode"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type moduleCallItem struct {
+	Name    string `json:"name"`
+	Source  string `json:"source"`
+	Version string `json:"version,omitempty"`
+}
+
+type moduleDocsResponse struct {
+	Name              string                  `json:"name,omitempty"`
+	Version           string                  `json:"version,omitempty"`
+	Path              string                  `json:"path"`
+	Readme            string                  `json:"readme"`
+	Inputs            []*moduleInputItem      `json:"inputs"`
+	Outputs           []*moduleOutputItem     `json:"outputs"`
+	RequiredProviders []*requiredProviderItem `json:"required_providers"`
+	Resources         []*moduleResourceItem   `json:"resources"`
+	ModuleCalls       []*moduleCallItem       `json:"module_calls"`
+	Submodules        []*moduleDocsResponse   `json:"submodules,omitempty"`
+	Examples          []*moduleDocsResponse   `json:"examples,omitempty"`
+}
+
+type sourceTreeItem struct {
+	Name     string            `json:"name"`
+	Path     string            `json:"path"`
+	Type     string            `json:"type"`
+	Size     uint64            `json:"size,omitempty"`
+	Binary   bool              `json:"binary,omitempty"`
+	Children []*sourceTreeItem `json:"children,omitempty"`
+}
+
+type sourceFilesResponse struct {
+	Name    string            `json:"name"`
+	Version string            `json:"version"`
+	Files   []*sourceTreeItem `json:"files"`
+}
+
+type sourceFileResponse struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Path    string `json:"path"`
+	Size    uint64 `json:"size"`
+	Binary  bool   `json:"binary"`
+	Content string `json:"content,omitempty"`
+}
+
+// createPendingApprovalsResponse combines the module and provider versions waiting for approval,
+// always reporting empty lists rather than null.
+func createPendingApprovalsResponse(moduleVersions []*services.PendingVersion, providerVersions []*providerServices.PendingVersion) *pendingApprovalsResponse {
+	response := &pendingApprovalsResponse{
+		Modules:   make([]*pendingVersionItem, 0, len(moduleVersions)),
+		Providers: make([]*pendingVersionItem, 0, len(providerVersions)),
+	}
+	for _, v := range moduleVersions {
+		response.Modules = append(response.Modules, &pendingVersionItem{Name: v.GetName(), Version: v.GetVersion(), SubmittedOn: v.GetSubmittedOn()})
+	}
+	for _, v := range providerVersions {
+		response.Providers = append(response.Providers, &pendingVersionItem{Name: v.GetName(), Version: v.GetVersion(), SubmittedOn: v.GetSubmittedOn()})
+	}
+	return response
+}
+
+// createDependentsResponse flattens the dependents streamed for a module, and for every module depending on it
+// when the lookup is transitive, into a single list recording which module each dependent uses.
+func createDependentsResponse(module *terrarium.Module, responses []*terrarium.DependentsResponse) *dependentsResponse {
+	response := &dependentsResponse{
+		Name:       module.GetName(),
+		Version:    module.GetVersion(),
+		Dependents: []*dependentItem{},
+	}
+	for _, r := range responses {
+		dependsOn := r.GetModule().GetName()
+		if r.GetModule().GetVersion() != "" {
+			dependsOn = fmt.Sprintf("%s@%s", dependsOn, r.GetModule().GetVersion())
+		}
+		for _, d := range r.GetDependents() {
+			response.Dependents = append(response.Dependents, &dependentItem{Name: d.GetName(), Version: d.GetVersion(), DependsOn: dependsOn})
+		}
+	}
+	return response
+}
+
+// createUsageResponse lists the consumers of a dependency with the version of it each one uses.
+func createUsageResponse(usages []*usageServices.Usage) *usageResponse {
+	response := &usageResponse{Usage: make([]*usageItem, 0, len(usages))}
+	for _, u := range usages {
+		response.Usage = append(response.Usage, &usageItem{
+			Type:         u.GetConsumer().GetUnit().GetType(),
+			Organization: u.GetConsumer().GetUnit().GetOrganization(),
+			Name:         u.GetConsumer().GetUnit().GetName(),
+			Version:      u.GetConsumer().GetVersion(),
+			Uses:         u.GetVersion(),
+			ReportedOn:   u.GetReportedOn(),
+		})
+	}
+	return response
+}
+
+// createContainerImagesResponse lists the module versions referencing matching container images,
+// with the images of every version sorted by name.
+func createContainerImagesResponse(modules []*terrarium.ContainerImageUsage) *containerImagesResponse {
+	response := &containerImagesResponse{Modules: make([]*imageModuleItem, 0, len(modules))}
+	for _, m := range modules {
+		module := &imageModuleItem{Name: m.GetName(), LatestVersion: m.GetLatestVersion(), Versions: make([]*imageVersionItem, 0, len(m.GetVersions()))}
+		for _, v := range m.GetVersions() {
+			version := &imageVersionItem{Version: v.GetVersion(), Images: make([]*imageItem, 0, len(v.GetImages()))}
+			for name, details := range v.GetImages() {
+				image := &imageItem{Name: name, Namespace: details.GetNamespace(), Tag: details.GetTag(), Images: make([]*imageRefItem, 0, len(details.GetImages()))}
+				for _, ref := range details.GetImages() {
+					image.Images = append(image.Images, &imageRefItem{Arch: ref.GetArch(), Image: ref.GetImage()})
+				}
+				version.Images = append(version.Images, image)
+			}
+			sort.Slice(version.Images, func(i, j int) bool {
+				return version.Images[i].Name < version.Images[j].Name
+			})
+			module.Versions = append(module.Versions, version)
+		}
+		response.Modules = append(response.Modules, module)
+	}
+	return response
+}
+
+// createProviderDependentsResponse lists the module versions requiring a provider with the constraints they require it with.
+func createProviderDependentsResponse(request *services.RetrieveProviderDependentsRequest, dependents []*services.ProviderDependent) *providerDependentsResponse {
+	response := &providerDependentsResponse{
+		Provider: request.GetProvider(),
+		Version:  request.GetVersion(),
+		Modules:  make([]*providerDependentItem, 0, len(dependents)),
+	}
+	for _, d := range dependents {
+		constraints := d.GetVersionConstraints()
+		if constraints == nil {
+			constraints = []string{}
+		}
+		response.Modules = append(response.Modules, &providerDependentItem{
+			Name:               d.GetModule().GetName(),
+			Version:            d.GetModule().GetVersion(),
+			Source:             d.GetSource(),
+			VersionConstraints: constraints,
+		})
+	}
+	return response
+}
+
+// createDependencyGraphResponse lists the nodes and edges of a dependency graph, with node types and maturities
+// in lower case and empty lists rather than null.
+func createDependencyGraphResponse(graph *terrarium.DependencyGraph) *dependencyGraphResponse {
+	response := &dependencyGraphResponse{
+		Nodes: make([]*graphNodeItem, 0, len(graph.GetNodes())),
+		Edges: make([]*graphEdgeItem, 0, len(graph.GetEdges())),
+	}
+	for _, n := range graph.GetNodes() {
+		node := &graphNodeItem{
+			ID:         n.GetId(),
+			Type:       strings.ToLower(n.GetType().String()),
+			Name:       n.GetName(),
+			Version:    n.GetVersion(),
+			Depth:      n.GetDepth(),
+			Deprecated: n.GetDeprecated(),
+		}
+		if n.Maturity != nil {
+			node.Maturity = strings.ToLower(n.GetMaturity().String())
+		}
+		response.Nodes = append(response.Nodes, node)
+	}
+	for _, e := range graph.GetEdges() {
+		response.Edges = append(response.Edges, &graphEdgeItem{From: e.GetFrom(), To: e.GetTo(), Cycle: e.GetCycle()})
+	}
+	return response
+}
+
+// parseSBOMFormat returns the SBOM format named in a query, CycloneDX when none is given.
+func parseSBOMFormat(format string) (terrarium.SBOMFormat, bool) {
+	if format == "" {
+		return terrarium.SBOMFormat_CYCLONEDX, true
+	}
+	value, ok := terrarium.SBOMFormat_value[strings.ToUpper(format)]
+	return terrarium.SBOMFormat(value), ok
+}
+
+func createReviewResponse(name, version, decision, reviewer string, review reviewRequest) *reviewResponse {
+	return &reviewResponse{
+		Name:     name,
+		Version:  version,
+		Decision: decision,
+		Reviewer: reviewer,
+		Comment:  review.Comment,
+	}
+}
+
+// backendErrorStatusCode maps the error returned by a backend service to the HTTP status reported to the client.
+func backendErrorStatusCode(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.FailedPrecondition:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// getPageFromRequest returns the page selected by the limit and cursor query parameters, or nil when neither is
+// given and the whole list is requested.
+func getPageFromRequest(r *http.Request) (*paging.PageInfoRequest, error) {
+	values := r.URL.Query()
+	if !values.Has("limit") && !values.Has("cursor") {
+		return nil, nil
+	}
+	page := &paging.PageInfoRequest{Cursor: values.Get("cursor")}
+	if values.Has("limit") {
+		limit, err := strconv.ParseUint(values.Get("limit"), 10, 64)
+		if err != nil || limit == 0 {
+			return nil, errors.New("limit must be a positive number")
+		}
+		page.Count = limit
+	}
+	return page, nil
+}
+
+// writeNextCursor sets the cursor of the next page in the X-Next-Cursor header, unless the page is the last one.
+func writeNextCursor(rw http.ResponseWriter, page *paging.PageInfoResponse) {
+	if page.GetNextCursor() != "" {
+		rw.Header().Set("X-Next-Cursor", page.GetNextCursor())
+	}
 }
 
-func createModulesResponse(modules []*services.ModuleMetadata) *modulesResponse {
+func createModulesResponse(modules []*services.ModuleMetadata, page *paging.PageInfoResponse) *modulesResponse {
 	return &modulesResponse{
-		Modules: modules,
+		Modules:    modules,
+		NextCursor: page.GetNextCursor(),
+	}
+}
+
+// setModuleTags sets the tags of the modules listed, matching them by name.
+func setModuleTags(modules []*services.ModuleMetadata, moduleTags []*terrarium.ModuleTags) {
+	tags := map[string][]string{}
+	for _, moduleTag := range moduleTags {
+		tags[moduleTag.GetName()] = moduleTag.GetTags()
+	}
+	for _, module := range modules {
+		module.Tags = tags[fmt.Sprintf("%s/%s/%s", module.GetOrganization(), module.GetName(), module.GetProvider())]
 	}
 }
 
-func createProvidersResponse(providers []*providerServices.ListProviderItem) *providersResponse {
+// createTagsResponse reports the tags in use, always as a list rather than null.
+func createTagsResponse(response *services.ListTagsResponse) *tagsResponse {
+	tags := make([]*tagItem, 0, len(response.GetTags()))
+	for _, tag := range response.GetTags() {
+		tags = append(tags, &tagItem{Tag: tag.GetTag(), Count: tag.GetCount()})
+	}
+	return &tagsResponse{Tags: tags, NextCursor: response.GetPage().GetNextCursor()}
+}
+
+func createProvidersResponse(providers []*providerServices.ListProviderItem, page *paging.PageInfoResponse) *providersResponse {
 	return &providersResponse{
-		Providers: providers,
+		Providers:  providers,
+		NextCursor: page.GetNextCursor(),
 	}
 }
 
-func createModuleMetadataResponse(moduleMetadata *services.ModuleMetadata, moduleVersions []string) *moduleItem {
+func createModuleMetadataResponse(moduleMetadata *services.ModuleMetadata, moduleVersions []string, versionMaturities map[string]terrarium.Maturity) *moduleItem {
+	var versionMaturity map[string]string
+	for _, moduleVersion := range moduleVersions {
+		if versionMaturity == nil {
+			versionMaturity = map[string]string{}
+		}
+		if maturity, ok := versionMaturities[moduleVersion]; ok {
+			versionMaturity[moduleVersion] = maturity.String()
+		} else {
+			versionMaturity[moduleVersion] = moduleMetadata.Maturity.String()
+		}
+	}
+
 	return &moduleItem{
-		Organization: moduleMetadata.Organization,
-		Name:         moduleMetadata.Name,
-		Provider:     moduleMetadata.Provider,
-		Description:  moduleMetadata.Description,
-		SourceUrl:    moduleMetadata.SourceUrl,
-		Maturity:     moduleMetadata.Maturity.String(),
-		Versions:     moduleVersions,
+		Organization:    moduleMetadata.Organization,
+		Name:            moduleMetadata.Name,
+		Provider:        moduleMetadata.Provider,
+		Description:     moduleMetadata.Description,
+		SourceUrl:       moduleMetadata.SourceUrl,
+		Maturity:        moduleMetadata.Maturity.String(),
+		Versions:        moduleVersions,
+		Tags:            []string{},
+		VersionMaturity: versionMaturity,
 	}
 }
 
-func createProviderMetadataResponse(providerMetadata *providerServices.ListProviderItem, providerVersions []string) *providerItem {
+func createProviderMetadataResponse(providerMetadata *providerServices.ListProviderItem, providerVersions []string, warnings []string) *providerItem {
 	return &providerItem{
 		Organization:  providerMetadata.Organization,
 		Name:          providerMetadata.Name,
@@ -67,16 +529,191 @@ func createProviderMetadataResponse(providerMetadata *providerServices.ListProvi
 		SourceRepoUrl: providerMetadata.SourceRepoUrl,
 		Maturity:      providerMetadata.Maturity.String(),
 		Versions:      providerVersions,
+		Warnings:      warnings,
 	}
 }
 
 type releaseResponse struct {
-	Releases []*release.Release `json:"releases"`
+	Releases   []*release.Release `json:"releases"`
+	NextCursor string             `json:"next_cursor,omitempty"`
 }
 
-func createReleaseResponse(releases []*release.Release) *releaseResponse {
+func createReleaseResponse(releases []*release.Release, page *paging.PageInfoResponse) *releaseResponse {
 	return &releaseResponse{
-		Releases: releases,
+		Releases:   releases,
+		NextCursor: page.GetNextCursor(),
+	}
+}
+
+// getSearchRequestFromRequest returns the search selected by the q query parameter, narrowed down by the
+// organization, provider, maturity and tag parameters which can each be repeated.
+func getSearchRequestFromRequest(r *http.Request) (*searchServices.SearchRequest, error) {
+	values := r.URL.Query()
+	query := strings.TrimSpace(values.Get("q"))
+	if query == "" {
+		return nil, errors.New("q is required")
+	}
+
+	page, err := getPageFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &searchServices.SearchRequest{
+		Query:         query,
+		Organizations: values["organization"],
+		Providers:     values["provider"],
+		Maturities:    values["maturity"],
+		Tags:          values["tag"],
+		Page:          page,
+	}, nil
+}
+
+// createSearchResponse reports the results and facets of a search, always as lists rather than null.
+func createSearchResponse(query string, response *searchServices.SearchResponse) *searchResponse {
+	results := make([]*searchResultItem, 0, len(response.GetResults()))
+	for _, result := range response.GetResults() {
+		results = append(results, &searchResultItem{
+			Kind:         result.GetKind(),
+			Name:         result.GetName(),
+			Organization: result.GetOrganization(),
+			Provider:     result.GetProvider(),
+			Description:  result.GetDescription(),
+			Maturity:     result.GetMaturity(),
+			Tags:         result.GetTags(),
+			Version:      result.GetVersion(),
+			Score:        result.GetScore(),
+		})
+	}
+
+	facets := make([]*facetItem, 0, len(response.GetFacets()))
+	for _, facet := range response.GetFacets() {
+		values := make([]*facetValueItem, 0, len(facet.GetValues()))
+		for _, value := range facet.GetValues() {
+			values = append(values, &facetValueItem{Value: value.GetValue(), Count: value.GetCount()})
+		}
+		facets = append(facets, &facetItem{Name: facet.GetName(), Values: values})
+	}
+
+	return &searchResponse{
+		Query:      query,
+		Results:    results,
+		Facets:     facets,
+		NextCursor: response.GetPage().GetNextCursor(),
+	}
+}
+
+// createModuleDocsResponse reports the documentation of a module version, always as lists rather than null.
+// Submodules and examples are reported the same way, without the name and version of the module.
+func createModuleDocsResponse(module *terrarium.Module, docs *services.ModuleDocs) *moduleDocsResponse {
+	response := createDocsItem(docs)
+	response.Name = module.GetName()
+	response.Version = module.GetVersion()
+	return response
+}
+
+func createDocsItem(docs *services.ModuleDocs) *moduleDocsResponse {
+	item := &moduleDocsResponse{
+		Path:              docs.GetPath(),
+		Readme:            docs.GetReadme(),
+		Inputs:            make([]*moduleInputItem, 0, len(docs.GetInputs())),
+		Outputs:           make([]*moduleOutputItem, 0, len(docs.GetOutputs())),
+		RequiredProviders: make([]*requiredProviderItem, 0, len(docs.GetRequiredProviders())),
+		Resources:         make([]*moduleResourceItem, 0, len(docs.GetResources())),
+		ModuleCalls:       make([]*moduleCallItem, 0, len(docs.GetModuleCalls())),
+	}
+	for _, input := range docs.GetInputs() {
+		item.Inputs = append(item.Inputs, &moduleInputItem{
+			Name:        input.GetName(),
+			Type:        input.GetType(),
+			Default:     input.GetDefault(),
+			Description: input.GetDescription(),
+			Sensitive:   input.GetSensitive(),
+			Required:    input.GetRequired(),
+		})
+	}
+	for _, output := range docs.GetOutputs() {
+		item.Outputs = append(item.Outputs, &moduleOutputItem{Name: output.GetName(), Description: output.GetDescription(), Sensitive: output.GetSensitive()})
+	}
+	for _, provider := range docs.GetRequiredProviders() {
+		constraints := provider.GetVersionConstraints()
+		if constraints == nil {
+			constraints = make([]string, 0)
+		}
+		item.RequiredProviders = append(item.RequiredProviders, &requiredProviderItem{Name: provider.GetName(), Source: provider.GetSource(), VersionConstraints: constraints})
+	}
+	for _, resource := range docs.GetResources() {
+		item.Resources = append(item.Resources, &moduleResourceItem{Mode: resource.GetMode(), Type: resource.GetType(), Name: resource.GetName()})
+	}
+	for _, call := range docs.GetModuleCalls() {
+		item.ModuleCalls = append(item.ModuleCalls, &moduleCallItem{Name: call.GetName(), Source: call.GetSource(), Version: call.GetVersion()})
+	}
+	for _, submodule := range docs.GetSubmodules() {
+		item.Submodules = append(item.Submodules, createDocsItem(submodule))
+	}
+	for _, example := range docs.GetExamples() {
+		item.Examples = append(item.Examples, createDocsItem(example))
+	}
+	return item
+}
+
+// createSourceFilesResponse arranges the files of a module version in a tree, directories first and then by name.
+func createSourceFilesResponse(module *terrarium.Module, files []*services.SourceFile) *sourceFilesResponse {
+	root := &sourceTreeItem{Children: make([]*sourceTreeItem, 0)}
+	dirs := map[string]*sourceTreeItem{"": root}
+
+	var dirOf func(dir string) *sourceTreeItem
+	dirOf = func(dir string) *sourceTreeItem {
+		if item, ok := dirs[dir]; ok {
+			return item
+		}
+		parent, name := "", dir
+		if i := strings.LastIndex(dir, "/"); i >= 0 {
+			parent, name = dir[:i], dir[i+1:]
+		}
+		item := &sourceTreeItem{Name: name, Path: dir, Type: "dir"}
+		dirs[dir] = item
+		parentItem := dirOf(parent)
+		parentItem.Children = append(parentItem.Children, item)
+		return item
+	}
+
+	for _, file := range files {
+		dir, name := "", file.GetPath()
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			dir, name = name[:i], name[i+1:]
+		}
+		parent := dirOf(dir)
+		parent.Children = append(parent.Children, &sourceTreeItem{
+			Name:   name,
+			Path:   file.GetPath(),
+			Type:   "file",
+			Size:   file.GetSize(),
+			Binary: file.GetBinary(),
+		})
+	}
+
+	for _, item := range dirs {
+		children := item.Children
+		sort.SliceStable(children, func(i, j int) bool {
+			if children[i].Type != children[j].Type {
+				return children[i].Type == "dir"
+			}
+			return children[i].Name < children[j].Name
+		})
+	}
+
+	return &sourceFilesResponse{Name: module.GetName(), Version: module.GetVersion(), Files: root.Children}
+}
+
+func createSourceFileResponse(module *terrarium.Module, file *services.SourceFile) *sourceFileResponse {
+	return &sourceFileResponse{
+		Name:    module.GetName(),
+		Version: module.GetVersion(),
+		Path:    file.GetPath(),
+		Size:    file.GetSize(),
+		Binary:  file.GetBinary(),
+		Content: string(file.GetContent()),
 	}
 }
 
@@ -1,17 +1,25 @@
 package browse
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
 	providerServices "github.com/terrariumcloud/terrarium/internal/provider/services"
+	searchServices "github.com/terrariumcloud/terrarium/internal/search/services"
+	usageServices "github.com/terrariumcloud/terrarium/internal/usage/services"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
 )
 
 func Test_createModuleMetadataResponse(t *testing.T) {
 	type args struct {
-		moduleMetadata *services.ModuleMetadata
-		moduleVersions []string
+		moduleMetadata    *services.ModuleMetadata
+		moduleVersions    []string
+		versionMaturities map[string]terrarium.Maturity
 	}
 	tests := []struct {
 		name string
@@ -33,6 +41,9 @@ func Test_createModuleMetadataResponse(t *testing.T) {
 					"1.0.1",
 					"1.0.2",
 				},
+				versionMaturities: map[string]terrarium.Maturity{
+					"1.0.1": terrarium.Maturity_END_OF_LIFE,
+				},
 			},
 			want: &moduleItem{
 				Organization: "cie",
@@ -45,12 +56,17 @@ func Test_createModuleMetadataResponse(t *testing.T) {
 					"1.0.1",
 					"1.0.2",
 				},
+				Tags: []string{},
+				VersionMaturity: map[string]string{
+					"1.0.1": "END_OF_LIFE",
+					"1.0.2": "DEPRECATED",
+				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := createModuleMetadataResponse(tt.args.moduleMetadata, tt.args.moduleVersions); !reflect.DeepEqual(got, tt.want) {
+			if got := createModuleMetadataResponse(tt.args.moduleMetadata, tt.args.moduleVersions, tt.args.versionMaturities); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("createModuleMetadataResponse() = %v, want %v", got, tt.want)
 			}
 		})
@@ -61,6 +77,7 @@ func Test_createProviderMetadataResponse(t *testing.T) {
 	type args struct {
 		providerMetadata *providerServices.ListProviderItem
 		providerVersions []string
+		warnings         []string
 	}
 	tests := []struct {
 		name string
@@ -81,6 +98,9 @@ func Test_createProviderMetadataResponse(t *testing.T) {
 					"1.0.3",
 					"1.0.4",
 				},
+				warnings: []string{
+					"All versions of cie/test-provider are deprecated: No longer recommended for use.",
+				},
 			},
 			want: &providerItem{
 				Organization:  "cie",
@@ -92,14 +112,473 @@ func Test_createProviderMetadataResponse(t *testing.T) {
 					"1.0.3",
 					"1.0.4",
 				},
+				Warnings: []string{
+					"All versions of cie/test-provider are deprecated: No longer recommended for use.",
+				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := createProviderMetadataResponse(tt.args.providerMetadata, tt.args.providerVersions); !reflect.DeepEqual(got, tt.want) {
+			if got := createProviderMetadataResponse(tt.args.providerMetadata, tt.args.providerVersions, tt.args.warnings); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("createProviderMetadataResponse() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_createPendingApprovalsResponse(t *testing.T) {
+	tests := []struct {
+		name             string
+		moduleVersions   []*services.PendingVersion
+		providerVersions []*providerServices.PendingVersion
+		want             *pendingApprovalsResponse
+	}{
+		{
+			name: "Pending module and provider versions",
+			moduleVersions: []*services.PendingVersion{
+				{Name: "cie/test-module/aws", Version: "1.0.0", SubmittedOn: "2024-01-02 10:00:00 +0000 UTC"},
+			},
+			providerVersions: []*providerServices.PendingVersion{
+				{Name: "cie/test-provider", Version: "2.0.0"},
+			},
+			want: &pendingApprovalsResponse{
+				Modules: []*pendingVersionItem{
+					{Name: "cie/test-module/aws", Version: "1.0.0", SubmittedOn: "2024-01-02 10:00:00 +0000 UTC"},
+				},
+				Providers: []*pendingVersionItem{
+					{Name: "cie/test-provider", Version: "2.0.0"},
+				},
+			},
+		},
+		{
+			name: "Nothing pending",
+			want: &pendingApprovalsResponse{
+				Modules:   []*pendingVersionItem{},
+				Providers: []*pendingVersionItem{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createPendingApprovalsResponse(tt.moduleVersions, tt.providerVersions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createPendingApprovalsResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_createDependentsResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		module    *terrarium.Module
+		responses []*terrarium.DependentsResponse
+		want      *dependentsResponse
+	}{
+		{
+			name:   "Transitive dependents",
+			module: &terrarium.Module{Name: "cie/vpc/aws"},
+			responses: []*terrarium.DependentsResponse{
+				{
+					Module:     &terrarium.Module{Name: "cie/vpc/aws"},
+					Dependents: []*terrarium.Module{{Name: "cie/eks/aws", Version: "3.0.0"}},
+				},
+				{
+					Module:     &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"},
+					Dependents: []*terrarium.Module{{Name: "cie/platform/aws", Version: "2.0.0"}},
+				},
+			},
+			want: &dependentsResponse{
+				Name: "cie/vpc/aws",
+				Dependents: []*dependentItem{
+					{Name: "cie/eks/aws", Version: "3.0.0", DependsOn: "cie/vpc/aws"},
+					{Name: "cie/platform/aws", Version: "2.0.0", DependsOn: "cie/eks/aws@3.0.0"},
+				},
+			},
+		},
+		{
+			name:   "No dependents",
+			module: &terrarium.Module{Name: "cie/vpc/aws", Version: "1.0.0"},
+			want: &dependentsResponse{
+				Name:       "cie/vpc/aws",
+				Version:    "1.0.0",
+				Dependents: []*dependentItem{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createDependentsResponse(tt.module, tt.responses); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createDependentsResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_createUsageResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		usages []*usageServices.Usage
+		want   *usageResponse
+	}{
+		{
+			name: "Consumers of a dependency",
+			usages: []*usageServices.Usage{
+				{
+					Consumer: &usage.VersionedDeploymentUnit{
+						Unit:    &usage.DeploymentUnit{Type: "application", Organization: "cie", Name: "billing"},
+						Version: "4.2.0",
+					},
+					Version:    "1.0.0",
+					ReportedOn: "2024-01-02 10:00:00 +0000 UTC",
+				},
+			},
+			want: &usageResponse{
+				Usage: []*usageItem{
+					{Type: "application", Organization: "cie", Name: "billing", Version: "4.2.0", Uses: "1.0.0", ReportedOn: "2024-01-02 10:00:00 +0000 UTC"},
+				},
+			},
+		},
+		{
+			name: "Not used",
+			want: &usageResponse{Usage: []*usageItem{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createUsageResponse(tt.usages); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createUsageResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_createContainerImagesResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		modules []*terrarium.ContainerImageUsage
+		want    *containerImagesResponse
+	}{
+		{
+			name: "Module versions referencing an image",
+			modules: []*terrarium.ContainerImageUsage{
+				{
+					Name:          "cie/web/aws",
+					LatestVersion: "2.0.0",
+					Versions: []*terrarium.ContainerImageVersion{
+						{
+							Version: "2.0.0",
+							Images: map[string]*terrarium.ContainerImageDetails{
+								"sidecar": {Namespace: "base", Tag: "1.0"},
+								"nginx": {Namespace: "base", Tag: "1.23", Images: []*terrarium.ContainerImageRef{
+									{Arch: "linux/amd64", Image: "registry.example.com/base/nginx@sha256:0d17"},
+								}},
+							},
+						},
+					},
+				},
+			},
+			want: &containerImagesResponse{
+				Modules: []*imageModuleItem{
+					{
+						Name:          "cie/web/aws",
+						LatestVersion: "2.0.0",
+						Versions: []*imageVersionItem{
+							{
+								Version: "2.0.0",
+								Images: []*imageItem{
+									{Name: "nginx", Namespace: "base", Tag: "1.23", Images: []*imageRefItem{{Arch: "linux/amd64", Image: "registry.example.com/base/nginx@sha256:0d17"}}},
+									{Name: "sidecar", Namespace: "base", Tag: "1.0", Images: []*imageRefItem{}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "No match",
+			want: &containerImagesResponse{Modules: []*imageModuleItem{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createContainerImagesResponse(tt.modules); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createContainerImagesResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseSBOMFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		want   terrarium.SBOMFormat
+		ok     bool
+	}{
+		{"", terrarium.SBOMFormat_CYCLONEDX, true},
+		{"cyclonedx", terrarium.SBOMFormat_CYCLONEDX, true},
+		{"SPDX", terrarium.SBOMFormat_SPDX, true},
+		{"swid", terrarium.SBOMFormat_CYCLONEDX, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseSBOMFormat(tt.format)
+		if ok != tt.ok || (ok && got != tt.want) {
+			t.Errorf("parseSBOMFormat(%s) = %v, %v, want %v, %v", tt.format, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func Test_createDependencyGraphResponse(t *testing.T) {
+	deprecated := terrarium.Maturity_DEPRECATED
+	graph := &terrarium.DependencyGraph{
+		Nodes: []*terrarium.GraphNode{
+			{Id: "module:cie/eks/aws@3.0.0", Type: terrarium.GraphNode_MODULE, Name: "cie/eks/aws", Version: "3.0.0", Maturity: &deprecated, Deprecated: true},
+			{Id: "image:base/nginx:1.23", Type: terrarium.GraphNode_CONTAINER, Name: "base/nginx", Version: "1.23", Depth: 1},
+		},
+		Edges: []*terrarium.GraphEdge{{From: "module:cie/eks/aws@3.0.0", To: "image:base/nginx:1.23"}},
+	}
+	want := &dependencyGraphResponse{
+		Nodes: []*graphNodeItem{
+			{ID: "module:cie/eks/aws@3.0.0", Type: "module", Name: "cie/eks/aws", Version: "3.0.0", Maturity: "deprecated", Deprecated: true},
+			{ID: "image:base/nginx:1.23", Type: "container", Name: "base/nginx", Version: "1.23", Depth: 1},
+		},
+		Edges: []*graphEdgeItem{{From: "module:cie/eks/aws@3.0.0", To: "image:base/nginx:1.23"}},
+	}
+
+	if got := createDependencyGraphResponse(graph); !reflect.DeepEqual(got, want) {
+		t.Errorf("createDependencyGraphResponse() = %v, want %v", got, want)
+	}
+
+	if got := createDependencyGraphResponse(&terrarium.DependencyGraph{}); got.Nodes == nil || got.Edges == nil {
+		t.Errorf("Expected empty lists, got %v", got)
+	}
+}
+
+func Test_createProviderDependentsResponse(t *testing.T) {
+	request := &services.RetrieveProviderDependentsRequest{Provider: "hashicorp/aws", Version: "5.2.0"}
+	dependents := []*services.ProviderDependent{
+		{Module: &terrarium.Module{Name: "cie/vpc/aws", Version: "2.0.0"}, Source: "hashicorp/aws", VersionConstraints: []string{">= 4.0, < 6.0"}},
+		{Module: &terrarium.Module{Name: "cie/eks/aws", Version: "3.0.0"}, Source: "registry.terraform.io/hashicorp/aws"},
+	}
+	want := &providerDependentsResponse{
+		Provider: "hashicorp/aws",
+		Version:  "5.2.0",
+		Modules: []*providerDependentItem{
+			{Name: "cie/vpc/aws", Version: "2.0.0", Source: "hashicorp/aws", VersionConstraints: []string{">= 4.0, < 6.0"}},
+			{Name: "cie/eks/aws", Version: "3.0.0", Source: "registry.terraform.io/hashicorp/aws", VersionConstraints: []string{}},
+		},
+	}
+
+	if got := createProviderDependentsResponse(request, dependents); !reflect.DeepEqual(got, want) {
+		t.Errorf("createProviderDependentsResponse() = %v, want %v", got, want)
+	}
+}
+
+func Test_getPageFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		want    *paging.PageInfoRequest
+		wantErr bool
+	}{
+		{name: "Whole list", target: "/api/modules", want: nil},
+		{name: "First page", target: "/api/modules?limit=20", want: &paging.PageInfoRequest{Count: 20}},
+		{name: "Next page", target: "/api/modules?limit=20&cursor=Y2llL3ZwYy9hd3M", want: &paging.PageInfoRequest{Count: 20, Cursor: "Y2llL3ZwYy9hd3M"}},
+		{name: "Rest of the list", target: "/api/modules?cursor=Y2llL3ZwYy9hd3M", want: &paging.PageInfoRequest{Cursor: "Y2llL3ZwYy9hd3M"}},
+		{name: "Zero limit", target: "/api/modules?limit=0", wantErr: true},
+		{name: "Invalid limit", target: "/api/modules?limit=ten", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getPageFromRequest(httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getPageFromRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getPageFromRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getSearchRequestFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		want    *searchServices.SearchRequest
+		wantErr bool
+	}{
+		{name: "Query only", target: "/api/search?q=vpc", want: &searchServices.SearchRequest{Query: "vpc"}},
+		{
+			name:   "Facets and page",
+			target: "/api/search?q=vpc+peering&organization=cie&tag=network&tag=aws&maturity=STABLE&limit=10",
+			want: &searchServices.SearchRequest{
+				Query:         "vpc peering",
+				Organizations: []string{"cie"},
+				Maturities:    []string{"STABLE"},
+				Tags:          []string{"network", "aws"},
+				Page:          &paging.PageInfoRequest{Count: 10},
+			},
+		},
+		{name: "Missing query", target: "/api/search?organization=cie", wantErr: true},
+		{name: "Blank query", target: "/api/search?q=+", wantErr: true},
+		{name: "Invalid limit", target: "/api/search?q=vpc&limit=0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getSearchRequestFromRequest(httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getSearchRequestFromRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getSearchRequestFromRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_createSearchResponse(t *testing.T) {
+	response := &searchServices.SearchResponse{
+		Results: []*searchServices.SearchResult{
+			{Kind: "module", Name: "cie/vpc/aws", Organization: "cie", Provider: "aws", Maturity: "STABLE", Tags: []string{"network"}, Version: "1.2.0", Score: 15},
+		},
+		Facets: []*searchServices.Facet{
+			{Name: "organization", Values: []*searchServices.FacetValue{{Value: "cie", Count: 1}}},
+			{Name: "tag"},
+		},
+		Page: &paging.PageInfoResponse{NextCursor: "abc"},
+	}
+
+	want := &searchResponse{
+		Query: "vpc",
+		Results: []*searchResultItem{
+			{Kind: "module", Name: "cie/vpc/aws", Organization: "cie", Provider: "aws", Maturity: "STABLE", Tags: []string{"network"}, Version: "1.2.0", Score: 15},
+		},
+		Facets: []*facetItem{
+			{Name: "organization", Values: []*facetValueItem{{Value: "cie", Count: 1}}},
+			{Name: "tag", Values: []*facetValueItem{}},
+		},
+		NextCursor: "abc",
+	}
+
+	if got := createSearchResponse("vpc", response); !reflect.DeepEqual(got, want) {
+		t.Errorf("createSearchResponse() = %v, want %v", got, want)
+	}
+
+	if got := createSearchResponse("vpc", &searchServices.SearchResponse{}); got.Results == nil || got.Facets == nil {
+		t.Errorf("createSearchResponse() = %v, want empty lists", got)
+	}
+}
+
+func Test_createModuleDocsResponse(t *testing.T) {
+	module := &terrarium.Module{Name: "cie/vpc/aws", Version: "1.0.0"}
+	docs := &services.ModuleDocs{
+		Path:              ".",
+		Readme:            "# VPC",
+		Inputs:            []*services.ModuleInput{{Name: "cidr", Type: "string", Description: "CIDR block", Required: true}},
+		Outputs:           []*services.ModuleOutput{{Name: "id", Sensitive: true}},
+		RequiredProviders: []*terrarium.ProviderRequirement{{Name: "aws", Source: "hashicorp/aws"}},
+		Resources:         []*services.ModuleResource{{Mode: "managed", Type: "aws_vpc", Name: "this"}},
+		ModuleCalls:       []*services.ModuleCall{{Name: "subnets", Source: "./modules/subnets"}},
+		Submodules:        []*services.ModuleDocs{{Path: "modules/subnets"}},
+	}
+
+	want := &moduleDocsResponse{
+		Name:              "cie/vpc/aws",
+		Version:           "1.0.0",
+		Path:              ".",
+		Readme:            "# VPC",
+		Inputs:            []*moduleInputItem{{Name: "cidr", Type: "string", Description: "CIDR block", Required: true}},
+		Outputs:           []*moduleOutputItem{{Name: "id", Sensitive: true}},
+		RequiredProviders: []*requiredProviderItem{{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{}}},
+		Resources:         []*moduleResourceItem{{Mode: "managed", Type: "aws_vpc", Name: "this"}},
+		ModuleCalls:       []*moduleCallItem{{Name: "subnets", Source: "./modules/subnets"}},
+		Submodules: []*moduleDocsResponse{{
+			Path:              "modules/subnets",
+			Inputs:            []*moduleInputItem{},
+			Outputs:           []*moduleOutputItem{},
+			RequiredProviders: []*requiredProviderItem{},
+			Resources:         []*moduleResourceItem{},
+			ModuleCalls:       []*moduleCallItem{},
+		}},
+	}
+
+	if got := createModuleDocsResponse(module, docs); !reflect.DeepEqual(got, want) {
+		t.Errorf("createModuleDocsResponse() = %v, want %v", got, want)
+	}
+}
+
+func Test_createSourceFilesResponse(t *testing.T) {
+	module := &terrarium.Module{Name: "cie/vpc/aws", Version: "1.0.0"}
+	files := []*services.SourceFile{
+		{Path: "README.md", Size: 10},
+		{Path: "logo.png", Size: 9, Binary: true},
+		{Path: "main.tf", Size: 350},
+		{Path: "modules/subnets/main.tf", Size: 19},
+	}
+
+	want := &sourceFilesResponse{
+		Name:    "cie/vpc/aws",
+		Version: "1.0.0",
+		Files: []*sourceTreeItem{
+			{Name: "modules", Path: "modules", Type: "dir", Children: []*sourceTreeItem{
+				{Name: "subnets", Path: "modules/subnets", Type: "dir", Children: []*sourceTreeItem{
+					{Name: "main.tf", Path: "modules/subnets/main.tf", Type: "file", Size: 19},
+				}},
+			}},
+			{Name: "README.md", Path: "README.md", Type: "file", Size: 10},
+			{Name: "logo.png", Path: "logo.png", Type: "file", Size: 9, Binary: true},
+			{Name: "main.tf", Path: "main.tf", Type: "file", Size: 350},
+		},
+	}
+
+	if got := createSourceFilesResponse(module, files); !reflect.DeepEqual(got, want) {
+		t.Errorf("createSourceFilesResponse() = %v, want %v", got, want)
+	}
+
+	if got := createSourceFilesResponse(module, nil); got.Files == nil {
+		t.Errorf("createSourceFilesResponse() = %v, want an empty list", got)
+	}
+}
+
+func Test_setModuleTags(t *testing.T) {
+	modules := []*services.ModuleMetadata{
+		{Organization: "cie", Name: "vpc", Provider: "aws"},
+		{Organization: "cie", Name: "eks", Provider: "aws"},
+	}
+	moduleTags := []*terrarium.ModuleTags{
+		{Name: "cie/vpc/aws", Tags: []string{"network", "aws"}},
+		{Name: "cie/eks/aws"},
+	}
+
+	setModuleTags(modules, moduleTags)
+
+	if !reflect.DeepEqual(modules[0].Tags, []string{"network", "aws"}) {
+		t.Errorf("setModuleTags() set %v on cie/vpc/aws, want [network aws]", modules[0].Tags)
+	}
+
+	if len(modules[1].Tags) != 0 {
+		t.Errorf("setModuleTags() set %v on cie/eks/aws, want no tags", modules[1].Tags)
+	}
+}
+
+func Test_createTagsResponse(t *testing.T) {
+	response := &services.ListTagsResponse{
+		Tags: []*services.TagCount{{Tag: "aws", Count: 1}, {Tag: "network", Count: 2}},
+		Page: &paging.PageInfoResponse{NextCursor: "abc"},
+	}
+
+	want := &tagsResponse{
+		Tags:       []*tagItem{{Tag: "aws", Count: 1}, {Tag: "network", Count: 2}},
+		NextCursor: "abc",
+	}
+
+	if got := createTagsResponse(response); !reflect.DeepEqual(got, want) {
+		t.Errorf("createTagsResponse() = %v, want %v", got, want)
+	}
+
+	if got := createTagsResponse(&services.ListTagsResponse{}); got.Tags == nil {
+		t.Errorf("createTagsResponse() = %v, want an empty list", got)
+	}
+}
@@ -0,0 +1,60 @@
+package discovery
+
+import (
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
+
+	"github.com/terrariumcloud/terrarium/internal/restapi"
+)
+
+// Path is where Terraform looks up the services of a registry host.
+const Path = "/.well-known/terraform.json"
+
+// Services are the base URLs advertised to Terraform for each service of the registry.
+// URLs are resolved by Terraform relative to the discovery document, absolute paths or URLs are used as they are.
+// Services left empty are not advertised.
+type Services struct {
+	ModulesV1   string   `json:"modules.v1,omitempty"`
+	ProvidersV1 string   `json:"providers.v1,omitempty"`
+	LoginV1     *LoginV1 `json:"login.v1,omitempty"`
+}
+
+// LoginV1 is the OAuth client `terraform login` uses to obtain an API token for the registry.
+type LoginV1 struct {
+	Client     string   `json:"client"`
+	GrantTypes []string `json:"grant_types,omitempty"`
+	Authz      string   `json:"authz"`
+	Token      string   `json:"token"`
+	Ports      []int    `json:"ports,omitempty"`
+}
+
+type discoveryHttpService struct {
+	services        Services
+	responseHandler restapi.ResponseHandler
+}
+
+func New(services Services) *discoveryHttpService {
+	return &discoveryHttpService{services: services}
+}
+
+func (h *discoveryHttpService) GetHttpHandler(mountPath string) http.Handler {
+	router := h.createRouter(mountPath)
+	return handlers.CombinedLoggingHandler(os.Stdout, router)
+}
+
+func (h *discoveryHttpService) createRouter(mountPath string) *mux.Router {
+	log.Printf("Advertising services: modules.v1=%q providers.v1=%q", h.services.ModulesV1, h.services.ProvidersV1)
+	r := mux.NewRouter()
+	r.Handle(mountPath+Path, h.servicesHandler()).Methods(http.MethodGet)
+	return r
+}
+
+func (h *discoveryHttpService) servicesHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		h.responseHandler.WriteRaw(rw, h.services, http.StatusOK)
+	})
+}
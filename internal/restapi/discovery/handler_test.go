@@ -0,0 +1,63 @@
+package discovery
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Test_servicesHandler checks:
+// - if the base URLs of the services are returned under their Terraform service identifiers
+// - if services left empty are not advertised
+// - if the document is only served at the well-known path
+func Test_servicesHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when modules and providers are mounted", func(t *testing.T) {
+		handler := New(Services{ModulesV1: "/modules/v1/", ProvidersV1: "https://registry.example.com/tf/providers/v1/"}).GetHttpHandler("")
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected %v, got %v.", http.StatusOK, rec.Code)
+		}
+
+		if rec.Header().Get("Content-Type") != "application/json" {
+			t.Errorf("Expected application/json, got %v.", rec.Header().Get("Content-Type"))
+		}
+
+		var got map[string]interface{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+			t.Fatalf("Expected JSON document, got %v.", err)
+		}
+
+		want := map[string]interface{}{"modules.v1": "/modules/v1/", "providers.v1": "https://registry.example.com/tf/providers/v1/"}
+		if len(got) != len(want) || got["modules.v1"] != want["modules.v1"] || got["providers.v1"] != want["providers.v1"] {
+			t.Errorf("Expected %v, got %v.", want, got)
+		}
+	})
+
+	t.Run("when only modules are mounted", func(t *testing.T) {
+		handler := New(Services{ModulesV1: "/modules/v1/"}).GetHttpHandler("")
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+
+		if rec.Body.String() != "{\n   \"modules.v1\": \"/modules/v1/\"\n}" {
+			t.Errorf("Expected only modules.v1, got %v.", rec.Body.String())
+		}
+	})
+
+	t.Run("when another path is requested", func(t *testing.T) {
+		handler := New(Services{ModulesV1: "/modules/v1/"}).GetHttpHandler("")
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/terraform.json", nil))
+
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected %v, got %v.", http.StatusNotFound, rec.Code)
+		}
+	})
+}
@@ -6,29 +6,70 @@ import (
 
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
+	"github.com/terrariumcloud/terrarium/internal/common/upstream"
 	"github.com/terrariumcloud/terrarium/internal/module/services"
 	"github.com/terrariumcloud/terrarium/internal/restapi"
 	pb "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"io"
 	"log"
 	"net/http"
 	"os"
+	"path"
+	"strconv"
 
 	"gopkg.in/errgo.v2/errors"
 
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 )
 
+// maxModuleListLimit is the largest number of modules listed or searched at once, larger limits are lowered to it.
+const maxModuleListLimit = 100
+
 type modulesV1HttpService struct {
+	registrarClient      services.RegistrarClient
 	versionManagerClient services.VersionManagerClient
 	storageClient        services.StorageClient
+	upstream             *upstream.Registry
 	responseHandler      restapi.ResponseHandler
 	errorHandler         restapi.ErrorHandler
 }
 
+type ModuleItem struct {
+	ID          string `json:"id"`
+	Owner       string `json:"owner"`
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Provider    string `json:"provider"`
+	Description string `json:"description"`
+	Source      string `json:"source"`
+	Downloads   int    `json:"downloads"`
+	Verified    bool   `json:"verified"`
+}
+
+type ModuleListMeta struct {
+	Limit         int     `json:"limit"`
+	CurrentOffset int     `json:"current_offset"`
+	NextOffset    *uint64 `json:"next_offset,omitempty"`
+	NextURL       string  `json:"next_url,omitempty"`
+}
+
+type ModuleListResponse struct {
+	Meta    ModuleListMeta `json:"meta"`
+	Modules []*ModuleItem  `json:"modules"`
+}
+
+type LatestModuleResponse struct {
+	*ModuleItem
+	Versions []string `json:"versions"`
+}
+
 type ModuleVersionItem struct {
 	Version string `json:"version"`
 }
@@ -46,8 +87,16 @@ func (h *modulesV1HttpService) GetHttpHandler(mountPath string) http.Handler {
 	return handlers.CombinedLoggingHandler(os.Stdout, router)
 }
 
-func New(versionManagerClient services.VersionManagerClient, storageClient services.StorageClient) *modulesV1HttpService {
-	return &modulesV1HttpService{versionManagerClient: versionManagerClient, storageClient: storageClient}
+// New returns the handler of the module registry protocol. Modules of the organizations proxied by the upstream
+// registry, when one is given, are fetched from it and cached the first time they are downloaded.
+func New(registrarClient services.RegistrarClient, versionManagerClient services.VersionManagerClient, storageClient services.StorageClient, upstream *upstream.Registry) *modulesV1HttpService {
+	return &modulesV1HttpService{registrarClient: registrarClient, versionManagerClient: versionManagerClient, storageClient: storageClient, upstream: upstream}
+}
+
+// BasePath returns the path the registry protocol is served at for a mount path, as advertised to Terraform
+// through service discovery.
+func BasePath(mountPath string) string {
+	return path.Join("/", mountPath, "v1") + "/"
 }
 
 func (h *modulesV1HttpService) createRouter(mountPath string) *mux.Router {
@@ -58,6 +107,10 @@ func (h *modulesV1HttpService) createRouter(mountPath string) *mux.Router {
 	sr := r.PathPrefix(prefix).Subrouter()
 	sr.Use(otelmux.Middleware("modules-v1"))
 	sr.StrictSlash(true)
+	sr.Handle("/", h.listModulesHandler()).Methods(http.MethodGet)
+	sr.Handle("/search", h.searchModulesHandler()).Methods(http.MethodGet)
+	sr.Handle("/{organization_name}", h.listModulesHandler()).Methods(http.MethodGet)
+	sr.Handle("/{organization_name}/{name}/{provider}/latest", h.getLatestModuleHandler()).Methods(http.MethodGet)
 	sr.Handle("/{organization_name}/{name}/{provider}/versions", h.getModuleVersionHandler()).Methods(http.MethodGet)
 	sr.Handle("/{organization_name}/{name}/{provider}/{version}/download", h.downloadModuleHandler()).Methods(http.MethodGet)
 	sr.Handle("/{organization_name}/{name}/{provider}/{version}/archive", h.archiveHandler()).Methods(http.MethodGet)
@@ -70,8 +123,143 @@ func (h *modulesV1HttpService) healthHandler() http.Handler {
 	})
 }
 
+// listModulesHandler returns a page of the registered modules with their latest version, optionally restricted to the
+// organization in the path and the provider given in the query.
+// This handler complies with the following implementation from the public registry API
+// https://developer.hashicorp.com/terraform/registry/api-docs#list-modules
+func (h *modulesV1HttpService) listModulesHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		log.Printf("listModulesHandler")
+		h.writeModuleList(rw, r, &services.ListModulesRequest{
+			Organization: mux.Vars(r)["organization_name"],
+			Provider:     r.URL.Query().Get("provider"),
+		})
+	})
+}
+
+// searchModulesHandler returns a page of the modules whose name or description contain the query.
+// This handler complies with the following implementation from the public registry API
+// https://developer.hashicorp.com/terraform/registry/api-docs#search-modules
+func (h *modulesV1HttpService) searchModulesHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		log.Printf("searchModulesHandler")
+		query := r.URL.Query().Get("q")
+		if query == "" {
+			h.errorHandler.Write(rw, errors.New("q is required"), http.StatusBadRequest)
+			return
+		}
+		h.writeModuleList(rw, r, &services.ListModulesRequest{
+			Query:        query,
+			Organization: r.URL.Query().Get("namespace"),
+			Provider:     r.URL.Query().Get("provider"),
+		})
+	})
+}
+
+func (h *modulesV1HttpService) writeModuleList(rw http.ResponseWriter, r *http.Request, request *services.ListModulesRequest) {
+	ctx := r.Context()
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("module.organization", request.GetOrganization()),
+		attribute.String("module.provider", request.GetProvider()),
+		attribute.String("module.query", request.GetQuery()),
+	)
+
+	limit, offset, err := restapi.ExtractLimitAndOffset(r.URL.Query())
+	if err == nil && (limit < 1 || offset < 0) {
+		err = errors.New("limit must be positive and offset must not be negative")
+	}
+	if err != nil {
+		h.errorHandler.Write(rw, err, http.StatusBadRequest)
+		return
+	}
+	limit = min(limit, maxModuleListLimit)
+
+	verified := false
+	if r.URL.Query().Has("verified") {
+		if verified, err = strconv.ParseBool(r.URL.Query().Get("verified")); err != nil {
+			h.errorHandler.Write(rw, errors.New("verified must be true or false"), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Modules of a private registry are never verified by a partner program, so none match verified=true.
+	if verified {
+		h.responseHandler.WriteRaw(rw, createModuleListResponse(r.URL, limit, offset, nil, nil, nil), http.StatusOK)
+		return
+	}
+
+	request.Page = &paging.PageInfoRequest{Offset: uint64(offset), Count: uint64(limit)}
+	registrarResponse, err := h.registrarClient.ListModules(ctx, request)
+	if err != nil {
+		log.Printf("Failed GRPC call with error: %v", err)
+		span.RecordError(err)
+		h.errorHandler.Write(rw, errors.New("failed to retrieve the list of modules from backend service"), http.StatusInternalServerError)
+		return
+	}
+
+	var latest map[string]string
+	if len(registrarResponse.GetModules()) > 0 {
+		names := make([]string, 0, len(registrarResponse.GetModules()))
+		for _, module := range registrarResponse.GetModules() {
+			names = append(names, moduleName(module))
+		}
+		versionResponse, err := h.versionManagerClient.ListLatestModuleVersions(ctx, &services.ListLatestModuleVersionsRequest{Modules: names})
+		if err != nil {
+			log.Printf("Failed GRPC call with error: %v", err)
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of versions from backend service"), http.StatusInternalServerError)
+			return
+		}
+		latest = versionResponse.GetVersions()
+	}
+
+	h.responseHandler.WriteRaw(rw, createModuleListResponse(r.URL, limit, offset, registrarResponse.GetModules(), latest, registrarResponse.GetPage()), http.StatusOK)
+}
+
+// getLatestModuleHandler returns a module with its latest version and the list of all its versions.
+// Will return a 404 if the module has no published version.
+// This handler complies with the following implementation from the public registry API
+// https://developer.hashicorp.com/terraform/registry/api-docs#latest-version-for-a-specific-module-provider
+func (h *modulesV1HttpService) getLatestModuleHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		log.Printf("getLatestModuleHandler")
+		moduleName := GetModuleNameFromRequest(r)
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("module.name", moduleName),
+		)
+
+		versionResponse, err := h.versionManagerClient.ListModuleVersions(ctx, &services.ListModuleVersionsRequest{Module: moduleName})
+		if err != nil {
+			log.Printf("Failed GRPC call with error: %v", err)
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of versions from backend service"), http.StatusInternalServerError)
+			return
+		}
+		if len(versionResponse.GetVersions()) == 0 {
+			h.errorHandler.Write(rw, errors.New("module has no published version"), http.StatusNotFound)
+			return
+		}
+
+		registrarResponse, err := h.registrarClient.GetModule(ctx, &services.GetModuleRequest{Name: moduleName})
+		if err != nil {
+			log.Printf("Failed GRPC call with error: %v", err)
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to retrieve the module details from backend service"), http.StatusInternalServerError)
+			return
+		}
+
+		h.responseHandler.WriteRaw(rw, createLatestModuleResponse(registrarResponse.GetModule(), versionResponse.GetVersions()), http.StatusOK)
+	})
+}
+
 // GetModuleVersionHandler will return a list of available versions for a given module.
 // This signifies to the requesting CLI if that module is available to consume from the registry.
+// The versions offered by the upstream registry for a module of a proxied organization are listed along with the
+// hosted ones, so that the versions not cached yet can be selected.
 // Will return a 404 if a non-existent organization and/or module is requested.
 // This handler complies with the following implementation from the module protocol
 // https://www.terraform.io/internals/module-registry-protocol#download-source-code-for-a-specific-module-version
@@ -94,7 +282,9 @@ func (h *modulesV1HttpService) getModuleVersionHandler() http.Handler {
 			h.errorHandler.Write(rw, errors.New("failed to retrieve the list of versions from backend service"), http.StatusInternalServerError)
 			return
 		}
-		data, _ := json.Marshal(createModuleVersionsResponse(versionResponse.Versions))
+		params := mux.Vars(r)
+		versions := mergeVersions(versionResponse.GetVersions(), h.upstreamVersions(ctx, params["organization_name"], params["name"], params["provider"]))
+		data, _ := json.Marshal(createModuleVersionsResponse(versions))
 		rw.Header().Add("Content-Type", "application/json")
 		_, _ = rw.Write(data)
 	})
@@ -112,6 +302,7 @@ func (h *modulesV1HttpService) downloadModuleHandler() http.Handler {
 // archiveHandler performs a fetch of the restapi.d module source code from the chosen backing store and presents it to the client
 // As part of the module flow clients are redirected here from the DownloadModuleHandler x-terraform-get header. This handler
 // makes the stored registry code available to the client
+// A version missing from storage is fetched from the upstream registry when its organization is proxied, and cached.
 func (h *modulesV1HttpService) archiveHandler() http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		moduleName := GetModuleNameFromRequest(r)
@@ -132,13 +323,33 @@ func (h *modulesV1HttpService) archiveHandler() http.Handler {
 			return
 		}
 
-		r.Header.Set("Content-Type", "application/zip")
+		written := false
 		for {
 			chunk, err := downloadStream.Recv()
 			if err == io.EOF {
 				return
 			}
-			_, _ = rw.Write(chunk.ZipDataChunk)
+			if err != nil && !written && status.Code(err) == codes.NotFound {
+				if h.upstream.Allowed(mux.Vars(r)["organization_name"]) {
+					h.writeUpstreamArchive(rw, r)
+				} else {
+					h.errorHandler.Write(rw, errors.New("module version not found"), http.StatusNotFound)
+				}
+				return
+			}
+			if err != nil {
+				log.Printf("Failed to download archive: %v", err)
+				span.RecordError(err)
+				if !written {
+					h.errorHandler.Write(rw, errors.New("failed to download the archive from storage backend service"), http.StatusInternalServerError)
+				}
+				return
+			}
+			if !written {
+				rw.Header().Set("Content-Type", "application/zip")
+				written = true
+			}
+			_, _ = rw.Write(chunk.GetZipDataChunk())
 		}
 	})
 }
@@ -0,0 +1,349 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
+	"github.com/terrariumcloud/terrarium/internal/common/upstream"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	"github.com/terrariumcloud/terrarium/internal/module/services/mocks"
+	pb "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func serve(h *modulesV1HttpService, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.GetHttpHandler("/modules").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+// Test_listModulesHandler checks:
+// - if a page of modules is listed with their latest version, fetched in a single call
+// - if limits above the maximum are lowered to it
+// - if modules are listed for the organization in the path
+// - if modules are searched with the query
+// - if no module is listed when only verified modules are requested
+// - if bad request is returned for an invalid limit or a missing query
+// - if error is returned when ListModules or ListLatestModuleVersions fails
+func Test_listModulesHandler(t *testing.T) {
+	t.Parallel()
+
+	modules := &services.ListModulesResponse{
+		Modules: []*services.ModuleMetadata{{Organization: "cie", Name: "vpc", Provider: "aws"}},
+		Page:    &paging.PageInfoResponse{NextOffset: 6, TotalCount: 9},
+	}
+
+	t.Run("when modules are listed", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{ListModulesResponse: modules}
+		versionManager := &mocks.MockVersionManagerClient{ListLatestModuleVersionsResponse: &services.ListLatestModuleVersionsResponse{Versions: map[string]string{"cie/vpc/aws": "1.1.0"}}}
+		h := New(registrar, versionManager, nil, nil)
+
+		rec := serve(h, "/modules/v1/?provider=aws&offset=5&limit=1")
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected %v, got %v: %v", http.StatusOK, rec.Code, rec.Body.String())
+		}
+
+		req := registrar.ListModulesRequest
+		if req.Provider != "aws" || req.Organization != "" || req.Page.Offset != 5 || req.Page.Count != 1 {
+			t.Errorf("Expected provider aws and page 5+1, got %v", req)
+		}
+
+		var res ModuleListResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		if len(res.Modules) != 1 || res.Modules[0].ID != "cie/vpc/aws/1.1.0" || *res.Meta.NextOffset != 6 {
+			t.Errorf("Expected cie/vpc/aws/1.1.0 and next offset 6, got %v", rec.Body.String())
+		}
+
+		if versionManager.ListLatestModuleVersionsInvocations != 1 || versionManager.ListModuleVersionsInvocations != 0 {
+			t.Errorf("Expected the latest versions to be fetched in a single call, got %v and %v calls", versionManager.ListLatestModuleVersionsInvocations, versionManager.ListModuleVersionsInvocations)
+		}
+	})
+
+	t.Run("when more modules than allowed are requested", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{ListModulesResponse: &services.ListModulesResponse{}}
+		h := New(registrar, &mocks.MockVersionManagerClient{}, nil, nil)
+
+		rec := serve(h, "/modules/v1/?limit=1000")
+
+		var res ModuleListResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		if rec.Code != http.StatusOK || registrar.ListModulesRequest.Page.Count != maxModuleListLimit || res.Meta.Limit != maxModuleListLimit {
+			t.Errorf("Expected the limit to be lowered to %v, got %v: %v", maxModuleListLimit, rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("when modules of an organization are listed", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{ListModulesResponse: &services.ListModulesResponse{}}
+		h := New(registrar, &mocks.MockVersionManagerClient{}, nil, nil)
+
+		rec := serve(h, "/modules/v1/cie")
+
+		if rec.Code != http.StatusOK || registrar.ListModulesRequest.Organization != "cie" {
+			t.Errorf("Expected modules of cie, got %v: %v", rec.Code, registrar.ListModulesRequest)
+		}
+	})
+
+	t.Run("when modules are searched", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{ListModulesResponse: &services.ListModulesResponse{}}
+		h := New(registrar, &mocks.MockVersionManagerClient{}, nil, nil)
+
+		rec := serve(h, "/modules/v1/search?q=network&namespace=cie")
+
+		if rec.Code != http.StatusOK || registrar.ListModulesRequest.Query != "network" || registrar.ListModulesRequest.Organization != "cie" {
+			t.Errorf("Expected search for network in cie, got %v: %v", rec.Code, registrar.ListModulesRequest)
+		}
+	})
+
+	t.Run("when verified modules are listed", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{}
+		h := New(registrar, &mocks.MockVersionManagerClient{}, nil, nil)
+
+		rec := serve(h, "/modules/v1/?verified=true")
+
+		if rec.Code != http.StatusOK || registrar.ListModulesInvocations != 0 {
+			t.Errorf("Expected no module without calling the registrar, got %v and %v calls", rec.Code, registrar.ListModulesInvocations)
+		}
+	})
+
+	t.Run("when the request is invalid", func(t *testing.T) {
+		h := New(&mocks.MockRegistrarClient{}, &mocks.MockVersionManagerClient{}, nil, nil)
+
+		for _, target := range []string{"/modules/v1/?limit=0", "/modules/v1/?offset=-1", "/modules/v1/?verified=maybe", "/modules/v1/search"} {
+			if rec := serve(h, target); rec.Code != http.StatusBadRequest {
+				t.Errorf("Expected %v for %s, got %v", http.StatusBadRequest, target, rec.Code)
+			}
+		}
+	})
+
+	t.Run("when ListModules fails", func(t *testing.T) {
+		h := New(&mocks.MockRegistrarClient{ListModulesError: errors.New("some error")}, &mocks.MockVersionManagerClient{}, nil, nil)
+
+		if rec := serve(h, "/modules/v1/"); rec.Code != http.StatusInternalServerError {
+			t.Errorf("Expected %v, got %v", http.StatusInternalServerError, rec.Code)
+		}
+	})
+
+	t.Run("when ListLatestModuleVersions fails", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{ListModulesResponse: modules}
+		h := New(registrar, &mocks.MockVersionManagerClient{ListLatestModuleVersionsError: errors.New("some error")}, nil, nil)
+
+		if rec := serve(h, "/modules/v1/"); rec.Code != http.StatusInternalServerError {
+			t.Errorf("Expected %v, got %v", http.StatusInternalServerError, rec.Code)
+		}
+	})
+}
+
+// Test_getLatestModuleHandler checks:
+// - if the module is returned with its latest version and all its versions
+// - if not found is returned when the module has no published version
+func Test_getLatestModuleHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the module has versions", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{GetModuleResponse: &services.GetModuleResponse{Module: &services.ModuleMetadata{Organization: "cie", Name: "vpc", Provider: "aws"}}}
+		versionManager := &mocks.MockVersionManagerClient{ListModuleVersionsResponse: &services.ListModuleVersionsResponse{Versions: []string{"1.2.0", "1.10.0"}}}
+		h := New(registrar, versionManager, nil, nil)
+
+		rec := serve(h, "/modules/v1/cie/vpc/aws/latest")
+
+		var res LatestModuleResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		if rec.Code != http.StatusOK || res.Version != "1.10.0" || len(res.Versions) != 2 {
+			t.Errorf("Expected cie/vpc/aws at 1.10.0, got %v: %v", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("when the module has no version", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{}
+		versionManager := &mocks.MockVersionManagerClient{ListModuleVersionsResponse: &services.ListModuleVersionsResponse{}}
+		h := New(registrar, versionManager, nil, nil)
+
+		rec := serve(h, "/modules/v1/cie/vpc/aws/latest")
+
+		if rec.Code != http.StatusNotFound || registrar.GetModuleInvocations != 0 {
+			t.Errorf("Expected %v, got %v", http.StatusNotFound, rec.Code)
+		}
+	})
+}
+
+// standIn returns an upstream registry proxying the cie organization, served by a stand-in offering the versions
+// 1.0.0 and 2.0.0 of cie/vpc/aws.
+func standIn(t *testing.T) *upstream.Registry {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc(upstream.DiscoveryPath, func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(`{"modules.v1": "/modules/v1/"}`))
+	})
+	mux.HandleFunc("/modules/v1/cie/vpc/aws/versions", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(`{"modules": [{"versions": [{"version": "1.0.0"}, {"version": "2.0.0"}]}]}`))
+	})
+	mux.HandleFunc("/modules/v1/cie/vpc/aws/2.0.0/download", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("X-Terraform-Get", "./archive?archive=zip")
+		rw.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/modules/v1/cie/vpc/aws/2.0.0/archive", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte("PK module"))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	registry, err := upstream.New(server.URL, []string{"cie"})
+	if err != nil {
+		t.Fatalf("upstream.New() error = %v", err)
+	}
+	return registry
+}
+
+// Test_getModuleVersionHandler checks:
+// - if the hosted versions are merged with the versions of the upstream registry for a proxied organization
+// - if the versions of the upstream registry are listed for a proxied organization when the module is not hosted
+// - if only the hosted versions are listed for an organization that is not proxied
+// - if no version is listed when the module is not hosted and the upstream registry cannot be reached
+func Test_getModuleVersionHandler(t *testing.T) {
+	t.Parallel()
+
+	hosted := &services.ListModuleVersionsResponse{Versions: []string{"1.0.0", "1.1.0"}}
+	unreachable := func(t *testing.T) *upstream.Registry {
+		registry, _ := upstream.New("http://127.0.0.1:1", []string{"cie"})
+		return registry
+	}
+
+	tests := []struct {
+		name     string
+		target   string
+		hosted   *services.ListModuleVersionsResponse
+		upstream func(t *testing.T) *upstream.Registry
+		want     []string
+	}{
+		{"when the module of a proxied organization is hosted", "/modules/v1/cie/vpc/aws/versions", hosted, standIn, []string{"1.0.0", "1.1.0", "2.0.0"}},
+		{"when the module of a proxied organization is not hosted", "/modules/v1/cie/vpc/aws/versions", &services.ListModuleVersionsResponse{}, standIn, []string{"1.0.0", "2.0.0"}},
+		{"when the organization is not proxied", "/modules/v1/other/vpc/aws/versions", hosted, standIn, []string{"1.0.0", "1.1.0"}},
+		{"when the upstream registry cannot be reached", "/modules/v1/cie/vpc/aws/versions", &services.ListModuleVersionsResponse{}, unreachable, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versionManager := &mocks.MockVersionManagerClient{ListModuleVersionsResponse: tt.hosted}
+			h := New(&mocks.MockRegistrarClient{}, versionManager, nil, tt.upstream(t))
+
+			rec := serve(h, tt.target)
+
+			var res ModuleVersionResponse
+			_ = json.Unmarshal(rec.Body.Bytes(), &res)
+			var got []string
+			for _, version := range res.Modules[0].Versions {
+				got = append(got, version.Version)
+			}
+			if rec.Code != http.StatusOK || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v: %v", tt.want, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+// Test_archiveHandler checks:
+// - if the archive is downloaded from storage
+// - if a version missing from storage is fetched from the upstream registry and published
+// - if a module already registered is not registered again when one of its versions is cached
+// - if NotFound is returned for a version missing from storage and the upstream registry
+// - if NotFound is returned for a version missing from storage when the organization is not proxied
+// - if error is returned when the download fails
+func Test_archiveHandler(t *testing.T) {
+	t.Parallel()
+
+	missing := status.Error(codes.NotFound, "Source zip not found.")
+
+	t.Run("when the archive is downloaded", func(t *testing.T) {
+		download := &mocks.MockStorage_DownloadSourceZipClient{RecvResponse: &pb.SourceZipResponse{ZipDataChunk: []byte("PK")}, RecvError: io.EOF}
+		storage := &mocks.MockStorageClient{DownloadSourceZipClient: download}
+		h := New(&mocks.MockRegistrarClient{}, &mocks.MockVersionManagerClient{}, storage, standIn(t))
+
+		rec := serve(h, "/modules/v1/cie/vpc/aws/1.0.0/archive")
+
+		if rec.Code != http.StatusOK || storage.UploadSourceZipInvocations != 0 {
+			t.Errorf("Expected the archive to be downloaded from storage, got %v", rec.Code)
+		}
+	})
+
+	t.Run("when the version is cached from the upstream registry", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{GetModuleError: status.Error(codes.NotFound, "module not found")}
+		versionManager := &mocks.MockVersionManagerClient{}
+		upload := &mocks.MockStorage_UploadSourceZipClient{}
+		storage := &mocks.MockStorageClient{
+			DownloadSourceZipClient: &mocks.MockStorage_DownloadSourceZipClient{RecvError: missing},
+			UploadSourceZipClient:   upload,
+		}
+		h := New(registrar, versionManager, storage, standIn(t))
+
+		rec := serve(h, "/modules/v1/cie/vpc/aws/2.0.0/archive")
+
+		if rec.Code != http.StatusOK || rec.Body.String() != "PK module" {
+			t.Fatalf("Expected the archive of the upstream registry, got %v: %v", rec.Code, rec.Body.String())
+		}
+
+		if registrar.RegisterInvocations != 1 || registrar.RegisterRequest.GetName() != "cie/vpc/aws" {
+			t.Errorf("Expected cie/vpc/aws to be registered, got %v", registrar.RegisterRequest)
+		}
+
+		if versionManager.BeginVersionInvocations != 1 || upload.SendInvocations != 1 || upload.CloseAndRecvInvocations != 1 || versionManager.PublishVersionInvocations != 1 {
+			t.Errorf("Expected the version to be uploaded and published, got %v begin, %v sends and %v publish",
+				versionManager.BeginVersionInvocations, upload.SendInvocations, versionManager.PublishVersionInvocations)
+		}
+	})
+
+	t.Run("when the module is already registered", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{GetModuleResponse: &services.GetModuleResponse{}}
+		storage := &mocks.MockStorageClient{
+			DownloadSourceZipClient: &mocks.MockStorage_DownloadSourceZipClient{RecvError: missing},
+			UploadSourceZipClient:   &mocks.MockStorage_UploadSourceZipClient{},
+		}
+		h := New(registrar, &mocks.MockVersionManagerClient{}, storage, standIn(t))
+
+		rec := serve(h, "/modules/v1/cie/vpc/aws/2.0.0/archive")
+
+		if rec.Code != http.StatusOK || registrar.GetModuleInvocations != 1 || registrar.RegisterInvocations != 0 {
+			t.Errorf("Expected the module not to be registered again, got %v and %v registrations", rec.Code, registrar.RegisterInvocations)
+		}
+	})
+
+	t.Run("when the version is missing upstream", func(t *testing.T) {
+		registrar := &mocks.MockRegistrarClient{}
+		storage := &mocks.MockStorageClient{DownloadSourceZipClient: &mocks.MockStorage_DownloadSourceZipClient{RecvError: missing}}
+		h := New(registrar, &mocks.MockVersionManagerClient{}, storage, standIn(t))
+
+		rec := serve(h, "/modules/v1/cie/vpc/aws/3.0.0/archive")
+
+		if rec.Code != http.StatusNotFound || registrar.RegisterInvocations != 0 {
+			t.Errorf("Expected %v, got %v", http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("when the organization is not proxied", func(t *testing.T) {
+		storage := &mocks.MockStorageClient{DownloadSourceZipClient: &mocks.MockStorage_DownloadSourceZipClient{RecvError: missing}}
+		h := New(&mocks.MockRegistrarClient{}, &mocks.MockVersionManagerClient{}, storage, standIn(t))
+
+		rec := serve(h, "/modules/v1/other/vpc/aws/2.0.0/archive")
+
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected %v, got %v", http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("when the download fails", func(t *testing.T) {
+		storage := &mocks.MockStorageClient{DownloadSourceZipClient: &mocks.MockStorage_DownloadSourceZipClient{RecvError: errors.New("some error")}}
+		h := New(&mocks.MockRegistrarClient{}, &mocks.MockVersionManagerClient{}, storage, nil)
+
+		rec := serve(h, "/modules/v1/cie/vpc/aws/2.0.0/archive")
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("Expected %v, got %v", http.StatusInternalServerError, rec.Code)
+		}
+	})
+}
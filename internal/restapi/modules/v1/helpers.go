@@ -3,8 +3,14 @@ package v1
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
+	"github.com/apparentlymart/go-versions/versions"
 	"github.com/gorilla/mux"
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
 	pb "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
 	"google.golang.org/grpc"
 )
@@ -46,6 +52,71 @@ func createModuleVersionsResponse(versions []string) *ModuleVersionResponse {
 	}
 }
 
+func moduleName(module *services.ModuleMetadata) string {
+	return fmt.Sprintf("%s/%s/%s", module.GetOrganization(), module.GetName(), module.GetProvider())
+}
+
+func createModuleItem(module *services.ModuleMetadata, version string) *ModuleItem {
+	id := moduleName(module)
+	if version != "" {
+		id = fmt.Sprintf("%s/%s", id, version)
+	}
+	return &ModuleItem{
+		ID:          id,
+		Namespace:   module.GetOrganization(),
+		Name:        module.GetName(),
+		Version:     version,
+		Provider:    module.GetProvider(),
+		Description: module.GetDescription(),
+		Source:      module.GetSourceUrl(),
+	}
+}
+
+// createModuleListResponse returns a page of modules with their latest version, and the URL of the next page
+// when there is one.
+func createModuleListResponse(u *url.URL, limit, offset int, modules []*services.ModuleMetadata, latest map[string]string, page *paging.PageInfoResponse) *ModuleListResponse {
+	response := &ModuleListResponse{
+		Meta:    ModuleListMeta{Limit: limit, CurrentOffset: offset},
+		Modules: []*ModuleItem{},
+	}
+	for _, module := range modules {
+		response.Modules = append(response.Modules, createModuleItem(module, latest[moduleName(module)]))
+	}
+	if page.GetNextOffset() > 0 {
+		next := page.GetNextOffset()
+		query := u.Query()
+		query.Set("offset", strconv.FormatUint(next, 10))
+		query.Set("limit", strconv.Itoa(limit))
+		response.Meta.NextOffset = &next
+		response.Meta.NextURL = fmt.Sprintf("%s?%s", u.Path, query.Encode())
+	}
+	return response
+}
+
+func createLatestModuleResponse(module *services.ModuleMetadata, versions []string) *LatestModuleResponse {
+	return &LatestModuleResponse{
+		ModuleItem: createModuleItem(module, latestVersion(versions)),
+		Versions:   versions,
+	}
+}
+
+// latestVersion returns the newest of the versions as it was published, or an empty string when none can be parsed.
+func latestVersion(available []string) string {
+	var latest versions.Version
+	var latestName string
+	for _, name := range available {
+		version, err := versions.ParseVersion(strings.TrimPrefix(name, "v"))
+		if err != nil {
+			continue
+		}
+		if latestName == "" || version.GreaterThan(latest) {
+			latest = version
+			latestName = name
+		}
+	}
+	return latestName
+}
+
 func closeClient(conn *grpc.ClientConn) {
 	err := conn.Close()
 	if err != nil {
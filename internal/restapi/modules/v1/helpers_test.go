@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+)
+
+func Test_createModuleListResponse(t *testing.T) {
+	modules := []*services.ModuleMetadata{
+		{Organization: "cie", Name: "vpc", Provider: "aws", Description: "Network", SourceUrl: "https://git.example.com/vpc"},
+		{Organization: "cie", Name: "eks", Provider: "aws"},
+	}
+	latest := map[string]string{"cie/vpc/aws": "2.1.0"}
+	u, _ := url.Parse("/modules/v1/search?q=cie&limit=2")
+	next := uint64(2)
+
+	want := &ModuleListResponse{
+		Meta: ModuleListMeta{Limit: 2, CurrentOffset: 0, NextOffset: &next, NextURL: "/modules/v1/search?limit=2&offset=2&q=cie"},
+		Modules: []*ModuleItem{
+			{ID: "cie/vpc/aws/2.1.0", Namespace: "cie", Name: "vpc", Version: "2.1.0", Provider: "aws", Description: "Network", Source: "https://git.example.com/vpc"},
+			{ID: "cie/eks/aws", Namespace: "cie", Name: "eks", Provider: "aws"},
+		},
+	}
+
+	if got := createModuleListResponse(u, 2, 0, modules, latest, &paging.PageInfoResponse{NextOffset: 2, TotalCount: 3}); !reflect.DeepEqual(got, want) {
+		t.Errorf("createModuleListResponse() = %v, want %v", got, want)
+	}
+
+	last := createModuleListResponse(u, 2, 2, nil, nil, &paging.PageInfoResponse{TotalCount: 2})
+	if last.Meta.NextOffset != nil || last.Meta.NextURL != "" || last.Modules == nil {
+		t.Errorf("Expected an empty last page, got %v", last)
+	}
+}
+
+func Test_latestVersion(t *testing.T) {
+	tests := []struct {
+		available []string
+		want      string
+	}{
+		{[]string{"1.0.0", "1.10.0", "1.2.0"}, "1.10.0"},
+		{[]string{"v0.9.0", "v1.0.0-beta", "v0.10.1"}, "v1.0.0-beta"},
+		{[]string{"not-a-version"}, ""},
+		{nil, ""},
+	}
+	for _, tt := range tests {
+		if got := latestVersion(tt.available); got != tt.want {
+			t.Errorf("latestVersion(%v) = %q, want %q", tt.available, got, tt.want)
+		}
+	}
+}
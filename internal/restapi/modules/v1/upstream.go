@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/terrariumcloud/terrarium/internal/common/upstream"
+	"github.com/terrariumcloud/terrarium/internal/module/services"
+	pb "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadChunkSize is the size of the chunks a module archive fetched from the upstream registry is streamed to
+// storage in.
+const uploadChunkSize = 64 * 1024
+
+// upstreamVersions returns the versions of a module offered by the upstream registry, or none when the organization
+// of the module is not proxied or the upstream registry cannot be reached.
+func (h *modulesV1HttpService) upstreamVersions(ctx context.Context, organization, name, provider string) []string {
+	if !h.upstream.Allowed(organization) {
+		return nil
+	}
+	versions, err := h.upstream.ModuleVersions(ctx, organization, name, provider)
+	if err != nil {
+		log.Printf("Failed to list the versions of %s/%s/%s from the upstream registry: %v", organization, name, provider, err)
+		trace.SpanFromContext(ctx).RecordError(err)
+		return nil
+	}
+	return versions
+}
+
+// mergeVersions returns the hosted versions of a module followed by the versions offered upstream that are not hosted.
+func mergeVersions(hosted, offered []string) []string {
+	listed := map[string]bool{}
+	for _, version := range hosted {
+		listed[version] = true
+	}
+	merged := hosted
+	for _, version := range offered {
+		if !listed[version] {
+			listed[version] = true
+			merged = append(merged, version)
+		}
+	}
+	return merged
+}
+
+// writeUpstreamArchive caches the requested module version from the upstream registry and presents its archive to
+// the client.
+func (h *modulesV1HttpService) writeUpstreamArchive(rw http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	ctx := r.Context()
+	span := trace.SpanFromContext(ctx)
+
+	archive, err := h.cacheModule(ctx, params["organization_name"], params["name"], params["provider"], params["version"])
+	if errors.Is(err, upstream.ErrNotFound) {
+		h.errorHandler.Write(rw, errors.New("module version not found in the upstream registry"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to cache module from the upstream registry: %v", err)
+		span.RecordError(err)
+		h.errorHandler.Write(rw, errors.New("failed to fetch the archive from the upstream registry"), http.StatusBadGateway)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/zip")
+	_, _ = rw.Write(archive)
+}
+
+// cacheModule fetches the archive of a module version from the upstream registry and publishes it as any other
+// module version, so that it is served by the registry from then on.
+func (h *modulesV1HttpService) cacheModule(ctx context.Context, organization, name, provider, version string) ([]byte, error) {
+	module := &pb.Module{Name: fmt.Sprintf("%s/%s/%s", organization, name, provider), Version: version}
+
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("Caching module from the upstream registry", trace.WithAttributes(
+		attribute.String("module.name", module.GetName()),
+		attribute.String("module.version", module.GetVersion()),
+		attribute.String("upstream.host", h.upstream.Host()),
+	))
+
+	archive, err := h.upstream.DownloadModule(ctx, organization, name, provider, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.registerUpstreamModule(ctx, module); err != nil {
+		return nil, err
+	}
+
+	if _, err := h.versionManagerClient.BeginVersion(ctx, &pb.BeginVersionRequest{Module: module}); err != nil {
+		return nil, err
+	}
+
+	if err := h.uploadSourceZip(ctx, module, archive); err != nil {
+		if _, abortErr := h.versionManagerClient.AbortVersion(ctx, &services.TerminateVersionRequest{Module: module}); abortErr != nil {
+			log.Printf("Failed to abort version %s of %s: %v", module.GetVersion(), module.GetName(), abortErr)
+			span.RecordError(abortErr)
+		}
+		return nil, err
+	}
+
+	if _, err := h.versionManagerClient.PublishVersion(ctx, &services.TerminateVersionRequest{Module: module}); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// registerUpstreamModule registers a module cached from the upstream registry, unless it is already registered so
+// that the metadata of a module hosted by the registry is kept.
+func (h *modulesV1HttpService) registerUpstreamModule(ctx context.Context, module *pb.Module) error {
+	_, err := h.registrarClient.GetModule(ctx, &services.GetModuleRequest{Name: module.GetName()})
+	if status.Code(err) != codes.NotFound {
+		return err
+	}
+
+	_, err = h.registrarClient.Register(ctx, &pb.RegisterModuleRequest{
+		Name:        module.GetName(),
+		Description: fmt.Sprintf("Cached from %s", h.upstream.Host()),
+		Source:      fmt.Sprintf("%s/%s", h.upstream.Host(), module.GetName()),
+	})
+	return err
+}
+
+func (h *modulesV1HttpService) uploadSourceZip(ctx context.Context, module *pb.Module, archive []byte) error {
+	upload, err := h.storageClient.UploadSourceZip(ctx)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(archive); i += uploadChunkSize {
+		end := min(i+uploadChunkSize, len(archive))
+		if err := upload.Send(&pb.UploadSourceZipRequest{Module: module, ZipDataChunk: archive[i:end]}); err != nil {
+			return err
+		}
+	}
+	_, err = upload.CloseAndRecv()
+	return err
+}
@@ -0,0 +1,203 @@
+package mirror
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+
+	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
+
+	"github.com/terrariumcloud/terrarium/internal/provider/services"
+	"github.com/terrariumcloud/terrarium/internal/restapi"
+
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type providersMirrorHttpService struct {
+	versionManagerClient services.VersionManagerClient
+	storageClient        services.StorageClient
+	responseHandler      restapi.ResponseHandler
+	errorHandler         restapi.ErrorHandler
+}
+
+// New returns the handler of the provider network mirror protocol, serving the providers hosted by the registry
+// for any hostname Terraform asks the mirror for.
+func New(versionManagerClient services.VersionManagerClient, storageClient services.StorageClient) *providersMirrorHttpService {
+	return &providersMirrorHttpService{
+		versionManagerClient: versionManagerClient,
+		storageClient:        storageClient,
+	}
+}
+
+func (h *providersMirrorHttpService) GetHttpHandler(mountPath string) http.Handler {
+	router := h.createRouter(mountPath)
+	return handlers.CombinedLoggingHandler(os.Stdout, router)
+}
+
+// BasePath returns the path the network mirror protocol is served at for a mount path, to be set as the url of the
+// network_mirror block of the Terraform CLI configuration.
+func BasePath(mountPath string) string {
+	return path.Join("/", mountPath, "mirror") + "/"
+}
+
+func (h *providersMirrorHttpService) createRouter(mountPath string) *mux.Router {
+	prefix := path.Join("/", mountPath, "mirror")
+	log.Printf("prefix for network mirror implementation: %s", prefix)
+	r := mux.NewRouter()
+	r.Handle("/healthz", h.healthHandler()).Methods(http.MethodGet)
+	sr := r.PathPrefix(prefix).Subrouter()
+	sr.Use(otelmux.Middleware("providers-mirror"))
+	sr.Handle("/{hostname}/{organization_name}/{name}/index.json", h.listVersionsHandler()).Methods(http.MethodGet)
+	sr.Handle("/{hostname}/{organization_name}/{name}/{version}.json", h.listArchivesHandler()).Methods(http.MethodGet)
+	sr.Handle("/{hostname}/{organization_name}/{name}/{version}/{os}/{arch}/{filename}", h.archiveHandler()).Methods(http.MethodGet)
+	return r
+}
+
+func (h *providersMirrorHttpService) healthHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		h.responseHandler.Write(rw, "OK", http.StatusOK)
+	})
+}
+
+// listVersionsHandler returns the versions of a provider available from the mirror.
+// Will return a 404 if the provider has no published version.
+// This handler complies with the following implementation from the network mirror protocol
+// https://developer.hashicorp.com/terraform/internals/provider-network-mirror-protocol#list-available-versions
+func (h *providersMirrorHttpService) listVersionsHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		providerName := GetProviderNameFromRequest(r)
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("provider.hostname", mux.Vars(r)["hostname"]),
+			attribute.String("provider.name", providerName),
+		)
+
+		versions, err := h.versionManagerClient.ListProviderVersions(ctx, &services.ProviderName{Provider: providerName})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to list provider versions"), http.StatusInternalServerError)
+			return
+		}
+		if len(versions.GetVersions()) == 0 {
+			h.errorHandler.Write(rw, fmt.Errorf("provider %s not found", providerName), http.StatusNotFound)
+			return
+		}
+
+		h.responseHandler.WriteRaw(rw, createIndexResponse(versions.GetVersions()), http.StatusOK)
+	})
+}
+
+// listArchivesHandler returns the archives of a provider version for each of its platforms, with their hashes.
+// The h1: hash of an archive is left out when storage cannot provide it, Terraform then relying on the zh: hash.
+// Will return a 404 if a non-existent version is requested.
+// This handler complies with the following implementation from the network mirror protocol
+// https://developer.hashicorp.com/terraform/internals/provider-network-mirror-protocol#list-available-installation-packages
+func (h *providersMirrorHttpService) listArchivesHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		provider := GetProviderLocationFromRequest(r)
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("provider.hostname", mux.Vars(r)["hostname"]),
+			attribute.String("provider.name", provider.GetName()),
+			attribute.String("provider.version", provider.GetVersion()),
+		)
+
+		versions, err := h.versionManagerClient.ListProviderVersions(ctx, &services.ProviderName{Provider: provider.GetName()})
+		if err != nil {
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to list provider versions"), http.StatusInternalServerError)
+			return
+		}
+		version := findVersion(versions.GetVersions(), provider.GetVersion())
+		if version == nil {
+			h.errorHandler.Write(rw, fmt.Errorf("version %s of provider %s not found", provider.GetVersion(), provider.GetName()), http.StatusNotFound)
+			return
+		}
+
+		response := &VersionResponse{Archives: map[string]*Archive{}}
+		for _, platform := range version.GetPlatforms() {
+			location := &services.ProviderRequest{Name: provider.GetName(), Version: provider.GetVersion(), Os: platform.GetOs(), Arch: platform.GetArch()}
+
+			metadata, err := h.versionManagerClient.GetVersionData(ctx, &services.VersionDataRequest{
+				Name:    location.GetName(),
+				Version: location.GetVersion(),
+				Os:      location.GetOs(),
+				Arch:    location.GetArch(),
+			})
+			if err != nil {
+				span.RecordError(err)
+				h.errorHandler.Write(rw, errors.New("failed to get provider platform"), http.StatusInternalServerError)
+				return
+			}
+
+			hash, err := h.storageClient.GetArchiveHash(ctx, &services.DownloadSourceZipRequest{Provider: location})
+			if err != nil {
+				log.Printf("Failed to get the hash of %s %s %s_%s: %v", location.GetName(), location.GetVersion(), location.GetOs(), location.GetArch(), err)
+				span.RecordError(err)
+			}
+
+			response.Archives[fmt.Sprintf("%s_%s", platform.GetOs(), platform.GetArch())] = &Archive{
+				URL:    archiveURL(location),
+				Hashes: archiveHashes(hash.GetHash(), metadata.GetShasum()),
+			}
+		}
+
+		h.responseHandler.WriteRaw(rw, response, http.StatusOK)
+	})
+}
+
+// archiveHandler performs a fetch of the provider archive from the chosen backing store and presents it to the client.
+func (h *providersMirrorHttpService) archiveHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		provider := GetProviderLocationFromRequest(r)
+
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("provider.name", provider.GetName()),
+			attribute.String("provider.version", provider.GetVersion()),
+			attribute.String("provider.os", provider.GetOs()),
+			attribute.String("provider.arch", provider.GetArch()),
+		)
+
+		downloadStream, err := h.storageClient.DownloadProviderSourceZip(ctx, &services.DownloadSourceZipRequest{Provider: provider})
+		if err != nil {
+			log.Printf("Failed to connect: %v", err)
+			span.RecordError(err)
+			h.errorHandler.Write(rw, errors.New("failed to initiate the download of the archive from storage backend service"), http.StatusInternalServerError)
+			return
+		}
+
+		written := false
+		for {
+			chunk, err := downloadStream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				log.Printf("Failed to download archive: %v", err)
+				span.RecordError(err)
+				if !written {
+					h.errorHandler.Write(rw, errors.New("failed to download the archive from storage backend service"), http.StatusInternalServerError)
+				}
+				return
+			}
+			if !written {
+				rw.Header().Set("Content-Type", "application/zip")
+				written = true
+			}
+			_, _ = rw.Write(chunk.GetZipDataChunk())
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package mirror
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	moduleMocks "github.com/terrariumcloud/terrarium/internal/module/services/mocks"
+	"github.com/terrariumcloud/terrarium/internal/provider/services"
+	"github.com/terrariumcloud/terrarium/internal/provider/services/mocks"
+)
+
+func serve(h *providersMirrorHttpService, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.GetHttpHandler("/providers").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+var providerVersions = &services.ProviderVersionsResponse{
+	Versions: []*services.VersionItem{
+		{Version: "1.0.0", Platforms: []*services.Platform{{Os: "linux", Arch: "amd64"}}},
+		{Version: "1.1.0", Platforms: []*services.Platform{{Os: "linux", Arch: "amd64"}, {Os: "darwin", Arch: "arm64"}}},
+	},
+}
+
+// Test_listVersionsHandler checks:
+// - if the versions of a provider are listed for any hostname
+// - if NotFound is returned for a provider without versions
+// - if error is returned when ListProviderVersions fails
+func Test_listVersionsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the provider has versions", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: providerVersions}
+		h := New(versionManager, &mocks.MockProviderStorageClient{})
+
+		rec := serve(h, "/providers/mirror/registry.terraform.io/cie/test/index.json")
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected %v, got %v: %v", http.StatusOK, rec.Code, rec.Body.String())
+		}
+
+		var res IndexResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		want := map[string]struct{}{"1.0.0": {}, "1.1.0": {}}
+		if !reflect.DeepEqual(res.Versions, want) {
+			t.Errorf("Expected %v, got %v", want, rec.Body.String())
+		}
+	})
+
+	t.Run("when the provider has no versions", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: &services.ProviderVersionsResponse{}}
+		h := New(versionManager, &mocks.MockProviderStorageClient{})
+
+		rec := serve(h, "/providers/mirror/registry.terraform.io/cie/test/index.json")
+
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected %v, got %v", http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("when ListProviderVersions fails", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsError: errors.New("some error")}
+		h := New(versionManager, &mocks.MockProviderStorageClient{})
+
+		rec := serve(h, "/providers/mirror/registry.terraform.io/cie/test/index.json")
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("Expected %v, got %v", http.StatusInternalServerError, rec.Code)
+		}
+	})
+}
+
+// Test_listArchivesHandler checks:
+// - if the archives of each platform are listed with relative URLs and their h1: and zh: hashes
+// - if the h1: hash is left out when GetArchiveHash fails
+// - if NotFound is returned for a version that is not published
+// - if error is returned when GetVersionData fails
+func Test_listArchivesHandler(t *testing.T) {
+	t.Parallel()
+
+	metadata := &services.PlatformMetadataResponse{Shasum: "5f9c"}
+
+	t.Run("when the version is published", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: providerVersions, GetVersionDataResponse: metadata}
+		storage := &mocks.MockProviderStorageClient{GetArchiveHashResponse: &services.ArchiveHashResponse{Hash: "h1:abc="}}
+		h := New(versionManager, storage)
+
+		rec := serve(h, "/providers/mirror/registry.terraform.io/cie/test/1.1.0.json")
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected %v, got %v: %v", http.StatusOK, rec.Code, rec.Body.String())
+		}
+
+		var res VersionResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		want := map[string]*Archive{
+			"linux_amd64":  {URL: "1.1.0/linux/amd64/terraform-provider-test_1.1.0_linux_amd64.zip", Hashes: []string{"h1:abc=", "zh:5f9c"}},
+			"darwin_arm64": {URL: "1.1.0/darwin/arm64/terraform-provider-test_1.1.0_darwin_arm64.zip", Hashes: []string{"h1:abc=", "zh:5f9c"}},
+		}
+		if !reflect.DeepEqual(res.Archives, want) {
+			t.Errorf("Expected %v, got %v", want, rec.Body.String())
+		}
+
+		if storage.GetArchiveHashInvocations != 2 || storage.GetArchiveHashRequests[1].GetProvider().GetOs() != "darwin" {
+			t.Errorf("Expected the hash of each platform, got %v", storage.GetArchiveHashRequests)
+		}
+	})
+
+	t.Run("when GetArchiveHash fails", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: providerVersions, GetVersionDataResponse: metadata}
+		storage := &mocks.MockProviderStorageClient{GetArchiveHashError: errors.New("some error")}
+		h := New(versionManager, storage)
+
+		rec := serve(h, "/providers/mirror/registry.terraform.io/cie/test/1.0.0.json")
+
+		var res VersionResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		if rec.Code != http.StatusOK || !reflect.DeepEqual(res.Archives["linux_amd64"].Hashes, []string{"zh:5f9c"}) {
+			t.Errorf("Expected the zh: hash only, got %v: %v", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("when the version is not published", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: providerVersions}
+		h := New(versionManager, &mocks.MockProviderStorageClient{})
+
+		rec := serve(h, "/providers/mirror/registry.terraform.io/cie/test/2.0.0.json")
+
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected %v, got %v", http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("when GetVersionData fails", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: providerVersions, GetVersionDataError: errors.New("some error")}
+		h := New(versionManager, &mocks.MockProviderStorageClient{})
+
+		rec := serve(h, "/providers/mirror/registry.terraform.io/cie/test/1.0.0.json")
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("Expected %v, got %v", http.StatusInternalServerError, rec.Code)
+		}
+	})
+}
+
+// Test_archiveHandler checks:
+// - if the archive is downloaded from storage
+// - if error is returned when the download fails
+func Test_archiveHandler(t *testing.T) {
+	t.Parallel()
+
+	target := "/providers/mirror/registry.terraform.io/cie/test/1.0.0/linux/amd64/terraform-provider-test_1.0.0_linux_amd64.zip"
+
+	t.Run("when the archive is downloaded", func(t *testing.T) {
+		download := &mocks.MockStorage_DownloadProviderSourceZipClient{RecvResponse: &services.SourceZipResponse{ZipDataChunk: []byte("PK")}, RecvError: io.EOF}
+		storage := &mocks.MockProviderStorageClient{DownloadSourceZipClient: download}
+		h := New(&moduleMocks.MockProviderVersionManagerClient{}, storage)
+
+		rec := serve(h, target)
+
+		if rec.Code != http.StatusOK || storage.DownloadSourceZipInvocations != 1 {
+			t.Errorf("Expected the archive to be downloaded, got %v", rec.Code)
+		}
+	})
+
+	t.Run("when the download fails", func(t *testing.T) {
+		download := &mocks.MockStorage_DownloadProviderSourceZipClient{RecvError: errors.New("some error")}
+		storage := &mocks.MockProviderStorageClient{DownloadSourceZipClient: download}
+		h := New(&moduleMocks.MockProviderVersionManagerClient{}, storage)
+
+		rec := serve(h, target)
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("Expected %v, got %v", http.StatusInternalServerError, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package mirror
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/terrariumcloud/terrarium/internal/provider/services"
+)
+
+// IndexResponse lists the versions of a provider available from the mirror.
+type IndexResponse struct {
+	Versions map[string]struct{} `json:"versions"`
+}
+
+// VersionResponse lists the archives of a provider version, keyed by os_arch.
+type VersionResponse struct {
+	Archives map[string]*Archive `json:"archives"`
+}
+
+// Archive is the location of the archive of a provider version for a platform, relative to the version document,
+// with the hashes Terraform checks the archive against and records in its dependency lock file.
+type Archive struct {
+	URL    string   `json:"url"`
+	Hashes []string `json:"hashes,omitempty"`
+}
+
+// GetProviderNameFromRequest returns the name the provider is hosted under, whatever the hostname it is mirrored for.
+func GetProviderNameFromRequest(r *http.Request) string {
+	params := mux.Vars(r)
+	return fmt.Sprintf("%s/%s", params["organization_name"], params["name"])
+}
+
+func GetProviderLocationFromRequest(r *http.Request) *services.ProviderRequest {
+	params := mux.Vars(r)
+	return &services.ProviderRequest{
+		Name:    GetProviderNameFromRequest(r),
+		Version: params["version"],
+		Os:      params["os"],
+		Arch:    params["arch"],
+	}
+}
+
+func createIndexResponse(versions []*services.VersionItem) *IndexResponse {
+	index := &IndexResponse{Versions: map[string]struct{}{}}
+	for _, version := range versions {
+		index.Versions[version.GetVersion()] = struct{}{}
+	}
+	return index
+}
+
+func findVersion(versions []*services.VersionItem, version string) *services.VersionItem {
+	for _, item := range versions {
+		if item.GetVersion() == version {
+			return item
+		}
+	}
+	return nil
+}
+
+// archiveURL returns the location of the archive of a provider version for a platform, relative to the version
+// document served at {version}.json.
+func archiveURL(provider *services.ProviderRequest) string {
+	name := provider.GetName()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return fmt.Sprintf("%s/%s/%s/terraform-provider-%s_%s_%s_%s.zip", provider.GetVersion(), provider.GetOs(), provider.GetArch(),
+		name, provider.GetVersion(), provider.GetOs(), provider.GetArch())
+}
+
+// archiveHashes returns the h1: hash of the content of an archive, when known, and the zh: hash of the archive itself.
+func archiveHashes(h1, shasum string) []string {
+	var hashes []string
+	if h1 != "" {
+		hashes = append(hashes, h1)
+	}
+	if shasum != "" {
+		hashes = append(hashes, "zh:"+shasum)
+	}
+	return hashes
+}
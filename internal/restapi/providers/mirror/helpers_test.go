@@ -0,0 +1,37 @@
+package mirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terrariumcloud/terrarium/internal/provider/services"
+)
+
+func Test_archiveURL(t *testing.T) {
+	provider := &services.ProviderRequest{Name: "cie/test", Version: "1.0.0", Os: "linux", Arch: "amd64"}
+
+	want := "1.0.0/linux/amd64/terraform-provider-test_1.0.0_linux_amd64.zip"
+	if got := archiveURL(provider); got != want {
+		t.Errorf("archiveURL() = %v, want %v", got, want)
+	}
+}
+
+func Test_archiveHashes(t *testing.T) {
+	tests := []struct {
+		name   string
+		h1     string
+		shasum string
+		want   []string
+	}{
+		{"both hashes", "h1:abc=", "5f9c", []string{"h1:abc=", "zh:5f9c"}},
+		{"no h1 hash", "", "5f9c", []string{"zh:5f9c"}},
+		{"no hashes", "", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := archiveHashes(tt.h1, tt.shasum); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("archiveHashes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
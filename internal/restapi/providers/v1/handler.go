@@ -8,29 +8,37 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path"
 
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 
+	"github.com/terrariumcloud/terrarium/internal/common/upstream"
 	"github.com/terrariumcloud/terrarium/internal/provider/services"
 	"github.com/terrariumcloud/terrarium/internal/restapi"
 
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type providersV1HttpService struct {
 	versionManagerClient services.VersionManagerClient
 	storageClient        services.StorageClient
+	upstream             *upstream.Registry
 	responseHandler      restapi.ResponseHandler
 	errorHandler         restapi.ErrorHandler
 }
 
-func New(versionManagerClient services.VersionManagerClient, storageClient services.StorageClient) *providersV1HttpService {
+// New returns the handler of the provider registry protocol. Providers of the organizations proxied by the upstream
+// registry, when one is given, are fetched from it and cached the first time a platform of theirs is requested.
+func New(versionManagerClient services.VersionManagerClient, storageClient services.StorageClient, upstream *upstream.Registry) *providersV1HttpService {
 	return &providersV1HttpService{
 		versionManagerClient: versionManagerClient,
 		storageClient:        storageClient,
+		upstream:             upstream,
 	}
 }
 
@@ -39,6 +47,12 @@ func (h *providersV1HttpService) GetHttpHandler(mountPath string) http.Handler {
 	return handlers.CombinedLoggingHandler(os.Stdout, router)
 }
 
+// BasePath returns the path the registry protocol is served at for a mount path, as advertised to Terraform
+// through service discovery.
+func BasePath(mountPath string) string {
+	return path.Join("/", mountPath, "v1") + "/"
+}
+
 func (h *providersV1HttpService) createRouter(mountPath string) *mux.Router {
 	prefix := fmt.Sprintf("%s/v1", mountPath)
 	log.Printf("prefix for registry implementation: %s", prefix)
@@ -63,6 +77,9 @@ func (h *providersV1HttpService) healthHandler() http.Handler {
 
 // GetProviderVersionHandler will return a list of available versions for a given provider.
 // This signifies to the requesting CLI if that provider is available to consume from the registry.
+// Deprecated versions are reported through the warnings of the response, which terraform prints to the user.
+// The versions offered by the upstream registry for a provider of a proxied organization are listed along with the
+// hosted ones, so that the versions not cached yet can be selected.
 // Will return a 404 if a non-existent organization and/or provider is requested.
 // This handler complies with the following implementation from the provider protocol
 // https://developer.hashicorp.com/terraform/internals/provider-registry-protocol#list-available-versions
@@ -84,6 +101,8 @@ func (h *providersV1HttpService) getProviderVersionHandler() http.Handler {
 			return
 		}
 
+		providerVersions.Versions = mergeVersions(providerVersions.GetVersions(), h.upstreamVersions(ctx, providerName))
+
 		data, _ := json.Marshal(providerVersions)
 		rw.Header().Add("Content-Type", "application/json")
 		_, _ = rw.Write(data)
@@ -95,6 +114,8 @@ func (h *providersV1HttpService) getProviderVersionHandler() http.Handler {
 // Terraform CLI uses this operation after it has selected the newest available version matching the configured
 // version constraints, in order to find the zip archive containing the plugin itself.
 // Will return a 404 if a non-existent version or os or arch is requested.
+// A platform that is not hosted is fetched from the upstream registry when the organization of the provider is
+// proxied, and cached.
 // This handler complies with the following implementation from the provider protocol
 // https://developer.hashicorp.com/terraform/internals/provider-registry-protocol#find-a-provider-package
 func (h *providersV1HttpService) downloadProviderHandler() http.Handler {
@@ -119,6 +140,17 @@ func (h *providersV1HttpService) downloadProviderHandler() http.Handler {
 			Os:      providerOS,
 			Arch:    providerArch,
 		})
+		if err != nil && h.upstream.Allowed(mux.Vars(r)["organization_name"]) {
+			var cached *cachedPackage
+			if cached, err = h.cacheProvider(ctx, GetProviderLocationFromRequest(r)); err == nil {
+				providerMetadata = cached.metadata
+			} else if !errors.Is(err, upstream.ErrNotFound) {
+				log.Printf("Failed to cache provider from the upstream registry: %v", err)
+				span.RecordError(err)
+				h.errorHandler.Write(rw, errors.New("failed to fetch the provider from the upstream registry"), http.StatusBadGateway)
+				return
+			}
+		}
 		if err != nil {
 			h.errorHandler.Write(rw, err, http.StatusNotFound)
 			return
@@ -153,14 +185,16 @@ func (h *providersV1HttpService) archiveHandler() http.Handler {
 			h.errorHandler.Write(rw, errors.New("failed to initiate the download of the archive from storage backend service"), http.StatusInternalServerError)
 			return
 		}
-		r.Header.Set("Content-Type", "application/zip")
-		for {
+		h.writeStream(rw, r, "application/zip", func() ([]byte, error) {
 			chunk, err := downloadStream.Recv()
-			if err == io.EOF {
-				return
+			return chunk.GetZipDataChunk(), err
+		}, func() ([]byte, error) {
+			cached, err := h.cacheProvider(ctx, GetProviderLocationFromRequest(r))
+			if err != nil {
+				return nil, err
 			}
-			_, _ = rw.Write(chunk.ZipDataChunk)
-		}
+			return cached.archive, nil
+		})
 	})
 }
 
@@ -187,14 +221,16 @@ func (h *providersV1HttpService) shasumHandler() http.Handler {
 			return
 		}
 
-		r.Header.Set("Content-Type", "text/plain")
-		for {
+		h.writeStream(rw, r, "text/plain", func() ([]byte, error) {
 			chunk, err := downloadStream.Recv()
-			if err == io.EOF {
-				return
+			return chunk.GetShasumDataChunk(), err
+		}, func() ([]byte, error) {
+			cached, err := h.cacheFirstPlatform(ctx, providerName, providerVersion)
+			if err != nil {
+				return nil, err
 			}
-			_, _ = rw.Write(chunk.ShasumDataChunk)
-		}
+			return cached.shasums, nil
+		})
 	})
 }
 
@@ -221,13 +257,71 @@ func (h *providersV1HttpService) shasumSignatureHandler() http.Handler {
 			return
 		}
 
-		r.Header.Set("Content-Type", "text/plain")
-		for {
+		h.writeStream(rw, r, "text/plain", func() ([]byte, error) {
 			chunk, err := downloadStream.Recv()
-			if err == io.EOF {
+			return chunk.GetShasumDataChunk(), err
+		}, func() ([]byte, error) {
+			cached, err := h.cacheFirstPlatform(ctx, providerName, providerVersion)
+			if err != nil {
+				return nil, err
+			}
+			if cached.signature == nil {
+				return nil, upstream.ErrNotFound
+			}
+			return cached.signature, nil
+		})
+	})
+}
+
+// writeStream presents the chunks received from a download stream of the storage backend service to the client.
+// A file missing from storage is fetched from the upstream registry when the organization of the provider is
+// proxied, and cached.
+func (h *providersV1HttpService) writeStream(rw http.ResponseWriter, r *http.Request, contentType string, recv func() ([]byte, error), fetch func() ([]byte, error)) {
+	span := trace.SpanFromContext(r.Context())
+
+	written := false
+	for {
+		chunk, err := recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil && !written && status.Code(err) == codes.NotFound {
+			if !h.upstream.Allowed(mux.Vars(r)["organization_name"]) {
+				h.errorHandler.Write(rw, errors.New("file not found"), http.StatusNotFound)
 				return
 			}
-			_, _ = rw.Write(chunk.ShasumDataChunk)
+			h.writeUpstreamFile(rw, r, contentType, fetch)
+			return
 		}
-	})
+		if err != nil {
+			log.Printf("Failed to download: %v", err)
+			span.RecordError(err)
+			if !written {
+				h.errorHandler.Write(rw, errors.New("failed to download the file from storage backend service"), http.StatusInternalServerError)
+			}
+			return
+		}
+		if !written {
+			rw.Header().Set("Content-Type", contentType)
+			written = true
+		}
+		_, _ = rw.Write(chunk)
+	}
+}
+
+func (h *providersV1HttpService) writeUpstreamFile(rw http.ResponseWriter, r *http.Request, contentType string, fetch func() ([]byte, error)) {
+	data, err := fetch()
+	if errors.Is(err, upstream.ErrNotFound) {
+		h.errorHandler.Write(rw, errors.New("file not found in the upstream registry"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to cache provider from the upstream registry: %v", err)
+		trace.SpanFromContext(r.Context()).RecordError(err)
+		h.errorHandler.Write(rw, errors.New("failed to fetch the file from the upstream registry"), http.StatusBadGateway)
+		return
+	}
+
+	rw.Header().Set("Content-Type", contentType)
+	_, _ = rw.Write(data)
 }
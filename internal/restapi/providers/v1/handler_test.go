@@ -0,0 +1,271 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/terrariumcloud/terrarium/internal/common/upstream"
+	moduleMocks "github.com/terrariumcloud/terrarium/internal/module/services/mocks"
+	"github.com/terrariumcloud/terrarium/internal/provider/services"
+	"github.com/terrariumcloud/terrarium/internal/provider/services/mocks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const upstreamArchive = "PK provider"
+
+func serve(h *providersV1HttpService, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.GetHttpHandler("/providers").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+// standIn returns an upstream registry proxying the cie organization, served by a stand-in offering the version
+// 2.0.0 of cie/test for linux_amd64. The shasum of the archive is the given one.
+func standIn(t *testing.T, shasum string) *upstream.Registry {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc(upstream.DiscoveryPath, func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(`{"providers.v1": "/providers/v1/"}`))
+	})
+	mux.HandleFunc("/providers/v1/cie/test/versions", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(`{"versions": [{"version": "2.0.0", "protocols": ["5.0"], "platforms": [{"os": "linux", "arch": "amd64"}]}]}`))
+	})
+	mux.HandleFunc("/providers/v1/cie/test/2.0.0/download/linux/amd64", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(`{"protocols": ["5.0"], "os": "linux", "arch": "amd64", "filename": "terraform-provider-test_2.0.0_linux_amd64.zip",
+			"download_url": "/files/terraform-provider-test_2.0.0_linux_amd64.zip",
+			"shasums_url": "/files/terraform-provider-test_2.0.0_SHA256SUMS",
+			"shasums_signature_url": "/files/terraform-provider-test_2.0.0_SHA256SUMS.sig",
+			"shasum": "` + shasum + `", "signing_keys": {"gpg_public_keys": [{"key_id": "ABC", "ascii_armor": "KEY"}]}}`))
+	})
+	mux.HandleFunc("/files/terraform-provider-test_2.0.0_linux_amd64.zip", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(upstreamArchive))
+	})
+	mux.HandleFunc("/files/terraform-provider-test_2.0.0_SHA256SUMS", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte(shasum + "  terraform-provider-test_2.0.0_linux_amd64.zip\n"))
+	})
+	mux.HandleFunc("/files/terraform-provider-test_2.0.0_SHA256SUMS.sig", func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte("signature"))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	registry, err := upstream.New(server.URL, []string{"cie"})
+	if err != nil {
+		t.Fatalf("upstream.New() error = %v", err)
+	}
+	return registry
+}
+
+func archiveShasum() string {
+	sum := sha256.Sum256([]byte(upstreamArchive))
+	return hex.EncodeToString(sum[:])
+}
+
+// cachingStorage returns a storage missing every file, and accepting the uploads of cached ones.
+func cachingStorage() *mocks.MockProviderStorageClient {
+	missing := status.Error(codes.NotFound, "not found")
+	return &mocks.MockProviderStorageClient{
+		DownloadSourceZipClient:       &mocks.MockStorage_DownloadProviderSourceZipClient{RecvError: missing},
+		DownloadShasumClient:          &mocks.MockStorage_DownloadProviderShasumClient{RecvError: missing},
+		UploadProviderBinaryZipClient: &mocks.MockStorage_UploadProviderBinaryZipClient{},
+		UploadShasumClient:            &mocks.MockStorage_UploadShasumClient{},
+		UploadShasumSignatureClient:   &mocks.MockStorage_UploadShasumSignatureClient{},
+	}
+}
+
+// Test_getProviderVersionHandler checks:
+// - if the hosted versions are merged with the versions of the upstream registry for a proxied organization
+// - if the versions of the upstream registry are listed for a proxied organization when the provider is not hosted
+// - if only the hosted versions are listed for an organization that is not proxied
+func Test_getProviderVersionHandler(t *testing.T) {
+	t.Parallel()
+
+	hosted := func() *services.ProviderVersionsResponse {
+		return &services.ProviderVersionsResponse{Versions: []*services.VersionItem{{Version: "1.0.0"}}}
+	}
+
+	t.Run("when the provider of a proxied organization is hosted", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: hosted()}
+		h := New(versionManager, &mocks.MockProviderStorageClient{}, standIn(t, archiveShasum()))
+
+		rec := serve(h, "/providers/v1/cie/test/versions")
+
+		var res services.ProviderVersionsResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		if rec.Code != http.StatusOK || len(res.Versions) != 2 || res.Versions[0].Version != "1.0.0" || len(res.Versions[0].Platforms) != 0 || res.Versions[1].Version != "2.0.0" {
+			t.Errorf("Expected hosted 1.0.0 and upstream 2.0.0, got %v: %v", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("when the provider of a proxied organization is not hosted", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: &services.ProviderVersionsResponse{}}
+		h := New(versionManager, &mocks.MockProviderStorageClient{}, standIn(t, archiveShasum()))
+
+		rec := serve(h, "/providers/v1/cie/test/versions")
+
+		var res services.ProviderVersionsResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		if rec.Code != http.StatusOK || len(res.Versions) != 1 || res.Versions[0].Version != "2.0.0" || res.Versions[0].Platforms[0].Os != "linux" {
+			t.Errorf("Expected 2.0.0, got %v: %v", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("when the organization is not proxied", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: hosted()}
+		h := New(versionManager, &mocks.MockProviderStorageClient{}, standIn(t, archiveShasum()))
+
+		rec := serve(h, "/providers/v1/other/test/versions")
+
+		var res services.ProviderVersionsResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		if rec.Code != http.StatusOK || len(res.Versions) != 1 {
+			t.Errorf("Expected 1.0.0 only, got %v: %v", rec.Code, rec.Body.String())
+		}
+	})
+}
+
+// Test_downloadProviderHandler checks:
+// - if the metadata of a hosted platform is returned
+// - if a platform that is not hosted is fetched from the upstream registry, stored, registered and published
+// - if error is returned when the archive of the upstream registry does not match its shasum
+// - if NotFound is returned for a platform that is not hosted when the organization is not proxied
+func Test_downloadProviderHandler(t *testing.T) {
+	t.Parallel()
+
+	target := "/providers/v1/cie/test/2.0.0/download/linux/amd64"
+
+	t.Run("when the platform is hosted", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{GetVersionDataResponse: &services.PlatformMetadataResponse{Os: "linux", Shasum: "5f9c"}}
+		h := New(versionManager, &mocks.MockProviderStorageClient{}, standIn(t, archiveShasum()))
+
+		rec := serve(h, target)
+
+		if rec.Code != http.StatusOK || versionManager.AddPlatformsInvocations != 0 {
+			t.Errorf("Expected the hosted platform, got %v: %v", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("when the platform is cached from the upstream registry", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{
+			GetVersionDataError:          errors.New("requested os 'linux' and arch 'amd64' doesn't exist"),
+			ListProviderVersionsResponse: &services.ProviderVersionsResponse{},
+		}
+		storage := cachingStorage()
+		h := New(versionManager, storage, standIn(t, archiveShasum()))
+
+		rec := serve(h, target)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected %v, got %v: %v", http.StatusOK, rec.Code, rec.Body.String())
+		}
+
+		var res services.PlatformMetadataResponse
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		if res.DownloadUrl != "../../linux/amd64/terraform-provider-test_2.0.0_linux_amd64.zip" || res.ShasumsSignatureUrl != "../../terraform-provider-test_2.0.0_SHA256SUMS.sig" || res.Shasum != archiveShasum() {
+			t.Errorf("Expected the files to be served by the registry, got %v", rec.Body.String())
+		}
+
+		if storage.UploadProviderBinaryZipInvocations != 1 || storage.UploadShasumInvocations != 1 || storage.UploadShasumSignatureInvocations != 1 {
+			t.Errorf("Expected the archive, shasums and signature to be stored")
+		}
+
+		registered := versionManager.AddPlatformsRequest
+		if registered.GetName() != "cie/test" || len(registered.GetPlatforms()) != 1 || registered.GetPlatforms()[0].GetSigningKeys().GetGpgPublicKeys()[0].GetKeyId() != "ABC" {
+			t.Errorf("Expected linux_amd64 of cie/test 2.0.0 to be registered, got %v", registered)
+		}
+
+		if versionManager.PublishVersionInvocations != 1 {
+			t.Errorf("Expected 1 call to PublishVersion, got %v", versionManager.PublishVersionInvocations)
+		}
+	})
+
+	t.Run("when the archive does not match its shasum", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{GetVersionDataError: errors.New("some error")}
+		storage := cachingStorage()
+		h := New(versionManager, storage, standIn(t, "5f9c"))
+
+		rec := serve(h, target)
+
+		if rec.Code != http.StatusBadGateway || storage.UploadProviderBinaryZipInvocations != 0 {
+			t.Errorf("Expected %v without storing the archive, got %v", http.StatusBadGateway, rec.Code)
+		}
+	})
+
+	t.Run("when the organization is not proxied", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{GetVersionDataError: errors.New("some error")}
+		h := New(versionManager, cachingStorage(), standIn(t, archiveShasum()))
+
+		rec := serve(h, "/providers/v1/other/test/2.0.0/download/linux/amd64")
+
+		if rec.Code != http.StatusNotFound || versionManager.AddPlatformsInvocations != 0 {
+			t.Errorf("Expected %v, got %v", http.StatusNotFound, rec.Code)
+		}
+	})
+}
+
+// Test_archiveHandler checks:
+// - if an archive missing from storage is fetched from the upstream registry
+// - if NotFound is returned for an archive missing from storage when the organization is not proxied
+// - if error is returned when the download fails
+func Test_archiveHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the archive is cached from the upstream registry", func(t *testing.T) {
+		versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: &services.ProviderVersionsResponse{}}
+		storage := cachingStorage()
+		h := New(versionManager, storage, standIn(t, archiveShasum()))
+
+		rec := serve(h, "/providers/v1/cie/test/2.0.0/linux/amd64/terraform-provider-test_2.0.0_linux_amd64.zip")
+
+		if rec.Code != http.StatusOK || rec.Body.String() != upstreamArchive || storage.UploadProviderBinaryZipInvocations != 1 {
+			t.Errorf("Expected the archive of the upstream registry, got %v: %v", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("when the organization is not proxied", func(t *testing.T) {
+		h := New(&moduleMocks.MockProviderVersionManagerClient{}, cachingStorage(), standIn(t, archiveShasum()))
+
+		rec := serve(h, "/providers/v1/other/test/2.0.0/linux/amd64/terraform-provider-test_2.0.0_linux_amd64.zip")
+
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected %v, got %v", http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("when the download fails", func(t *testing.T) {
+		download := &mocks.MockStorage_DownloadProviderSourceZipClient{RecvError: errors.New("some error")}
+		h := New(&moduleMocks.MockProviderVersionManagerClient{}, &mocks.MockProviderStorageClient{DownloadSourceZipClient: download}, nil)
+
+		rec := serve(h, "/providers/v1/cie/test/2.0.0/linux/amd64/terraform-provider-test_2.0.0_linux_amd64.zip")
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("Expected %v, got %v", http.StatusInternalServerError, rec.Code)
+		}
+	})
+}
+
+// Test_shasumHandler checks:
+// - if shasums missing from storage are fetched from the upstream registry with the first platform of the version
+func Test_shasumHandler(t *testing.T) {
+	t.Parallel()
+
+	versionManager := &moduleMocks.MockProviderVersionManagerClient{ListProviderVersionsResponse: &services.ProviderVersionsResponse{}}
+	storage := cachingStorage()
+	h := New(versionManager, storage, standIn(t, archiveShasum()))
+
+	rec := serve(h, "/providers/v1/cie/test/2.0.0/terraform-provider-test_2.0.0_SHA256SUMS")
+
+	if rec.Code != http.StatusOK || rec.Body.String() != archiveShasum()+"  terraform-provider-test_2.0.0_linux_amd64.zip\n" {
+		t.Errorf("Expected the shasums of the upstream registry, got %v: %v", rec.Code, rec.Body.String())
+	}
+
+	if storage.UploadShasumInvocations != 1 || versionManager.AddPlatformsRequest.GetPlatforms()[0].GetOs() != "linux" {
+		t.Errorf("Expected linux_amd64 to be cached with the shasums")
+	}
+}
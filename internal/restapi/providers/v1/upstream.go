@@ -0,0 +1,288 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/terrariumcloud/terrarium/internal/common/upstream"
+	"github.com/terrariumcloud/terrarium/internal/provider/services"
+	pb "github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// uploadChunkSize is the size of the chunks the files of a provider fetched from the upstream registry are streamed
+// to storage in.
+const uploadChunkSize = 64 * 1024
+
+var ErrShasumMismatch = errors.New("archive of the upstream registry does not match its shasum")
+
+// cachedPackage is a provider package fetched from the upstream registry, with the metadata it is now served with.
+type cachedPackage struct {
+	metadata  *services.PlatformMetadataResponse
+	archive   []byte
+	shasums   []byte
+	signature []byte
+}
+
+// splitProviderName returns the organization and the type of a provider name.
+func splitProviderName(name string) (string, string) {
+	organization, providerType, _ := strings.Cut(name, "/")
+	return organization, providerType
+}
+
+// createVersionItems returns the versions of the upstream registry as listed by the registry.
+func createVersionItems(offered []*upstream.ProviderVersion) []*services.VersionItem {
+	var items []*services.VersionItem
+	for _, version := range offered {
+		item := &services.VersionItem{Version: version.Version, Protocols: version.Protocols}
+		for _, platform := range version.Platforms {
+			item.Platforms = append(item.Platforms, &services.Platform{Os: platform.Os, Arch: platform.Arch})
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// upstreamVersions returns the versions of a provider offered by the upstream registry, or none when the organization of the provider is not proxied or the upstream registry cannot be reached.
+func (h *providersV1HttpService) upstreamVersions(ctx context.Context, name string) []*services.VersionItem {
+	organization, providerType := splitProviderName(name)
+	if !h.upstream.Allowed(organization) {
+		return nil
+	}
+	offered, err := h.upstream.ProviderVersions(ctx, organization, providerType)
+	if err != nil {
+		log.Printf("Failed to list the versions of %s from the upstream registry: %v", name, err)
+		trace.SpanFromContext(ctx).RecordError(err)
+		return nil
+	}
+	return createVersionItems(offered)
+}
+
+// mergeVersions returns the hosted versions of a provider followed by the versions offered upstream that are not
+// hosted. A version partly cached is listed with its hosted platforms.
+func mergeVersions(hosted, offered []*services.VersionItem) []*services.VersionItem {
+	listed := map[string]bool{}
+	for _, item := range hosted {
+		listed[item.GetVersion()] = true
+	}
+	merged := hosted
+	for _, item := range offered {
+		if !listed[item.GetVersion()] {
+			listed[item.GetVersion()] = true
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
+
+// cacheFirstPlatform caches the package of the first platform the upstream registry offers a provider version for,
+// which brings along the shasums of the version and their signature.
+func (h *providersV1HttpService) cacheFirstPlatform(ctx context.Context, name, version string) (*cachedPackage, error) {
+	organization, providerType := splitProviderName(name)
+	offered, err := h.upstream.ProviderVersions(ctx, organization, providerType)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range offered {
+		if item.Version == version && len(item.Platforms) > 0 {
+			platform := item.Platforms[0]
+			return h.cacheProvider(ctx, &services.ProviderRequest{Name: name, Version: version, Os: platform.Os, Arch: platform.Arch})
+		}
+	}
+	return nil, fmt.Errorf("%w: version %s of %s", upstream.ErrNotFound, version, name)
+}
+
+// cacheProvider fetches the package of a provider version for a platform from the upstream registry, stores its
+// archive, shasums and signature, and adds the platform to the platforms of the version already cached.
+// The version is published the first time one of its platforms is cached.
+func (h *providersV1HttpService) cacheProvider(ctx context.Context, location *services.ProviderRequest) (*cachedPackage, error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("Caching provider from the upstream registry", trace.WithAttributes(
+		attribute.String("provider.name", location.GetName()),
+		attribute.String("provider.version", location.GetVersion()),
+		attribute.String("provider.os", location.GetOs()),
+		attribute.String("provider.arch", location.GetArch()),
+		attribute.String("upstream.host", h.upstream.Host()),
+	))
+
+	organization, providerType := splitProviderName(location.GetName())
+	pkg, err := h.upstream.ProviderPackage(ctx, organization, providerType, location.GetVersion(), location.GetOs(), location.GetArch())
+	if err != nil {
+		return nil, err
+	}
+
+	cached := &cachedPackage{}
+	if cached.archive, err = h.upstream.Download(ctx, pkg.DownloadURL); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(cached.archive)
+	if hex.EncodeToString(sum[:]) != pkg.Shasum {
+		return nil, ErrShasumMismatch
+	}
+	if cached.shasums, err = h.upstream.Download(ctx, pkg.ShasumsURL); err != nil {
+		return nil, err
+	}
+	if pkg.ShasumsSignatureURL != "" {
+		if cached.signature, err = h.upstream.Download(ctx, pkg.ShasumsSignatureURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.storeProvider(ctx, location, cached); err != nil {
+		return nil, err
+	}
+
+	cached.metadata = createPlatformMetadata(location, pkg)
+	published, err := h.versionPublished(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.versionManagerClient.AddPlatforms(ctx, &pb.RegisterProviderRequest{
+		Name:          location.GetName(),
+		Version:       location.GetVersion(),
+		Protocols:     pkg.Protocols,
+		Platforms:     []*pb.PlatformItem{createPlatformItem(cached.metadata)},
+		Description:   fmt.Sprintf("Cached from %s", h.upstream.Host()),
+		SourceRepoUrl: fmt.Sprintf("%s/%s", h.upstream.Host(), location.GetName()),
+	}); err != nil {
+		return nil, err
+	}
+
+	if !published {
+		provider := &pb.Provider{Name: location.GetName(), Version: location.GetVersion()}
+		if _, err := h.versionManagerClient.PublishVersion(ctx, &services.TerminateVersionRequest{Provider: provider}); err != nil {
+			return nil, err
+		}
+	}
+	return cached, nil
+}
+
+// versionPublished returns whether a provider version is already published.
+func (h *providersV1HttpService) versionPublished(ctx context.Context, location *services.ProviderRequest) (bool, error) {
+	versions, err := h.versionManagerClient.ListProviderVersions(ctx, &services.ProviderName{Provider: location.GetName()})
+	if err != nil {
+		return false, err
+	}
+	for _, version := range versions.GetVersions() {
+		if version.GetVersion() == location.GetVersion() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// storeProvider uploads the archive of a provider package, the shasums of its version and their signature.
+func (h *providersV1HttpService) storeProvider(ctx context.Context, location *services.ProviderRequest, cached *cachedPackage) error {
+	provider := &pb.Provider{Name: location.GetName(), Version: location.GetVersion()}
+
+	archive, err := h.storageClient.UploadProviderBinaryZip(ctx)
+	if err != nil {
+		return err
+	}
+	for _, chunk := range chunks(cached.archive) {
+		if err := archive.Send(&pb.UploadProviderBinaryZipRequest{Provider: provider, Os: location.GetOs(), Arch: location.GetArch(), ZipDataChunk: chunk}); err != nil {
+			return err
+		}
+	}
+	if _, err := archive.CloseAndRecv(); err != nil {
+		return err
+	}
+
+	shasums, err := h.storageClient.UploadShasum(ctx)
+	if err != nil {
+		return err
+	}
+	for _, chunk := range chunks(cached.shasums) {
+		if err := shasums.Send(&pb.UploadShasumRequest{Provider: provider, ShasumDataChunk: chunk}); err != nil {
+			return err
+		}
+	}
+	if _, err := shasums.CloseAndRecv(); err != nil {
+		return err
+	}
+
+	if cached.signature == nil {
+		return nil
+	}
+	signature, err := h.storageClient.UploadShasumSignature(ctx)
+	if err != nil {
+		return err
+	}
+	for _, chunk := range chunks(cached.signature) {
+		if err := signature.Send(&pb.UploadShasumRequest{Provider: provider, ShasumDataChunk: chunk}); err != nil {
+			return err
+		}
+	}
+	_, err = signature.CloseAndRecv()
+	return err
+}
+
+func chunks(data []byte) [][]byte {
+	var result [][]byte
+	for i := 0; i < len(data); i += uploadChunkSize {
+		result = append(result, data[i:min(i+uploadChunkSize, len(data))])
+	}
+	return result
+}
+
+// createPlatformMetadata returns the metadata a cached provider package is served with, its files being downloaded
+// from the registry rather than from the upstream registry. Its URLs are relative to the download endpoint.
+func createPlatformMetadata(location *services.ProviderRequest, pkg *upstream.ProviderPackage) *services.PlatformMetadataResponse {
+	_, providerType := splitProviderName(location.GetName())
+	shasumsURL := fmt.Sprintf("../../terraform-provider-%s_%s_SHA256SUMS", providerType, location.GetVersion())
+
+	metadata := &services.PlatformMetadataResponse{
+		Protocols:   pkg.Protocols,
+		Os:          location.GetOs(),
+		Arch:        location.GetArch(),
+		Filename:    pkg.Filename,
+		DownloadUrl: fmt.Sprintf("../../%s/%s/terraform-provider-%s_%s_%s_%s.zip", location.GetOs(), location.GetArch(), providerType, location.GetVersion(), location.GetOs(), location.GetArch()),
+		ShasumsUrl:  shasumsURL,
+		Shasum:      pkg.Shasum,
+		SigningKeys: &services.SigningKeys{},
+	}
+	if pkg.ShasumsSignatureURL != "" {
+		metadata.ShasumsSignatureUrl = shasumsURL + ".sig"
+	}
+	for _, key := range pkg.SigningKeys.GPGPublicKeys {
+		metadata.SigningKeys.GpgPublicKeys = append(metadata.SigningKeys.GpgPublicKeys, &services.GPGPublicKey{
+			KeyId:          key.KeyID,
+			AsciiArmor:     key.ASCIIArmor,
+			TrustSignature: key.TrustSignature,
+			Source:         key.Source,
+			SourceUrl:      key.SourceURL,
+		})
+	}
+	return metadata
+}
+
+func createPlatformItem(metadata *services.PlatformMetadataResponse) *pb.PlatformItem {
+	item := &pb.PlatformItem{
+		Os:                  metadata.GetOs(),
+		Arch:                metadata.GetArch(),
+		Filename:            metadata.GetFilename(),
+		DownloadUrl:         metadata.GetDownloadUrl(),
+		ShasumsUrl:          metadata.GetShasumsUrl(),
+		ShasumsSignatureUrl: metadata.GetShasumsSignatureUrl(),
+		Shasum:              metadata.GetShasum(),
+		SigningKeys:         &pb.SigningKeys{},
+	}
+	for _, key := range metadata.GetSigningKeys().GetGpgPublicKeys() {
+		item.SigningKeys.GpgPublicKeys = append(item.SigningKeys.GpgPublicKeys, &pb.GPGPublicKey{
+			KeyId:          key.GetKeyId(),
+			AsciiArmor:     key.GetAsciiArmor(),
+			TrustSignature: key.GetTrustSignature(),
+			Source:         key.GetSource(),
+			SourceUrl:      key.GetSourceUrl(),
+		})
+	}
+	return item
+}
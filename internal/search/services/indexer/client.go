@@ -0,0 +1,49 @@
+package indexer
+
+import (
+	"context"
+	"github.com/terrariumcloud/terrarium/internal/common/grpc_service"
+	"github.com/terrariumcloud/terrarium/internal/search/services"
+	"google.golang.org/grpc"
+)
+
+type indexerGrpcClient struct {
+	endpoint string
+}
+
+func NewIndexerGrpcClient(endpoint string) services.IndexerClient {
+	return &indexerGrpcClient{endpoint: endpoint}
+}
+
+func (i indexerGrpcClient) IndexModule(ctx context.Context, in *services.IndexModuleRequest, opts ...grpc.CallOption) (*services.IndexResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(i.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewIndexerClient(conn)
+		return client.IndexModule(ctx, in, opts...)
+	}
+}
+
+func (i indexerGrpcClient) IndexProvider(ctx context.Context, in *services.IndexProviderRequest, opts ...grpc.CallOption) (*services.IndexResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(i.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewIndexerClient(conn)
+		return client.IndexProvider(ctx, in, opts...)
+	}
+}
+
+func (i indexerGrpcClient) Search(ctx context.Context, in *services.SearchRequest, opts ...grpc.CallOption) (*services.SearchResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(i.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewIndexerClient(conn)
+		return client.Search(ctx, in, opts...)
+	}
+}
@@ -0,0 +1,479 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/apparentlymart/go-versions/versions"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	moduleServices "github.com/terrariumcloud/terrarium/internal/module/services"
+	"github.com/terrariumcloud/terrarium/internal/search/services"
+	"github.com/terrariumcloud/terrarium/internal/storage"
+	terrarium "github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultDocumentsTableName = "terrarium-search-documents"
+	DefaultTermsTableName     = "terrarium-search-terms"
+	DefaultIndexerEndpoint    = "indexer:3001"
+
+	ModuleKind   = "module"
+	ProviderKind = "provider"
+)
+
+var (
+	DocumentsTableName = DefaultDocumentsTableName
+	TermsTableName     = DefaultTermsTableName
+	IndexerEndpoint    = DefaultIndexerEndpoint
+
+	Indexed = &services.IndexResponse{}
+
+	DocumentsTableInitializationError = status.Error(codes.Unknown, "Failed to initialize table for search documents.")
+	TermsTableInitializationError     = status.Error(codes.Unknown, "Failed to initialize table for search terms.")
+	InvalidModuleNameError            = status.Error(codes.InvalidArgument, "Module name must be <organization>/<name>/<provider>.")
+	InvalidProviderNameError          = status.Error(codes.InvalidArgument, "Provider name must be <organization>/<name>.")
+	GetDocumentError                  = status.Error(codes.Unknown, "Failed to get search document.")
+	MarshalDocumentError              = status.Error(codes.Unknown, "Failed to marshal search document.")
+	IndexDocumentError                = status.Error(codes.Unknown, "Failed to index search document.")
+	IndexConflictError                = status.Error(codes.Aborted, "Search document was modified concurrently, it was not indexed.")
+)
+
+// maxIndexAttempts is how many times a document is read and written when it is modified concurrently.
+const maxIndexAttempts = 5
+
+// Weights of the terms found in each field of a document. A term found in several fields gets the sum of their weights.
+var (
+	NameWeight         uint64 = 10
+	OrganizationWeight uint64 = 5
+	ProviderWeight     uint64 = 5
+	TagWeight          uint64 = 4
+	DescriptionWeight  uint64 = 2
+	ReadmeWeight       uint64 = 1
+)
+
+// stopWords are left out of the index and of queries.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "be": true, "by": true, "for": true, "from": true,
+	"in": true, "is": true, "it": true, "of": true, "on": true, "or": true, "the": true, "this": true, "to": true,
+	"with": true,
+}
+
+type IndexerService struct {
+	services.UnimplementedIndexerServer
+	Db              storage.DynamoDBTableCreator
+	DocumentsTable  string
+	DocumentsSchema *dynamodb.CreateTableInput
+	TermsTable      string
+	TermsSchema     *dynamodb.CreateTableInput
+	// Storage, when set, is used to read the README of published module versions
+	Storage moduleServices.StorageClient
+}
+
+// Document is what is known of a module or provider for searching it.
+// Terms holds the weight of every term the document is indexed with, so that stale terms can be removed, and
+// Revision counts the updates of the document, so that concurrent updates do not overwrite each other.
+type Document struct {
+	ID           string            `json:"id" bson:"id" dynamodbav:"id"`
+	Kind         string            `json:"kind" bson:"kind" dynamodbav:"kind"`
+	Name         string            `json:"name" bson:"name" dynamodbav:"name"`
+	Organization string            `json:"organization" bson:"organization" dynamodbav:"organization"`
+	Provider     string            `json:"provider" bson:"provider" dynamodbav:"provider"`
+	Description  string            `json:"description" bson:"description" dynamodbav:"description"`
+	Maturity     string            `json:"maturity" bson:"maturity" dynamodbav:"maturity"`
+	Tags         []string          `json:"tags" bson:"tags" dynamodbav:"tags"`
+	Version      string            `json:"version" bson:"version" dynamodbav:"version"`
+	Readme       string            `json:"readme" bson:"readme" dynamodbav:"readme"`
+	Terms        map[string]uint64 `json:"terms" bson:"terms" dynamodbav:"terms"`
+	IndexedOn    string            `json:"indexed_on" bson:"indexed_on" dynamodbav:"indexed_on"`
+	Revision     uint64            `json:"revision" bson:"revision" dynamodbav:"revision"`
+}
+
+// TermEntry is an entry of the inverted index, giving the weight of a term in a document.
+type TermEntry struct {
+	Term   string `json:"term" bson:"term" dynamodbav:"term"`
+	ID     string `json:"id" bson:"id" dynamodbav:"id"`
+	Weight uint64 `json:"weight" bson:"weight" dynamodbav:"weight"`
+}
+
+// RegisterWithServer registers IndexerService with grpc server
+func (s *IndexerService) RegisterWithServer(grpcServer grpc.ServiceRegistrar) error {
+	if err := storage.InitializeDynamoDb(s.DocumentsTable, s.DocumentsSchema, s.Db); err != nil {
+		log.Println(err)
+		return DocumentsTableInitializationError
+	}
+
+	if err := storage.InitializeDynamoDb(s.TermsTable, s.TermsSchema, s.Db); err != nil {
+		log.Println(err)
+		return TermsTableInitializationError
+	}
+
+	services.RegisterIndexerServer(grpcServer, s)
+
+	return nil
+}
+
+// DocumentID identifies the document of a module or provider as "<kind>:<name>".
+func DocumentID(kind, name string) string {
+	return fmt.Sprintf("%s:%s", kind, name)
+}
+
+// IndexModule updates the fields of the search document of a module that are set in the request.
+// A version only replaces the indexed one when it is newer, its README being read from its stored documentation when
+// possible.
+func (s *IndexerService) IndexModule(ctx context.Context, request *services.IndexModuleRequest) (*services.IndexResponse, error) {
+	log.Printf("Indexing module %s.\n", request.GetName())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("module.name", request.GetName()))
+
+	address := strings.Split(request.GetName(), "/")
+	if len(address) != 3 {
+		span.RecordError(InvalidModuleNameError)
+		return nil, InvalidModuleNameError
+	}
+
+	err := s.index(ctx, ModuleKind, request.GetName(), func(doc *Document) {
+		doc.Organization = address[0]
+		doc.Provider = address[2]
+		if request.Description != nil {
+			doc.Description = request.GetDescription()
+		}
+		if request.Maturity != nil {
+			doc.Maturity = request.GetMaturity()
+		}
+		if request.Tags != nil {
+			doc.Tags = request.GetTags().GetValues()
+		}
+		if request.Version != nil && isNewer(request.GetVersion(), doc.Version) {
+			doc.Version = request.GetVersion()
+			if readme, ok := s.readModuleReadme(ctx, &terrarium.Module{Name: request.GetName(), Version: request.GetVersion()}); ok {
+				doc.Readme = readme
+			}
+		}
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	log.Println("Module indexed.")
+	return Indexed, nil
+}
+
+// IndexProvider updates the fields of the search document of a provider that are set in the request.
+// A version only replaces the indexed one when it is newer.
+func (s *IndexerService) IndexProvider(ctx context.Context, request *services.IndexProviderRequest) (*services.IndexResponse, error) {
+	log.Printf("Indexing provider %s.\n", request.GetName())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("provider.name", request.GetName()))
+
+	address := strings.Split(request.GetName(), "/")
+	if len(address) != 2 {
+		span.RecordError(InvalidProviderNameError)
+		return nil, InvalidProviderNameError
+	}
+
+	err := s.index(ctx, ProviderKind, request.GetName(), func(doc *Document) {
+		doc.Organization = address[0]
+		doc.Provider = address[1]
+		if request.Description != nil {
+			doc.Description = request.GetDescription()
+		}
+		if request.Maturity != nil {
+			doc.Maturity = request.GetMaturity()
+		}
+		if request.Version != nil && isNewer(request.GetVersion(), doc.Version) {
+			doc.Version = request.GetVersion()
+		}
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	log.Println("Provider indexed.")
+	return Indexed, nil
+}
+
+// index applies an update to the document of a module or provider, then replaces its entries in the inverted index.
+// The entries are written before the document, which is only written when it was not modified since it was read.
+// Otherwise the update is applied again to the document read anew, the entries already written being rewritten.
+func (s *IndexerService) index(ctx context.Context, kind, name string, update func(doc *Document)) error {
+	id := DocumentID(kind, name)
+	written := map[string]bool{}
+	for attempt := 0; attempt < maxIndexAttempts; attempt++ {
+		doc, err := s.getDocument(ctx, id, true)
+		if err != nil {
+			return err
+		}
+		if doc == nil {
+			doc = &Document{ID: id, Kind: kind, Name: name}
+		}
+		previous := doc.Terms
+		revision := doc.Revision
+
+		update(doc)
+		doc.Terms = documentTerms(doc)
+		doc.IndexedOn = time.Now().UTC().String()
+		doc.Revision = revision + 1
+
+		if err := s.replaceTerms(ctx, id, previous, doc.Terms, written); err != nil {
+			return err
+		}
+
+		err = s.putDocument(ctx, doc, revision)
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			trace.SpanFromContext(ctx).AddEvent("Search document modified concurrently, indexing again.")
+			continue
+		}
+		if err != nil {
+			log.Println(err)
+			return IndexDocumentError
+		}
+		return nil
+	}
+	return IndexConflictError
+}
+
+// replaceTerms deletes the entries of the inverted index of a document for the previous terms that are not in terms,
+// and writes the entries of terms whose weight changed. Every term written or deleted is added to written, so that it
+// is written again on the next attempt whatever its previous weight.
+func (s *IndexerService) replaceTerms(ctx context.Context, id string, previous, terms map[string]uint64, written map[string]bool) error {
+	stale := map[string]bool{}
+	for term := range previous {
+		stale[term] = true
+	}
+	for term := range written {
+		stale[term] = true
+	}
+
+	for term := range stale {
+		if _, ok := terms[term]; ok {
+			continue
+		}
+		key, err := attributevalue.MarshalMap(map[string]string{"term": term, "id": id})
+		if err != nil {
+			log.Println(err)
+			return MarshalDocumentError
+		}
+		written[term] = true
+		if _, err := s.Db.DeleteItem(ctx, &dynamodb.DeleteItemInput{TableName: aws.String(s.TermsTable), Key: key}); err != nil {
+			log.Println(err)
+			return IndexDocumentError
+		}
+	}
+
+	for term, weight := range terms {
+		if previous[term] == weight && !written[term] {
+			continue
+		}
+		written[term] = true
+		if err := s.putItem(ctx, s.TermsTable, TermEntry{Term: term, ID: id, Weight: weight}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *IndexerService) putItem(ctx context.Context, table string, item interface{}) error {
+	av, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		log.Println(err)
+		return MarshalDocumentError
+	}
+	if _, err := s.Db.PutItem(ctx, &dynamodb.PutItemInput{TableName: aws.String(table), Item: av}); err != nil {
+		log.Println(err)
+		return IndexDocumentError
+	}
+	return nil
+}
+
+// putDocument writes a document, unless its revision is no longer the one it was read at.
+func (s *IndexerService) putDocument(ctx context.Context, doc *Document, revision uint64) error {
+	av, err := attributevalue.MarshalMap(doc)
+	if err != nil {
+		return err
+	}
+
+	unmodified := expression.Name("id").AttributeNotExists()
+	if revision > 0 {
+		unmodified = expression.Name("revision").Equal(expression.Value(revision))
+	}
+	expr, err := expression.NewBuilder().WithCondition(unmodified).Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Db.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:                 aws.String(s.DocumentsTable),
+		Item:                      av,
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+	return err
+}
+
+// GetDocument returns the search document with the given id, or nil when there is none.
+func (s *IndexerService) GetDocument(ctx context.Context, id string) (*Document, error) {
+	return s.getDocument(ctx, id, false)
+}
+
+// getDocument returns the search document with the given id, read with strong consistency when consistent is set.
+func (s *IndexerService) getDocument(ctx context.Context, id string, consistent bool) (*Document, error) {
+	key, err := attributevalue.MarshalMap(map[string]string{"id": id})
+	if err != nil {
+		log.Println(err)
+		return nil, GetDocumentError
+	}
+
+	out, err := s.Db.GetItem(ctx, &dynamodb.GetItemInput{TableName: aws.String(s.DocumentsTable), Key: key, ConsistentRead: aws.Bool(consistent)})
+	if err != nil {
+		log.Println(err)
+		return nil, GetDocumentError
+	}
+	if out == nil || out.Item == nil {
+		return nil, nil
+	}
+
+	doc := &Document{}
+	if err := attributevalue.UnmarshalMap(out.Item, doc); err != nil {
+		log.Println(err)
+		return nil, GetDocumentError
+	}
+	return doc, nil
+}
+
+// readModuleReadme returns the README of a module version stored with its documentation.
+// It returns false when the documentation cannot be read, so that the README indexed before is kept.
+func (s *IndexerService) readModuleReadme(ctx context.Context, module *terrarium.Module) (string, bool) {
+	if s.Storage == nil {
+		return "", false
+	}
+
+	docs, err := s.Storage.GetModuleDocs(ctx, &moduleServices.GetModuleDocsRequest{Module: module})
+	if err != nil {
+		log.Printf("Failed to read the README of %s %s: %v", module.GetName(), module.GetVersion(), err)
+		trace.SpanFromContext(ctx).RecordError(err)
+		return "", false
+	}
+	return docs.GetReadme(), true
+}
+
+// documentTerms returns the terms of every field of a document with their weight.
+func documentTerms(doc *Document) map[string]uint64 {
+	terms := map[string]uint64{}
+	add := func(text string, weight uint64) {
+		for _, term := range Tokenize(text) {
+			terms[term] += weight
+		}
+	}
+
+	name := doc.Name
+	if parts := strings.Split(doc.Name, "/"); len(parts) > 1 {
+		name = parts[1]
+	}
+	add(name, NameWeight)
+	add(doc.Organization, OrganizationWeight)
+	add(doc.Provider, ProviderWeight)
+	add(strings.Join(doc.Tags, " "), TagWeight)
+	add(doc.Description, DescriptionWeight)
+	add(doc.Readme, ReadmeWeight)
+	return terms
+}
+
+// Tokenize returns the distinct lower case words of a text, leaving out stop words and single characters.
+func Tokenize(text string) []string {
+	seen := map[string]bool{}
+	var tokens []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len(word) < 2 || stopWords[word] || seen[word] {
+			continue
+		}
+		seen[word] = true
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
+
+// isNewer reports whether a version is newer than the current one, any version being newer than one that is not set
+// or cannot be parsed.
+func isNewer(version, current string) bool {
+	currentVersion, err := versions.ParseVersion(strings.TrimPrefix(current, "v"))
+	if err != nil {
+		return true
+	}
+	newVersion, err := versions.ParseVersion(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return false
+	}
+	return newVersion.GreaterThan(currentVersion)
+}
+
+// GetDocumentsSchema returns CreateTableInput
+// that can be used to create table if it does not exist
+func GetDocumentsSchema(table string) *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("id"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		TableName:   aws.String(table),
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
+
+// GetTermsSchema returns CreateTableInput
+// that can be used to create table if it does not exist
+func GetTermsSchema(table string) *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("term"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("term"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("id"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		TableName:   aws.String(table),
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
@@ -0,0 +1,425 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	moduleServices "github.com/terrariumcloud/terrarium/internal/module/services"
+	moduleMocks "github.com/terrariumcloud/terrarium/internal/module/services/mocks"
+	"github.com/terrariumcloud/terrarium/internal/search/services"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func documentOutput(t *testing.T, doc *Document) *dynamodb.GetItemOutput {
+	item, err := attributevalue.MarshalMap(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &dynamodb.GetItemOutput{Item: item}
+}
+
+// conflictingDynamoDB fails the first conditional writes as if the document was modified concurrently, and keeps the
+// documents written.
+type conflictingDynamoDB struct {
+	*mocks.DynamoDB
+	conflicts int
+	documents []*Document
+}
+
+func (db *conflictingDynamoDB) PutItem(ctx context.Context, in *dynamodb.PutItemInput, opts ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	if in.ConditionExpression == nil {
+		return db.DynamoDB.PutItem(ctx, in, opts...)
+	}
+	if db.conflicts > 0 {
+		db.conflicts--
+		db.PutItemInvocations++
+		return nil, &types.ConditionalCheckFailedException{}
+	}
+	doc := &Document{}
+	_ = attributevalue.UnmarshalMap(in.Item, doc)
+	db.documents = append(db.documents, doc)
+	return db.DynamoDB.PutItem(ctx, in, opts...)
+}
+
+// Test_RegisterIndexerWithServer checks:
+// - if there was no error with table init
+// - if error is returned when the documents table initialization fails
+func Test_RegisterIndexerWithServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when there is no error with table init", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		is := &IndexerService{Db: db}
+
+		err := is.RegisterWithServer(grpc.NewServer())
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if db.DescribeTableInvocations != 2 {
+			t.Errorf("Expected 2 calls to DescribeTable, got %v.", db.DescribeTableInvocations)
+		}
+	})
+
+	t.Run("when the documents table initialization fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			DescribeTableErrors: []error{errors.New("some error")},
+			CreateTableError:    errors.New("some error"),
+		}
+
+		is := &IndexerService{Db: db}
+
+		err := is.RegisterWithServer(grpc.NewServer())
+
+		if err != DocumentsTableInitializationError {
+			t.Errorf("Expected %v, got %v.", DocumentsTableInitializationError, err)
+		}
+
+		if db.DescribeTableInvocations != 1 {
+			t.Errorf("Expected 1 call to DescribeTable, got %v.", db.DescribeTableInvocations)
+		}
+	})
+}
+
+// Test_IndexModule checks:
+// - if every term of a new module is indexed with the document
+// - if terms that are no longer in the document are removed
+// - if the README of a newer version is indexed
+// - if an older version is not indexed
+// - if the update is applied again when the document was modified concurrently
+// - if error is returned when the document keeps being modified concurrently
+// - if error is returned when the module name is invalid
+// - if error is returned when GetItem fails
+// - if error is returned when PutItem fails
+func Test_IndexModule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the module is new", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		res, err := is.IndexModule(context.TODO(), &services.IndexModuleRequest{
+			Name:        "cie/vpc/aws",
+			Description: proto.String("Network for the apps"),
+		})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if res != Indexed {
+			t.Errorf("Expected %v, got %v.", Indexed, res)
+		}
+
+		// vpc, cie, aws, network and apps, then the document
+		if db.PutItemInvocations != 6 {
+			t.Errorf("Expected 6 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+
+		if db.TableName != DefaultDocumentsTableName {
+			t.Errorf("Expected the document to be written last, got table %v.", db.TableName)
+		}
+	})
+
+	t.Run("when terms are no longer in the document", func(t *testing.T) {
+		existing := &Document{
+			ID:           "module:cie/vpc/aws",
+			Kind:         ModuleKind,
+			Name:         "cie/vpc/aws",
+			Organization: "cie",
+			Provider:     "aws",
+			Description:  "Network for the apps",
+		}
+		existing.Terms = documentTerms(existing)
+
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{documentOutput(t, existing)}}
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		_, err := is.IndexModule(context.TODO(), &services.IndexModuleRequest{
+			Name:        "cie/vpc/aws",
+			Description: proto.String("Subnets"),
+		})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if db.DeleteItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to DeleteItem, got %v.", db.DeleteItemInvocations)
+		}
+
+		if db.PutItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when a newer version is published", func(t *testing.T) {
+		existing := &Document{ID: "module:cie/vpc/aws", Kind: ModuleKind, Name: "cie/vpc/aws", Organization: "cie", Provider: "aws", Version: "1.0.0"}
+		existing.Terms = documentTerms(existing)
+
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{documentOutput(t, existing)}}
+		storage := &moduleMocks.MockStorageClient{GetModuleDocsResponse: &moduleServices.ModuleDocs{Readme: "Peering"}}
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName, Storage: storage}
+
+		_, err := is.IndexModule(context.TODO(), &services.IndexModuleRequest{Name: "cie/vpc/aws", Version: proto.String("1.1.0")})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if storage.GetModuleDocsInvocations != 1 || storage.GetModuleDocsRequest.GetModule().GetVersion() != "1.1.0" {
+			t.Errorf("Expected the docs of 1.1.0 to be read once, got %v calls.", storage.GetModuleDocsInvocations)
+		}
+
+		// peering, then the document
+		if db.PutItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when an older version is published", func(t *testing.T) {
+		existing := &Document{ID: "module:cie/vpc/aws", Kind: ModuleKind, Name: "cie/vpc/aws", Organization: "cie", Provider: "aws", Version: "2.0.0"}
+		existing.Terms = documentTerms(existing)
+
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{documentOutput(t, existing)}}
+		storage := &moduleMocks.MockStorageClient{}
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName, Storage: storage}
+
+		_, err := is.IndexModule(context.TODO(), &services.IndexModuleRequest{Name: "cie/vpc/aws", Version: proto.String("1.1.0")})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if storage.GetModuleDocsInvocations != 0 {
+			t.Errorf("Expected no calls to GetModuleDocs, got %v.", storage.GetModuleDocsInvocations)
+		}
+
+		if db.PutItemInvocations != 1 {
+			t.Errorf("Expected 1 call to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when the document is modified concurrently", func(t *testing.T) {
+		existing := &Document{ID: "module:cie/vpc/aws", Kind: ModuleKind, Name: "cie/vpc/aws", Organization: "cie", Provider: "aws", Revision: 1}
+		existing.Terms = documentTerms(existing)
+		modified := &Document{ID: "module:cie/vpc/aws", Kind: ModuleKind, Name: "cie/vpc/aws", Organization: "cie", Provider: "aws", Tags: []string{"network"}, Revision: 2}
+		modified.Terms = documentTerms(modified)
+
+		db := &conflictingDynamoDB{DynamoDB: &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{documentOutput(t, existing), documentOutput(t, modified)}}, conflicts: 1}
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		_, err := is.IndexModule(context.TODO(), &services.IndexModuleRequest{Name: "cie/vpc/aws", Description: proto.String("Subnets")})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.GetItemInvocations != 2 {
+			t.Errorf("Expected the document to be read twice, got %v.", db.GetItemInvocations)
+		}
+
+		if len(db.documents) != 1 {
+			t.Fatalf("Expected the document to be written once, got %v.", db.documents)
+		}
+		written := db.documents[0]
+		if written.Description != "Subnets" || !reflect.DeepEqual(written.Tags, []string{"network"}) || written.Revision != 3 {
+			t.Errorf("Expected the update to be applied to the modified document, got %+v.", written)
+		}
+
+		// subnets, then the document, twice
+		if db.PutItemInvocations != 4 {
+			t.Errorf("Expected 4 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when the document keeps being modified concurrently", func(t *testing.T) {
+		db := &conflictingDynamoDB{DynamoDB: &mocks.DynamoDB{}, conflicts: maxIndexAttempts}
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		_, err := is.IndexModule(context.TODO(), &services.IndexModuleRequest{Name: "cie/vpc/aws"})
+
+		if err != IndexConflictError {
+			t.Errorf("Expected %v, got %v.", IndexConflictError, err)
+		}
+
+		if db.GetItemInvocations != maxIndexAttempts {
+			t.Errorf("Expected %v reads of the document, got %v.", maxIndexAttempts, db.GetItemInvocations)
+		}
+	})
+
+	t.Run("when the module name is invalid", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		is := &IndexerService{Db: db}
+
+		_, err := is.IndexModule(context.TODO(), &services.IndexModuleRequest{Name: "cie/vpc"})
+
+		if err != InvalidModuleNameError {
+			t.Errorf("Expected %v, got %v.", InvalidModuleNameError, err)
+		}
+
+		if db.GetItemInvocations != 0 {
+			t.Errorf("Expected no calls to GetItem, got %v.", db.GetItemInvocations)
+		}
+	})
+
+	t.Run("when GetItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemErrors: []error{errors.New("some error")}}
+
+		is := &IndexerService{Db: db}
+
+		_, err := is.IndexModule(context.TODO(), &services.IndexModuleRequest{Name: "cie/vpc/aws"})
+
+		if err != GetDocumentError {
+			t.Errorf("Expected %v, got %v.", GetDocumentError, err)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected no calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when PutItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{PutItemError: errors.New("some error")}
+
+		is := &IndexerService{Db: db}
+
+		_, err := is.IndexModule(context.TODO(), &services.IndexModuleRequest{Name: "cie/vpc/aws"})
+
+		if err != IndexDocumentError {
+			t.Errorf("Expected %v, got %v.", IndexDocumentError, err)
+		}
+	})
+}
+
+// Test_IndexProvider checks:
+// - if every term of a new provider is indexed with the document
+// - if error is returned when the provider name is invalid
+func Test_IndexProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the provider is new", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		_, err := is.IndexProvider(context.TODO(), &services.IndexProviderRequest{Name: "cie/aws", Version: proto.String("1.0.0")})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		// aws and cie, then the document
+		if db.PutItemInvocations != 3 {
+			t.Errorf("Expected 3 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when the provider name is invalid", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		is := &IndexerService{Db: db}
+
+		_, err := is.IndexProvider(context.TODO(), &services.IndexProviderRequest{Name: "cie/aws/extra"})
+
+		if err != InvalidProviderNameError {
+			t.Errorf("Expected %v, got %v.", InvalidProviderNameError, err)
+		}
+	})
+}
+
+// Test_documentTerms checks:
+// - if the weights of the fields a term is found in are summed
+func Test_documentTerms(t *testing.T) {
+	t.Parallel()
+
+	doc := &Document{
+		Name:         "cie/aws-vpc/aws",
+		Organization: "cie",
+		Provider:     "aws",
+		Tags:         []string{"network"},
+		Description:  "VPC network",
+		Readme:       "A VPC",
+	}
+
+	want := map[string]uint64{
+		"aws":     NameWeight + ProviderWeight,
+		"vpc":     NameWeight + DescriptionWeight + ReadmeWeight,
+		"cie":     OrganizationWeight,
+		"network": TagWeight + DescriptionWeight,
+	}
+
+	if got := documentTerms(doc); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v.", want, got)
+	}
+}
+
+// Test_Tokenize checks:
+// - if words are lower cased, split on punctuation and deduplicated
+// - if stop words and single characters are left out
+func Test_Tokenize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "when words are mixed case", text: "AWS-VPC aws_vpc", want: []string{"aws", "vpc"}},
+		{name: "when there are stop words", text: "a VPC for the apps, v2", want: []string{"vpc", "apps", "v2"}},
+		{name: "when there is no word", text: " - ", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v.", tt.want, got)
+			}
+		})
+	}
+}
+
+// Test_isNewer checks:
+// - if versions are compared semantically
+// - if any version is newer than none
+func Test_isNewer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		version string
+		current string
+		want    bool
+	}{
+		{name: "when the version is newer", version: "1.10.0", current: "1.9.0", want: true},
+		{name: "when the version is older", version: "v1.2.0", current: "1.3.0", want: false},
+		{name: "when the version is the same", version: "1.0.0", current: "1.0.0", want: false},
+		{name: "when there is no current version", version: "1.0.0", current: "", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNewer(tt.version, tt.current); got != tt.want {
+				t.Errorf("Expected %v, got %v.", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
+	"github.com/terrariumcloud/terrarium/internal/search/services"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	OrganizationFacet = "organization"
+	ProviderFacet     = "provider"
+	MaturityFacet     = "maturity"
+	TagFacet          = "tag"
+)
+
+var (
+	QueryRequiredError = status.Error(codes.InvalidArgument, "Search query must contain at least one word.")
+	SearchTermsError   = status.Error(codes.Unknown, "Failed to search terms.")
+)
+
+// Search returns the modules and providers matching every word of the query, best matches first. Results are
+// narrowed down by the facet values requested, any of the values of a facet matching, and the facets of the
+// remaining results are counted.
+func (s *IndexerService) Search(ctx context.Context, request *services.SearchRequest) (*services.SearchResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("search.query", request.GetQuery()))
+
+	terms := Tokenize(request.GetQuery())
+	if len(terms) == 0 {
+		span.RecordError(QueryRequiredError)
+		return nil, QueryRequiredError
+	}
+
+	scores, err := s.scoreDocuments(ctx, terms)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	docs, err := s.getDocuments(ctx, ids)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	results := []*services.SearchResult{}
+	for _, id := range ids {
+		doc, ok := docs[id]
+		if !ok || !matchesFilters(doc, request) {
+			continue
+		}
+		results = append(results, &services.SearchResult{
+			Kind:         doc.Kind,
+			Name:         doc.Name,
+			Organization: doc.Organization,
+			Provider:     doc.Provider,
+			Description:  doc.Description,
+			Maturity:     doc.Maturity,
+			Tags:         doc.Tags,
+			Version:      doc.Version,
+			Score:        scores[id],
+		})
+	}
+
+	// Complementing the score sorts the best matches first, ties being broken by name.
+	rank := func(result *services.SearchResult) string {
+		return fmt.Sprintf("%020d/%s", ^result.GetScore(), DocumentID(result.GetKind(), result.GetName()))
+	}
+	sort.Slice(results, func(i, j int) bool { return rank(results[i]) < rank(results[j]) })
+	facets := countFacets(results)
+
+	results, page, err := paging.Paginate(results, rank, request.GetPage())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &services.SearchResponse{Results: results, Facets: facets, Page: page}, nil
+}
+
+// scoreDocuments returns the documents indexed with every term, scored with the sum of the weights of the terms.
+func (s *IndexerService) scoreDocuments(ctx context.Context, terms []string) (map[string]uint64, error) {
+	var scores map[string]uint64
+	for _, term := range terms {
+		weights, err := s.queryTerm(ctx, term)
+		if err != nil {
+			return nil, err
+		}
+
+		matched := map[string]uint64{}
+		for id, weight := range weights {
+			if scores == nil {
+				matched[id] = weight
+			} else if score, ok := scores[id]; ok {
+				matched[id] = score + weight
+			}
+		}
+		scores = matched
+		if len(scores) == 0 {
+			break
+		}
+	}
+	return scores, nil
+}
+
+// maxBatchGetKeys is the number of items a single BatchGetItem call can read.
+const maxBatchGetKeys = 100
+
+// getDocuments returns the search documents with the given ids by id, leaving out the ones that do not exist.
+// The keys DynamoDB leaves unprocessed are requested again.
+func (s *IndexerService) getDocuments(ctx context.Context, ids []string) (map[string]*Document, error) {
+	docs := map[string]*Document{}
+	for start := 0; start < len(ids); start += maxBatchGetKeys {
+		keys := []map[string]types.AttributeValue{}
+		for _, id := range ids[start:min(start+maxBatchGetKeys, len(ids))] {
+			keys = append(keys, map[string]types.AttributeValue{"id": &types.AttributeValueMemberS{Value: id}})
+		}
+
+		requested := map[string]types.KeysAndAttributes{s.DocumentsTable: {Keys: keys}}
+		for len(requested) > 0 {
+			out, err := s.Db.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{RequestItems: requested})
+			if err != nil {
+				log.Println(err)
+				return nil, GetDocumentError
+			}
+			if out == nil {
+				break
+			}
+
+			var page []*Document
+			if err := attributevalue.UnmarshalListOfMaps(out.Responses[s.DocumentsTable], &page); err != nil {
+				log.Println(err)
+				return nil, GetDocumentError
+			}
+			for _, doc := range page {
+				docs[doc.ID] = doc
+			}
+			requested = out.UnprocessedKeys
+		}
+	}
+	return docs, nil
+}
+
+// queryTerm returns the weight of a term in each of the documents indexed with it.
+func (s *IndexerService) queryTerm(ctx context.Context, term string) (map[string]uint64, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(s.TermsTable),
+		KeyConditionExpression: aws.String("#term = :term"),
+		ExpressionAttributeNames: map[string]string{
+			"#term": "term",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":term": &types.AttributeValueMemberS{Value: term},
+		},
+	}
+
+	weights := map[string]uint64{}
+	for {
+		out, err := s.Db.Query(ctx, input)
+		if err != nil {
+			log.Println(err)
+			return nil, SearchTermsError
+		}
+
+		var entries []TermEntry
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &entries); err != nil {
+			log.Println(err)
+			return nil, SearchTermsError
+		}
+		for _, entry := range entries {
+			weights[entry.ID] = entry.Weight
+		}
+
+		if len(out.LastEvaluatedKey) == 0 {
+			return weights, nil
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
+
+// matchesFilters reports whether a document has one of the values requested for each facet.
+func matchesFilters(doc *Document, request *services.SearchRequest) bool {
+	return matchesAny([]string{doc.Organization}, request.GetOrganizations()) &&
+		matchesAny([]string{doc.Provider}, request.GetProviders()) &&
+		matchesAny([]string{doc.Maturity}, request.GetMaturities()) &&
+		matchesAny(doc.Tags, request.GetTags())
+}
+
+func matchesAny(values []string, filter []string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, value := range values {
+		for _, wanted := range filter {
+			if strings.EqualFold(value, wanted) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// countFacets returns how many results have each organization, provider, maturity and tag, most common first.
+func countFacets(results []*services.SearchResult) []*services.Facet {
+	names := []string{OrganizationFacet, ProviderFacet, MaturityFacet, TagFacet}
+	counts := map[string]map[string]uint64{}
+	for _, name := range names {
+		counts[name] = map[string]uint64{}
+	}
+
+	count := func(name, value string) {
+		if value != "" {
+			counts[name][value]++
+		}
+	}
+	for _, result := range results {
+		count(OrganizationFacet, result.GetOrganization())
+		count(ProviderFacet, result.GetProvider())
+		count(MaturityFacet, result.GetMaturity())
+		for _, tag := range result.GetTags() {
+			count(TagFacet, tag)
+		}
+	}
+
+	facets := make([]*services.Facet, 0, len(names))
+	for _, name := range names {
+		values := make([]*services.FacetValue, 0, len(counts[name]))
+		for value, n := range counts[name] {
+			values = append(values, &services.FacetValue{Value: value, Count: n})
+		}
+		sort.Slice(values, func(i, j int) bool {
+			if values[i].GetCount() != values[j].GetCount() {
+				return values[i].GetCount() > values[j].GetCount()
+			}
+			return values[i].GetValue() < values[j].GetValue()
+		})
+		facets = append(facets, &services.Facet{Name: name, Values: values})
+	}
+	return facets
+}
@@ -0,0 +1,216 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/terrariumcloud/terrarium/internal/common/paging"
+	"github.com/terrariumcloud/terrarium/internal/search/services"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+)
+
+func termsOutput(t *testing.T, entries ...TermEntry) *dynamodb.QueryOutput {
+	items := []map[string]types.AttributeValue{}
+	for _, entry := range entries {
+		item, err := attributevalue.MarshalMap(entry)
+		if err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, item)
+	}
+	return &dynamodb.QueryOutput{Items: items}
+}
+
+func documentsOutput(t *testing.T, docs ...*Document) *dynamodb.BatchGetItemOutput {
+	items := []map[string]types.AttributeValue{}
+	for _, doc := range docs {
+		item, err := attributevalue.MarshalMap(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, item)
+	}
+	return &dynamodb.BatchGetItemOutput{Responses: map[string][]map[string]types.AttributeValue{DefaultDocumentsTableName: items}}
+}
+
+// Test_Search checks:
+// - if only documents matching every term are returned, best scores first
+// - if facets are counted over the results
+// - if results are narrowed down by facet values
+// - if results are paged
+// - if documents left unprocessed are read again
+// - if error is returned when the query has no word
+// - if error is returned when Query fails
+// - if error is returned when BatchGetItem fails
+func Test_Search(t *testing.T) {
+	t.Parallel()
+
+	vpc := &Document{ID: "module:cie/vpc/aws", Kind: ModuleKind, Name: "cie/vpc/aws", Organization: "cie", Provider: "aws", Maturity: "STABLE", Tags: []string{"network"}}
+	peering := &Document{ID: "module:cie/peering/aws", Kind: ModuleKind, Name: "cie/peering/aws", Organization: "cie", Provider: "aws", Maturity: "BETA", Tags: []string{"network", "vpc"}}
+
+	newDb := func() *mocks.DynamoDB {
+		return &mocks.DynamoDB{
+			QueryOuts: []*dynamodb.QueryOutput{
+				termsOutput(t,
+					TermEntry{Term: "vpc", ID: peering.ID, Weight: TagWeight},
+					TermEntry{Term: "vpc", ID: vpc.ID, Weight: NameWeight},
+					TermEntry{Term: "vpc", ID: "module:cie/other/aws", Weight: ReadmeWeight},
+				),
+				termsOutput(t,
+					TermEntry{Term: "aws", ID: vpc.ID, Weight: ProviderWeight},
+					TermEntry{Term: "aws", ID: peering.ID, Weight: ProviderWeight},
+				),
+			},
+			BatchGetItemOuts: []*dynamodb.BatchGetItemOutput{documentsOutput(t, peering, vpc)},
+		}
+	}
+
+	t.Run("when documents match every term", func(t *testing.T) {
+		db := newDb()
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		res, err := is.Search(context.TODO(), &services.SearchRequest{Query: "VPC on AWS"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.QueryItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to Query, got %v.", db.QueryItemInvocations)
+		}
+
+		if db.BatchGetItemInvocations != 1 || len(db.BatchGetItemIns[0].RequestItems[DefaultDocumentsTableName].Keys) != 2 {
+			t.Errorf("Expected the 2 documents to be read at once, got %v calls.", db.BatchGetItemInvocations)
+		}
+
+		var names []string
+		for _, result := range res.GetResults() {
+			names = append(names, result.GetName())
+		}
+		if want := []string{vpc.Name, peering.Name}; !reflect.DeepEqual(names, want) {
+			t.Errorf("Expected %v, got %v.", want, names)
+		}
+
+		if score := res.GetResults()[0].GetScore(); score != NameWeight+ProviderWeight {
+			t.Errorf("Expected score %v, got %v.", NameWeight+ProviderWeight, score)
+		}
+
+		tags := res.GetFacets()[3]
+		if tags.GetName() != TagFacet || len(tags.GetValues()) != 2 || tags.GetValues()[0].GetValue() != "network" || tags.GetValues()[0].GetCount() != 2 {
+			t.Errorf("Expected tag facet with network counted twice, got %v.", tags)
+		}
+	})
+
+	t.Run("when results are narrowed down by facet values", func(t *testing.T) {
+		db := newDb()
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		res, err := is.Search(context.TODO(), &services.SearchRequest{Query: "vpc aws", Maturities: []string{"beta"}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(res.GetResults()) != 1 || res.GetResults()[0].GetName() != peering.Name {
+			t.Errorf("Expected only %v, got %v.", peering.Name, res.GetResults())
+		}
+
+		maturities := res.GetFacets()[2]
+		if len(maturities.GetValues()) != 1 || maturities.GetValues()[0].GetValue() != "BETA" {
+			t.Errorf("Expected maturity facet with BETA only, got %v.", maturities)
+		}
+	})
+
+	t.Run("when results are paged", func(t *testing.T) {
+		db := newDb()
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		res, err := is.Search(context.TODO(), &services.SearchRequest{Query: "vpc aws", Page: &paging.PageInfoRequest{Count: 1}})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(res.GetResults()) != 1 || res.GetResults()[0].GetName() != vpc.Name {
+			t.Errorf("Expected only %v, got %v.", vpc.Name, res.GetResults())
+		}
+
+		if res.GetPage().GetTotalCount() != 2 || res.GetPage().GetNextCursor() == "" {
+			t.Errorf("Expected a next page out of 2 results, got %v.", res.GetPage())
+		}
+	})
+
+	t.Run("when documents are left unprocessed", func(t *testing.T) {
+		db := newDb()
+		unprocessed := documentsOutput(t, peering)
+		unprocessed.UnprocessedKeys = map[string]types.KeysAndAttributes{DefaultDocumentsTableName: {
+			Keys: []map[string]types.AttributeValue{{"id": &types.AttributeValueMemberS{Value: vpc.ID}}},
+		}}
+		db.BatchGetItemOuts = []*dynamodb.BatchGetItemOutput{unprocessed, documentsOutput(t, vpc)}
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		res, err := is.Search(context.TODO(), &services.SearchRequest{Query: "vpc aws"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.BatchGetItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to BatchGetItem, got %v.", db.BatchGetItemInvocations)
+		}
+
+		if len(res.GetResults()) != 2 {
+			t.Errorf("Expected 2 results, got %v.", res.GetResults())
+		}
+	})
+
+	t.Run("when the query has no word", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		is := &IndexerService{Db: db}
+
+		_, err := is.Search(context.TODO(), &services.SearchRequest{Query: "the"})
+
+		if err != QueryRequiredError {
+			t.Errorf("Expected %v, got %v.", QueryRequiredError, err)
+		}
+
+		if db.QueryItemInvocations != 0 {
+			t.Errorf("Expected no calls to Query, got %v.", db.QueryItemInvocations)
+		}
+	})
+
+	t.Run("when Query fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryError: errors.New("some error")}
+
+		is := &IndexerService{Db: db}
+
+		_, err := is.Search(context.TODO(), &services.SearchRequest{Query: "vpc"})
+
+		if err != SearchTermsError {
+			t.Errorf("Expected %v, got %v.", SearchTermsError, err)
+		}
+	})
+	t.Run("when BatchGetItem fails", func(t *testing.T) {
+		db := newDb()
+		db.BatchGetItemError = errors.New("some error")
+
+		is := &IndexerService{Db: db, DocumentsTable: DefaultDocumentsTableName, TermsTable: DefaultTermsTableName}
+
+		_, err := is.Search(context.TODO(), &services.SearchRequest{Query: "vpc"})
+
+		if err != GetDocumentError {
+			t.Errorf("Expected %v, got %v.", GetDocumentError, err)
+		}
+	})
+}
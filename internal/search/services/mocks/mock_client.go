@@ -0,0 +1,43 @@
+package searchMocks
+
+import (
+	"context"
+
+	"github.com/terrariumcloud/terrarium/internal/search/services"
+
+	"google.golang.org/grpc"
+)
+
+type MockIndexerClient struct {
+	services.IndexerClient
+	IndexModuleInvocations   int
+	IndexModuleRequest       *services.IndexModuleRequest
+	IndexModuleResponse      *services.IndexResponse
+	IndexModuleError         error
+	IndexProviderInvocations int
+	IndexProviderRequest     *services.IndexProviderRequest
+	IndexProviderResponse    *services.IndexResponse
+	IndexProviderError       error
+	SearchInvocations        int
+	SearchRequest            *services.SearchRequest
+	SearchResponse           *services.SearchResponse
+	SearchError              error
+}
+
+func (m *MockIndexerClient) IndexModule(ctx context.Context, in *services.IndexModuleRequest, opts ...grpc.CallOption) (*services.IndexResponse, error) {
+	m.IndexModuleInvocations++
+	m.IndexModuleRequest = in
+	return m.IndexModuleResponse, m.IndexModuleError
+}
+
+func (m *MockIndexerClient) IndexProvider(ctx context.Context, in *services.IndexProviderRequest, opts ...grpc.CallOption) (*services.IndexResponse, error) {
+	m.IndexProviderInvocations++
+	m.IndexProviderRequest = in
+	return m.IndexProviderResponse, m.IndexProviderError
+}
+
+func (m *MockIndexerClient) Search(ctx context.Context, in *services.SearchRequest, opts ...grpc.CallOption) (*services.SearchResponse, error) {
+	m.SearchInvocations++
+	m.SearchRequest = in
+	return m.SearchResponse, m.SearchError
+}
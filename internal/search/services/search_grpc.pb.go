@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
+// source: pb/terrarium/search/services/search.proto
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Indexer_IndexModule_FullMethodName   = "/terrarium.search.services.Indexer/IndexModule"
+	Indexer_IndexProvider_FullMethodName = "/terrarium.search.services.Indexer/IndexProvider"
+	Indexer_Search_FullMethodName        = "/terrarium.search.services.Indexer/Search"
+)
+
+// IndexerClient is the client API for Indexer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IndexerClient interface {
+	IndexModule(ctx context.Context, in *IndexModuleRequest, opts ...grpc.CallOption) (*IndexResponse, error)
+	IndexProvider(ctx context.Context, in *IndexProviderRequest, opts ...grpc.CallOption) (*IndexResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type indexerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIndexerClient(cc grpc.ClientConnInterface) IndexerClient {
+	return &indexerClient{cc}
+}
+
+func (c *indexerClient) IndexModule(ctx context.Context, in *IndexModuleRequest, opts ...grpc.CallOption) (*IndexResponse, error) {
+	out := new(IndexResponse)
+	err := c.cc.Invoke(ctx, Indexer_IndexModule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) IndexProvider(ctx context.Context, in *IndexProviderRequest, opts ...grpc.CallOption) (*IndexResponse, error) {
+	out := new(IndexResponse)
+	err := c.cc.Invoke(ctx, Indexer_IndexProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, Indexer_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IndexerServer is the server API for Indexer service.
+// All implementations must embed UnimplementedIndexerServer
+// for forward compatibility
+type IndexerServer interface {
+	IndexModule(context.Context, *IndexModuleRequest) (*IndexResponse, error)
+	IndexProvider(context.Context, *IndexProviderRequest) (*IndexResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	mustEmbedUnimplementedIndexerServer()
+}
+
+// UnimplementedIndexerServer must be embedded to have forward compatible implementations.
+type UnimplementedIndexerServer struct {
+}
+
+func (UnimplementedIndexerServer) IndexModule(context.Context, *IndexModuleRequest) (*IndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexModule not implemented")
+}
+func (UnimplementedIndexerServer) IndexProvider(context.Context, *IndexProviderRequest) (*IndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexProvider not implemented")
+}
+func (UnimplementedIndexerServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedIndexerServer) mustEmbedUnimplementedIndexerServer() {}
+
+// UnsafeIndexerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IndexerServer will
+// result in compilation errors.
+type UnsafeIndexerServer interface {
+	mustEmbedUnimplementedIndexerServer()
+}
+
+func RegisterIndexerServer(s grpc.ServiceRegistrar, srv IndexerServer) {
+	s.RegisterService(&Indexer_ServiceDesc, srv)
+}
+
+func _Indexer_IndexModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).IndexModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_IndexModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).IndexModule(ctx, req.(*IndexModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_IndexProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).IndexProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_IndexProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).IndexProvider(ctx, req.(*IndexProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Indexer_ServiceDesc is the grpc.ServiceDesc for Indexer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Indexer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "terrarium.search.services.Indexer",
+	HandlerType: (*IndexerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IndexModule",
+			Handler:    _Indexer_IndexModule_Handler,
+		},
+		{
+			MethodName: "IndexProvider",
+			Handler:    _Indexer_IndexProvider_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Indexer_Search_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pb/terrarium/search/services/search.proto",
+}
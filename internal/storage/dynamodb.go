@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
 
 type DynamoDBTableCreator interface {
@@ -17,8 +18,12 @@ type DynamoDBTableCreator interface {
 	UpdateItem(ctx context.Context, in *dynamodb.UpdateItemInput, opsFns ...func(*dynamodb.Options)) (*dynamodb.UpdateItemOutput, error)
 	DeleteItem(ctx context.Context, in *dynamodb.DeleteItemInput, opsFns ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error)
 	Query(ctx context.Context, in *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error)
+	BatchGetItem(ctx context.Context, in *dynamodb.BatchGetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchGetItemOutput, error)
 }
 
+// TimeFormatLayout is the layout of the times stored in DynamoDB, which are written with time.Time.String().
+const TimeFormatLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
 // Create new DynamoDB client
 func NewDynamoDbClient(sessionConfig AWSSessionConfig) *dynamodb.Client {
 	cfg, err := NewAwsSession(sessionConfig)
@@ -28,6 +33,26 @@ func NewDynamoDbClient(sessionConfig AWSSessionConfig) *dynamodb.Client {
 	return dynamodb.NewFromConfig(*cfg)
 }
 
+// ScanAll returns every item of a scan, following LastEvaluatedKey past the 1 MB DynamoDB returns at once.
+func ScanAll(ctx context.Context, db DynamoDBTableCreator, in *dynamodb.ScanInput) ([]map[string]types.AttributeValue, error) {
+	var items []map[string]types.AttributeValue
+	input := *in
+	for {
+		out, err := db.Scan(ctx, &input)
+		if err != nil {
+			return nil, err
+		}
+		if out == nil {
+			return items, nil
+		}
+		items = append(items, out.Items...)
+		if len(out.LastEvaluatedKey) == 0 {
+			return items, nil
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
+
 // InitializeDynamoDb - checks if table exists, in case it doesn't it creates it
 func InitializeDynamoDb(tableName string, schema *dynamodb.CreateTableInput, db DynamoDBTableCreator) error {
 	if _, err := db.DescribeTable(context.TODO(), &dynamodb.DescribeTableInput{TableName: aws.String(tableName)}); err != nil {
@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"context"
 	"errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
@@ -124,3 +126,46 @@ func Test_InitializeDynamoDb(t *testing.T) {
 		}
 	})
 }
+
+// Test_ScanAll checks:
+// - if items of every page are returned
+// - if error is returned when Scan fails
+func Test_ScanAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the scan has several pages", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanOuts: []*dynamodb.ScanOutput{
+			{
+				Items:            []map[string]types.AttributeValue{{"name": &types.AttributeValueMemberS{Value: "a"}}},
+				LastEvaluatedKey: map[string]types.AttributeValue{"name": &types.AttributeValueMemberS{Value: "a"}},
+			},
+			{
+				Items: []map[string]types.AttributeValue{{"name": &types.AttributeValueMemberS{Value: "b"}}},
+			},
+		}}
+
+		items, err := ScanAll(context.TODO(), db, &dynamodb.ScanInput{TableName: aws.String("Test")})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if db.ScanItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to Scan, got %v.", db.ScanItemInvocations)
+		}
+
+		if len(items) != 2 {
+			t.Errorf("Expected 2 items, got %v.", len(items))
+		}
+	})
+
+	t.Run("when Scan fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{ScanError: errors.New("some error")}
+
+		_, err := ScanAll(context.TODO(), db, &dynamodb.ScanInput{TableName: aws.String("Test")})
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
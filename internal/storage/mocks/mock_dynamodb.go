@@ -22,6 +22,7 @@ type DynamoDB struct {
 	PutItemOut               *dynamodb.PutItemOutput
 	PutItemError             error
 	UpdateItemInvocations    int
+	UpdateItemIn             *dynamodb.UpdateItemInput
 	UpdateItemOut            *dynamodb.UpdateItemOutput
 	UpdateItemError          error
 	DeleteItemInvocations    int
@@ -29,10 +30,16 @@ type DynamoDB struct {
 	DeleteItemError          error
 	ScanItemInvocations      int
 	ScanOut                  *dynamodb.ScanOutput
+	ScanOuts                 []*dynamodb.ScanOutput
 	ScanError                error
 	QueryItemInvocations     int
 	QueryOut                 *dynamodb.QueryOutput
+	QueryOuts                []*dynamodb.QueryOutput
 	QueryError               error
+	BatchGetItemInvocations  int
+	BatchGetItemIns          []*dynamodb.BatchGetItemInput
+	BatchGetItemOuts         []*dynamodb.BatchGetItemOutput
+	BatchGetItemError        error
 }
 
 func (mdb *DynamoDB) DescribeTable(_ context.Context, in *dynamodb.DescribeTableInput, _ ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error) {
@@ -77,6 +84,7 @@ func (mdb *DynamoDB) PutItem(_ context.Context, in *dynamodb.PutItemInput, _ ...
 
 func (mdb *DynamoDB) UpdateItem(_ context.Context, in *dynamodb.UpdateItemInput, _ ...func(*dynamodb.Options)) (*dynamodb.UpdateItemOutput, error) {
 	mdb.UpdateItemInvocations++
+	mdb.UpdateItemIn = in
 	mdb.TableName = *in.TableName
 	return mdb.UpdateItemOut, mdb.UpdateItemError
 
@@ -90,15 +98,38 @@ func (mdb *DynamoDB) DeleteItem(_ context.Context, in *dynamodb.DeleteItemInput,
 
 func (mdb *DynamoDB) Scan(ctx context.Context, in *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
 
+	out := mdb.ScanOut
+	if len(mdb.ScanOuts) > mdb.ScanItemInvocations {
+		out = mdb.ScanOuts[mdb.ScanItemInvocations]
+	}
+
 	mdb.ScanItemInvocations++
 	mdb.TableName = *in.TableName
 
-	return mdb.ScanOut, mdb.ScanError
+	return out, mdb.ScanError
 }
 func (mdb *DynamoDB) Query(ctx context.Context, in *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
 
+	out := mdb.QueryOut
+	if len(mdb.QueryOuts) > mdb.QueryItemInvocations {
+		out = mdb.QueryOuts[mdb.QueryItemInvocations]
+	}
+
 	mdb.QueryItemInvocations++
 	mdb.TableName = *in.TableName
 
-	return mdb.QueryOut, mdb.QueryError
+	return out, mdb.QueryError
+}
+
+func (mdb *DynamoDB) BatchGetItem(ctx context.Context, in *dynamodb.BatchGetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchGetItemOutput, error) {
+
+	var out *dynamodb.BatchGetItemOutput = nil
+	if len(mdb.BatchGetItemOuts) > mdb.BatchGetItemInvocations {
+		out = mdb.BatchGetItemOuts[mdb.BatchGetItemInvocations]
+	}
+
+	mdb.BatchGetItemInvocations++
+	mdb.BatchGetItemIns = append(mdb.BatchGetItemIns, in)
+
+	return out, mdb.BatchGetItemError
 }
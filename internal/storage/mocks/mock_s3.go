@@ -21,6 +21,17 @@ type S3 struct {
 	GetObjectInvocations    int
 	GetObjectOut            *s3.GetObjectOutput
 	GetObjectError          error
+	GetObjectOuts           []*s3.GetObjectOutput
+	GetObjectErrors         []error
+	Ranges                  []string
+	DeleteObjectInvocations int
+	DeletedKeys             []string
+	DeleteObjectOut         *s3.DeleteObjectOutput
+	DeleteObjectError       error
+	ListObjectsInvocations  int
+	Prefix                  string
+	ListObjectsOut          *s3.ListObjectsV2Output
+	ListObjectsError        error
 }
 
 func (ms3 *S3) HeadBucket(_ context.Context, in *s3.HeadBucketInput, _ ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
@@ -43,8 +54,34 @@ func (ms3 *S3) PutObject(_ context.Context, in *s3.PutObjectInput, _ ...func(*s3
 }
 
 func (ms3 *S3) GetObject(_ context.Context, in *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	out, err := ms3.GetObjectOut, ms3.GetObjectError
+	if len(ms3.GetObjectOuts) > ms3.GetObjectInvocations {
+		out = ms3.GetObjectOuts[ms3.GetObjectInvocations]
+	}
+	if len(ms3.GetObjectErrors) > ms3.GetObjectInvocations {
+		err = ms3.GetObjectErrors[ms3.GetObjectInvocations]
+	}
+
 	ms3.GetObjectInvocations++
+	if in.Range != nil {
+		ms3.Ranges = append(ms3.Ranges, *in.Range)
+	}
+	ms3.BucketName = *in.Bucket
+	ms3.Filename = *in.Key
+	return out, err
+}
+
+func (ms3 *S3) DeleteObject(_ context.Context, in *s3.DeleteObjectInput, _ ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	ms3.DeleteObjectInvocations++
 	ms3.BucketName = *in.Bucket
 	ms3.Filename = *in.Key
-	return ms3.GetObjectOut, ms3.GetObjectError
+	ms3.DeletedKeys = append(ms3.DeletedKeys, *in.Key)
+	return ms3.DeleteObjectOut, ms3.DeleteObjectError
+}
+
+func (ms3 *S3) ListObjectsV2(_ context.Context, in *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	ms3.ListObjectsInvocations++
+	ms3.BucketName = *in.Bucket
+	ms3.Prefix = *in.Prefix
+	return ms3.ListObjectsOut, ms3.ListObjectsError
 }
@@ -0,0 +1,73 @@
+package dependency_tracker
+
+import (
+	"context"
+
+	"github.com/terrariumcloud/terrarium/internal/common/grpc_service"
+	"github.com/terrariumcloud/terrarium/internal/usage/services"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
+	"google.golang.org/grpc"
+)
+
+type dependencyTrackerGrpcClient struct {
+	endpoint string
+}
+
+func NewDependencyTrackerGrpcClient(endpoint string) services.DependencyTrackerClient {
+	return &dependencyTrackerGrpcClient{endpoint: endpoint}
+}
+
+func (d dependencyTrackerGrpcClient) RegisterDeploymentUnit(ctx context.Context, in *usage.RegisterDeploymentUnitRequest, opts ...grpc.CallOption) (*usage.RegisterDeploymentUnitResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyTrackerClient(conn)
+		return client.RegisterDeploymentUnit(ctx, in, opts...)
+	}
+}
+
+func (d dependencyTrackerGrpcClient) NotifyUsage(ctx context.Context, in *usage.NotifyDependencyRequest, opts ...grpc.CallOption) (*usage.NotifyDependencyResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyTrackerClient(conn)
+		return client.NotifyUsage(ctx, in, opts...)
+	}
+}
+
+func (d dependencyTrackerGrpcClient) ListUsage(ctx context.Context, in *services.ListUsageRequest, opts ...grpc.CallOption) (*services.ListUsageResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyTrackerClient(conn)
+		return client.ListUsage(ctx, in, opts...)
+	}
+}
+
+func (d dependencyTrackerGrpcClient) NotifyRelease(ctx context.Context, in *services.NotifyReleaseRequest, opts ...grpc.CallOption) (*services.NotifyReleaseResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyTrackerClient(conn)
+		return client.NotifyRelease(ctx, in, opts...)
+	}
+}
+
+func (d dependencyTrackerGrpcClient) ListDeliveries(ctx context.Context, in *services.ListDeliveriesRequest, opts ...grpc.CallOption) (*services.ListDeliveriesResponse, error) {
+	if conn, err := grpc_service.CreateGRPCConnection(d.endpoint); err != nil {
+		return nil, err
+	} else {
+		defer func() { _ = conn.Close() }()
+
+		client := services.NewDependencyTrackerClient(conn)
+		return client.ListDeliveries(ctx, in, opts...)
+	}
+}
@@ -0,0 +1,350 @@
+package dependency_tracker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apparentlymart/go-versions/versions"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terrariumcloud/terrarium/internal/storage"
+	"github.com/terrariumcloud/terrarium/internal/usage/services"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
+)
+
+const (
+	DeliverySucceeded = "delivered"
+	DeliveryFailed    = "failed"
+
+	// attemptedOnLayout has a fixed width so that delivery log entries sort chronologically
+	attemptedOnLayout = "2006-01-02T15:04:05.000000000Z07:00"
+)
+
+// Delivery is an entry of the delivery log, keyed by the notified unit and "<attempted_on>#<dependency>@<version>#<method>".
+type Delivery struct {
+	Unit           string `json:"unit" bson:"unit" dynamodbav:"unit"`
+	ID             string `json:"id" bson:"id" dynamodbav:"id"`
+	Dependency     string `json:"dependency" bson:"dependency" dynamodbav:"dependency"`
+	Version        string `json:"version" bson:"version" dynamodbav:"version"`
+	UnitVersion    string `json:"unit_version" bson:"unit_version" dynamodbav:"unit_version"`
+	CurrentVersion string `json:"current_version" bson:"current_version" dynamodbav:"current_version"`
+	MethodType     string `json:"method_type" bson:"method_type" dynamodbav:"method_type"`
+	MethodUri      string `json:"method_uri" bson:"method_uri" dynamodbav:"method_uri"`
+	Status         string `json:"status" bson:"status" dynamodbav:"status"`
+	Attempts       int    `json:"attempts" bson:"attempts" dynamodbav:"attempts"`
+	Error          string `json:"error" bson:"error" dynamodbav:"error"`
+	AttemptedOn    string `json:"attempted_on" bson:"attempted_on" dynamodbav:"attempted_on"`
+}
+
+func (s *DependencyTrackerService) notifiers() map[string]Notifier {
+	if s.Notifiers == nil {
+		return DefaultNotifiers()
+	}
+	return s.Notifiers
+}
+
+// NotifyRelease notifies the registered deployment units using an older version of a module or provider
+// that a new version was released. Notifications are delivered in the background, bounded by DeliveryTimeout,
+// so that a release does not wait on the endpoints of the units. Every notification method of a unit is tried
+// up to MaxDeliveryAttempts times and the outcome is recorded in the delivery log.
+func (s *DependencyTrackerService) NotifyRelease(ctx context.Context, request *services.NotifyReleaseRequest) (*services.NotifyReleaseResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("dependency.type", request.GetDependency().GetType()),
+		attribute.String("dependency.organization", request.GetDependency().GetOrganization()),
+		attribute.String("dependency.name", request.GetDependency().GetName()),
+		attribute.String("dependency.version", request.GetVersion()),
+	)
+
+	if !validUnit(request.GetDependency()) || request.GetVersion() == "" {
+		span.RecordError(InvalidDependencyError)
+		return nil, InvalidDependencyError
+	}
+
+	entries, err := s.queryUsage(ctx, request.GetDependency(), "")
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, NotifyReleaseError
+	}
+
+	dependency := UnitKey(request.GetDependency())
+	var pending []pendingDelivery
+	for _, entry := range currentUsage(entries) {
+		if !isNewer(request.GetVersion(), entry.Version) {
+			continue
+		}
+
+		unit := &usage.DeploymentUnit{Type: entry.ConsumerType, Organization: entry.ConsumerOrganization, Name: entry.ConsumerName}
+		registered, err := s.GetDeploymentUnit(ctx, unit)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		if registered == nil {
+			continue
+		}
+
+		notification := &Notification{
+			Unit:           registered.ID,
+			UnitVersion:    entry.ConsumerVersion,
+			Dependency:     dependency,
+			CurrentVersion: entry.Version,
+			NewVersion:     request.GetVersion(),
+		}
+		for i, method := range registered.Notifications {
+			pending = append(pending, pendingDelivery{index: i, method: method, notification: notification})
+		}
+	}
+
+	timeout := s.DeliveryTimeout
+	if timeout <= 0 {
+		timeout = DefaultDeliveryTimeout
+	}
+	deliveryCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	s.deliveries.Add(1)
+	go func() {
+		defer s.deliveries.Done()
+		defer cancel()
+		s.deliverAll(deliveryCtx, pending)
+	}()
+
+	span.SetAttributes(attribute.Int("notifications.queued", len(pending)))
+	log.Printf("Release %s@%s notified: %d notifications queued.", dependency, request.GetVersion(), len(pending))
+	return &services.NotifyReleaseResponse{Queued: int32(len(pending))}, nil
+}
+
+// Close waits for the notifications of the releases already notified to be delivered and recorded, each release
+// being bounded by DeliveryTimeout, so that stopping the service does not drop them.
+func (s *DependencyTrackerService) Close() error {
+	s.deliveries.Wait()
+	return nil
+}
+
+// pendingDelivery is a notification to deliver through the method at index of the notification methods of its unit.
+type pendingDelivery struct {
+	index        int
+	method       *usage.NotificationMethod
+	notification *Notification
+}
+
+// deliverAll delivers notifications with at most MaxConcurrentDeliveries in flight, so that an endpoint that does not
+// answer does not hold up the others, and records every outcome in the delivery log.
+func (s *DependencyTrackerService) deliverAll(ctx context.Context, pending []pendingDelivery) {
+	concurrency := s.MaxConcurrentDeliveries
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for _, p := range pending {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(p pendingDelivery) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			s.deliverPending(ctx, p)
+		}(p)
+	}
+	wg.Wait()
+}
+
+func (s *DependencyTrackerService) deliverPending(ctx context.Context, p pendingDelivery) {
+	notification := p.notification
+	attemptedOn := time.Now().UTC().Format(attemptedOnLayout)
+	attempts, err := s.deliver(ctx, p.method, notification)
+
+	delivery := Delivery{
+		Unit:           notification.Unit,
+		ID:             fmt.Sprintf("%s#%s@%s#%d", attemptedOn, notification.Dependency, notification.NewVersion, p.index),
+		Dependency:     notification.Dependency,
+		Version:        notification.NewVersion,
+		UnitVersion:    notification.UnitVersion,
+		CurrentVersion: notification.CurrentVersion,
+		MethodType:     p.method.GetType(),
+		MethodUri:      p.method.GetUri(),
+		Status:         DeliverySucceeded,
+		Attempts:       attempts,
+		AttemptedOn:    attemptedOn,
+	}
+	if err != nil {
+		log.Printf("Failed to notify %s of %s@%s through %s: %v", notification.Unit, notification.Dependency, notification.NewVersion, p.method.GetType(), err)
+		delivery.Status = DeliveryFailed
+		delivery.Error = err.Error()
+	}
+
+	// deliveries cut short by the timeout are still recorded
+	s.logDelivery(context.WithoutCancel(ctx), delivery)
+}
+
+// deliver sends a notification through a notification method, retrying with a doubling delay,
+// and returns the number of attempts made.
+func (s *DependencyTrackerService) deliver(ctx context.Context, method *usage.NotificationMethod, notification *Notification) (int, error) {
+	notifier, ok := s.notifiers()[method.GetType()]
+	if !ok {
+		return 0, fmt.Errorf("unsupported notification method %s", method.GetType())
+	}
+
+	maxAttempts := s.MaxDeliveryAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	delay := s.RetryDelay
+	for attempt := 1; ; attempt++ {
+		err := notifier.Notify(ctx, method.GetUri(), notification)
+		if err == nil || attempt == maxAttempts {
+			return attempt, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// logDelivery records a delivery in the delivery log. Failing to record it does not fail the notification.
+func (s *DependencyTrackerService) logDelivery(ctx context.Context, delivery Delivery) {
+	span := trace.SpanFromContext(ctx)
+
+	av, err := attributevalue.MarshalMap(delivery)
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return
+	}
+
+	if _, err := s.Db.PutItem(ctx, &dynamodb.PutItemInput{Item: av, TableName: aws.String(s.DeliveryTable)}); err != nil {
+		log.Println(err)
+		span.RecordError(err)
+	}
+}
+
+// currentUsage keeps the most recently reported usage entry of every deployment unit, sorted by unit.
+func currentUsage(entries []Usage) []Usage {
+	latest := map[string]Usage{}
+	for _, entry := range entries {
+		unit := strings.SplitN(entry.Consumer, "@", 2)[0]
+		if current, ok := latest[unit]; !ok || reportedAfter(entry, current) {
+			latest[unit] = entry
+		}
+	}
+
+	current := make([]Usage, 0, len(latest))
+	for _, entry := range latest {
+		current = append(current, entry)
+	}
+	sort.Slice(current, func(i, j int) bool {
+		return current[i].Consumer < current[j].Consumer
+	})
+	return current
+}
+
+func reportedAfter(a, b Usage) bool {
+	timeA, errA := time.Parse(storage.TimeFormatLayout, a.ReportedOn)
+	timeB, errB := time.Parse(storage.TimeFormatLayout, b.ReportedOn)
+	if errA != nil || errB != nil {
+		return a.ReportedOn > b.ReportedOn
+	}
+	return timeA.After(timeB)
+}
+
+// isNewer reports whether a released version supersedes the version in use.
+// Versions that cannot be parsed are only compared for equality.
+func isNewer(released, inUse string) bool {
+	releasedVersion, errReleased := versions.ParseVersion(strings.TrimPrefix(released, "v"))
+	inUseVersion, errInUse := versions.ParseVersion(strings.TrimPrefix(inUse, "v"))
+	if errReleased != nil || errInUse != nil {
+		return released != inUse
+	}
+	return releasedVersion.GreaterThan(inUseVersion)
+}
+
+// ListDeliveries returns the delivery log of a deployment unit, oldest first.
+func (s *DependencyTrackerService) ListDeliveries(ctx context.Context, request *services.ListDeliveriesRequest) (*services.ListDeliveriesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("unit.type", request.GetUnit().GetType()),
+		attribute.String("unit.organization", request.GetUnit().GetOrganization()),
+		attribute.String("unit.name", request.GetUnit().GetName()),
+	)
+
+	if !validUnit(request.GetUnit()) {
+		span.RecordError(InvalidDeploymentUnitError)
+		return nil, InvalidDeploymentUnitError
+	}
+
+	expr, err := expression.NewBuilder().WithKeyCondition(expression.Key("unit").Equal(expression.Value(UnitKey(request.GetUnit())))).Build()
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, ListDeliveriesError
+	}
+
+	in := &dynamodb.QueryInput{
+		TableName:                 aws.String(s.DeliveryTable),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+
+	var deliveries []Delivery
+	for {
+		out, err := s.Db.Query(ctx, in)
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, ListDeliveriesError
+		}
+		if out == nil {
+			break
+		}
+
+		var page []Delivery
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &page); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, ListDeliveriesError
+		}
+		deliveries = append(deliveries, page...)
+
+		if len(out.LastEvaluatedKey) == 0 {
+			break
+		}
+		in.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].ID < deliveries[j].ID
+	})
+
+	response := &services.ListDeliveriesResponse{Deliveries: make([]*services.Delivery, 0, len(deliveries))}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, &services.Delivery{
+			Dependency:     delivery.Dependency,
+			Version:        delivery.Version,
+			UnitVersion:    delivery.UnitVersion,
+			CurrentVersion: delivery.CurrentVersion,
+			Method:         &usage.NotificationMethod{Type: delivery.MethodType, Uri: delivery.MethodUri},
+			Status:         delivery.Status,
+			Attempts:       int32(delivery.Attempts),
+			Error:          delivery.Error,
+			AttemptedOn:    delivery.AttemptedOn,
+		})
+	}
+	return response, nil
+}
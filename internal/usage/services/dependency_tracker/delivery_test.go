@@ -0,0 +1,374 @@
+package dependency_tracker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	"github.com/terrariumcloud/terrarium/internal/usage/services"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
+)
+
+// deliveryDynamoDB keeps the delivery log entries written by the service.
+type deliveryDynamoDB struct {
+	*mocks.DynamoDB
+	mu         sync.Mutex
+	deliveries []Delivery
+}
+
+func (db *deliveryDynamoDB) PutItem(ctx context.Context, in *dynamodb.PutItemInput, opts ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if *in.TableName == DeliveriesTableName {
+		delivery := Delivery{}
+		if err := attributevalue.UnmarshalMap(in.Item, &delivery); err != nil {
+			return nil, err
+		}
+		db.deliveries = append(db.deliveries, delivery)
+	}
+	return db.DynamoDB.PutItem(ctx, in, opts...)
+}
+
+// fakeNotifier fails the first failures notifications it is given, and waits for release to be closed when set.
+type fakeNotifier struct {
+	failures      int
+	release       chan struct{}
+	mu            sync.Mutex
+	uris          []string
+	notifications []*Notification
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, uri string, notification *Notification) error {
+	if n.release != nil {
+		select {
+		case <-n.release:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.uris = append(n.uris, uri)
+	n.notifications = append(n.notifications, notification)
+	if len(n.notifications) <= n.failures {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+func marshalItems(t *testing.T, values ...interface{}) []map[string]types.AttributeValue {
+	t.Helper()
+	var items []map[string]types.AttributeValue
+	for _, value := range values {
+		item, err := attributevalue.MarshalMap(value)
+		if err != nil {
+			t.Fatalf("Failed to marshal test data %s", err)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func usageOf(name, unitVersion, version, reportedOn string) Usage {
+	return Usage{
+		Dependency:           "module/cie/vpc/aws",
+		Consumer:             "application/cie/" + name + "@" + unitVersion,
+		Version:              version,
+		ConsumerType:         "application",
+		ConsumerOrganization: "cie",
+		ConsumerName:         name,
+		ConsumerVersion:      unitVersion,
+		ReportedOn:           reportedOn,
+	}
+}
+
+func registeredWith(t *testing.T, notifications ...*usage.NotificationMethod) *dynamodb.GetItemOutput {
+	t.Helper()
+	return &dynamodb.GetItemOutput{Item: marshalItems(t, DeploymentUnit{
+		ID:            UnitKey(testUnit),
+		Type:          testUnit.Type,
+		Organization:  testUnit.Organization,
+		Name:          testUnit.Name,
+		Notifications: notifications,
+	})[0]}
+}
+
+var releaseRequest = &services.NotifyReleaseRequest{
+	Dependency: &usage.DeploymentUnit{Type: "module", Organization: "cie", Name: "vpc/aws"},
+	Version:    "1.1.0",
+}
+
+// Test_NotifyRelease checks:
+// - if units using an older version are notified through every method with their current versions
+// - if failed deliveries are retried until they succeed
+// - if deliveries failing every attempt are logged as failed
+// - if the release is not held up by the delivery of its notifications
+// - if deliveries still pending when the delivery timeout expires are logged as failed
+// - if error is returned when the dependency is incomplete
+// - if error is returned when Query fails
+func Test_NotifyRelease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when units use an older version", func(t *testing.T) {
+		db := &deliveryDynamoDB{DynamoDB: &mocks.DynamoDB{
+			QueryOut: &dynamodb.QueryOutput{Items: marshalItems(t,
+				usageOf("billing", "4.2.0", "1.0.0", "2024-01-03 10:00:00 +0000 UTC"),
+				usageOf("billing", "4.1.0", "0.9.0", "2024-01-02 10:00:00 +0000 UTC"),
+				usageOf("orders", "2.0.0", "1.1.0", "2024-01-02 10:00:00 +0000 UTC"),
+				usageOf("payments", "1.0.0", "0.9.0", "2024-01-02 10:00:00 +0000 UTC"),
+			)},
+			GetItemOuts: []*dynamodb.GetItemOutput{
+				registeredWith(t,
+					&usage.NotificationMethod{Type: WebhookNotification, Uri: "https://hooks.example.com/billing"},
+					&usage.NotificationMethod{Type: ChatNotification, Uri: "https://chat.example.com/billing"},
+				),
+				{},
+			},
+		}}
+		webhook, chat := &fakeNotifier{}, &fakeNotifier{}
+		svc := newTestService(db.DynamoDB)
+		svc.Db = db
+		svc.Notifiers = map[string]Notifier{WebhookNotification: webhook, ChatNotification: chat}
+
+		res, err := svc.NotifyRelease(context.TODO(), releaseRequest)
+		_ = svc.Close()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if res.GetQueued() != 2 {
+			t.Errorf("Expected 2 queued notifications, got %v.", res)
+		}
+
+		if db.GetItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to GetItem, got %v.", db.GetItemInvocations)
+		}
+
+		if len(webhook.notifications) != 1 || len(chat.notifications) != 1 {
+			t.Fatalf("Expected 1 notification per method, got %v and %v.", len(webhook.notifications), len(chat.notifications))
+		}
+
+		expected := Notification{Unit: "application/cie/billing", UnitVersion: "4.2.0", Dependency: "module/cie/vpc/aws", CurrentVersion: "1.0.0", NewVersion: "1.1.0"}
+		if *webhook.notifications[0] != expected {
+			t.Errorf("Expected %v, got %v.", expected, *webhook.notifications[0])
+		}
+
+		if webhook.uris[0] != "https://hooks.example.com/billing" {
+			t.Errorf("Expected the registered uri, got %v.", webhook.uris[0])
+		}
+
+		if len(db.deliveries) != 2 {
+			t.Fatalf("Expected 2 deliveries to be logged, got %v.", len(db.deliveries))
+		}
+
+		for _, delivery := range db.deliveries {
+			if delivery.Status != DeliverySucceeded || delivery.Attempts != 1 || delivery.Unit != "application/cie/billing" {
+				t.Errorf("Expected a first time delivery to billing, got %v.", delivery)
+			}
+		}
+	})
+
+	t.Run("when a delivery succeeds after retries", func(t *testing.T) {
+		db := &deliveryDynamoDB{DynamoDB: &mocks.DynamoDB{
+			QueryOut:    &dynamodb.QueryOutput{Items: marshalItems(t, usageOf("billing", "4.2.0", "1.0.0", ""))},
+			GetItemOuts: []*dynamodb.GetItemOutput{registeredWith(t, &usage.NotificationMethod{Type: WebhookNotification, Uri: "https://hooks.example.com/billing"})},
+		}}
+		webhook := &fakeNotifier{failures: 2}
+		svc := newTestService(db.DynamoDB)
+		svc.Db = db
+		svc.Notifiers = map[string]Notifier{WebhookNotification: webhook}
+		svc.MaxDeliveryAttempts = 3
+
+		_, err := svc.NotifyRelease(context.TODO(), releaseRequest)
+		_ = svc.Close()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(webhook.notifications) != 3 {
+			t.Errorf("Expected 3 attempts, got %v.", len(webhook.notifications))
+		}
+
+		if len(db.deliveries) != 1 || db.deliveries[0].Attempts != 3 || db.deliveries[0].Status != DeliverySucceeded {
+			t.Errorf("Expected a delivery after 3 attempts to be logged, got %v.", db.deliveries)
+		}
+	})
+
+	t.Run("when every attempt fails", func(t *testing.T) {
+		db := &deliveryDynamoDB{DynamoDB: &mocks.DynamoDB{
+			QueryOut:    &dynamodb.QueryOutput{Items: marshalItems(t, usageOf("billing", "4.2.0", "1.0.0", ""))},
+			GetItemOuts: []*dynamodb.GetItemOutput{registeredWith(t, &usage.NotificationMethod{Type: EmailNotification, Uri: "mailto:billing@example.com"})},
+		}}
+		email := &fakeNotifier{failures: 5}
+		svc := newTestService(db.DynamoDB)
+		svc.Db = db
+		svc.Notifiers = map[string]Notifier{EmailNotification: email}
+		svc.MaxDeliveryAttempts = 2
+
+		_, err := svc.NotifyRelease(context.TODO(), releaseRequest)
+		_ = svc.Close()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(db.deliveries) != 1 || db.deliveries[0].Status != DeliveryFailed || db.deliveries[0].Attempts != 2 || db.deliveries[0].Error == "" {
+			t.Errorf("Expected a failed delivery after 2 attempts to be logged, got %v.", db.deliveries)
+		}
+	})
+
+	t.Run("when an endpoint does not answer", func(t *testing.T) {
+		db := &deliveryDynamoDB{DynamoDB: &mocks.DynamoDB{
+			QueryOut:    &dynamodb.QueryOutput{Items: marshalItems(t, usageOf("billing", "4.2.0", "1.0.0", ""))},
+			GetItemOuts: []*dynamodb.GetItemOutput{registeredWith(t, &usage.NotificationMethod{Type: WebhookNotification, Uri: "https://hooks.example.com/billing"})},
+		}}
+		webhook := &fakeNotifier{release: make(chan struct{})}
+		svc := newTestService(db.DynamoDB)
+		svc.Db = db
+		svc.Notifiers = map[string]Notifier{WebhookNotification: webhook}
+		svc.DeliveryTimeout = time.Hour
+
+		ctx, cancel := context.WithCancel(context.Background())
+		res, err := svc.NotifyRelease(ctx, releaseRequest)
+		cancel()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if res.GetQueued() != 1 {
+			t.Errorf("Expected 1 queued notification, got %v.", res)
+		}
+
+		close(webhook.release)
+		_ = svc.Close()
+
+		if len(db.deliveries) != 1 || db.deliveries[0].Status != DeliverySucceeded {
+			t.Errorf("Expected the delivery to outlive the release call, got %v.", db.deliveries)
+		}
+	})
+
+	t.Run("when the delivery timeout expires", func(t *testing.T) {
+		db := &deliveryDynamoDB{DynamoDB: &mocks.DynamoDB{
+			QueryOut:    &dynamodb.QueryOutput{Items: marshalItems(t, usageOf("billing", "4.2.0", "1.0.0", ""))},
+			GetItemOuts: []*dynamodb.GetItemOutput{registeredWith(t, &usage.NotificationMethod{Type: WebhookNotification, Uri: "https://hooks.example.com/billing"})},
+		}}
+		svc := newTestService(db.DynamoDB)
+		svc.Db = db
+		svc.Notifiers = map[string]Notifier{WebhookNotification: &fakeNotifier{release: make(chan struct{})}}
+		svc.DeliveryTimeout = time.Millisecond
+
+		_, err := svc.NotifyRelease(context.TODO(), releaseRequest)
+		_ = svc.Close()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if len(db.deliveries) != 1 || db.deliveries[0].Status != DeliveryFailed {
+			t.Errorf("Expected a failed delivery to be logged, got %v.", db.deliveries)
+		}
+	})
+
+	t.Run("when the dependency is incomplete", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		_, err := newTestService(db).NotifyRelease(context.TODO(), &services.NotifyReleaseRequest{Dependency: releaseRequest.Dependency})
+
+		if err != InvalidDependencyError {
+			t.Errorf("Expected %v, got %v.", InvalidDependencyError, err)
+		}
+	})
+
+	t.Run("when Query fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryError: errors.New("some error")}
+
+		_, err := newTestService(db).NotifyRelease(context.TODO(), releaseRequest)
+
+		if err != NotifyReleaseError {
+			t.Errorf("Expected %v, got %v.", NotifyReleaseError, err)
+		}
+	})
+}
+
+// Test_ListDeliveries checks:
+// - if the delivery log of a unit is returned oldest first
+// - if error is returned when the unit is incomplete
+// - if error is returned when Query fails
+func Test_ListDeliveries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when deliveries are listed", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryOut: &dynamodb.QueryOutput{Items: marshalItems(t,
+			Delivery{Unit: "application/cie/billing", ID: "2024-01-03T10:00:00.000000000Z#module/cie/vpc/aws@1.1.0#0", Version: "1.1.0", MethodType: WebhookNotification, Status: DeliverySucceeded, Attempts: 1},
+			Delivery{Unit: "application/cie/billing", ID: "2024-01-02T10:00:00.000000000Z#module/cie/vpc/aws@1.0.0#0", Version: "1.0.0", MethodType: WebhookNotification, Status: DeliveryFailed, Attempts: 3},
+		)}}
+
+		res, err := newTestService(db).ListDeliveries(context.TODO(), &services.ListDeliveriesRequest{Unit: testUnit})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.TableName != DeliveriesTableName {
+			t.Errorf("Expected tableName to be %v, got %v.", DeliveriesTableName, db.TableName)
+		}
+
+		if len(res.GetDeliveries()) != 2 {
+			t.Fatalf("Expected 2 deliveries, got %v.", len(res.GetDeliveries()))
+		}
+
+		if first := res.GetDeliveries()[0]; first.GetVersion() != "1.0.0" || first.GetAttempts() != 3 || first.GetMethod().GetType() != WebhookNotification {
+			t.Errorf("Expected the delivery of 1.0.0 first, got %v.", first)
+		}
+	})
+
+	t.Run("when the unit is incomplete", func(t *testing.T) {
+		_, err := newTestService(&mocks.DynamoDB{}).ListDeliveries(context.TODO(), &services.ListDeliveriesRequest{})
+
+		if err != InvalidDeploymentUnitError {
+			t.Errorf("Expected %v, got %v.", InvalidDeploymentUnitError, err)
+		}
+	})
+
+	t.Run("when Query fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryError: errors.New("some error")}
+
+		_, err := newTestService(db).ListDeliveries(context.TODO(), &services.ListDeliveriesRequest{Unit: testUnit})
+
+		if err != ListDeliveriesError {
+			t.Errorf("Expected %v, got %v.", ListDeliveriesError, err)
+		}
+	})
+}
+
+// Test_isNewer checks:
+// - if versions are compared semantically, ignoring a v prefix
+// - if versions that cannot be parsed are only compared for equality
+func Test_isNewer(t *testing.T) {
+	tests := []struct {
+		released string
+		inUse    string
+		want     bool
+	}{
+		{"1.10.0", "1.9.0", true},
+		{"v2.0.0", "1.9.0", true},
+		{"1.0.0", "1.0.0", false},
+		{"1.0.1", "1.2.0", false},
+		{"latest", "1.0.0", true},
+		{"latest", "latest", false},
+	}
+	for _, tt := range tests {
+		if got := isNewer(tt.released, tt.inUse); got != tt.want {
+			t.Errorf("isNewer(%s, %s) = %v, want %v", tt.released, tt.inUse, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,471 @@
+package dependency_tracker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/terrariumcloud/terrarium/internal/storage"
+	"github.com/terrariumcloud/terrarium/internal/usage/services"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultDeploymentUnitsTableName  = "terrarium-deployment-units"
+	DefaultUsageTableName            = "terrarium-usage"
+	DefaultDeliveriesTableName       = "terrarium-notification-deliveries"
+	DefaultDependencyTrackerEndpoint = "dependency_tracker:3001"
+	DefaultMaxDeliveryAttempts       = 3
+	DefaultDeliveryRetryDelay        = time.Second
+	DefaultDeliveryTimeout           = 10 * time.Minute
+	DefaultMaxConcurrentDeliveries   = 10
+)
+
+var (
+	DeploymentUnitsTableName  = DefaultDeploymentUnitsTableName
+	UsageTableName            = DefaultUsageTableName
+	DeliveriesTableName       = DefaultDeliveriesTableName
+	DependencyTrackerEndpoint = DefaultDependencyTrackerEndpoint
+	MaxDeliveryAttempts       = DefaultMaxDeliveryAttempts
+	DeliveryRetryDelay        = DefaultDeliveryRetryDelay
+	DeliveryTimeout           = DefaultDeliveryTimeout
+	MaxConcurrentDeliveries   = DefaultMaxConcurrentDeliveries
+
+	DeploymentUnitRegistered = &usage.RegisterDeploymentUnitResponse{}
+	UsageNotified            = &usage.NotifyDependencyResponse{}
+
+	DeploymentUnitsTableInitializationError = status.Error(codes.Unavailable, "Failed to initialize table for deployment units.")
+	UsageTableInitializationError           = status.Error(codes.Unavailable, "Failed to initialize table for usage.")
+	DeliveriesTableInitializationError      = status.Error(codes.Unavailable, "Failed to initialize table for notification deliveries.")
+	InvalidDeploymentUnitError              = status.Error(codes.InvalidArgument, "Deployment unit type, organization and name are required.")
+	InvalidNotificationMethodError          = status.Error(codes.InvalidArgument, "Notification method type and uri are required.")
+	UnsupportedNotificationMethodError      = status.Error(codes.InvalidArgument, "Notification method type must be webhook, email or chat.")
+	InvalidNotificationURIError             = status.Error(codes.InvalidArgument, "Notification uri must be an http or https url to an external host, or a mailto uri for email.")
+	VersionRequiredError                    = status.Error(codes.InvalidArgument, "Deployment unit version is required.")
+	InvalidDependencyError                  = status.Error(codes.InvalidArgument, "Dependency type, organization, name and version are required.")
+	DeploymentUnitNotRegisteredError        = status.Error(codes.NotFound, "Deployment unit is not registered.")
+	MarshalDeploymentUnitError              = status.Error(codes.Unknown, "Failed to marshal deployment unit.")
+	RegisterDeploymentUnitError             = status.Error(codes.Unknown, "Failed to register deployment unit.")
+	GetDeploymentUnitError                  = status.Error(codes.Unknown, "Failed to get deployment unit.")
+	NotifyUsageError                        = status.Error(codes.Unknown, "Failed to record usage.")
+	ListUsageError                          = status.Error(codes.Unknown, "Failed to list usage.")
+	NotifyReleaseError                      = status.Error(codes.Unknown, "Failed to notify release.")
+	ListDeliveriesError                     = status.Error(codes.Unknown, "Failed to list notification deliveries.")
+)
+
+type DependencyTrackerService struct {
+	services.UnimplementedDependencyTrackerServer
+	Db          storage.DynamoDBTableCreator
+	UnitTable   string
+	UnitSchema  *dynamodb.CreateTableInput
+	UsageTable  string
+	UsageSchema *dynamodb.CreateTableInput
+	// DeliveryTable keeps the delivery log of release notifications
+	DeliveryTable       string
+	DeliverySchema      *dynamodb.CreateTableInput
+	Notifiers           map[string]Notifier
+	MaxDeliveryAttempts int
+	RetryDelay          time.Duration
+	// DeliveryTimeout bounds the delivery of the notifications of a release, DefaultDeliveryTimeout when unset
+	DeliveryTimeout time.Duration
+	// MaxConcurrentDeliveries bounds the notifications delivered at once, 1 when unset
+	MaxConcurrentDeliveries int
+
+	// deliveries tracks the releases whose notifications are being delivered
+	deliveries sync.WaitGroup
+}
+
+// DeploymentUnit is a registered consumer of modules and providers, such as an application.
+type DeploymentUnit struct {
+	ID            string                      `json:"id" bson:"id" dynamodbav:"id"`
+	Type          string                      `json:"type" bson:"type" dynamodbav:"type"`
+	Organization  string                      `json:"organization" bson:"organization" dynamodbav:"organization"`
+	Name          string                      `json:"name" bson:"name" dynamodbav:"name"`
+	Notifications []*usage.NotificationMethod `json:"notifications" bson:"notifications" dynamodbav:"notifications"`
+	RegisteredOn  string                      `json:"registered_on" bson:"registered_on" dynamodbav:"registered_on"`
+}
+
+// Usage records that a version of a deployment unit consumes a version of a module or provider.
+// Dependency and Consumer identify the dependency and the consumer version, and make up the key of the entry.
+type Usage struct {
+	Dependency           string `json:"dependency" bson:"dependency" dynamodbav:"dependency"`
+	Consumer             string `json:"consumer" bson:"consumer" dynamodbav:"consumer"`
+	Version              string `json:"version" bson:"version" dynamodbav:"version"`
+	ConsumerType         string `json:"consumer_type" bson:"consumer_type" dynamodbav:"consumer_type"`
+	ConsumerOrganization string `json:"consumer_organization" bson:"consumer_organization" dynamodbav:"consumer_organization"`
+	ConsumerName         string `json:"consumer_name" bson:"consumer_name" dynamodbav:"consumer_name"`
+	ConsumerVersion      string `json:"consumer_version" bson:"consumer_version" dynamodbav:"consumer_version"`
+	ReportedOn           string `json:"reported_on" bson:"reported_on" dynamodbav:"reported_on"`
+}
+
+// RegisterWithServer registers DependencyTrackerService with grpc server
+func (s *DependencyTrackerService) RegisterWithServer(grpcServer grpc.ServiceRegistrar) error {
+	if err := storage.InitializeDynamoDb(s.UnitTable, s.UnitSchema, s.Db); err != nil {
+		log.Println(err)
+		return DeploymentUnitsTableInitializationError
+	}
+
+	if err := storage.InitializeDynamoDb(s.UsageTable, s.UsageSchema, s.Db); err != nil {
+		log.Println(err)
+		return UsageTableInitializationError
+	}
+
+	if err := storage.InitializeDynamoDb(s.DeliveryTable, s.DeliverySchema, s.Db); err != nil {
+		log.Println(err)
+		return DeliveriesTableInitializationError
+	}
+
+	services.RegisterDependencyTrackerServer(grpcServer, s)
+
+	return nil
+}
+
+// UnitKey identifies a deployment unit, module or provider as "<type>/<organization>/<name>".
+func UnitKey(unit *usage.DeploymentUnit) string {
+	return fmt.Sprintf("%s/%s/%s", unit.GetType(), unit.GetOrganization(), unit.GetName())
+}
+
+func validUnit(unit *usage.DeploymentUnit) bool {
+	return unit.GetType() != "" && unit.GetOrganization() != "" && unit.GetName() != ""
+}
+
+// RegisterDeploymentUnit registers a deployment unit, replacing the notification methods of a unit registered before.
+func (s *DependencyTrackerService) RegisterDeploymentUnit(ctx context.Context, request *usage.RegisterDeploymentUnitRequest) (*usage.RegisterDeploymentUnitResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("unit.type", request.GetUnit().GetType()),
+		attribute.String("unit.organization", request.GetUnit().GetOrganization()),
+		attribute.String("unit.name", request.GetUnit().GetName()),
+	)
+
+	if !validUnit(request.GetUnit()) {
+		span.RecordError(InvalidDeploymentUnitError)
+		return nil, InvalidDeploymentUnitError
+	}
+
+	for _, notification := range request.GetNotifications() {
+		if notification.GetType() == "" || notification.GetUri() == "" {
+			span.RecordError(InvalidNotificationMethodError)
+			return nil, InvalidNotificationMethodError
+		}
+		if _, ok := s.notifiers()[notification.GetType()]; !ok {
+			span.RecordError(UnsupportedNotificationMethodError)
+			return nil, UnsupportedNotificationMethodError
+		}
+		if err := validateNotificationURI(notification.GetType(), notification.GetUri()); err != nil {
+			log.Printf("Invalid %s notification uri: %v", notification.GetType(), err)
+			span.RecordError(err)
+			return nil, InvalidNotificationURIError
+		}
+	}
+
+	unit := DeploymentUnit{
+		ID:            UnitKey(request.GetUnit()),
+		Type:          request.GetUnit().GetType(),
+		Organization:  request.GetUnit().GetOrganization(),
+		Name:          request.GetUnit().GetName(),
+		Notifications: request.GetNotifications(),
+		RegisteredOn:  time.Now().UTC().String(),
+	}
+
+	av, err := attributevalue.MarshalMap(unit)
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, MarshalDeploymentUnitError
+	}
+
+	in := &dynamodb.PutItemInput{
+		Item:      av,
+		TableName: aws.String(s.UnitTable),
+	}
+
+	if _, err = s.Db.PutItem(ctx, in); err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, RegisterDeploymentUnitError
+	}
+
+	log.Printf("Deployment unit %s registered.", unit.ID)
+	return DeploymentUnitRegistered, nil
+}
+
+// GetDeploymentUnit returns a registered deployment unit, or nil when the unit is not registered.
+func (s *DependencyTrackerService) GetDeploymentUnit(ctx context.Context, unit *usage.DeploymentUnit) (*DeploymentUnit, error) {
+	in := &dynamodb.GetItemInput{
+		TableName: aws.String(s.UnitTable),
+		Key: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: UnitKey(unit)},
+		},
+	}
+
+	out, err := s.Db.GetItem(ctx, in)
+	if err != nil {
+		log.Println(err)
+		return nil, GetDeploymentUnitError
+	}
+
+	if out == nil || out.Item == nil {
+		return nil, nil
+	}
+
+	registered := &DeploymentUnit{}
+	if err := attributevalue.UnmarshalMap(out.Item, registered); err != nil {
+		log.Println(err)
+		return nil, GetDeploymentUnitError
+	}
+	return registered, nil
+}
+
+// NotifyUsage records the modules and providers used by a version of a registered deployment unit.
+// Reporting a version again updates the entries of the dependencies reported.
+func (s *DependencyTrackerService) NotifyUsage(ctx context.Context, request *usage.NotifyDependencyRequest) (*usage.NotifyDependencyResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	unit := request.GetUnit()
+	span.SetAttributes(
+		attribute.String("unit.type", unit.GetUnit().GetType()),
+		attribute.String("unit.organization", unit.GetUnit().GetOrganization()),
+		attribute.String("unit.name", unit.GetUnit().GetName()),
+		attribute.String("unit.version", unit.GetVersion()),
+	)
+
+	if !validUnit(unit.GetUnit()) {
+		span.RecordError(InvalidDeploymentUnitError)
+		return nil, InvalidDeploymentUnitError
+	}
+
+	if unit.GetVersion() == "" {
+		span.RecordError(VersionRequiredError)
+		return nil, VersionRequiredError
+	}
+
+	for _, dependency := range request.GetDependencies() {
+		if !validUnit(dependency.GetUnit()) || dependency.GetVersion() == "" {
+			span.RecordError(InvalidDependencyError)
+			return nil, InvalidDependencyError
+		}
+	}
+
+	registered, err := s.GetDeploymentUnit(ctx, unit.GetUnit())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if registered == nil {
+		span.RecordError(DeploymentUnitNotRegisteredError)
+		return nil, DeploymentUnitNotRegisteredError
+	}
+
+	reportedOn := time.Now().UTC().String()
+	for _, dependency := range request.GetDependencies() {
+		entry := Usage{
+			Dependency:           UnitKey(dependency.GetUnit()),
+			Consumer:             fmt.Sprintf("%s@%s", UnitKey(unit.GetUnit()), unit.GetVersion()),
+			Version:              dependency.GetVersion(),
+			ConsumerType:         unit.GetUnit().GetType(),
+			ConsumerOrganization: unit.GetUnit().GetOrganization(),
+			ConsumerName:         unit.GetUnit().GetName(),
+			ConsumerVersion:      unit.GetVersion(),
+			ReportedOn:           reportedOn,
+		}
+
+		av, err := attributevalue.MarshalMap(entry)
+		if err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, NotifyUsageError
+		}
+
+		if _, err := s.Db.PutItem(ctx, &dynamodb.PutItemInput{Item: av, TableName: aws.String(s.UsageTable)}); err != nil {
+			log.Println(err)
+			span.RecordError(err)
+			return nil, NotifyUsageError
+		}
+	}
+
+	log.Printf("Usage of %d dependencies recorded for %s@%s.", len(request.GetDependencies()), UnitKey(unit.GetUnit()), unit.GetVersion())
+	return UsageNotified, nil
+}
+
+// ListUsage returns the deployment unit versions consuming a module or provider, sorted by consumer.
+func (s *DependencyTrackerService) ListUsage(ctx context.Context, request *services.ListUsageRequest) (*services.ListUsageResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("dependency.type", request.GetDependency().GetType()),
+		attribute.String("dependency.organization", request.GetDependency().GetOrganization()),
+		attribute.String("dependency.name", request.GetDependency().GetName()),
+		attribute.String("dependency.version", request.GetVersion()),
+	)
+
+	if !validUnit(request.GetDependency()) {
+		span.RecordError(InvalidDependencyError)
+		return nil, InvalidDependencyError
+	}
+
+	entries, err := s.queryUsage(ctx, request.GetDependency(), request.GetVersion())
+	if err != nil {
+		log.Println(err)
+		span.RecordError(err)
+		return nil, ListUsageError
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Consumer < entries[j].Consumer
+	})
+
+	usages := make([]*services.Usage, 0, len(entries))
+	for _, entry := range entries {
+		usages = append(usages, &services.Usage{
+			Consumer: &usage.VersionedDeploymentUnit{
+				Unit: &usage.DeploymentUnit{
+					Type:         entry.ConsumerType,
+					Organization: entry.ConsumerOrganization,
+					Name:         entry.ConsumerName,
+				},
+				Version: entry.ConsumerVersion,
+			},
+			Version:    entry.Version,
+			ReportedOn: entry.ReportedOn,
+		})
+	}
+
+	return &services.ListUsageResponse{Usages: usages}, nil
+}
+
+// queryUsage returns the usage entries of a module or provider, restricted to a version of it when one is given.
+func (s *DependencyTrackerService) queryUsage(ctx context.Context, dependency *usage.DeploymentUnit, version string) ([]Usage, error) {
+	builder := expression.NewBuilder().WithKeyCondition(expression.Key("dependency").Equal(expression.Value(UnitKey(dependency))))
+	if version != "" {
+		builder = builder.WithFilter(expression.Name("version").Equal(expression.Value(version)))
+	}
+	expr, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	in := &dynamodb.QueryInput{
+		TableName:                 aws.String(s.UsageTable),
+		KeyConditionExpression:    expr.KeyCondition(),
+		FilterExpression:          expr.Filter(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+
+	var entries []Usage
+	for {
+		out, err := s.Db.Query(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		if out == nil {
+			break
+		}
+
+		var page []Usage
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &page); err != nil {
+			return nil, err
+		}
+		entries = append(entries, page...)
+
+		if len(out.LastEvaluatedKey) == 0 {
+			break
+		}
+		in.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+
+	return entries, nil
+}
+
+// GetDeploymentUnitsSchema returns CreateTableInput
+// that can be used to create table if it does not exist
+func GetDeploymentUnitsSchema(table string) *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("id"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		TableName:   aws.String(table),
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
+
+// GetUsageSchema returns CreateTableInput
+// that can be used to create table if it does not exist
+func GetUsageSchema(table string) *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("dependency"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("consumer"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("dependency"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("consumer"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		TableName:   aws.String(table),
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
+
+// GetDeliveriesSchema returns CreateTableInput
+// that can be used to create table if it does not exist
+func GetDeliveriesSchema(table string) *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("unit"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("unit"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("id"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		TableName:   aws.String(table),
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
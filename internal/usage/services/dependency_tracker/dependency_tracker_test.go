@@ -0,0 +1,370 @@
+package dependency_tracker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/terrariumcloud/terrarium/internal/storage/mocks"
+	"github.com/terrariumcloud/terrarium/internal/usage/services"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
+	"google.golang.org/grpc"
+)
+
+var testUnit = &usage.DeploymentUnit{Type: "application", Organization: "cie", Name: "billing"}
+
+func newTestService(db *mocks.DynamoDB) *DependencyTrackerService {
+	return &DependencyTrackerService{Db: db, UnitTable: DeploymentUnitsTableName, UsageTable: UsageTableName, DeliveryTable: DeliveriesTableName}
+}
+
+func registeredUnitOutput(t *testing.T) *dynamodb.GetItemOutput {
+	t.Helper()
+	item, err := attributevalue.MarshalMap(DeploymentUnit{ID: UnitKey(testUnit), Type: testUnit.Type, Organization: testUnit.Organization, Name: testUnit.Name})
+	if err != nil {
+		t.Fatalf("Failed to marshal test data %s", err)
+	}
+	return &dynamodb.GetItemOutput{Item: item}
+}
+
+// Test_RegisterDependencyTrackerWithServer checks:
+// - if there was no error with table init
+// - if error is returned when Deployment Units Table initialization fails
+// - if error is returned when Usage Table initialization fails
+// - if error is returned when Deliveries Table initialization fails
+func Test_RegisterDependencyTrackerWithServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when there is no error with table init", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		err := newTestService(db).RegisterWithServer(grpc.NewServer())
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if db.DescribeTableInvocations != 3 {
+			t.Errorf("Expected 3 calls to DescribeTable, got %v.", db.DescribeTableInvocations)
+		}
+	})
+
+	t.Run("when Deployment Units Table initialization fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			DescribeTableErrors: []error{errors.New("some error")},
+			CreateTableError:    errors.New("some error"),
+		}
+
+		err := newTestService(db).RegisterWithServer(grpc.NewServer())
+
+		if err != DeploymentUnitsTableInitializationError {
+			t.Errorf("Expected %v, got %v.", DeploymentUnitsTableInitializationError, err)
+		}
+	})
+
+	t.Run("when Usage Table initialization fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			DescribeTableErrors: []error{nil, errors.New("some error")},
+			CreateTableError:    errors.New("some error"),
+		}
+
+		err := newTestService(db).RegisterWithServer(grpc.NewServer())
+
+		if err != UsageTableInitializationError {
+			t.Errorf("Expected %v, got %v.", UsageTableInitializationError, err)
+		}
+	})
+
+	t.Run("when Deliveries Table initialization fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			DescribeTableErrors: []error{nil, nil, errors.New("some error")},
+			CreateTableError:    errors.New("some error"),
+		}
+
+		err := newTestService(db).RegisterWithServer(grpc.NewServer())
+
+		if err != DeliveriesTableInitializationError {
+			t.Errorf("Expected %v, got %v.", DeliveriesTableInitializationError, err)
+		}
+	})
+}
+
+// Test_RegisterDeploymentUnit checks:
+// - if correct response is returned when the deployment unit is registered
+// - if error is returned when the deployment unit is incomplete
+// - if error is returned when a notification method is incomplete
+// - if error is returned when a notification method type is not supported
+// - if error is returned when a notification uri targets an internal address
+// - if error is returned when PutItem fails
+func Test_RegisterDeploymentUnit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the deployment unit is registered", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		res, err := newTestService(db).RegisterDeploymentUnit(context.TODO(), &usage.RegisterDeploymentUnitRequest{
+			Unit:          testUnit,
+			Notifications: []*usage.NotificationMethod{{Type: "webhook", Uri: "https://hooks.example.com/billing"}},
+		})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if res != DeploymentUnitRegistered {
+			t.Errorf("Expected %v, got %v.", DeploymentUnitRegistered, res)
+		}
+
+		if db.PutItemInvocations != 1 {
+			t.Errorf("Expected 1 call to PutItem, got %v.", db.PutItemInvocations)
+		}
+
+		if db.TableName != DeploymentUnitsTableName {
+			t.Errorf("Expected tableName to be %v, got %v.", DeploymentUnitsTableName, db.TableName)
+		}
+	})
+
+	t.Run("when the deployment unit is incomplete", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		_, err := newTestService(db).RegisterDeploymentUnit(context.TODO(), &usage.RegisterDeploymentUnitRequest{
+			Unit: &usage.DeploymentUnit{Type: "application", Name: "billing"},
+		})
+
+		if err != InvalidDeploymentUnitError {
+			t.Errorf("Expected %v, got %v.", InvalidDeploymentUnitError, err)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when a notification method is incomplete", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		_, err := newTestService(db).RegisterDeploymentUnit(context.TODO(), &usage.RegisterDeploymentUnitRequest{
+			Unit:          testUnit,
+			Notifications: []*usage.NotificationMethod{{Type: "webhook"}},
+		})
+
+		if err != InvalidNotificationMethodError {
+			t.Errorf("Expected %v, got %v.", InvalidNotificationMethodError, err)
+		}
+	})
+
+	t.Run("when a notification method type is not supported", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		_, err := newTestService(db).RegisterDeploymentUnit(context.TODO(), &usage.RegisterDeploymentUnitRequest{
+			Unit:          testUnit,
+			Notifications: []*usage.NotificationMethod{{Type: "pager", Uri: "https://pager.example.com"}},
+		})
+
+		if err != UnsupportedNotificationMethodError {
+			t.Errorf("Expected %v, got %v.", UnsupportedNotificationMethodError, err)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when a notification uri targets an internal address", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		_, err := newTestService(db).RegisterDeploymentUnit(context.TODO(), &usage.RegisterDeploymentUnitRequest{
+			Unit:          testUnit,
+			Notifications: []*usage.NotificationMethod{{Type: "webhook", Uri: "http://169.254.169.254/latest/meta-data"}},
+		})
+
+		if err != InvalidNotificationURIError {
+			t.Errorf("Expected %v, got %v.", InvalidNotificationURIError, err)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when PutItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{PutItemError: errors.New("some error")}
+
+		res, err := newTestService(db).RegisterDeploymentUnit(context.TODO(), &usage.RegisterDeploymentUnitRequest{Unit: testUnit})
+
+		if res != nil {
+			t.Errorf("Expected no response, got %v.", res)
+		}
+
+		if err != RegisterDeploymentUnitError {
+			t.Errorf("Expected %v, got %v.", RegisterDeploymentUnitError, err)
+		}
+	})
+}
+
+// Test_NotifyUsage checks:
+// - if an entry is recorded for every dependency of a registered unit
+// - if error is returned when the unit is not registered
+// - if error is returned when the unit version is missing
+// - if error is returned when a dependency is incomplete
+// - if error is returned when PutItem fails
+func Test_NotifyUsage(t *testing.T) {
+	t.Parallel()
+
+	request := func() *usage.NotifyDependencyRequest {
+		return &usage.NotifyDependencyRequest{
+			Unit: &usage.VersionedDeploymentUnit{Unit: testUnit, Version: "4.2.0"},
+			Dependencies: []*usage.VersionedDeploymentUnit{
+				{Unit: &usage.DeploymentUnit{Type: "module", Organization: "cie", Name: "vpc/aws"}, Version: "1.0.0"},
+				{Unit: &usage.DeploymentUnit{Type: "provider", Organization: "cie", Name: "ldap"}, Version: "0.3.1"},
+			},
+		}
+	}
+
+	t.Run("when usage is recorded", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{registeredUnitOutput(t)}}
+
+		res, err := newTestService(db).NotifyUsage(context.TODO(), request())
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if res != UsageNotified {
+			t.Errorf("Expected %v, got %v.", UsageNotified, res)
+		}
+
+		if db.PutItemInvocations != 2 {
+			t.Errorf("Expected 2 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+
+		if db.TableName != UsageTableName {
+			t.Errorf("Expected tableName to be %v, got %v.", UsageTableName, db.TableName)
+		}
+	})
+
+	t.Run("when the unit is not registered", func(t *testing.T) {
+		db := &mocks.DynamoDB{GetItemOuts: []*dynamodb.GetItemOutput{{}}}
+
+		_, err := newTestService(db).NotifyUsage(context.TODO(), request())
+
+		if err != DeploymentUnitNotRegisteredError {
+			t.Errorf("Expected %v, got %v.", DeploymentUnitNotRegisteredError, err)
+		}
+
+		if db.PutItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to PutItem, got %v.", db.PutItemInvocations)
+		}
+	})
+
+	t.Run("when the unit version is missing", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		req := request()
+		req.Unit.Version = ""
+
+		_, err := newTestService(db).NotifyUsage(context.TODO(), req)
+
+		if err != VersionRequiredError {
+			t.Errorf("Expected %v, got %v.", VersionRequiredError, err)
+		}
+	})
+
+	t.Run("when a dependency is incomplete", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+		req := request()
+		req.Dependencies[1].Version = ""
+
+		_, err := newTestService(db).NotifyUsage(context.TODO(), req)
+
+		if err != InvalidDependencyError {
+			t.Errorf("Expected %v, got %v.", InvalidDependencyError, err)
+		}
+
+		if db.GetItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to GetItem, got %v.", db.GetItemInvocations)
+		}
+	})
+
+	t.Run("when PutItem fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{
+			GetItemOuts:  []*dynamodb.GetItemOutput{registeredUnitOutput(t)},
+			PutItemError: errors.New("some error"),
+		}
+
+		_, err := newTestService(db).NotifyUsage(context.TODO(), request())
+
+		if err != NotifyUsageError {
+			t.Errorf("Expected %v, got %v.", NotifyUsageError, err)
+		}
+	})
+}
+
+// Test_ListUsage checks:
+// - if consumers are returned sorted with the version they use
+// - if error is returned when the dependency is incomplete
+// - if error is returned when Query fails
+func Test_ListUsage(t *testing.T) {
+	t.Parallel()
+
+	dependency := &usage.DeploymentUnit{Type: "module", Organization: "cie", Name: "vpc/aws"}
+
+	t.Run("when usage is listed", func(t *testing.T) {
+		var items []map[string]types.AttributeValue
+		for _, entry := range []Usage{
+			{Dependency: "module/cie/vpc/aws", Consumer: "application/cie/orders@2.0.0", Version: "1.1.0", ConsumerType: "application", ConsumerOrganization: "cie", ConsumerName: "orders", ConsumerVersion: "2.0.0"},
+			{Dependency: "module/cie/vpc/aws", Consumer: "application/cie/billing@4.2.0", Version: "1.0.0", ConsumerType: "application", ConsumerOrganization: "cie", ConsumerName: "billing", ConsumerVersion: "4.2.0"},
+		} {
+			item, err := attributevalue.MarshalMap(entry)
+			if err != nil {
+				t.Fatalf("Failed to marshal test data %s", err)
+			}
+			items = append(items, item)
+		}
+		db := &mocks.DynamoDB{QueryOut: &dynamodb.QueryOutput{Items: items}}
+
+		res, err := newTestService(db).ListUsage(context.TODO(), &services.ListUsageRequest{Dependency: dependency})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v.", err)
+		}
+
+		if db.TableName != UsageTableName {
+			t.Errorf("Expected tableName to be %v, got %v.", UsageTableName, db.TableName)
+		}
+
+		if len(res.GetUsages()) != 2 {
+			t.Fatalf("Expected 2 usages, got %v.", len(res.GetUsages()))
+		}
+
+		first := res.GetUsages()[0]
+		if first.GetConsumer().GetUnit().GetName() != "billing" || first.GetConsumer().GetVersion() != "4.2.0" || first.GetVersion() != "1.0.0" {
+			t.Errorf("Expected billing 4.2.0 using 1.0.0 first, got %v.", first)
+		}
+	})
+
+	t.Run("when the dependency is incomplete", func(t *testing.T) {
+		db := &mocks.DynamoDB{}
+
+		_, err := newTestService(db).ListUsage(context.TODO(), &services.ListUsageRequest{Dependency: &usage.DeploymentUnit{Type: "module"}})
+
+		if err != InvalidDependencyError {
+			t.Errorf("Expected %v, got %v.", InvalidDependencyError, err)
+		}
+
+		if db.QueryItemInvocations != 0 {
+			t.Errorf("Expected 0 calls to Query, got %v.", db.QueryItemInvocations)
+		}
+	})
+
+	t.Run("when Query fails", func(t *testing.T) {
+		db := &mocks.DynamoDB{QueryError: errors.New("some error")}
+
+		_, err := newTestService(db).ListUsage(context.TODO(), &services.ListUsageRequest{Dependency: dependency, Version: "1.0.0"})
+
+		if err != ListUsageError {
+			t.Errorf("Expected %v, got %v.", ListUsageError, err)
+		}
+	})
+}
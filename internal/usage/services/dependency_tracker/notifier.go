@@ -0,0 +1,241 @@
+package dependency_tracker
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	WebhookNotification = "webhook"
+	EmailNotification   = "email"
+	ChatNotification    = "chat"
+
+	DefaultSMTPServerAddress   = "localhost:1025"
+	DefaultNotificationSender  = "terrarium@localhost"
+	DefaultNotificationTimeout = 10 * time.Second
+)
+
+var (
+	SMTPServerAddress   = DefaultSMTPServerAddress
+	NotificationSender  = DefaultNotificationSender
+	NotificationTimeout = DefaultNotificationTimeout
+
+	ErrInternalAddress = errors.New("notification target is a loopback, private or link-local address")
+)
+
+// Notification tells a deployment unit that a module or provider it uses has a new release.
+type Notification struct {
+	Unit           string `json:"unit"`
+	UnitVersion    string `json:"unit_version"`
+	Dependency     string `json:"dependency"`
+	CurrentVersion string `json:"current_version"`
+	NewVersion     string `json:"new_version"`
+}
+
+// Subject is a one line summary of the notification.
+func (n *Notification) Subject() string {
+	return fmt.Sprintf("New release of %s: %s", n.Dependency, n.NewVersion)
+}
+
+// Message describes the release and the version of the dependency the unit currently uses.
+func (n *Notification) Message() string {
+	return fmt.Sprintf("%s %s uses %s %s, version %s has been released.", n.Unit, n.UnitVersion, n.Dependency, n.CurrentVersion, n.NewVersion)
+}
+
+// Notifier delivers notifications to the uri of a notification method.
+type Notifier interface {
+	Notify(ctx context.Context, uri string, notification *Notification) error
+}
+
+// DefaultNotifiers returns the notifiers of the supported notification method types. Every attempt is bounded by
+// NotificationTimeout, and webhooks are refused when they resolve to a loopback, private or link-local address.
+func DefaultNotifiers() map[string]Notifier {
+	client := newNotificationClient(NotificationTimeout)
+	return map[string]Notifier{
+		WebhookNotification: &WebhookNotifier{Client: client},
+		EmailNotification:   &EmailNotifier{Address: SMTPServerAddress, From: NotificationSender, Timeout: NotificationTimeout},
+		ChatNotification:    &ChatNotifier{Client: client},
+	}
+}
+
+// newNotificationClient returns an HTTP client that gives up after timeout and does not connect to loopback, private
+// or link-local addresses, whatever the host of the uri resolves to.
+func newNotificationClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip != nil && internalIP(ip) {
+				return ErrInternalAddress
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598, which is not routed on the internet either.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func internalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || sharedAddressSpace.Contains(ip) ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
+}
+
+// validateNotificationURI checks the uri of a notification method. Webhook and chat uris must be http or https urls
+// whose host is not a loopback, private or link-local address, and email uris mailto uris of a single address.
+func validateNotificationURI(method, uri string) error {
+	if method == EmailNotification {
+		address := strings.TrimPrefix(uri, "mailto:")
+		if address == uri {
+			return fmt.Errorf("email notification uri %q is not a mailto uri", uri)
+		}
+		if parsed, err := mail.ParseAddress(address); err != nil || parsed.Name != "" || parsed.Address != address {
+			return fmt.Errorf("email notification uri %q is not a valid email address", uri)
+		}
+		return nil
+	}
+
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("notification uri %q is not an http or https url", uri)
+	}
+	host := parsed.Hostname()
+	if host == "" {
+		return fmt.Errorf("notification uri %q has no host", uri)
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return ErrInternalAddress
+	}
+	if ip := net.ParseIP(host); ip != nil && internalIP(ip) {
+		return ErrInternalAddress
+	}
+	return nil
+}
+
+// WebhookNotifier posts the notification as JSON.
+type WebhookNotifier struct {
+	Client *http.Client
+}
+
+func (w *WebhookNotifier) Notify(ctx context.Context, uri string, notification *Notification) error {
+	return postJSON(ctx, w.Client, uri, notification)
+}
+
+// ChatNotifier posts the notification message to a chat incoming webhook.
+type ChatNotifier struct {
+	Client *http.Client
+}
+
+func (c *ChatNotifier) Notify(ctx context.Context, uri string, notification *Notification) error {
+	return postJSON(ctx, c.Client, uri, map[string]string{"text": notification.Message()})
+}
+
+// EmailNotifier sends the notification through an SMTP server to the address of a mailto uri.
+// The exchange with the server is bounded by Timeout, when set, and by the deadline of the context.
+type EmailNotifier struct {
+	Address string
+	From    string
+	Timeout time.Duration
+}
+
+func (e *EmailNotifier) Notify(ctx context.Context, uri string, notification *Notification) error {
+	to := strings.TrimPrefix(uri, "mailto:")
+	message := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n", e.From, to, notification.Subject(), notification.Message())
+
+	if e.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.Timeout)
+		defer cancel()
+	}
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", e.Address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	host, _, _ := net.SplitHostPort(e.Address)
+	return sendMail(conn, host, e.From, to, []byte(message))
+}
+
+// sendMail sends a message over an established connection, as smtp.SendMail does without authentication.
+func sendMail(conn net.Conn, host, from, to string, message []byte) error {
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func postJSON(ctx context.Context, client *http.Client, uri string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("notification to %s failed with status %s", uri, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package dependency_tracker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNotification = &Notification{
+	Unit:           "application/cie/billing",
+	UnitVersion:    "4.2.0",
+	Dependency:     "module/cie/vpc/aws",
+	CurrentVersion: "1.0.0",
+	NewVersion:     "1.1.0",
+}
+
+// smtpStandIn accepts a single message over SMTP and sends its data on the returned channel.
+func smtpStandIn(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	messages := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+		reply("220 localhost")
+
+		var data strings.Builder
+		inData := false
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if inData {
+				if line == ".\r\n" {
+					inData = false
+					messages <- data.String()
+					reply("250 OK")
+				} else {
+					data.WriteString(line)
+				}
+				continue
+			}
+			switch command := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(command, "DATA"):
+				inData = true
+				reply("354 Go ahead")
+			case strings.HasPrefix(command, "QUIT"):
+				reply("221 Bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), messages
+}
+
+// Test_WebhookNotifier checks:
+// - if the notification is posted as JSON
+// - if error is returned when the webhook does not accept it
+func Test_WebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the webhook accepts the notification", func(t *testing.T) {
+		var received Notification
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("Failed to decode notification: %v", err)
+			}
+		}))
+		defer server.Close()
+
+		err := (&WebhookNotifier{Client: server.Client()}).Notify(context.TODO(), server.URL, testNotification)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v.", err)
+		}
+
+		if received != *testNotification {
+			t.Errorf("Expected %v, got %v.", *testNotification, received)
+		}
+	})
+
+	t.Run("when the webhook fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		err := (&WebhookNotifier{Client: server.Client()}).Notify(context.TODO(), server.URL, testNotification)
+
+		if err == nil {
+			t.Errorf("Expected error, got nil.")
+		}
+	})
+}
+
+// Test_ChatNotifier checks:
+// - if the message, with the current and new versions, is posted as chat text
+func Test_ChatNotifier(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("Failed to decode message: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	err := (&ChatNotifier{Client: server.Client()}).Notify(context.TODO(), server.URL, testNotification)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v.", err)
+	}
+
+	expected := "application/cie/billing 4.2.0 uses module/cie/vpc/aws 1.0.0, version 1.1.0 has been released."
+	if received["text"] != expected {
+		t.Errorf("Expected %q, got %q.", expected, received["text"])
+	}
+}
+
+// Test_EmailNotifier checks:
+// - if the notification is mailed to the address of the mailto uri through the SMTP server
+func Test_EmailNotifier(t *testing.T) {
+	t.Parallel()
+
+	address, messages := smtpStandIn(t)
+
+	err := (&EmailNotifier{Address: address, From: "terrarium@example.com"}).Notify(context.TODO(), "mailto:billing@example.com", testNotification)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v.", err)
+	}
+
+	message := <-messages
+	for _, expected := range []string{"To: billing@example.com", "Subject: New release of module/cie/vpc/aws: 1.1.0", testNotification.Message()} {
+		if !strings.Contains(message, expected) {
+			t.Errorf("Expected message to contain %q, got %q.", expected, message)
+		}
+	}
+}
+
+// Test_EmailNotifier_timeout checks:
+// - if error is returned when the SMTP server does not answer in time
+func Test_EmailNotifier_timeout(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			// never greets
+			defer conn.Close()
+			time.Sleep(time.Second)
+		}
+	}()
+
+	err = (&EmailNotifier{Address: listener.Addr().String(), From: "terrarium@example.com", Timeout: 50 * time.Millisecond}).Notify(context.TODO(), "mailto:billing@example.com", testNotification)
+
+	if err == nil {
+		t.Errorf("Expected error, got nil.")
+	}
+}
+
+// Test_newNotificationClient checks:
+// - if the client does not connect to loopback addresses
+func Test_newNotificationClient(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	err := (&WebhookNotifier{Client: newNotificationClient(time.Second)}).Notify(context.TODO(), server.URL, testNotification)
+
+	if !errors.Is(err, ErrInternalAddress) {
+		t.Errorf("Expected %v, got %v.", ErrInternalAddress, err)
+	}
+}
+
+func Test_validateNotificationURI(t *testing.T) {
+	tests := []struct {
+		method string
+		uri    string
+		valid  bool
+	}{
+		{WebhookNotification, "https://hooks.example.com/billing", true},
+		{ChatNotification, "http://100.128.0.1:8080/chat", true},
+		{ChatNotification, "http://10.1.2.3:8080/chat", false},
+		{WebhookNotification, "http://192.168.1.1/hook", false},
+		{WebhookNotification, "http://100.64.1.2/hook", false},
+		{WebhookNotification, "http://[fd00::1]/hook", false},
+		{WebhookNotification, "ftp://hooks.example.com", false},
+		{WebhookNotification, "file:///etc/passwd", false},
+		{WebhookNotification, "http://localhost:8080/hook", false},
+		{WebhookNotification, "http://127.0.0.1/hook", false},
+		{ChatNotification, "http://[::1]/chat", false},
+		{WebhookNotification, "http://169.254.169.254/latest/meta-data", false},
+		{EmailNotification, "mailto:billing@example.com", true},
+		{EmailNotification, "billing@example.com", false},
+		{EmailNotification, "mailto:", false},
+		{EmailNotification, "mailto:billing", false},
+		{EmailNotification, "mailto:Billing <billing@example.com>", false},
+		{EmailNotification, "mailto:billing@example.com,ops@example.com", false},
+	}
+	for _, tt := range tests {
+		if err := validateNotificationURI(tt.method, tt.uri); (err == nil) != tt.valid {
+			t.Errorf("validateNotificationURI(%q, %q) = %v, want valid %v", tt.method, tt.uri, err, tt.valid)
+		}
+	}
+}
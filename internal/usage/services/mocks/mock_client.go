@@ -0,0 +1,64 @@
+package usageMocks
+
+import (
+	"context"
+
+	"github.com/terrariumcloud/terrarium/internal/usage/services"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
+
+	"google.golang.org/grpc"
+)
+
+type MockDependencyTrackerClient struct {
+	services.DependencyTrackerClient
+	RegisterDeploymentUnitInvocations int
+	RegisterDeploymentUnitRequest     *usage.RegisterDeploymentUnitRequest
+	RegisterDeploymentUnitResponse    *usage.RegisterDeploymentUnitResponse
+	RegisterDeploymentUnitError       error
+	NotifyUsageInvocations            int
+	NotifyUsageRequest                *usage.NotifyDependencyRequest
+	NotifyUsageResponse               *usage.NotifyDependencyResponse
+	NotifyUsageError                  error
+	ListUsageInvocations              int
+	ListUsageRequest                  *services.ListUsageRequest
+	ListUsageResponse                 *services.ListUsageResponse
+	ListUsageError                    error
+	NotifyReleaseInvocations          int
+	NotifyReleaseRequest              *services.NotifyReleaseRequest
+	NotifyReleaseResponse             *services.NotifyReleaseResponse
+	NotifyReleaseError                error
+	ListDeliveriesInvocations         int
+	ListDeliveriesRequest             *services.ListDeliveriesRequest
+	ListDeliveriesResponse            *services.ListDeliveriesResponse
+	ListDeliveriesError               error
+}
+
+func (m *MockDependencyTrackerClient) RegisterDeploymentUnit(ctx context.Context, in *usage.RegisterDeploymentUnitRequest, opts ...grpc.CallOption) (*usage.RegisterDeploymentUnitResponse, error) {
+	m.RegisterDeploymentUnitInvocations++
+	m.RegisterDeploymentUnitRequest = in
+	return m.RegisterDeploymentUnitResponse, m.RegisterDeploymentUnitError
+}
+
+func (m *MockDependencyTrackerClient) NotifyUsage(ctx context.Context, in *usage.NotifyDependencyRequest, opts ...grpc.CallOption) (*usage.NotifyDependencyResponse, error) {
+	m.NotifyUsageInvocations++
+	m.NotifyUsageRequest = in
+	return m.NotifyUsageResponse, m.NotifyUsageError
+}
+
+func (m *MockDependencyTrackerClient) ListUsage(ctx context.Context, in *services.ListUsageRequest, opts ...grpc.CallOption) (*services.ListUsageResponse, error) {
+	m.ListUsageInvocations++
+	m.ListUsageRequest = in
+	return m.ListUsageResponse, m.ListUsageError
+}
+
+func (m *MockDependencyTrackerClient) NotifyRelease(ctx context.Context, in *services.NotifyReleaseRequest, opts ...grpc.CallOption) (*services.NotifyReleaseResponse, error) {
+	m.NotifyReleaseInvocations++
+	m.NotifyReleaseRequest = in
+	return m.NotifyReleaseResponse, m.NotifyReleaseError
+}
+
+func (m *MockDependencyTrackerClient) ListDeliveries(ctx context.Context, in *services.ListDeliveriesRequest, opts ...grpc.CallOption) (*services.ListDeliveriesResponse, error) {
+	m.ListDeliveriesInvocations++
+	m.ListDeliveriesRequest = in
+	return m.ListDeliveriesResponse, m.ListDeliveriesError
+}
@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
+// source: pb/terrarium/usage/services/usage.proto
+
+package services
+
+import (
+	context "context"
+	usage "github.com/terrariumcloud/terrarium/pkg/terrarium/usage"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DependencyTracker_RegisterDeploymentUnit_FullMethodName = "/terrarium.usage.services.DependencyTracker/RegisterDeploymentUnit"
+	DependencyTracker_NotifyUsage_FullMethodName            = "/terrarium.usage.services.DependencyTracker/NotifyUsage"
+	DependencyTracker_ListUsage_FullMethodName              = "/terrarium.usage.services.DependencyTracker/ListUsage"
+	DependencyTracker_NotifyRelease_FullMethodName          = "/terrarium.usage.services.DependencyTracker/NotifyRelease"
+	DependencyTracker_ListDeliveries_FullMethodName         = "/terrarium.usage.services.DependencyTracker/ListDeliveries"
+)
+
+// DependencyTrackerClient is the client API for DependencyTracker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DependencyTrackerClient interface {
+	RegisterDeploymentUnit(ctx context.Context, in *usage.RegisterDeploymentUnitRequest, opts ...grpc.CallOption) (*usage.RegisterDeploymentUnitResponse, error)
+	NotifyUsage(ctx context.Context, in *usage.NotifyDependencyRequest, opts ...grpc.CallOption) (*usage.NotifyDependencyResponse, error)
+	ListUsage(ctx context.Context, in *ListUsageRequest, opts ...grpc.CallOption) (*ListUsageResponse, error)
+	NotifyRelease(ctx context.Context, in *NotifyReleaseRequest, opts ...grpc.CallOption) (*NotifyReleaseResponse, error)
+	ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error)
+}
+
+type dependencyTrackerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDependencyTrackerClient(cc grpc.ClientConnInterface) DependencyTrackerClient {
+	return &dependencyTrackerClient{cc}
+}
+
+func (c *dependencyTrackerClient) RegisterDeploymentUnit(ctx context.Context, in *usage.RegisterDeploymentUnitRequest, opts ...grpc.CallOption) (*usage.RegisterDeploymentUnitResponse, error) {
+	out := new(usage.RegisterDeploymentUnitResponse)
+	err := c.cc.Invoke(ctx, DependencyTracker_RegisterDeploymentUnit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyTrackerClient) NotifyUsage(ctx context.Context, in *usage.NotifyDependencyRequest, opts ...grpc.CallOption) (*usage.NotifyDependencyResponse, error) {
+	out := new(usage.NotifyDependencyResponse)
+	err := c.cc.Invoke(ctx, DependencyTracker_NotifyUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyTrackerClient) ListUsage(ctx context.Context, in *ListUsageRequest, opts ...grpc.CallOption) (*ListUsageResponse, error) {
+	out := new(ListUsageResponse)
+	err := c.cc.Invoke(ctx, DependencyTracker_ListUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyTrackerClient) NotifyRelease(ctx context.Context, in *NotifyReleaseRequest, opts ...grpc.CallOption) (*NotifyReleaseResponse, error) {
+	out := new(NotifyReleaseResponse)
+	err := c.cc.Invoke(ctx, DependencyTracker_NotifyRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependencyTrackerClient) ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error) {
+	out := new(ListDeliveriesResponse)
+	err := c.cc.Invoke(ctx, DependencyTracker_ListDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DependencyTrackerServer is the server API for DependencyTracker service.
+// All implementations must embed UnimplementedDependencyTrackerServer
+// for forward compatibility
+type DependencyTrackerServer interface {
+	RegisterDeploymentUnit(context.Context, *usage.RegisterDeploymentUnitRequest) (*usage.RegisterDeploymentUnitResponse, error)
+	NotifyUsage(context.Context, *usage.NotifyDependencyRequest) (*usage.NotifyDependencyResponse, error)
+	ListUsage(context.Context, *ListUsageRequest) (*ListUsageResponse, error)
+	NotifyRelease(context.Context, *NotifyReleaseRequest) (*NotifyReleaseResponse, error)
+	ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error)
+	mustEmbedUnimplementedDependencyTrackerServer()
+}
+
+// UnimplementedDependencyTrackerServer must be embedded to have forward compatible implementations.
+type UnimplementedDependencyTrackerServer struct {
+}
+
+func (UnimplementedDependencyTrackerServer) RegisterDeploymentUnit(context.Context, *usage.RegisterDeploymentUnitRequest) (*usage.RegisterDeploymentUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDeploymentUnit not implemented")
+}
+func (UnimplementedDependencyTrackerServer) NotifyUsage(context.Context, *usage.NotifyDependencyRequest) (*usage.NotifyDependencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyUsage not implemented")
+}
+func (UnimplementedDependencyTrackerServer) ListUsage(context.Context, *ListUsageRequest) (*ListUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsage not implemented")
+}
+func (UnimplementedDependencyTrackerServer) NotifyRelease(context.Context, *NotifyReleaseRequest) (*NotifyReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyRelease not implemented")
+}
+func (UnimplementedDependencyTrackerServer) ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
+func (UnimplementedDependencyTrackerServer) mustEmbedUnimplementedDependencyTrackerServer() {}
+
+// UnsafeDependencyTrackerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DependencyTrackerServer will
+// result in compilation errors.
+type UnsafeDependencyTrackerServer interface {
+	mustEmbedUnimplementedDependencyTrackerServer()
+}
+
+func RegisterDependencyTrackerServer(s grpc.ServiceRegistrar, srv DependencyTrackerServer) {
+	s.RegisterService(&DependencyTracker_ServiceDesc, srv)
+}
+
+func _DependencyTracker_RegisterDeploymentUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(usage.RegisterDeploymentUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyTrackerServer).RegisterDeploymentUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyTracker_RegisterDeploymentUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyTrackerServer).RegisterDeploymentUnit(ctx, req.(*usage.RegisterDeploymentUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyTracker_NotifyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(usage.NotifyDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyTrackerServer).NotifyUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyTracker_NotifyUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyTrackerServer).NotifyUsage(ctx, req.(*usage.NotifyDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyTracker_ListUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyTrackerServer).ListUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyTracker_ListUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyTrackerServer).ListUsage(ctx, req.(*ListUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyTracker_NotifyRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyTrackerServer).NotifyRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyTracker_NotifyRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyTrackerServer).NotifyRelease(ctx, req.(*NotifyReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependencyTracker_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependencyTrackerServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependencyTracker_ListDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependencyTrackerServer).ListDeliveries(ctx, req.(*ListDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DependencyTracker_ServiceDesc is the grpc.ServiceDesc for DependencyTracker service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DependencyTracker_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "terrarium.usage.services.DependencyTracker",
+	HandlerType: (*DependencyTrackerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterDeploymentUnit",
+			Handler:    _DependencyTracker_RegisterDeploymentUnit_Handler,
+		},
+		{
+			MethodName: "NotifyUsage",
+			Handler:    _DependencyTracker_NotifyUsage_Handler,
+		},
+		{
+			MethodName: "ListUsage",
+			Handler:    _DependencyTracker_ListUsage_Handler,
+		},
+		{
+			MethodName: "NotifyRelease",
+			Handler:    _DependencyTracker_NotifyRelease_Handler,
+		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _DependencyTracker_ListDeliveries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pb/terrarium/usage/services/usage.proto",
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.31.0
+// 	protoc-gen-go v1.35.1
 // 	protoc        v4.24.4
 // source: pb/terrarium/module/module.proto
 
@@ -84,6 +84,52 @@ func (Maturity) EnumDescriptor() ([]byte, []int) {
 	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{0}
 }
 
+type SBOMFormat int32
+
+const (
+	SBOMFormat_CYCLONEDX SBOMFormat = 0
+	SBOMFormat_SPDX      SBOMFormat = 1
+)
+
+// Enum value maps for SBOMFormat.
+var (
+	SBOMFormat_name = map[int32]string{
+		0: "CYCLONEDX",
+		1: "SPDX",
+	}
+	SBOMFormat_value = map[string]int32{
+		"CYCLONEDX": 0,
+		"SPDX":      1,
+	}
+)
+
+func (x SBOMFormat) Enum() *SBOMFormat {
+	p := new(SBOMFormat)
+	*p = x
+	return p
+}
+
+func (x SBOMFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SBOMFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_terrarium_module_module_proto_enumTypes[1].Descriptor()
+}
+
+func (SBOMFormat) Type() protoreflect.EnumType {
+	return &file_pb_terrarium_module_module_proto_enumTypes[1]
+}
+
+func (x SBOMFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SBOMFormat.Descriptor instead.
+func (SBOMFormat) EnumDescriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{1}
+}
+
 type EndVersionRequest_Action int32
 
 const (
@@ -114,11 +160,11 @@ func (x EndVersionRequest_Action) String() string {
 }
 
 func (EndVersionRequest_Action) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_terrarium_module_module_proto_enumTypes[1].Descriptor()
+	return file_pb_terrarium_module_module_proto_enumTypes[2].Descriptor()
 }
 
 func (EndVersionRequest_Action) Type() protoreflect.EnumType {
-	return &file_pb_terrarium_module_module_proto_enumTypes[1]
+	return &file_pb_terrarium_module_module_proto_enumTypes[2]
 }
 
 func (x EndVersionRequest_Action) Number() protoreflect.EnumNumber {
@@ -127,7 +173,53 @@ func (x EndVersionRequest_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EndVersionRequest_Action.Descriptor instead.
 func (EndVersionRequest_Action) EnumDescriptor() ([]byte, []int) {
-	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{9, 0}
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type GraphNode_Type int32
+
+const (
+	GraphNode_MODULE    GraphNode_Type = 0
+	GraphNode_CONTAINER GraphNode_Type = 1
+)
+
+// Enum value maps for GraphNode_Type.
+var (
+	GraphNode_Type_name = map[int32]string{
+		0: "MODULE",
+		1: "CONTAINER",
+	}
+	GraphNode_Type_value = map[string]int32{
+		"MODULE":    0,
+		"CONTAINER": 1,
+	}
+)
+
+func (x GraphNode_Type) Enum() *GraphNode_Type {
+	p := new(GraphNode_Type)
+	*p = x
+	return p
+}
+
+func (x GraphNode_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GraphNode_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_terrarium_module_module_proto_enumTypes[3].Descriptor()
+}
+
+func (GraphNode_Type) Type() protoreflect.EnumType {
+	return &file_pb_terrarium_module_module_proto_enumTypes[3]
+}
+
+func (x GraphNode_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GraphNode_Type.Descriptor instead.
+func (GraphNode_Type) EnumDescriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type RegisterModuleRequest struct {
@@ -144,11 +236,9 @@ type RegisterModuleRequest struct {
 
 func (x *RegisterModuleRequest) Reset() {
 	*x = RegisterModuleRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterModuleRequest) String() string {
@@ -159,7 +249,7 @@ func (*RegisterModuleRequest) ProtoMessage() {}
 
 func (x *RegisterModuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -219,11 +309,9 @@ type Response struct {
 
 func (x *Response) Reset() {
 	*x = Response{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Response) String() string {
@@ -234,7 +322,7 @@ func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -267,11 +355,9 @@ type Module struct {
 
 func (x *Module) Reset() {
 	*x = Module{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Module) String() string {
@@ -282,7 +368,7 @@ func (*Module) ProtoMessage() {}
 
 func (x *Module) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -322,11 +408,9 @@ type ContainerImageRef struct {
 
 func (x *ContainerImageRef) Reset() {
 	*x = ContainerImageRef{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ContainerImageRef) String() string {
@@ -337,7 +421,7 @@ func (*ContainerImageRef) ProtoMessage() {}
 
 func (x *ContainerImageRef) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -378,11 +462,9 @@ type ContainerImageDetails struct {
 
 func (x *ContainerImageDetails) Reset() {
 	*x = ContainerImageDetails{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ContainerImageDetails) String() string {
@@ -393,7 +475,7 @@ func (*ContainerImageDetails) ProtoMessage() {}
 
 func (x *ContainerImageDetails) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -435,15 +517,15 @@ type BeginVersionRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Maturity of the version, defaults to the maturity of the module when not set.
+	Maturity *Maturity `protobuf:"varint,2,opt,name=maturity,proto3,enum=terrarium.module.Maturity,oneof" json:"maturity,omitempty"`
 }
 
 func (x *BeginVersionRequest) Reset() {
 	*x = BeginVersionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BeginVersionRequest) String() string {
@@ -454,7 +536,7 @@ func (*BeginVersionRequest) ProtoMessage() {}
 
 func (x *BeginVersionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -476,6 +558,13 @@ func (x *BeginVersionRequest) GetModule() *Module {
 	return nil
 }
 
+func (x *BeginVersionRequest) GetMaturity() Maturity {
+	if x != nil && x.Maturity != nil {
+		return *x.Maturity
+	}
+	return Maturity_IDEA
+}
+
 type RegisterModuleDependenciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -483,15 +572,17 @@ type RegisterModuleDependenciesRequest struct {
 
 	Module       *Module   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	Dependencies []*Module `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// Dependencies given with a version constraint such as "~> 2.1", resolved against the published versions.
+	Requirements []*ModuleRequirement `protobuf:"bytes,3,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	// Providers required by the module, taking precedence over the ones found in its source.
+	Providers []*ProviderRequirement `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty"`
 }
 
 func (x *RegisterModuleDependenciesRequest) Reset() {
 	*x = RegisterModuleDependenciesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterModuleDependenciesRequest) String() string {
@@ -502,7 +593,7 @@ func (*RegisterModuleDependenciesRequest) ProtoMessage() {}
 
 func (x *RegisterModuleDependenciesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -531,33 +622,48 @@ func (x *RegisterModuleDependenciesRequest) GetDependencies() []*Module {
 	return nil
 }
 
-type RegisterContainerDependenciesRequest struct {
+func (x *RegisterModuleDependenciesRequest) GetRequirements() []*ModuleRequirement {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+func (x *RegisterModuleDependenciesRequest) GetProviders() []*ProviderRequirement {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// ModuleRequirement is a module required by another one, with the constraint its version must meet.
+type ModuleRequirement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module *Module                           `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	Images map[string]*ContainerImageDetails `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VersionConstraint string `protobuf:"bytes,2,opt,name=version_constraint,json=versionConstraint,proto3" json:"version_constraint,omitempty"`
+	// Newest version meeting the constraint when the dependency was registered, set by the registry.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *RegisterContainerDependenciesRequest) Reset() {
-	*x = RegisterContainerDependenciesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *ModuleRequirement) Reset() {
+	*x = ModuleRequirement{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterContainerDependenciesRequest) String() string {
+func (x *ModuleRequirement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterContainerDependenciesRequest) ProtoMessage() {}
+func (*ModuleRequirement) ProtoMessage() {}
 
-func (x *RegisterContainerDependenciesRequest) ProtoReflect() protoreflect.Message {
+func (x *ModuleRequirement) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -567,52 +673,62 @@ func (x *RegisterContainerDependenciesRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterContainerDependenciesRequest.ProtoReflect.Descriptor instead.
-func (*RegisterContainerDependenciesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModuleRequirement.ProtoReflect.Descriptor instead.
+func (*ModuleRequirement) Descriptor() ([]byte, []int) {
 	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RegisterContainerDependenciesRequest) GetModule() *Module {
+func (x *ModuleRequirement) GetName() string {
 	if x != nil {
-		return x.Module
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *RegisterContainerDependenciesRequest) GetImages() map[string]*ContainerImageDetails {
+func (x *ModuleRequirement) GetVersionConstraint() string {
 	if x != nil {
-		return x.Images
+		return x.VersionConstraint
 	}
-	return nil
+	return ""
 }
 
-type UploadSourceZipRequest struct {
+func (x *ModuleRequirement) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// ResolvedRequirement is a module requirement resolved against the versions published at query time.
+// Differs is set when the newest version meeting the constraint is not the one recorded at registration.
+type ResolvedRequirement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module       *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	ZipDataChunk []byte  `protobuf:"bytes,2,opt,name=zip_data_chunk,json=zipDataChunk,proto3" json:"zip_data_chunk,omitempty"`
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VersionConstraint string `protobuf:"bytes,2,opt,name=version_constraint,json=versionConstraint,proto3" json:"version_constraint,omitempty"`
+	RecordedVersion   string `protobuf:"bytes,3,opt,name=recorded_version,json=recordedVersion,proto3" json:"recorded_version,omitempty"`
+	ResolvedVersion   string `protobuf:"bytes,4,opt,name=resolved_version,json=resolvedVersion,proto3" json:"resolved_version,omitempty"`
+	Differs           bool   `protobuf:"varint,5,opt,name=differs,proto3" json:"differs,omitempty"`
 }
 
-func (x *UploadSourceZipRequest) Reset() {
-	*x = UploadSourceZipRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *ResolvedRequirement) Reset() {
+	*x = ResolvedRequirement{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadSourceZipRequest) String() string {
+func (x *ResolvedRequirement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadSourceZipRequest) ProtoMessage() {}
+func (*ResolvedRequirement) ProtoMessage() {}
 
-func (x *UploadSourceZipRequest) ProtoReflect() protoreflect.Message {
+func (x *ResolvedRequirement) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -622,52 +738,73 @@ func (x *UploadSourceZipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadSourceZipRequest.ProtoReflect.Descriptor instead.
-func (*UploadSourceZipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolvedRequirement.ProtoReflect.Descriptor instead.
+func (*ResolvedRequirement) Descriptor() ([]byte, []int) {
 	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UploadSourceZipRequest) GetModule() *Module {
+func (x *ResolvedRequirement) GetName() string {
 	if x != nil {
-		return x.Module
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadSourceZipRequest) GetZipDataChunk() []byte {
+func (x *ResolvedRequirement) GetVersionConstraint() string {
 	if x != nil {
-		return x.ZipDataChunk
+		return x.VersionConstraint
 	}
-	return nil
+	return ""
 }
 
-type EndVersionRequest struct {
+func (x *ResolvedRequirement) GetRecordedVersion() string {
+	if x != nil {
+		return x.RecordedVersion
+	}
+	return ""
+}
+
+func (x *ResolvedRequirement) GetResolvedVersion() string {
+	if x != nil {
+		return x.ResolvedVersion
+	}
+	return ""
+}
+
+func (x *ResolvedRequirement) GetDiffers() bool {
+	if x != nil {
+		return x.Differs
+	}
+	return false
+}
+
+// ProviderRequirement is an entry of the required_providers of a module.
+type ProviderRequirement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module *Module                  `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	Action EndVersionRequest_Action `protobuf:"varint,2,opt,name=action,proto3,enum=terrarium.module.EndVersionRequest_Action" json:"action,omitempty"`
+	Name               string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source             string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	VersionConstraints []string `protobuf:"bytes,3,rep,name=version_constraints,json=versionConstraints,proto3" json:"version_constraints,omitempty"`
 }
 
-func (x *EndVersionRequest) Reset() {
-	*x = EndVersionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *ProviderRequirement) Reset() {
+	*x = ProviderRequirement{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *EndVersionRequest) String() string {
+func (x *ProviderRequirement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndVersionRequest) ProtoMessage() {}
+func (*ProviderRequirement) ProtoMessage() {}
 
-func (x *EndVersionRequest) ProtoReflect() protoreflect.Message {
+func (x *ProviderRequirement) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -677,51 +814,57 @@ func (x *EndVersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndVersionRequest.ProtoReflect.Descriptor instead.
-func (*EndVersionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProviderRequirement.ProtoReflect.Descriptor instead.
+func (*ProviderRequirement) Descriptor() ([]byte, []int) {
 	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EndVersionRequest) GetModule() *Module {
+func (x *ProviderRequirement) GetName() string {
 	if x != nil {
-		return x.Module
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *EndVersionRequest) GetAction() EndVersionRequest_Action {
+func (x *ProviderRequirement) GetSource() string {
 	if x != nil {
-		return x.Action
+		return x.Source
 	}
-	return EndVersionRequest_DISCARD
+	return ""
 }
 
-type DownloadSourceZipRequest struct {
+func (x *ProviderRequirement) GetVersionConstraints() []string {
+	if x != nil {
+		return x.VersionConstraints
+	}
+	return nil
+}
+
+type RegisterContainerDependenciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Module *Module                           `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Images map[string]*ContainerImageDetails `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DownloadSourceZipRequest) Reset() {
-	*x = DownloadSourceZipRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *RegisterContainerDependenciesRequest) Reset() {
+	*x = RegisterContainerDependenciesRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadSourceZipRequest) String() string {
+func (x *RegisterContainerDependenciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadSourceZipRequest) ProtoMessage() {}
+func (*RegisterContainerDependenciesRequest) ProtoMessage() {}
 
-func (x *DownloadSourceZipRequest) ProtoReflect() protoreflect.Message {
+func (x *RegisterContainerDependenciesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -731,44 +874,50 @@ func (x *DownloadSourceZipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadSourceZipRequest.ProtoReflect.Descriptor instead.
-func (*DownloadSourceZipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterContainerDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*RegisterContainerDependenciesRequest) Descriptor() ([]byte, []int) {
 	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DownloadSourceZipRequest) GetModule() *Module {
+func (x *RegisterContainerDependenciesRequest) GetModule() *Module {
 	if x != nil {
 		return x.Module
 	}
 	return nil
 }
 
-type SourceZipResponse struct {
+func (x *RegisterContainerDependenciesRequest) GetImages() map[string]*ContainerImageDetails {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type UploadSourceZipRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ZipDataChunk []byte `protobuf:"bytes,1,opt,name=zip_data_chunk,json=zipDataChunk,proto3" json:"zip_data_chunk,omitempty"`
+	Module       *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	ZipDataChunk []byte  `protobuf:"bytes,2,opt,name=zip_data_chunk,json=zipDataChunk,proto3" json:"zip_data_chunk,omitempty"`
 }
 
-func (x *SourceZipResponse) Reset() {
-	*x = SourceZipResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *UploadSourceZipRequest) Reset() {
+	*x = UploadSourceZipRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SourceZipResponse) String() string {
+func (x *UploadSourceZipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SourceZipResponse) ProtoMessage() {}
+func (*UploadSourceZipRequest) ProtoMessage() {}
 
-func (x *SourceZipResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadSourceZipRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_terrarium_module_module_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -778,34 +927,286 @@ func (x *SourceZipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SourceZipResponse.ProtoReflect.Descriptor instead.
-func (*SourceZipResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadSourceZipRequest.ProtoReflect.Descriptor instead.
+func (*UploadSourceZipRequest) Descriptor() ([]byte, []int) {
 	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SourceZipResponse) GetZipDataChunk() []byte {
+func (x *UploadSourceZipRequest) GetModule() *Module {
 	if x != nil {
-		return x.ZipDataChunk
+		return x.Module
 	}
 	return nil
 }
 
-type RetrieveModuleDependenciesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UploadSourceZipRequest) GetZipDataChunk() []byte {
+	if x != nil {
+		return x.ZipDataChunk
+	}
+	return nil
+}
+
+type EndVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module *Module                  `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Action EndVersionRequest_Action `protobuf:"varint,2,opt,name=action,proto3,enum=terrarium.module.EndVersionRequest_Action" json:"action,omitempty"`
+}
+
+func (x *EndVersionRequest) Reset() {
+	*x = EndVersionRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndVersionRequest) ProtoMessage() {}
+
+func (x *EndVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndVersionRequest.ProtoReflect.Descriptor instead.
+func (*EndVersionRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EndVersionRequest) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *EndVersionRequest) GetAction() EndVersionRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return EndVersionRequest_DISCARD
+}
+
+type DownloadSourceZipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+}
+
+func (x *DownloadSourceZipRequest) Reset() {
+	*x = DownloadSourceZipRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadSourceZipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadSourceZipRequest) ProtoMessage() {}
+
+func (x *DownloadSourceZipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadSourceZipRequest.ProtoReflect.Descriptor instead.
+func (*DownloadSourceZipRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DownloadSourceZipRequest) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+type SourceZipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ZipDataChunk []byte `protobuf:"bytes,1,opt,name=zip_data_chunk,json=zipDataChunk,proto3" json:"zip_data_chunk,omitempty"`
+}
+
+func (x *SourceZipResponse) Reset() {
+	*x = SourceZipResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceZipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceZipResponse) ProtoMessage() {}
+
+func (x *SourceZipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceZipResponse.ProtoReflect.Descriptor instead.
+func (*SourceZipResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SourceZipResponse) GetZipDataChunk() []byte {
+	if x != nil {
+		return x.ZipDataChunk
+	}
+	return nil
+}
+
+// RetrieveProviderDependenciesRequest looks up the providers required by a module version,
+// whether registered or found in its source.
+type RetrieveProviderDependenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+}
+
+func (x *RetrieveProviderDependenciesRequest) Reset() {
+	*x = RetrieveProviderDependenciesRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveProviderDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveProviderDependenciesRequest) ProtoMessage() {}
+
+func (x *RetrieveProviderDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveProviderDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveProviderDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RetrieveProviderDependenciesRequest) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+type ProviderDependenciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module    *Module                `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Providers []*ProviderRequirement `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *ProviderDependenciesResponse) Reset() {
+	*x = ProviderDependenciesResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDependenciesResponse) ProtoMessage() {}
+
+func (x *ProviderDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProviderDependenciesResponse) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ProviderDependenciesResponse) GetProviders() []*ProviderRequirement {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// RetrieveModuleDependenciesRequest walks the dependency graph of a module.
+// Every module in the graph is reported once; max_depth limits how many levels
+// below the requested module are followed, 0 meaning no limit.
+type RetrieveModuleDependenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	Module    *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	Recursive bool    `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	MaxDepth  int32   `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
 }
 
 func (x *RetrieveModuleDependenciesRequest) Reset() {
 	*x = RetrieveModuleDependenciesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RetrieveModuleDependenciesRequest) String() string {
@@ -815,8 +1216,8 @@ func (x *RetrieveModuleDependenciesRequest) String() string {
 func (*RetrieveModuleDependenciesRequest) ProtoMessage() {}
 
 func (x *RetrieveModuleDependenciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_terrarium_module_module_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[17]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -828,7 +1229,7 @@ func (x *RetrieveModuleDependenciesRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RetrieveModuleDependenciesRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveModuleDependenciesRequest) Descriptor() ([]byte, []int) {
-	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{12}
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RetrieveModuleDependenciesRequest) GetModule() *Module {
@@ -842,36 +1243,1445 @@ func (x *RetrieveModuleDependenciesRequest) GetRecursive() bool {
 	if x != nil {
 		return x.Recursive
 	}
-	return false
+	return false
+}
+
+func (x *RetrieveModuleDependenciesRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+// ModuleDependenciesResponse lists the dependencies of a module found at the given depth of the graph.
+// cycles holds the dependencies leading back to a module that depends on this one.
+type ModuleDependenciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module       *Module                `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Dependencies []*Module              `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Cycles       []*Module              `protobuf:"bytes,3,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	Depth        int32                  `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	Requirements []*ResolvedRequirement `protobuf:"bytes,5,rep,name=requirements,proto3" json:"requirements,omitempty"`
+}
+
+func (x *ModuleDependenciesResponse) Reset() {
+	*x = ModuleDependenciesResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleDependenciesResponse) ProtoMessage() {}
+
+func (x *ModuleDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ModuleDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ModuleDependenciesResponse) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ModuleDependenciesResponse) GetDependencies() []*Module {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *ModuleDependenciesResponse) GetCycles() []*Module {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+func (x *ModuleDependenciesResponse) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ModuleDependenciesResponse) GetRequirements() []*ResolvedRequirement {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+// RetrieveDependentsRequest looks up the modules that depend on a module.
+// Dependents of every version of the module are returned unless a version,
+// or a version range such as ">= 1.0.0, < 2.0.0", is given.
+type RetrieveDependentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module       *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Recursive    bool    `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	VersionRange string  `protobuf:"bytes,3,opt,name=version_range,json=versionRange,proto3" json:"version_range,omitempty"`
+}
+
+func (x *RetrieveDependentsRequest) Reset() {
+	*x = RetrieveDependentsRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveDependentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveDependentsRequest) ProtoMessage() {}
+
+func (x *RetrieveDependentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveDependentsRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveDependentsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RetrieveDependentsRequest) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *RetrieveDependentsRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *RetrieveDependentsRequest) GetVersionRange() string {
+	if x != nil {
+		return x.VersionRange
+	}
+	return ""
+}
+
+type DependentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module     *Module   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Dependents []*Module `protobuf:"bytes,2,rep,name=dependents,proto3" json:"dependents,omitempty"`
+}
+
+func (x *DependentsResponse) Reset() {
+	*x = DependentsResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependentsResponse) ProtoMessage() {}
+
+func (x *DependentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependentsResponse.ProtoReflect.Descriptor instead.
+func (*DependentsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DependentsResponse) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *DependentsResponse) GetDependents() []*Module {
+	if x != nil {
+		return x.Dependents
+	}
+	return nil
+}
+
+// SearchContainerImagesRequest looks up the module versions referencing container images.
+// Every criterion given must match: the image name and namespace exactly, the tag exactly,
+// and the digest of at least one of the images as a prefix, with or without the "sha256:" algorithm.
+type SearchContainerImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Tag       string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Digest    string `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *SearchContainerImagesRequest) Reset() {
+	*x = SearchContainerImagesRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContainerImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContainerImagesRequest) ProtoMessage() {}
+
+func (x *SearchContainerImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContainerImagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchContainerImagesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchContainerImagesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchContainerImagesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SearchContainerImagesRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SearchContainerImagesRequest) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+// ContainerImageVersion holds the matching images referenced by a module version, keyed by image name.
+type ContainerImageVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string                            `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Images  map[string]*ContainerImageDetails `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ContainerImageVersion) Reset() {
+	*x = ContainerImageVersion{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerImageVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerImageVersion) ProtoMessage() {}
+
+func (x *ContainerImageVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerImageVersion.ProtoReflect.Descriptor instead.
+func (*ContainerImageVersion) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ContainerImageVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ContainerImageVersion) GetImages() map[string]*ContainerImageDetails {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// ContainerImageUsage groups the versions of a module referencing matching images, latest version first.
+type ContainerImageUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LatestVersion string                   `protobuf:"bytes,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	Versions      []*ContainerImageVersion `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ContainerImageUsage) Reset() {
+	*x = ContainerImageUsage{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerImageUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerImageUsage) ProtoMessage() {}
+
+func (x *ContainerImageUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerImageUsage.ProtoReflect.Descriptor instead.
+func (*ContainerImageUsage) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ContainerImageUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerImageUsage) GetLatestVersion() string {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return ""
+}
+
+func (x *ContainerImageUsage) GetVersions() []*ContainerImageVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type SearchContainerImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Modules []*ContainerImageUsage `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (x *SearchContainerImagesResponse) Reset() {
+	*x = SearchContainerImagesResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContainerImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContainerImagesResponse) ProtoMessage() {}
+
+func (x *SearchContainerImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContainerImagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchContainerImagesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchContainerImagesResponse) GetModules() []*ContainerImageUsage {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+// ExportSBOMRequest asks for the software bill of materials of a module version, covering its transitive
+// module dependencies, the container images they reference and the providers they require.
+type ExportSBOMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module *Module    `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Format SBOMFormat `protobuf:"varint,2,opt,name=format,proto3,enum=terrarium.module.SBOMFormat" json:"format,omitempty"`
+}
+
+func (x *ExportSBOMRequest) Reset() {
+	*x = ExportSBOMRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSBOMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSBOMRequest) ProtoMessage() {}
+
+func (x *ExportSBOMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSBOMRequest.ProtoReflect.Descriptor instead.
+func (*ExportSBOMRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportSBOMRequest) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ExportSBOMRequest) GetFormat() SBOMFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SBOMFormat_CYCLONEDX
+}
+
+// SBOMResponse holds a CycloneDX JSON or SPDX JSON document along with its media type.
+type SBOMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaType string `protobuf:"bytes,1,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	Document  []byte `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+}
+
+func (x *SBOMResponse) Reset() {
+	*x = SBOMResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SBOMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SBOMResponse) ProtoMessage() {}
+
+func (x *SBOMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SBOMResponse.ProtoReflect.Descriptor instead.
+func (*SBOMResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SBOMResponse) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *SBOMResponse) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+// RetrieveDependencyGraphRequest asks for the module graph of a module version, with the container images
+// referenced by every module as leaves. Dependencies of modules at max_depth are not followed unless it is 0.
+type RetrieveDependencyGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module   *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	MaxDepth int32   `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+}
+
+func (x *RetrieveDependencyGraphRequest) Reset() {
+	*x = RetrieveDependencyGraphRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveDependencyGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveDependencyGraphRequest) ProtoMessage() {}
+
+func (x *RetrieveDependencyGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveDependencyGraphRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveDependencyGraphRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RetrieveDependencyGraphRequest) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *RetrieveDependencyGraphRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type GraphNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type GraphNode_Type `protobuf:"varint,2,opt,name=type,proto3,enum=terrarium.module.GraphNode_Type" json:"type,omitempty"`
+	Name string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Version of modules, tag of container images.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Depth   int32  `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Maturity of module versions, when known.
+	Maturity   *Maturity `protobuf:"varint,6,opt,name=maturity,proto3,enum=terrarium.module.Maturity,oneof" json:"maturity,omitempty"`
+	Deprecated bool      `protobuf:"varint,7,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+}
+
+func (x *GraphNode) Reset() {
+	*x = GraphNode{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphNode) ProtoMessage() {}
+
+func (x *GraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphNode.ProtoReflect.Descriptor instead.
+func (*GraphNode) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GraphNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GraphNode) GetType() GraphNode_Type {
+	if x != nil {
+		return x.Type
+	}
+	return GraphNode_MODULE
+}
+
+func (x *GraphNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GraphNode) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GraphNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GraphNode) GetMaturity() Maturity {
+	if x != nil && x.Maturity != nil {
+		return *x.Maturity
+	}
+	return Maturity_IDEA
+}
+
+func (x *GraphNode) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+// GraphEdge links a module to one of its dependencies. cycle is set when the dependency leads back to the module.
+type GraphEdge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Cycle bool   `protobuf:"varint,3,opt,name=cycle,proto3" json:"cycle,omitempty"`
+}
+
+func (x *GraphEdge) Reset() {
+	*x = GraphEdge{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphEdge) ProtoMessage() {}
+
+func (x *GraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphEdge.ProtoReflect.Descriptor instead.
+func (*GraphEdge) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GraphEdge) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetCycle() bool {
+	if x != nil {
+		return x.Cycle
+	}
+	return false
+}
+
+type DependencyGraph struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*GraphNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges []*GraphEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *DependencyGraph) Reset() {
+	*x = DependencyGraph{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyGraph) ProtoMessage() {}
+
+func (x *DependencyGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyGraph.ProtoReflect.Descriptor instead.
+func (*DependencyGraph) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DependencyGraph) GetNodes() []*GraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *DependencyGraph) GetEdges() []*GraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type RetrieveContainerDependenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module    *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Recursive bool    `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+}
+
+func (x *RetrieveContainerDependenciesRequest) Reset() {
+	*x = RetrieveContainerDependenciesRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveContainerDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveContainerDependenciesRequest) ProtoMessage() {}
+
+func (x *RetrieveContainerDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveContainerDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveContainerDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RetrieveContainerDependenciesRequest) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *RetrieveContainerDependenciesRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type ContainerDependenciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module       *Module  `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Dependencies []string `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (x *ContainerDependenciesResponse) Reset() {
+	*x = ContainerDependenciesResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerDependenciesResponse) ProtoMessage() {}
+
+func (x *ContainerDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ContainerDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ContainerDependenciesResponse) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ContainerDependenciesResponse) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type RetrieveContainerDependenciesRequestV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module   *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	MaxDepth int32   `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+}
+
+func (x *RetrieveContainerDependenciesRequestV2) Reset() {
+	*x = RetrieveContainerDependenciesRequestV2{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveContainerDependenciesRequestV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveContainerDependenciesRequestV2) ProtoMessage() {}
+
+func (x *RetrieveContainerDependenciesRequestV2) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveContainerDependenciesRequestV2.ProtoReflect.Descriptor instead.
+func (*RetrieveContainerDependenciesRequestV2) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RetrieveContainerDependenciesRequestV2) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *RetrieveContainerDependenciesRequestV2) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type ContainerDependenciesResponseV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module       *Module                           `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Dependencies map[string]*ContainerImageDetails `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cycles       []*Module                         `protobuf:"bytes,3,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	Depth        int32                             `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (x *ContainerDependenciesResponseV2) Reset() {
+	*x = ContainerDependenciesResponseV2{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerDependenciesResponseV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerDependenciesResponseV2) ProtoMessage() {}
+
+func (x *ContainerDependenciesResponseV2) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerDependenciesResponseV2.ProtoReflect.Descriptor instead.
+func (*ContainerDependenciesResponseV2) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ContainerDependenciesResponseV2) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ContainerDependenciesResponseV2) GetDependencies() map[string]*ContainerImageDetails {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *ContainerDependenciesResponseV2) GetCycles() []*Module {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+func (x *ContainerDependenciesResponseV2) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type PublishTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey string   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Name   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tags   []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *PublishTagRequest) Reset() {
+	*x = PublishTagRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTagRequest) ProtoMessage() {}
+
+func (x *PublishTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTagRequest.ProtoReflect.Descriptor instead.
+func (*PublishTagRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PublishTagRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *PublishTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublishTagRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetTagsRequest) Reset() {
+	*x = GetTagsRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagsRequest) ProtoMessage() {}
+
+func (x *GetTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetTagsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTagsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ModuleTags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ModuleTags) Reset() {
+	*x = ModuleTags{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleTags) ProtoMessage() {}
+
+func (x *ModuleTags) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleTags.ProtoReflect.Descriptor instead.
+func (*ModuleTags) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ModuleTags) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleTags) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListModulesByTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *ListModulesByTagRequest) Reset() {
+	*x = ListModulesByTagRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModulesByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModulesByTagRequest) ProtoMessage() {}
+
+func (x *ListModulesByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModulesByTagRequest.ProtoReflect.Descriptor instead.
+func (*ListModulesByTagRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListModulesByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// Names of the modules having a tag, sorted by name.
+type ListModulesByTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag     string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Modules []string `protobuf:"bytes,2,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (x *ListModulesByTagResponse) Reset() {
+	*x = ListModulesByTagResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModulesByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModulesByTagResponse) ProtoMessage() {}
+
+func (x *ListModulesByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModulesByTagResponse.ProtoReflect.Descriptor instead.
+func (*ListModulesByTagResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListModulesByTagResponse) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListModulesByTagResponse) GetModules() []string {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+// ListModulesRequest lists the modules of an organization, or of every organization when none is given.
+type ListModulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+}
+
+func (x *ListModulesRequest) Reset() {
+	*x = ListModulesRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModulesRequest) ProtoMessage() {}
+
+func (x *ListModulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModulesRequest.ProtoReflect.Descriptor instead.
+func (*ListModulesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListModulesRequest) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+type ModuleDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Source      string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Maturity    Maturity `protobuf:"varint,4,opt,name=maturity,proto3,enum=terrarium.module.Maturity" json:"maturity,omitempty"`
+}
+
+func (x *ModuleDetails) Reset() {
+	*x = ModuleDetails{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleDetails) ProtoMessage() {}
+
+func (x *ModuleDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleDetails.ProtoReflect.Descriptor instead.
+func (*ModuleDetails) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ModuleDetails) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleDetails) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ModuleDetails) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ModuleDetails) GetMaturity() Maturity {
+	if x != nil {
+		return x.Maturity
+	}
+	return Maturity_IDEA
 }
 
-type ModuleDependenciesResponse struct {
+// Modules sorted by name.
+type ListModulesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module       *Module   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	Dependencies []*Module `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Modules []*ModuleDetails `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
 }
 
-func (x *ModuleDependenciesResponse) Reset() {
-	*x = ModuleDependenciesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *ListModulesResponse) Reset() {
+	*x = ListModulesResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModuleDependenciesResponse) String() string {
+func (x *ListModulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModuleDependenciesResponse) ProtoMessage() {}
+func (*ListModulesResponse) ProtoMessage() {}
 
-func (x *ModuleDependenciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_terrarium_module_module_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ListModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[42]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -881,52 +2691,45 @@ func (x *ModuleDependenciesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModuleDependenciesResponse.ProtoReflect.Descriptor instead.
-func (*ModuleDependenciesResponse) Descriptor() ([]byte, []int) {
-	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ModuleDependenciesResponse) GetModule() *Module {
-	if x != nil {
-		return x.Module
-	}
-	return nil
+// Deprecated: Use ListModulesResponse.ProtoReflect.Descriptor instead.
+func (*ListModulesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ModuleDependenciesResponse) GetDependencies() []*Module {
+func (x *ListModulesResponse) GetModules() []*ModuleDetails {
 	if x != nil {
-		return x.Dependencies
+		return x.Modules
 	}
 	return nil
 }
 
-type RetrieveContainerDependenciesRequest struct {
+// ListModuleVersionsRequest lists the published versions of a module, restricted to a version range such as
+// ">= 1.0.0, < 2.0.0" when one is given.
+type ListModuleVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module    *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	Recursive bool    `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VersionRange string `protobuf:"bytes,2,opt,name=version_range,json=versionRange,proto3" json:"version_range,omitempty"`
 }
 
-func (x *RetrieveContainerDependenciesRequest) Reset() {
-	*x = RetrieveContainerDependenciesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *ListModuleVersionsRequest) Reset() {
+	*x = ListModuleVersionsRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RetrieveContainerDependenciesRequest) String() string {
+func (x *ListModuleVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetrieveContainerDependenciesRequest) ProtoMessage() {}
+func (*ListModuleVersionsRequest) ProtoMessage() {}
 
-func (x *RetrieveContainerDependenciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_terrarium_module_module_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ListModuleVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[43]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -936,52 +2739,50 @@ func (x *RetrieveContainerDependenciesRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetrieveContainerDependenciesRequest.ProtoReflect.Descriptor instead.
-func (*RetrieveContainerDependenciesRequest) Descriptor() ([]byte, []int) {
-	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListModuleVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListModuleVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RetrieveContainerDependenciesRequest) GetModule() *Module {
+func (x *ListModuleVersionsRequest) GetName() string {
 	if x != nil {
-		return x.Module
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *RetrieveContainerDependenciesRequest) GetRecursive() bool {
+func (x *ListModuleVersionsRequest) GetVersionRange() string {
 	if x != nil {
-		return x.Recursive
+		return x.VersionRange
 	}
-	return false
+	return ""
 }
 
-type ContainerDependenciesResponse struct {
+type ModuleVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module       *Module  `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	Dependencies []string `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Version  string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Maturity Maturity `protobuf:"varint,2,opt,name=maturity,proto3,enum=terrarium.module.Maturity" json:"maturity,omitempty"`
 }
 
-func (x *ContainerDependenciesResponse) Reset() {
-	*x = ContainerDependenciesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *ModuleVersion) Reset() {
+	*x = ModuleVersion{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerDependenciesResponse) String() string {
+func (x *ModuleVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerDependenciesResponse) ProtoMessage() {}
+func (*ModuleVersion) ProtoMessage() {}
 
-func (x *ContainerDependenciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_terrarium_module_module_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ModuleVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[44]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -991,51 +2792,50 @@ func (x *ContainerDependenciesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerDependenciesResponse.ProtoReflect.Descriptor instead.
-func (*ContainerDependenciesResponse) Descriptor() ([]byte, []int) {
-	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ModuleVersion.ProtoReflect.Descriptor instead.
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ContainerDependenciesResponse) GetModule() *Module {
+func (x *ModuleVersion) GetVersion() string {
 	if x != nil {
-		return x.Module
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *ContainerDependenciesResponse) GetDependencies() []string {
+func (x *ModuleVersion) GetMaturity() Maturity {
 	if x != nil {
-		return x.Dependencies
+		return x.Maturity
 	}
-	return nil
+	return Maturity_IDEA
 }
 
-type RetrieveContainerDependenciesRequestV2 struct {
+type ListModuleVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Name     string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Versions []*ModuleVersion `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *RetrieveContainerDependenciesRequestV2) Reset() {
-	*x = RetrieveContainerDependenciesRequestV2{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *ListModuleVersionsResponse) Reset() {
+	*x = ListModuleVersionsResponse{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RetrieveContainerDependenciesRequestV2) String() string {
+func (x *ListModuleVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetrieveContainerDependenciesRequestV2) ProtoMessage() {}
+func (*ListModuleVersionsResponse) ProtoMessage() {}
 
-func (x *RetrieveContainerDependenciesRequestV2) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_terrarium_module_module_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ListModuleVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[45]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1045,45 +2845,51 @@ func (x *RetrieveContainerDependenciesRequestV2) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetrieveContainerDependenciesRequestV2.ProtoReflect.Descriptor instead.
-func (*RetrieveContainerDependenciesRequestV2) Descriptor() ([]byte, []int) {
-	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListModuleVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListModuleVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RetrieveContainerDependenciesRequestV2) GetModule() *Module {
+func (x *ListModuleVersionsResponse) GetName() string {
 	if x != nil {
-		return x.Module
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListModuleVersionsResponse) GetVersions() []*ModuleVersion {
+	if x != nil {
+		return x.Versions
 	}
 	return nil
 }
 
-type ContainerDependenciesResponseV2 struct {
+type SetMaturityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module       *Module                           `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	Dependencies map[string]*ContainerImageDetails `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Module   *Module  `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Maturity Maturity `protobuf:"varint,2,opt,name=maturity,proto3,enum=terrarium.module.Maturity" json:"maturity,omitempty"`
+	Reason   string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ContainerDependenciesResponseV2) Reset() {
-	*x = ContainerDependenciesResponseV2{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *SetMaturityRequest) Reset() {
+	*x = SetMaturityRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerDependenciesResponseV2) String() string {
+func (x *SetMaturityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerDependenciesResponseV2) ProtoMessage() {}
+func (*SetMaturityRequest) ProtoMessage() {}
 
-func (x *ContainerDependenciesResponseV2) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_terrarium_module_module_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *SetMaturityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[46]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1093,53 +2899,59 @@ func (x *ContainerDependenciesResponseV2) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerDependenciesResponseV2.ProtoReflect.Descriptor instead.
-func (*ContainerDependenciesResponseV2) Descriptor() ([]byte, []int) {
-	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SetMaturityRequest.ProtoReflect.Descriptor instead.
+func (*SetMaturityRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ContainerDependenciesResponseV2) GetModule() *Module {
+func (x *SetMaturityRequest) GetModule() *Module {
 	if x != nil {
 		return x.Module
 	}
 	return nil
 }
 
-func (x *ContainerDependenciesResponseV2) GetDependencies() map[string]*ContainerImageDetails {
+func (x *SetMaturityRequest) GetMaturity() Maturity {
 	if x != nil {
-		return x.Dependencies
+		return x.Maturity
 	}
-	return nil
+	return Maturity_IDEA
 }
 
-type PublishTagRequest struct {
+func (x *SetMaturityRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ReviewVersionRequest approves or rejects a module version waiting for approval.
+// The reviewer is the authenticated caller of the request.
+type ReviewVersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiKey string   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
-	Name   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Tags   []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Module  *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Comment string  `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
 }
 
-func (x *PublishTagRequest) Reset() {
-	*x = PublishTagRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_terrarium_module_module_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *ReviewVersionRequest) Reset() {
+	*x = ReviewVersionRequest{}
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PublishTagRequest) String() string {
+func (x *ReviewVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishTagRequest) ProtoMessage() {}
+func (*ReviewVersionRequest) ProtoMessage() {}
 
-func (x *PublishTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_terrarium_module_module_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ReviewVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_terrarium_module_module_proto_msgTypes[47]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1149,32 +2961,25 @@ func (x *PublishTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishTagRequest.ProtoReflect.Descriptor instead.
-func (*PublishTagRequest) Descriptor() ([]byte, []int) {
-	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ReviewVersionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewVersionRequest) Descriptor() ([]byte, []int) {
+	return file_pb_terrarium_module_module_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *PublishTagRequest) GetApiKey() string {
+func (x *ReviewVersionRequest) GetModule() *Module {
 	if x != nil {
-		return x.ApiKey
+		return x.Module
 	}
-	return ""
+	return nil
 }
 
-func (x *PublishTagRequest) GetName() string {
+func (x *ReviewVersionRequest) GetComment() string {
 	if x != nil {
-		return x.Name
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *PublishTagRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
 var File_pb_terrarium_module_module_proto protoreflect.FileDescriptor
 
 var file_pb_terrarium_module_module_proto_rawDesc = []byte{
@@ -1211,114 +3016,292 @@ var file_pb_terrarium_module_module_proto_rawDesc = []byte{
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
 	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
 	0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x22, 0x47, 0x0a, 0x13, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
-	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x21, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70,
-	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
-	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x22, 0x98, 0x02, 0x0a, 0x24, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64,
+	0x73, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64,
 	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72,
 	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x74, 0x65,
-	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x6d,
+	0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x74,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x74,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0xa1, 0x02, 0x0a, 0x21, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3c, 0x0a,
+	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x11, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc8, 0x01, 0x0a, 0x13,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64,
+	0x69, 0x66, 0x66, 0x65, 0x72, 0x73, 0x22, 0x72, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x24, 0x52,
 	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
 	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
-	0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x70, 0x0a, 0x16, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
-	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52,
-	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x7a, 0x69, 0x70, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0c, 0x7a, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0xad, 0x01,
-	0x0a, 0x11, 0x45, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
 	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
-	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x06, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x01, 0x22, 0x4c, 0x0a,
-	0x18, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a,
-	0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72,
-	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x39, 0x0a, 0x11, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x24, 0x0a, 0x0e, 0x7a, 0x69, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x7a, 0x69, 0x70, 0x44, 0x61, 0x74,
-	0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x73, 0x0a, 0x21, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65,
-	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x1a,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x1a, 0x62, 0x0a, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x70, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x7a, 0x69, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x7a, 0x69, 0x70, 0x44, 0x61,
+	0x74, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0xad, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x42, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a,
+	0x07, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x55,
+	0x42, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x01, 0x22, 0x4c, 0x0a, 0x18, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x39, 0x0a, 0x11, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x7a, 0x69,
+	0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x7a, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x22, 0x57, 0x0a, 0x23, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x1c, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
 	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f,
 	0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72,
 	0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c,
-	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x64, 0x65,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x24, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44,
-	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69,
-	0x76, 0x65, 0x22, 0x75, 0x0a, 0x1d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x22, 0x90, 0x01, 0x0a, 0x21, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x22, 0x9f, 0x02, 0x0a, 0x1a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44,
 	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
 	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70,
-	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x26, 0x52, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x56, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x1f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72,
-	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x67, 0x0a, 0x0c, 0x64,
-	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x43, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x56, 0x32, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x1a, 0x68, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x63,
+	0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
+	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x73, 0x69, 0x76, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7a, 0x0a, 0x1c,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0xe2, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x06,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x0b, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x65, 0x72, 0x72,
+	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x95, 0x01,
+	0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x43, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x60, 0x0a, 0x1d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x42, 0x4f, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x34,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x53, 0x42, 0x4f, 0x4d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x22, 0x49, 0x0a, 0x0c, 0x53, 0x42, 0x4f, 0x4d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x6f, 0x0a, 0x1e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68,
+	0x22, 0xa2, 0x02, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x61,
+	0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x21, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a,
+	0x06, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e,
+	0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x74,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0x45, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x64,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x22, 0x77, 0x0a, 0x0f,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12,
+	0x31, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05,
+	0x65, 0x64, 0x67, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0x75, 0x0a,
+	0x1d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x26, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x32, 0x12, 0x30,
+	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0xee, 0x02,
+	0x0a, 0x1f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x32, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x67, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x74, 0x65, 0x72, 0x72,
+	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x2e, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x06,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x1a, 0x68, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
 	0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x65, 0x72,
@@ -1330,94 +3313,250 @@ var file_pb_terrarium_module_module_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x2a, 0x74, 0x0a, 0x08, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x12, 0x08, 0x0a, 0x04, 0x49, 0x44, 0x45, 0x41, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4c,
-	0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x56, 0x45,
-	0x4c, 0x4f, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x50, 0x48,
-	0x41, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x45, 0x54, 0x41, 0x10, 0x04, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x50,
-	0x52, 0x45, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x44,
-	0x5f, 0x4f, 0x46, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x10, 0x07, 0x32, 0x9a, 0x05, 0x0a, 0x09, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
-	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0c, 0x42,
-	0x65, 0x67, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x74, 0x65,
-	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x42,
-	0x65, 0x67, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x6f, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33,
+	0x74, 0x61, 0x67, 0x73, 0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x22, 0x2b, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42,
+	0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x46, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x95, 0x01, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x36, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x6d,
+	0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0x50, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0x61, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x61,
+	0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x22, 0x6d, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
+	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x61,
+	0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x14, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x04,
+	0x08, 0x02, 0x10, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x2a, 0x74,
+	0x0a, 0x08, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x44,
+	0x45, 0x41, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4c, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x56, 0x45, 0x4c, 0x4f, 0x50, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x10, 0x03, 0x12, 0x08, 0x0a,
+	0x04, 0x42, 0x45, 0x54, 0x41, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x44, 0x5f, 0x4f, 0x46, 0x5f, 0x4c, 0x49,
+	0x46, 0x45, 0x10, 0x07, 0x2a, 0x25, 0x0a, 0x0a, 0x53, 0x42, 0x4f, 0x4d, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x59, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x44, 0x58, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x50, 0x44, 0x58, 0x10, 0x01, 0x32, 0xbb, 0x08, 0x0a, 0x09,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x08, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
 	0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0c,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
 	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x75, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x12, 0x36, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x00, 0x12, 0x6f, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x33, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x75, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f,
 	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0f, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x12, 0x28, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4f, 0x0a, 0x0a, 0x45, 0x6e, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72,
+	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x54, 0x61, 0x67, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72,
 	0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x12, 0x28, 0x2e, 0x74, 0x65,
-	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
-	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4f, 0x0a, 0x0a, 0x45, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
 	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x54, 0x61, 0x67, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
 	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54,
 	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72,
 	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x9e, 0x04, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x73,
-	0x75, 0x6d, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x12, 0x2a, 0x2e, 0x74, 0x65, 0x72, 0x72,
-	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
-	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a,
-	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x8c,
-	0x01, 0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x12, 0x36, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61,
-	0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x83, 0x01,
-	0x0a, 0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x74,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4d, 0x61,
+	0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x74,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74,
 	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
-	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
+	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x55, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xe3, 0x0b, 0x0a, 0x08, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70, 0x12, 0x2a, 0x2e, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5a, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
+	0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5a, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x8c, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x36, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x83, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33,
+	0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x92, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x56, 0x32, 0x12, 0x38, 0x2e, 0x74, 0x65, 0x72, 0x72,
+	0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65,
 	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x92, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x56, 0x32, 0x12, 0x38, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72,
-	0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
-	0x32, 0x1a, 0x31, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x56, 0x32, 0x1a, 0x31, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6b, 0x0a, 0x12, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x2b, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7a, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x2e, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x42, 0x4f,
+	0x4d, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x42, 0x4f, 0x4d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69,
+	0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x42, 0x4f, 0x4d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x17, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x12, 0x30, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x22, 0x00, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65,
 	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x56, 0x32, 0x22, 0x00, 0x30, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x20, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x67, 0x73, 0x22,
+	0x00, 0x12, 0x6b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x42, 0x79, 0x54, 0x61, 0x67, 0x12, 0x29, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75,
+	0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42,
+	0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61,
+	0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1432,82 +3571,172 @@ func file_pb_terrarium_module_module_proto_rawDescGZIP() []byte {
 	return file_pb_terrarium_module_module_proto_rawDescData
 }
 
-var file_pb_terrarium_module_module_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pb_terrarium_module_module_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
-var file_pb_terrarium_module_module_proto_goTypes = []interface{}{
+var file_pb_terrarium_module_module_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pb_terrarium_module_module_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_pb_terrarium_module_module_proto_goTypes = []any{
 	(Maturity)(0),                                  // 0: terrarium.module.Maturity
-	(EndVersionRequest_Action)(0),                  // 1: terrarium.module.EndVersionRequest.Action
-	(*RegisterModuleRequest)(nil),                  // 2: terrarium.module.RegisterModuleRequest
-	(*Response)(nil),                               // 3: terrarium.module.Response
-	(*Module)(nil),                                 // 4: terrarium.module.Module
-	(*ContainerImageRef)(nil),                      // 5: terrarium.module.ContainerImageRef
-	(*ContainerImageDetails)(nil),                  // 6: terrarium.module.ContainerImageDetails
-	(*BeginVersionRequest)(nil),                    // 7: terrarium.module.BeginVersionRequest
-	(*RegisterModuleDependenciesRequest)(nil),      // 8: terrarium.module.RegisterModuleDependenciesRequest
-	(*RegisterContainerDependenciesRequest)(nil),   // 9: terrarium.module.RegisterContainerDependenciesRequest
-	(*UploadSourceZipRequest)(nil),                 // 10: terrarium.module.UploadSourceZipRequest
-	(*EndVersionRequest)(nil),                      // 11: terrarium.module.EndVersionRequest
-	(*DownloadSourceZipRequest)(nil),               // 12: terrarium.module.DownloadSourceZipRequest
-	(*SourceZipResponse)(nil),                      // 13: terrarium.module.SourceZipResponse
-	(*RetrieveModuleDependenciesRequest)(nil),      // 14: terrarium.module.RetrieveModuleDependenciesRequest
-	(*ModuleDependenciesResponse)(nil),             // 15: terrarium.module.ModuleDependenciesResponse
-	(*RetrieveContainerDependenciesRequest)(nil),   // 16: terrarium.module.RetrieveContainerDependenciesRequest
-	(*ContainerDependenciesResponse)(nil),          // 17: terrarium.module.ContainerDependenciesResponse
-	(*RetrieveContainerDependenciesRequestV2)(nil), // 18: terrarium.module.RetrieveContainerDependenciesRequestV2
-	(*ContainerDependenciesResponseV2)(nil),        // 19: terrarium.module.ContainerDependenciesResponseV2
-	(*PublishTagRequest)(nil),                      // 20: terrarium.module.PublishTagRequest
-	nil,                                            // 21: terrarium.module.RegisterContainerDependenciesRequest.ImagesEntry
-	nil,                                            // 22: terrarium.module.ContainerDependenciesResponseV2.DependenciesEntry
+	(SBOMFormat)(0),                                // 1: terrarium.module.SBOMFormat
+	(EndVersionRequest_Action)(0),                  // 2: terrarium.module.EndVersionRequest.Action
+	(GraphNode_Type)(0),                            // 3: terrarium.module.GraphNode.Type
+	(*RegisterModuleRequest)(nil),                  // 4: terrarium.module.RegisterModuleRequest
+	(*Response)(nil),                               // 5: terrarium.module.Response
+	(*Module)(nil),                                 // 6: terrarium.module.Module
+	(*ContainerImageRef)(nil),                      // 7: terrarium.module.ContainerImageRef
+	(*ContainerImageDetails)(nil),                  // 8: terrarium.module.ContainerImageDetails
+	(*BeginVersionRequest)(nil),                    // 9: terrarium.module.BeginVersionRequest
+	(*RegisterModuleDependenciesRequest)(nil),      // 10: terrarium.module.RegisterModuleDependenciesRequest
+	(*ModuleRequirement)(nil),                      // 11: terrarium.module.ModuleRequirement
+	(*ResolvedRequirement)(nil),                    // 12: terrarium.module.ResolvedRequirement
+	(*ProviderRequirement)(nil),                    // 13: terrarium.module.ProviderRequirement
+	(*RegisterContainerDependenciesRequest)(nil),   // 14: terrarium.module.RegisterContainerDependenciesRequest
+	(*UploadSourceZipRequest)(nil),                 // 15: terrarium.module.UploadSourceZipRequest
+	(*EndVersionRequest)(nil),                      // 16: terrarium.module.EndVersionRequest
+	(*DownloadSourceZipRequest)(nil),               // 17: terrarium.module.DownloadSourceZipRequest
+	(*SourceZipResponse)(nil),                      // 18: terrarium.module.SourceZipResponse
+	(*RetrieveProviderDependenciesRequest)(nil),    // 19: terrarium.module.RetrieveProviderDependenciesRequest
+	(*ProviderDependenciesResponse)(nil),           // 20: terrarium.module.ProviderDependenciesResponse
+	(*RetrieveModuleDependenciesRequest)(nil),      // 21: terrarium.module.RetrieveModuleDependenciesRequest
+	(*ModuleDependenciesResponse)(nil),             // 22: terrarium.module.ModuleDependenciesResponse
+	(*RetrieveDependentsRequest)(nil),              // 23: terrarium.module.RetrieveDependentsRequest
+	(*DependentsResponse)(nil),                     // 24: terrarium.module.DependentsResponse
+	(*SearchContainerImagesRequest)(nil),           // 25: terrarium.module.SearchContainerImagesRequest
+	(*ContainerImageVersion)(nil),                  // 26: terrarium.module.ContainerImageVersion
+	(*ContainerImageUsage)(nil),                    // 27: terrarium.module.ContainerImageUsage
+	(*SearchContainerImagesResponse)(nil),          // 28: terrarium.module.SearchContainerImagesResponse
+	(*ExportSBOMRequest)(nil),                      // 29: terrarium.module.ExportSBOMRequest
+	(*SBOMResponse)(nil),                           // 30: terrarium.module.SBOMResponse
+	(*RetrieveDependencyGraphRequest)(nil),         // 31: terrarium.module.RetrieveDependencyGraphRequest
+	(*GraphNode)(nil),                              // 32: terrarium.module.GraphNode
+	(*GraphEdge)(nil),                              // 33: terrarium.module.GraphEdge
+	(*DependencyGraph)(nil),                        // 34: terrarium.module.DependencyGraph
+	(*RetrieveContainerDependenciesRequest)(nil),   // 35: terrarium.module.RetrieveContainerDependenciesRequest
+	(*ContainerDependenciesResponse)(nil),          // 36: terrarium.module.ContainerDependenciesResponse
+	(*RetrieveContainerDependenciesRequestV2)(nil), // 37: terrarium.module.RetrieveContainerDependenciesRequestV2
+	(*ContainerDependenciesResponseV2)(nil),        // 38: terrarium.module.ContainerDependenciesResponseV2
+	(*PublishTagRequest)(nil),                      // 39: terrarium.module.PublishTagRequest
+	(*GetTagsRequest)(nil),                         // 40: terrarium.module.GetTagsRequest
+	(*ModuleTags)(nil),                             // 41: terrarium.module.ModuleTags
+	(*ListModulesByTagRequest)(nil),                // 42: terrarium.module.ListModulesByTagRequest
+	(*ListModulesByTagResponse)(nil),               // 43: terrarium.module.ListModulesByTagResponse
+	(*ListModulesRequest)(nil),                     // 44: terrarium.module.ListModulesRequest
+	(*ModuleDetails)(nil),                          // 45: terrarium.module.ModuleDetails
+	(*ListModulesResponse)(nil),                    // 46: terrarium.module.ListModulesResponse
+	(*ListModuleVersionsRequest)(nil),              // 47: terrarium.module.ListModuleVersionsRequest
+	(*ModuleVersion)(nil),                          // 48: terrarium.module.ModuleVersion
+	(*ListModuleVersionsResponse)(nil),             // 49: terrarium.module.ListModuleVersionsResponse
+	(*SetMaturityRequest)(nil),                     // 50: terrarium.module.SetMaturityRequest
+	(*ReviewVersionRequest)(nil),                   // 51: terrarium.module.ReviewVersionRequest
+	nil,                                            // 52: terrarium.module.RegisterContainerDependenciesRequest.ImagesEntry
+	nil,                                            // 53: terrarium.module.ContainerImageVersion.ImagesEntry
+	nil,                                            // 54: terrarium.module.ContainerDependenciesResponseV2.DependenciesEntry
 }
 var file_pb_terrarium_module_module_proto_depIdxs = []int32{
 	0,  // 0: terrarium.module.RegisterModuleRequest.maturity:type_name -> terrarium.module.Maturity
-	5,  // 1: terrarium.module.ContainerImageDetails.images:type_name -> terrarium.module.ContainerImageRef
-	4,  // 2: terrarium.module.BeginVersionRequest.module:type_name -> terrarium.module.Module
-	4,  // 3: terrarium.module.RegisterModuleDependenciesRequest.module:type_name -> terrarium.module.Module
-	4,  // 4: terrarium.module.RegisterModuleDependenciesRequest.dependencies:type_name -> terrarium.module.Module
-	4,  // 5: terrarium.module.RegisterContainerDependenciesRequest.module:type_name -> terrarium.module.Module
-	21, // 6: terrarium.module.RegisterContainerDependenciesRequest.images:type_name -> terrarium.module.RegisterContainerDependenciesRequest.ImagesEntry
-	4,  // 7: terrarium.module.UploadSourceZipRequest.module:type_name -> terrarium.module.Module
-	4,  // 8: terrarium.module.EndVersionRequest.module:type_name -> terrarium.module.Module
-	1,  // 9: terrarium.module.EndVersionRequest.action:type_name -> terrarium.module.EndVersionRequest.Action
-	4,  // 10: terrarium.module.DownloadSourceZipRequest.module:type_name -> terrarium.module.Module
-	4,  // 11: terrarium.module.RetrieveModuleDependenciesRequest.module:type_name -> terrarium.module.Module
-	4,  // 12: terrarium.module.ModuleDependenciesResponse.module:type_name -> terrarium.module.Module
-	4,  // 13: terrarium.module.ModuleDependenciesResponse.dependencies:type_name -> terrarium.module.Module
-	4,  // 14: terrarium.module.RetrieveContainerDependenciesRequest.module:type_name -> terrarium.module.Module
-	4,  // 15: terrarium.module.ContainerDependenciesResponse.module:type_name -> terrarium.module.Module
-	4,  // 16: terrarium.module.RetrieveContainerDependenciesRequestV2.module:type_name -> terrarium.module.Module
-	4,  // 17: terrarium.module.ContainerDependenciesResponseV2.module:type_name -> terrarium.module.Module
-	22, // 18: terrarium.module.ContainerDependenciesResponseV2.dependencies:type_name -> terrarium.module.ContainerDependenciesResponseV2.DependenciesEntry
-	6,  // 19: terrarium.module.RegisterContainerDependenciesRequest.ImagesEntry.value:type_name -> terrarium.module.ContainerImageDetails
-	6,  // 20: terrarium.module.ContainerDependenciesResponseV2.DependenciesEntry.value:type_name -> terrarium.module.ContainerImageDetails
-	2,  // 21: terrarium.module.Publisher.Register:input_type -> terrarium.module.RegisterModuleRequest
-	7,  // 22: terrarium.module.Publisher.BeginVersion:input_type -> terrarium.module.BeginVersionRequest
-	8,  // 23: terrarium.module.Publisher.RegisterModuleDependencies:input_type -> terrarium.module.RegisterModuleDependenciesRequest
-	9,  // 24: terrarium.module.Publisher.RegisterContainerDependencies:input_type -> terrarium.module.RegisterContainerDependenciesRequest
-	10, // 25: terrarium.module.Publisher.UploadSourceZip:input_type -> terrarium.module.UploadSourceZipRequest
-	11, // 26: terrarium.module.Publisher.EndVersion:input_type -> terrarium.module.EndVersionRequest
-	20, // 27: terrarium.module.Publisher.PublishTag:input_type -> terrarium.module.PublishTagRequest
-	12, // 28: terrarium.module.Consumer.DownloadSourceZip:input_type -> terrarium.module.DownloadSourceZipRequest
-	16, // 29: terrarium.module.Consumer.RetrieveContainerDependencies:input_type -> terrarium.module.RetrieveContainerDependenciesRequest
-	14, // 30: terrarium.module.Consumer.RetrieveModuleDependencies:input_type -> terrarium.module.RetrieveModuleDependenciesRequest
-	18, // 31: terrarium.module.Consumer.RetrieveContainerDependenciesV2:input_type -> terrarium.module.RetrieveContainerDependenciesRequestV2
-	3,  // 32: terrarium.module.Publisher.Register:output_type -> terrarium.module.Response
-	3,  // 33: terrarium.module.Publisher.BeginVersion:output_type -> terrarium.module.Response
-	3,  // 34: terrarium.module.Publisher.RegisterModuleDependencies:output_type -> terrarium.module.Response
-	3,  // 35: terrarium.module.Publisher.RegisterContainerDependencies:output_type -> terrarium.module.Response
-	3,  // 36: terrarium.module.Publisher.UploadSourceZip:output_type -> terrarium.module.Response
-	3,  // 37: terrarium.module.Publisher.EndVersion:output_type -> terrarium.module.Response
-	3,  // 38: terrarium.module.Publisher.PublishTag:output_type -> terrarium.module.Response
-	13, // 39: terrarium.module.Consumer.DownloadSourceZip:output_type -> terrarium.module.SourceZipResponse
-	17, // 40: terrarium.module.Consumer.RetrieveContainerDependencies:output_type -> terrarium.module.ContainerDependenciesResponse
-	15, // 41: terrarium.module.Consumer.RetrieveModuleDependencies:output_type -> terrarium.module.ModuleDependenciesResponse
-	19, // 42: terrarium.module.Consumer.RetrieveContainerDependenciesV2:output_type -> terrarium.module.ContainerDependenciesResponseV2
-	32, // [32:43] is the sub-list for method output_type
-	21, // [21:32] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	7,  // 1: terrarium.module.ContainerImageDetails.images:type_name -> terrarium.module.ContainerImageRef
+	6,  // 2: terrarium.module.BeginVersionRequest.module:type_name -> terrarium.module.Module
+	0,  // 3: terrarium.module.BeginVersionRequest.maturity:type_name -> terrarium.module.Maturity
+	6,  // 4: terrarium.module.RegisterModuleDependenciesRequest.module:type_name -> terrarium.module.Module
+	6,  // 5: terrarium.module.RegisterModuleDependenciesRequest.dependencies:type_name -> terrarium.module.Module
+	11, // 6: terrarium.module.RegisterModuleDependenciesRequest.requirements:type_name -> terrarium.module.ModuleRequirement
+	13, // 7: terrarium.module.RegisterModuleDependenciesRequest.providers:type_name -> terrarium.module.ProviderRequirement
+	6,  // 8: terrarium.module.RegisterContainerDependenciesRequest.module:type_name -> terrarium.module.Module
+	52, // 9: terrarium.module.RegisterContainerDependenciesRequest.images:type_name -> terrarium.module.RegisterContainerDependenciesRequest.ImagesEntry
+	6,  // 10: terrarium.module.UploadSourceZipRequest.module:type_name -> terrarium.module.Module
+	6,  // 11: terrarium.module.EndVersionRequest.module:type_name -> terrarium.module.Module
+	2,  // 12: terrarium.module.EndVersionRequest.action:type_name -> terrarium.module.EndVersionRequest.Action
+	6,  // 13: terrarium.module.DownloadSourceZipRequest.module:type_name -> terrarium.module.Module
+	6,  // 14: terrarium.module.RetrieveProviderDependenciesRequest.module:type_name -> terrarium.module.Module
+	6,  // 15: terrarium.module.ProviderDependenciesResponse.module:type_name -> terrarium.module.Module
+	13, // 16: terrarium.module.ProviderDependenciesResponse.providers:type_name -> terrarium.module.ProviderRequirement
+	6,  // 17: terrarium.module.RetrieveModuleDependenciesRequest.module:type_name -> terrarium.module.Module
+	6,  // 18: terrarium.module.ModuleDependenciesResponse.module:type_name -> terrarium.module.Module
+	6,  // 19: terrarium.module.ModuleDependenciesResponse.dependencies:type_name -> terrarium.module.Module
+	6,  // 20: terrarium.module.ModuleDependenciesResponse.cycles:type_name -> terrarium.module.Module
+	12, // 21: terrarium.module.ModuleDependenciesResponse.requirements:type_name -> terrarium.module.ResolvedRequirement
+	6,  // 22: terrarium.module.RetrieveDependentsRequest.module:type_name -> terrarium.module.Module
+	6,  // 23: terrarium.module.DependentsResponse.module:type_name -> terrarium.module.Module
+	6,  // 24: terrarium.module.DependentsResponse.dependents:type_name -> terrarium.module.Module
+	53, // 25: terrarium.module.ContainerImageVersion.images:type_name -> terrarium.module.ContainerImageVersion.ImagesEntry
+	26, // 26: terrarium.module.ContainerImageUsage.versions:type_name -> terrarium.module.ContainerImageVersion
+	27, // 27: terrarium.module.SearchContainerImagesResponse.modules:type_name -> terrarium.module.ContainerImageUsage
+	6,  // 28: terrarium.module.ExportSBOMRequest.module:type_name -> terrarium.module.Module
+	1,  // 29: terrarium.module.ExportSBOMRequest.format:type_name -> terrarium.module.SBOMFormat
+	6,  // 30: terrarium.module.RetrieveDependencyGraphRequest.module:type_name -> terrarium.module.Module
+	3,  // 31: terrarium.module.GraphNode.type:type_name -> terrarium.module.GraphNode.Type
+	0,  // 32: terrarium.module.GraphNode.maturity:type_name -> terrarium.module.Maturity
+	32, // 33: terrarium.module.DependencyGraph.nodes:type_name -> terrarium.module.GraphNode
+	33, // 34: terrarium.module.DependencyGraph.edges:type_name -> terrarium.module.GraphEdge
+	6,  // 35: terrarium.module.RetrieveContainerDependenciesRequest.module:type_name -> terrarium.module.Module
+	6,  // 36: terrarium.module.ContainerDependenciesResponse.module:type_name -> terrarium.module.Module
+	6,  // 37: terrarium.module.RetrieveContainerDependenciesRequestV2.module:type_name -> terrarium.module.Module
+	6,  // 38: terrarium.module.ContainerDependenciesResponseV2.module:type_name -> terrarium.module.Module
+	54, // 39: terrarium.module.ContainerDependenciesResponseV2.dependencies:type_name -> terrarium.module.ContainerDependenciesResponseV2.DependenciesEntry
+	6,  // 40: terrarium.module.ContainerDependenciesResponseV2.cycles:type_name -> terrarium.module.Module
+	0,  // 41: terrarium.module.ModuleDetails.maturity:type_name -> terrarium.module.Maturity
+	45, // 42: terrarium.module.ListModulesResponse.modules:type_name -> terrarium.module.ModuleDetails
+	0,  // 43: terrarium.module.ModuleVersion.maturity:type_name -> terrarium.module.Maturity
+	48, // 44: terrarium.module.ListModuleVersionsResponse.versions:type_name -> terrarium.module.ModuleVersion
+	6,  // 45: terrarium.module.SetMaturityRequest.module:type_name -> terrarium.module.Module
+	0,  // 46: terrarium.module.SetMaturityRequest.maturity:type_name -> terrarium.module.Maturity
+	6,  // 47: terrarium.module.ReviewVersionRequest.module:type_name -> terrarium.module.Module
+	8,  // 48: terrarium.module.RegisterContainerDependenciesRequest.ImagesEntry.value:type_name -> terrarium.module.ContainerImageDetails
+	8,  // 49: terrarium.module.ContainerImageVersion.ImagesEntry.value:type_name -> terrarium.module.ContainerImageDetails
+	8,  // 50: terrarium.module.ContainerDependenciesResponseV2.DependenciesEntry.value:type_name -> terrarium.module.ContainerImageDetails
+	4,  // 51: terrarium.module.Publisher.Register:input_type -> terrarium.module.RegisterModuleRequest
+	9,  // 52: terrarium.module.Publisher.BeginVersion:input_type -> terrarium.module.BeginVersionRequest
+	10, // 53: terrarium.module.Publisher.RegisterModuleDependencies:input_type -> terrarium.module.RegisterModuleDependenciesRequest
+	14, // 54: terrarium.module.Publisher.RegisterContainerDependencies:input_type -> terrarium.module.RegisterContainerDependenciesRequest
+	15, // 55: terrarium.module.Publisher.UploadSourceZip:input_type -> terrarium.module.UploadSourceZipRequest
+	16, // 56: terrarium.module.Publisher.EndVersion:input_type -> terrarium.module.EndVersionRequest
+	39, // 57: terrarium.module.Publisher.PublishTag:input_type -> terrarium.module.PublishTagRequest
+	39, // 58: terrarium.module.Publisher.AddTags:input_type -> terrarium.module.PublishTagRequest
+	39, // 59: terrarium.module.Publisher.RemoveTags:input_type -> terrarium.module.PublishTagRequest
+	50, // 60: terrarium.module.Publisher.SetMaturity:input_type -> terrarium.module.SetMaturityRequest
+	51, // 61: terrarium.module.Publisher.ApproveVersion:input_type -> terrarium.module.ReviewVersionRequest
+	51, // 62: terrarium.module.Publisher.RejectVersion:input_type -> terrarium.module.ReviewVersionRequest
+	17, // 63: terrarium.module.Consumer.DownloadSourceZip:input_type -> terrarium.module.DownloadSourceZipRequest
+	35, // 64: terrarium.module.Consumer.RetrieveContainerDependencies:input_type -> terrarium.module.RetrieveContainerDependenciesRequest
+	21, // 65: terrarium.module.Consumer.RetrieveModuleDependencies:input_type -> terrarium.module.RetrieveModuleDependenciesRequest
+	37, // 66: terrarium.module.Consumer.RetrieveContainerDependenciesV2:input_type -> terrarium.module.RetrieveContainerDependenciesRequestV2
+	23, // 67: terrarium.module.Consumer.RetrieveDependents:input_type -> terrarium.module.RetrieveDependentsRequest
+	25, // 68: terrarium.module.Consumer.SearchContainerImages:input_type -> terrarium.module.SearchContainerImagesRequest
+	29, // 69: terrarium.module.Consumer.ExportSBOM:input_type -> terrarium.module.ExportSBOMRequest
+	31, // 70: terrarium.module.Consumer.RetrieveDependencyGraph:input_type -> terrarium.module.RetrieveDependencyGraphRequest
+	19, // 71: terrarium.module.Consumer.RetrieveProviderDependencies:input_type -> terrarium.module.RetrieveProviderDependenciesRequest
+	40, // 72: terrarium.module.Consumer.GetTags:input_type -> terrarium.module.GetTagsRequest
+	42, // 73: terrarium.module.Consumer.ListModulesByTag:input_type -> terrarium.module.ListModulesByTagRequest
+	44, // 74: terrarium.module.Consumer.ListModules:input_type -> terrarium.module.ListModulesRequest
+	47, // 75: terrarium.module.Consumer.ListModuleVersions:input_type -> terrarium.module.ListModuleVersionsRequest
+	5,  // 76: terrarium.module.Publisher.Register:output_type -> terrarium.module.Response
+	5,  // 77: terrarium.module.Publisher.BeginVersion:output_type -> terrarium.module.Response
+	5,  // 78: terrarium.module.Publisher.RegisterModuleDependencies:output_type -> terrarium.module.Response
+	5,  // 79: terrarium.module.Publisher.RegisterContainerDependencies:output_type -> terrarium.module.Response
+	5,  // 80: terrarium.module.Publisher.UploadSourceZip:output_type -> terrarium.module.Response
+	5,  // 81: terrarium.module.Publisher.EndVersion:output_type -> terrarium.module.Response
+	5,  // 82: terrarium.module.Publisher.PublishTag:output_type -> terrarium.module.Response
+	5,  // 83: terrarium.module.Publisher.AddTags:output_type -> terrarium.module.Response
+	5,  // 84: terrarium.module.Publisher.RemoveTags:output_type -> terrarium.module.Response
+	5,  // 85: terrarium.module.Publisher.SetMaturity:output_type -> terrarium.module.Response
+	5,  // 86: terrarium.module.Publisher.ApproveVersion:output_type -> terrarium.module.Response
+	5,  // 87: terrarium.module.Publisher.RejectVersion:output_type -> terrarium.module.Response
+	18, // 88: terrarium.module.Consumer.DownloadSourceZip:output_type -> terrarium.module.SourceZipResponse
+	36, // 89: terrarium.module.Consumer.RetrieveContainerDependencies:output_type -> terrarium.module.ContainerDependenciesResponse
+	22, // 90: terrarium.module.Consumer.RetrieveModuleDependencies:output_type -> terrarium.module.ModuleDependenciesResponse
+	38, // 91: terrarium.module.Consumer.RetrieveContainerDependenciesV2:output_type -> terrarium.module.ContainerDependenciesResponseV2
+	24, // 92: terrarium.module.Consumer.RetrieveDependents:output_type -> terrarium.module.DependentsResponse
+	28, // 93: terrarium.module.Consumer.SearchContainerImages:output_type -> terrarium.module.SearchContainerImagesResponse
+	30, // 94: terrarium.module.Consumer.ExportSBOM:output_type -> terrarium.module.SBOMResponse
+	34, // 95: terrarium.module.Consumer.RetrieveDependencyGraph:output_type -> terrarium.module.DependencyGraph
+	20, // 96: terrarium.module.Consumer.RetrieveProviderDependencies:output_type -> terrarium.module.ProviderDependenciesResponse
+	41, // 97: terrarium.module.Consumer.GetTags:output_type -> terrarium.module.ModuleTags
+	43, // 98: terrarium.module.Consumer.ListModulesByTag:output_type -> terrarium.module.ListModulesByTagResponse
+	46, // 99: terrarium.module.Consumer.ListModules:output_type -> terrarium.module.ListModulesResponse
+	49, // 100: terrarium.module.Consumer.ListModuleVersions:output_type -> terrarium.module.ListModuleVersionsResponse
+	76, // [76:101] is the sub-list for method output_type
+	51, // [51:76] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_pb_terrarium_module_module_proto_init() }
@@ -1515,243 +3744,15 @@ func file_pb_terrarium_module_module_proto_init() {
 	if File_pb_terrarium_module_module_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pb_terrarium_module_module_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterModuleRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Module); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerImageRef); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerImageDetails); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeginVersionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterModuleDependenciesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterContainerDependenciesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadSourceZipRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EndVersionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadSourceZipRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SourceZipResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveModuleDependenciesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModuleDependenciesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveContainerDependenciesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerDependenciesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveContainerDependenciesRequestV2); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerDependenciesResponseV2); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_terrarium_module_module_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishTagRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
+	file_pb_terrarium_module_module_proto_msgTypes[5].OneofWrappers = []any{}
+	file_pb_terrarium_module_module_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_terrarium_module_module_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   21,
+			NumEnums:      4,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
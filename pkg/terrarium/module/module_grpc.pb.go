@@ -26,6 +26,11 @@ const (
 	Publisher_UploadSourceZip_FullMethodName               = "/terrarium.module.Publisher/UploadSourceZip"
 	Publisher_EndVersion_FullMethodName                    = "/terrarium.module.Publisher/EndVersion"
 	Publisher_PublishTag_FullMethodName                    = "/terrarium.module.Publisher/PublishTag"
+	Publisher_AddTags_FullMethodName                       = "/terrarium.module.Publisher/AddTags"
+	Publisher_RemoveTags_FullMethodName                    = "/terrarium.module.Publisher/RemoveTags"
+	Publisher_SetMaturity_FullMethodName                   = "/terrarium.module.Publisher/SetMaturity"
+	Publisher_ApproveVersion_FullMethodName                = "/terrarium.module.Publisher/ApproveVersion"
+	Publisher_RejectVersion_FullMethodName                 = "/terrarium.module.Publisher/RejectVersion"
 )
 
 // PublisherClient is the client API for Publisher service.
@@ -41,6 +46,11 @@ type PublisherClient interface {
 	// Upload Documentation
 	EndVersion(ctx context.Context, in *EndVersionRequest, opts ...grpc.CallOption) (*Response, error)
 	PublishTag(ctx context.Context, in *PublishTagRequest, opts ...grpc.CallOption) (*Response, error)
+	AddTags(ctx context.Context, in *PublishTagRequest, opts ...grpc.CallOption) (*Response, error)
+	RemoveTags(ctx context.Context, in *PublishTagRequest, opts ...grpc.CallOption) (*Response, error)
+	SetMaturity(ctx context.Context, in *SetMaturityRequest, opts ...grpc.CallOption) (*Response, error)
+	ApproveVersion(ctx context.Context, in *ReviewVersionRequest, opts ...grpc.CallOption) (*Response, error)
+	RejectVersion(ctx context.Context, in *ReviewVersionRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type publisherClient struct {
@@ -139,6 +149,51 @@ func (c *publisherClient) PublishTag(ctx context.Context, in *PublishTagRequest,
 	return out, nil
 }
 
+func (c *publisherClient) AddTags(ctx context.Context, in *PublishTagRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Publisher_AddTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) RemoveTags(ctx context.Context, in *PublishTagRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Publisher_RemoveTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) SetMaturity(ctx context.Context, in *SetMaturityRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Publisher_SetMaturity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) ApproveVersion(ctx context.Context, in *ReviewVersionRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Publisher_ApproveVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) RejectVersion(ctx context.Context, in *ReviewVersionRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Publisher_RejectVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PublisherServer is the server API for Publisher service.
 // All implementations must embed UnimplementedPublisherServer
 // for forward compatibility
@@ -152,6 +207,11 @@ type PublisherServer interface {
 	// Upload Documentation
 	EndVersion(context.Context, *EndVersionRequest) (*Response, error)
 	PublishTag(context.Context, *PublishTagRequest) (*Response, error)
+	AddTags(context.Context, *PublishTagRequest) (*Response, error)
+	RemoveTags(context.Context, *PublishTagRequest) (*Response, error)
+	SetMaturity(context.Context, *SetMaturityRequest) (*Response, error)
+	ApproveVersion(context.Context, *ReviewVersionRequest) (*Response, error)
+	RejectVersion(context.Context, *ReviewVersionRequest) (*Response, error)
 	mustEmbedUnimplementedPublisherServer()
 }
 
@@ -180,6 +240,21 @@ func (UnimplementedPublisherServer) EndVersion(context.Context, *EndVersionReque
 func (UnimplementedPublisherServer) PublishTag(context.Context, *PublishTagRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishTag not implemented")
 }
+func (UnimplementedPublisherServer) AddTags(context.Context, *PublishTagRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTags not implemented")
+}
+func (UnimplementedPublisherServer) RemoveTags(context.Context, *PublishTagRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTags not implemented")
+}
+func (UnimplementedPublisherServer) SetMaturity(context.Context, *SetMaturityRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaturity not implemented")
+}
+func (UnimplementedPublisherServer) ApproveVersion(context.Context, *ReviewVersionRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveVersion not implemented")
+}
+func (UnimplementedPublisherServer) RejectVersion(context.Context, *ReviewVersionRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectVersion not implemented")
+}
 func (UnimplementedPublisherServer) mustEmbedUnimplementedPublisherServer() {}
 
 // UnsafePublisherServer may be embedded to opt out of forward compatibility for this service.
@@ -327,6 +402,96 @@ func _Publisher_PublishTag_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Publisher_AddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).AddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Publisher_AddTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).AddTags(ctx, req.(*PublishTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_RemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).RemoveTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Publisher_RemoveTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).RemoveTags(ctx, req.(*PublishTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_SetMaturity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaturityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).SetMaturity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Publisher_SetMaturity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).SetMaturity(ctx, req.(*SetMaturityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_ApproveVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).ApproveVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Publisher_ApproveVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).ApproveVersion(ctx, req.(*ReviewVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_RejectVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).RejectVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Publisher_RejectVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).RejectVersion(ctx, req.(*ReviewVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Publisher_ServiceDesc is the grpc.ServiceDesc for Publisher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -358,6 +523,26 @@ var Publisher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishTag",
 			Handler:    _Publisher_PublishTag_Handler,
 		},
+		{
+			MethodName: "AddTags",
+			Handler:    _Publisher_AddTags_Handler,
+		},
+		{
+			MethodName: "RemoveTags",
+			Handler:    _Publisher_RemoveTags_Handler,
+		},
+		{
+			MethodName: "SetMaturity",
+			Handler:    _Publisher_SetMaturity_Handler,
+		},
+		{
+			MethodName: "ApproveVersion",
+			Handler:    _Publisher_ApproveVersion_Handler,
+		},
+		{
+			MethodName: "RejectVersion",
+			Handler:    _Publisher_RejectVersion_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -374,6 +559,15 @@ const (
 	Consumer_RetrieveContainerDependencies_FullMethodName   = "/terrarium.module.Consumer/RetrieveContainerDependencies"
 	Consumer_RetrieveModuleDependencies_FullMethodName      = "/terrarium.module.Consumer/RetrieveModuleDependencies"
 	Consumer_RetrieveContainerDependenciesV2_FullMethodName = "/terrarium.module.Consumer/RetrieveContainerDependenciesV2"
+	Consumer_RetrieveDependents_FullMethodName              = "/terrarium.module.Consumer/RetrieveDependents"
+	Consumer_SearchContainerImages_FullMethodName           = "/terrarium.module.Consumer/SearchContainerImages"
+	Consumer_ExportSBOM_FullMethodName                      = "/terrarium.module.Consumer/ExportSBOM"
+	Consumer_RetrieveDependencyGraph_FullMethodName         = "/terrarium.module.Consumer/RetrieveDependencyGraph"
+	Consumer_RetrieveProviderDependencies_FullMethodName    = "/terrarium.module.Consumer/RetrieveProviderDependencies"
+	Consumer_GetTags_FullMethodName                         = "/terrarium.module.Consumer/GetTags"
+	Consumer_ListModulesByTag_FullMethodName                = "/terrarium.module.Consumer/ListModulesByTag"
+	Consumer_ListModules_FullMethodName                     = "/terrarium.module.Consumer/ListModules"
+	Consumer_ListModuleVersions_FullMethodName              = "/terrarium.module.Consumer/ListModuleVersions"
 )
 
 // ConsumerClient is the client API for Consumer service.
@@ -384,6 +578,15 @@ type ConsumerClient interface {
 	RetrieveContainerDependencies(ctx context.Context, in *RetrieveContainerDependenciesRequest, opts ...grpc.CallOption) (Consumer_RetrieveContainerDependenciesClient, error)
 	RetrieveModuleDependencies(ctx context.Context, in *RetrieveModuleDependenciesRequest, opts ...grpc.CallOption) (Consumer_RetrieveModuleDependenciesClient, error)
 	RetrieveContainerDependenciesV2(ctx context.Context, in *RetrieveContainerDependenciesRequestV2, opts ...grpc.CallOption) (Consumer_RetrieveContainerDependenciesV2Client, error)
+	RetrieveDependents(ctx context.Context, in *RetrieveDependentsRequest, opts ...grpc.CallOption) (Consumer_RetrieveDependentsClient, error)
+	SearchContainerImages(ctx context.Context, in *SearchContainerImagesRequest, opts ...grpc.CallOption) (*SearchContainerImagesResponse, error)
+	ExportSBOM(ctx context.Context, in *ExportSBOMRequest, opts ...grpc.CallOption) (*SBOMResponse, error)
+	RetrieveDependencyGraph(ctx context.Context, in *RetrieveDependencyGraphRequest, opts ...grpc.CallOption) (*DependencyGraph, error)
+	RetrieveProviderDependencies(ctx context.Context, in *RetrieveProviderDependenciesRequest, opts ...grpc.CallOption) (*ProviderDependenciesResponse, error)
+	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*ModuleTags, error)
+	ListModulesByTag(ctx context.Context, in *ListModulesByTagRequest, opts ...grpc.CallOption) (*ListModulesByTagResponse, error)
+	ListModules(ctx context.Context, in *ListModulesRequest, opts ...grpc.CallOption) (*ListModulesResponse, error)
+	ListModuleVersions(ctx context.Context, in *ListModuleVersionsRequest, opts ...grpc.CallOption) (*ListModuleVersionsResponse, error)
 }
 
 type consumerClient struct {
@@ -522,6 +725,110 @@ func (x *consumerRetrieveContainerDependenciesV2Client) Recv() (*ContainerDepend
 	return m, nil
 }
 
+func (c *consumerClient) RetrieveDependents(ctx context.Context, in *RetrieveDependentsRequest, opts ...grpc.CallOption) (Consumer_RetrieveDependentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Consumer_ServiceDesc.Streams[4], Consumer_RetrieveDependents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &consumerRetrieveDependentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Consumer_RetrieveDependentsClient interface {
+	Recv() (*DependentsResponse, error)
+	grpc.ClientStream
+}
+
+type consumerRetrieveDependentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *consumerRetrieveDependentsClient) Recv() (*DependentsResponse, error) {
+	m := new(DependentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *consumerClient) SearchContainerImages(ctx context.Context, in *SearchContainerImagesRequest, opts ...grpc.CallOption) (*SearchContainerImagesResponse, error) {
+	out := new(SearchContainerImagesResponse)
+	err := c.cc.Invoke(ctx, Consumer_SearchContainerImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerClient) ExportSBOM(ctx context.Context, in *ExportSBOMRequest, opts ...grpc.CallOption) (*SBOMResponse, error) {
+	out := new(SBOMResponse)
+	err := c.cc.Invoke(ctx, Consumer_ExportSBOM_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerClient) RetrieveDependencyGraph(ctx context.Context, in *RetrieveDependencyGraphRequest, opts ...grpc.CallOption) (*DependencyGraph, error) {
+	out := new(DependencyGraph)
+	err := c.cc.Invoke(ctx, Consumer_RetrieveDependencyGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerClient) RetrieveProviderDependencies(ctx context.Context, in *RetrieveProviderDependenciesRequest, opts ...grpc.CallOption) (*ProviderDependenciesResponse, error) {
+	out := new(ProviderDependenciesResponse)
+	err := c.cc.Invoke(ctx, Consumer_RetrieveProviderDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*ModuleTags, error) {
+	out := new(ModuleTags)
+	err := c.cc.Invoke(ctx, Consumer_GetTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerClient) ListModulesByTag(ctx context.Context, in *ListModulesByTagRequest, opts ...grpc.CallOption) (*ListModulesByTagResponse, error) {
+	out := new(ListModulesByTagResponse)
+	err := c.cc.Invoke(ctx, Consumer_ListModulesByTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerClient) ListModules(ctx context.Context, in *ListModulesRequest, opts ...grpc.CallOption) (*ListModulesResponse, error) {
+	out := new(ListModulesResponse)
+	err := c.cc.Invoke(ctx, Consumer_ListModules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consumerClient) ListModuleVersions(ctx context.Context, in *ListModuleVersionsRequest, opts ...grpc.CallOption) (*ListModuleVersionsResponse, error) {
+	out := new(ListModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, Consumer_ListModuleVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConsumerServer is the server API for Consumer service.
 // All implementations must embed UnimplementedConsumerServer
 // for forward compatibility
@@ -530,6 +837,15 @@ type ConsumerServer interface {
 	RetrieveContainerDependencies(*RetrieveContainerDependenciesRequest, Consumer_RetrieveContainerDependenciesServer) error
 	RetrieveModuleDependencies(*RetrieveModuleDependenciesRequest, Consumer_RetrieveModuleDependenciesServer) error
 	RetrieveContainerDependenciesV2(*RetrieveContainerDependenciesRequestV2, Consumer_RetrieveContainerDependenciesV2Server) error
+	RetrieveDependents(*RetrieveDependentsRequest, Consumer_RetrieveDependentsServer) error
+	SearchContainerImages(context.Context, *SearchContainerImagesRequest) (*SearchContainerImagesResponse, error)
+	ExportSBOM(context.Context, *ExportSBOMRequest) (*SBOMResponse, error)
+	RetrieveDependencyGraph(context.Context, *RetrieveDependencyGraphRequest) (*DependencyGraph, error)
+	RetrieveProviderDependencies(context.Context, *RetrieveProviderDependenciesRequest) (*ProviderDependenciesResponse, error)
+	GetTags(context.Context, *GetTagsRequest) (*ModuleTags, error)
+	ListModulesByTag(context.Context, *ListModulesByTagRequest) (*ListModulesByTagResponse, error)
+	ListModules(context.Context, *ListModulesRequest) (*ListModulesResponse, error)
+	ListModuleVersions(context.Context, *ListModuleVersionsRequest) (*ListModuleVersionsResponse, error)
 	mustEmbedUnimplementedConsumerServer()
 }
 
@@ -549,6 +865,33 @@ func (UnimplementedConsumerServer) RetrieveModuleDependencies(*RetrieveModuleDep
 func (UnimplementedConsumerServer) RetrieveContainerDependenciesV2(*RetrieveContainerDependenciesRequestV2, Consumer_RetrieveContainerDependenciesV2Server) error {
 	return status.Errorf(codes.Unimplemented, "method RetrieveContainerDependenciesV2 not implemented")
 }
+func (UnimplementedConsumerServer) RetrieveDependents(*RetrieveDependentsRequest, Consumer_RetrieveDependentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method RetrieveDependents not implemented")
+}
+func (UnimplementedConsumerServer) SearchContainerImages(context.Context, *SearchContainerImagesRequest) (*SearchContainerImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchContainerImages not implemented")
+}
+func (UnimplementedConsumerServer) ExportSBOM(context.Context, *ExportSBOMRequest) (*SBOMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSBOM not implemented")
+}
+func (UnimplementedConsumerServer) RetrieveDependencyGraph(context.Context, *RetrieveDependencyGraphRequest) (*DependencyGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveDependencyGraph not implemented")
+}
+func (UnimplementedConsumerServer) RetrieveProviderDependencies(context.Context, *RetrieveProviderDependenciesRequest) (*ProviderDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveProviderDependencies not implemented")
+}
+func (UnimplementedConsumerServer) GetTags(context.Context, *GetTagsRequest) (*ModuleTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedConsumerServer) ListModulesByTag(context.Context, *ListModulesByTagRequest) (*ListModulesByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModulesByTag not implemented")
+}
+func (UnimplementedConsumerServer) ListModules(context.Context, *ListModulesRequest) (*ListModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModules not implemented")
+}
+func (UnimplementedConsumerServer) ListModuleVersions(context.Context, *ListModuleVersionsRequest) (*ListModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModuleVersions not implemented")
+}
 func (UnimplementedConsumerServer) mustEmbedUnimplementedConsumerServer() {}
 
 // UnsafeConsumerServer may be embedded to opt out of forward compatibility for this service.
@@ -646,13 +989,211 @@ func (x *consumerRetrieveContainerDependenciesV2Server) Send(m *ContainerDepende
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Consumer_RetrieveDependents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RetrieveDependentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConsumerServer).RetrieveDependents(m, &consumerRetrieveDependentsServer{stream})
+}
+
+type Consumer_RetrieveDependentsServer interface {
+	Send(*DependentsResponse) error
+	grpc.ServerStream
+}
+
+type consumerRetrieveDependentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *consumerRetrieveDependentsServer) Send(m *DependentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Consumer_SearchContainerImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchContainerImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServer).SearchContainerImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Consumer_SearchContainerImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServer).SearchContainerImages(ctx, req.(*SearchContainerImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Consumer_ExportSBOM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSBOMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServer).ExportSBOM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Consumer_ExportSBOM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServer).ExportSBOM(ctx, req.(*ExportSBOMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Consumer_RetrieveDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveDependencyGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServer).RetrieveDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Consumer_RetrieveDependencyGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServer).RetrieveDependencyGraph(ctx, req.(*RetrieveDependencyGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Consumer_RetrieveProviderDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveProviderDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServer).RetrieveProviderDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Consumer_RetrieveProviderDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServer).RetrieveProviderDependencies(ctx, req.(*RetrieveProviderDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Consumer_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Consumer_GetTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServer).GetTags(ctx, req.(*GetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Consumer_ListModulesByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModulesByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServer).ListModulesByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Consumer_ListModulesByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServer).ListModulesByTag(ctx, req.(*ListModulesByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Consumer_ListModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServer).ListModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Consumer_ListModules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServer).ListModules(ctx, req.(*ListModulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Consumer_ListModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumerServer).ListModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Consumer_ListModuleVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumerServer).ListModuleVersions(ctx, req.(*ListModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Consumer_ServiceDesc is the grpc.ServiceDesc for Consumer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Consumer_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "terrarium.module.Consumer",
 	HandlerType: (*ConsumerServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchContainerImages",
+			Handler:    _Consumer_SearchContainerImages_Handler,
+		},
+		{
+			MethodName: "ExportSBOM",
+			Handler:    _Consumer_ExportSBOM_Handler,
+		},
+		{
+			MethodName: "RetrieveDependencyGraph",
+			Handler:    _Consumer_RetrieveDependencyGraph_Handler,
+		},
+		{
+			MethodName: "RetrieveProviderDependencies",
+			Handler:    _Consumer_RetrieveProviderDependencies_Handler,
+		},
+		{
+			MethodName: "GetTags",
+			Handler:    _Consumer_GetTags_Handler,
+		},
+		{
+			MethodName: "ListModulesByTag",
+			Handler:    _Consumer_ListModulesByTag_Handler,
+		},
+		{
+			MethodName: "ListModules",
+			Handler:    _Consumer_ListModules_Handler,
+		},
+		{
+			MethodName: "ListModuleVersions",
+			Handler:    _Consumer_ListModuleVersions_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "DownloadSourceZip",
@@ -674,6 +1215,11 @@ var Consumer_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Consumer_RetrieveContainerDependenciesV2_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RetrieveDependents",
+			Handler:       _Consumer_RetrieveDependents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pb/terrarium/module/module.proto",
 }
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
-// - protoc             v3.12.4
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
 // source: pb/terrarium/provider/provider.proto
 
 package provider
@@ -18,6 +18,16 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+const (
+	ProviderPublisher_UploadProviderBinaryZip_FullMethodName = "/terrarium.provider.ProviderPublisher/UploadProviderBinaryZip"
+	ProviderPublisher_UploadShasum_FullMethodName            = "/terrarium.provider.ProviderPublisher/UploadShasum"
+	ProviderPublisher_UploadShasumSignature_FullMethodName   = "/terrarium.provider.ProviderPublisher/UploadShasumSignature"
+	ProviderPublisher_RegisterProvider_FullMethodName        = "/terrarium.provider.ProviderPublisher/RegisterProvider"
+	ProviderPublisher_EndProvider_FullMethodName             = "/terrarium.provider.ProviderPublisher/EndProvider"
+	ProviderPublisher_ApproveProviderVersion_FullMethodName  = "/terrarium.provider.ProviderPublisher/ApproveProviderVersion"
+	ProviderPublisher_RejectProviderVersion_FullMethodName   = "/terrarium.provider.ProviderPublisher/RejectProviderVersion"
+)
+
 // ProviderPublisherClient is the client API for ProviderPublisher service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -27,6 +37,8 @@ type ProviderPublisherClient interface {
 	UploadShasumSignature(ctx context.Context, opts ...grpc.CallOption) (ProviderPublisher_UploadShasumSignatureClient, error)
 	RegisterProvider(ctx context.Context, in *RegisterProviderRequest, opts ...grpc.CallOption) (*Response, error)
 	EndProvider(ctx context.Context, in *EndProviderRequest, opts ...grpc.CallOption) (*Response, error)
+	ApproveProviderVersion(ctx context.Context, in *ReviewProviderVersionRequest, opts ...grpc.CallOption) (*Response, error)
+	RejectProviderVersion(ctx context.Context, in *ReviewProviderVersionRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type providerPublisherClient struct {
@@ -38,7 +50,7 @@ func NewProviderPublisherClient(cc grpc.ClientConnInterface) ProviderPublisherCl
 }
 
 func (c *providerPublisherClient) UploadProviderBinaryZip(ctx context.Context, opts ...grpc.CallOption) (ProviderPublisher_UploadProviderBinaryZipClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ProviderPublisher_ServiceDesc.Streams[0], "/terrarium.provider.ProviderPublisher/UploadProviderBinaryZip", opts...)
+	stream, err := c.cc.NewStream(ctx, &ProviderPublisher_ServiceDesc.Streams[0], ProviderPublisher_UploadProviderBinaryZip_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +84,7 @@ func (x *providerPublisherUploadProviderBinaryZipClient) CloseAndRecv() (*Respon
 }
 
 func (c *providerPublisherClient) UploadShasum(ctx context.Context, opts ...grpc.CallOption) (ProviderPublisher_UploadShasumClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ProviderPublisher_ServiceDesc.Streams[1], "/terrarium.provider.ProviderPublisher/UploadShasum", opts...)
+	stream, err := c.cc.NewStream(ctx, &ProviderPublisher_ServiceDesc.Streams[1], ProviderPublisher_UploadShasum_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +118,7 @@ func (x *providerPublisherUploadShasumClient) CloseAndRecv() (*Response, error)
 }
 
 func (c *providerPublisherClient) UploadShasumSignature(ctx context.Context, opts ...grpc.CallOption) (ProviderPublisher_UploadShasumSignatureClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ProviderPublisher_ServiceDesc.Streams[2], "/terrarium.provider.ProviderPublisher/UploadShasumSignature", opts...)
+	stream, err := c.cc.NewStream(ctx, &ProviderPublisher_ServiceDesc.Streams[2], ProviderPublisher_UploadShasumSignature_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +153,7 @@ func (x *providerPublisherUploadShasumSignatureClient) CloseAndRecv() (*Response
 
 func (c *providerPublisherClient) RegisterProvider(ctx context.Context, in *RegisterProviderRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
-	err := c.cc.Invoke(ctx, "/terrarium.provider.ProviderPublisher/RegisterProvider", in, out, opts...)
+	err := c.cc.Invoke(ctx, ProviderPublisher_RegisterProvider_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +162,25 @@ func (c *providerPublisherClient) RegisterProvider(ctx context.Context, in *Regi
 
 func (c *providerPublisherClient) EndProvider(ctx context.Context, in *EndProviderRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
-	err := c.cc.Invoke(ctx, "/terrarium.provider.ProviderPublisher/EndProvider", in, out, opts...)
+	err := c.cc.Invoke(ctx, ProviderPublisher_EndProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPublisherClient) ApproveProviderVersion(ctx context.Context, in *ReviewProviderVersionRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, ProviderPublisher_ApproveProviderVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerPublisherClient) RejectProviderVersion(ctx context.Context, in *ReviewProviderVersionRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, ProviderPublisher_RejectProviderVersion_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +196,8 @@ type ProviderPublisherServer interface {
 	UploadShasumSignature(ProviderPublisher_UploadShasumSignatureServer) error
 	RegisterProvider(context.Context, *RegisterProviderRequest) (*Response, error)
 	EndProvider(context.Context, *EndProviderRequest) (*Response, error)
+	ApproveProviderVersion(context.Context, *ReviewProviderVersionRequest) (*Response, error)
+	RejectProviderVersion(context.Context, *ReviewProviderVersionRequest) (*Response, error)
 	mustEmbedUnimplementedProviderPublisherServer()
 }
 
@@ -188,6 +220,12 @@ func (UnimplementedProviderPublisherServer) RegisterProvider(context.Context, *R
 func (UnimplementedProviderPublisherServer) EndProvider(context.Context, *EndProviderRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndProvider not implemented")
 }
+func (UnimplementedProviderPublisherServer) ApproveProviderVersion(context.Context, *ReviewProviderVersionRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveProviderVersion not implemented")
+}
+func (UnimplementedProviderPublisherServer) RejectProviderVersion(context.Context, *ReviewProviderVersionRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectProviderVersion not implemented")
+}
 func (UnimplementedProviderPublisherServer) mustEmbedUnimplementedProviderPublisherServer() {}
 
 // UnsafeProviderPublisherServer may be embedded to opt out of forward compatibility for this service.
@@ -289,7 +327,7 @@ func _ProviderPublisher_RegisterProvider_Handler(srv interface{}, ctx context.Co
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.provider.ProviderPublisher/RegisterProvider",
+		FullMethod: ProviderPublisher_RegisterProvider_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ProviderPublisherServer).RegisterProvider(ctx, req.(*RegisterProviderRequest))
@@ -307,7 +345,7 @@ func _ProviderPublisher_EndProvider_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/terrarium.provider.ProviderPublisher/EndProvider",
+		FullMethod: ProviderPublisher_EndProvider_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ProviderPublisherServer).EndProvider(ctx, req.(*EndProviderRequest))
@@ -315,6 +353,42 @@ func _ProviderPublisher_EndProvider_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProviderPublisher_ApproveProviderVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewProviderVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPublisherServer).ApproveProviderVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderPublisher_ApproveProviderVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPublisherServer).ApproveProviderVersion(ctx, req.(*ReviewProviderVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderPublisher_RejectProviderVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewProviderVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderPublisherServer).RejectProviderVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderPublisher_RejectProviderVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderPublisherServer).RejectProviderVersion(ctx, req.(*ReviewProviderVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProviderPublisher_ServiceDesc is the grpc.ServiceDesc for ProviderPublisher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -330,6 +404,14 @@ var ProviderPublisher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EndProvider",
 			Handler:    _ProviderPublisher_EndProvider_Handler,
 		},
+		{
+			MethodName: "ApproveProviderVersion",
+			Handler:    _ProviderPublisher_ApproveProviderVersion_Handler,
+		},
+		{
+			MethodName: "RejectProviderVersion",
+			Handler:    _ProviderPublisher_RejectProviderVersion_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -350,3 +432,323 @@ var ProviderPublisher_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "pb/terrarium/provider/provider.proto",
 }
+
+const (
+	ProviderConsumer_ListProviders_FullMethodName             = "/terrarium.provider.ProviderConsumer/ListProviders"
+	ProviderConsumer_ListProviderVersions_FullMethodName      = "/terrarium.provider.ProviderConsumer/ListProviderVersions"
+	ProviderConsumer_DownloadProviderBinaryZip_FullMethodName = "/terrarium.provider.ProviderConsumer/DownloadProviderBinaryZip"
+	ProviderConsumer_DownloadShasum_FullMethodName            = "/terrarium.provider.ProviderConsumer/DownloadShasum"
+	ProviderConsumer_DownloadShasumSignature_FullMethodName   = "/terrarium.provider.ProviderConsumer/DownloadShasumSignature"
+)
+
+// ProviderConsumerClient is the client API for ProviderConsumer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProviderConsumerClient interface {
+	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
+	ListProviderVersions(ctx context.Context, in *ListProviderVersionsRequest, opts ...grpc.CallOption) (*ListProviderVersionsResponse, error)
+	DownloadProviderBinaryZip(ctx context.Context, in *DownloadProviderBinaryZipRequest, opts ...grpc.CallOption) (ProviderConsumer_DownloadProviderBinaryZipClient, error)
+	DownloadShasum(ctx context.Context, in *DownloadShasumRequest, opts ...grpc.CallOption) (ProviderConsumer_DownloadShasumClient, error)
+	DownloadShasumSignature(ctx context.Context, in *DownloadShasumRequest, opts ...grpc.CallOption) (ProviderConsumer_DownloadShasumSignatureClient, error)
+}
+
+type providerConsumerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProviderConsumerClient(cc grpc.ClientConnInterface) ProviderConsumerClient {
+	return &providerConsumerClient{cc}
+}
+
+func (c *providerConsumerClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
+	out := new(ListProvidersResponse)
+	err := c.cc.Invoke(ctx, ProviderConsumer_ListProviders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerConsumerClient) ListProviderVersions(ctx context.Context, in *ListProviderVersionsRequest, opts ...grpc.CallOption) (*ListProviderVersionsResponse, error) {
+	out := new(ListProviderVersionsResponse)
+	err := c.cc.Invoke(ctx, ProviderConsumer_ListProviderVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerConsumerClient) DownloadProviderBinaryZip(ctx context.Context, in *DownloadProviderBinaryZipRequest, opts ...grpc.CallOption) (ProviderConsumer_DownloadProviderBinaryZipClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProviderConsumer_ServiceDesc.Streams[0], ProviderConsumer_DownloadProviderBinaryZip_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &providerConsumerDownloadProviderBinaryZipClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProviderConsumer_DownloadProviderBinaryZipClient interface {
+	Recv() (*ProviderBinaryZipResponse, error)
+	grpc.ClientStream
+}
+
+type providerConsumerDownloadProviderBinaryZipClient struct {
+	grpc.ClientStream
+}
+
+func (x *providerConsumerDownloadProviderBinaryZipClient) Recv() (*ProviderBinaryZipResponse, error) {
+	m := new(ProviderBinaryZipResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *providerConsumerClient) DownloadShasum(ctx context.Context, in *DownloadShasumRequest, opts ...grpc.CallOption) (ProviderConsumer_DownloadShasumClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProviderConsumer_ServiceDesc.Streams[1], ProviderConsumer_DownloadShasum_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &providerConsumerDownloadShasumClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProviderConsumer_DownloadShasumClient interface {
+	Recv() (*ShasumResponse, error)
+	grpc.ClientStream
+}
+
+type providerConsumerDownloadShasumClient struct {
+	grpc.ClientStream
+}
+
+func (x *providerConsumerDownloadShasumClient) Recv() (*ShasumResponse, error) {
+	m := new(ShasumResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *providerConsumerClient) DownloadShasumSignature(ctx context.Context, in *DownloadShasumRequest, opts ...grpc.CallOption) (ProviderConsumer_DownloadShasumSignatureClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProviderConsumer_ServiceDesc.Streams[2], ProviderConsumer_DownloadShasumSignature_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &providerConsumerDownloadShasumSignatureClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProviderConsumer_DownloadShasumSignatureClient interface {
+	Recv() (*ShasumResponse, error)
+	grpc.ClientStream
+}
+
+type providerConsumerDownloadShasumSignatureClient struct {
+	grpc.ClientStream
+}
+
+func (x *providerConsumerDownloadShasumSignatureClient) Recv() (*ShasumResponse, error) {
+	m := new(ShasumResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ProviderConsumerServer is the server API for ProviderConsumer service.
+// All implementations must embed UnimplementedProviderConsumerServer
+// for forward compatibility
+type ProviderConsumerServer interface {
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
+	ListProviderVersions(context.Context, *ListProviderVersionsRequest) (*ListProviderVersionsResponse, error)
+	DownloadProviderBinaryZip(*DownloadProviderBinaryZipRequest, ProviderConsumer_DownloadProviderBinaryZipServer) error
+	DownloadShasum(*DownloadShasumRequest, ProviderConsumer_DownloadShasumServer) error
+	DownloadShasumSignature(*DownloadShasumRequest, ProviderConsumer_DownloadShasumSignatureServer) error
+	mustEmbedUnimplementedProviderConsumerServer()
+}
+
+// UnimplementedProviderConsumerServer must be embedded to have forward compatible implementations.
+type UnimplementedProviderConsumerServer struct {
+}
+
+func (UnimplementedProviderConsumerServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
+}
+func (UnimplementedProviderConsumerServer) ListProviderVersions(context.Context, *ListProviderVersionsRequest) (*ListProviderVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviderVersions not implemented")
+}
+func (UnimplementedProviderConsumerServer) DownloadProviderBinaryZip(*DownloadProviderBinaryZipRequest, ProviderConsumer_DownloadProviderBinaryZipServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadProviderBinaryZip not implemented")
+}
+func (UnimplementedProviderConsumerServer) DownloadShasum(*DownloadShasumRequest, ProviderConsumer_DownloadShasumServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadShasum not implemented")
+}
+func (UnimplementedProviderConsumerServer) DownloadShasumSignature(*DownloadShasumRequest, ProviderConsumer_DownloadShasumSignatureServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadShasumSignature not implemented")
+}
+func (UnimplementedProviderConsumerServer) mustEmbedUnimplementedProviderConsumerServer() {}
+
+// UnsafeProviderConsumerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProviderConsumerServer will
+// result in compilation errors.
+type UnsafeProviderConsumerServer interface {
+	mustEmbedUnimplementedProviderConsumerServer()
+}
+
+func RegisterProviderConsumerServer(s grpc.ServiceRegistrar, srv ProviderConsumerServer) {
+	s.RegisterService(&ProviderConsumer_ServiceDesc, srv)
+}
+
+func _ProviderConsumer_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderConsumerServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderConsumer_ListProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderConsumerServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderConsumer_ListProviderVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderConsumerServer).ListProviderVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderConsumer_ListProviderVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderConsumerServer).ListProviderVersions(ctx, req.(*ListProviderVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderConsumer_DownloadProviderBinaryZip_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadProviderBinaryZipRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderConsumerServer).DownloadProviderBinaryZip(m, &providerConsumerDownloadProviderBinaryZipServer{stream})
+}
+
+type ProviderConsumer_DownloadProviderBinaryZipServer interface {
+	Send(*ProviderBinaryZipResponse) error
+	grpc.ServerStream
+}
+
+type providerConsumerDownloadProviderBinaryZipServer struct {
+	grpc.ServerStream
+}
+
+func (x *providerConsumerDownloadProviderBinaryZipServer) Send(m *ProviderBinaryZipResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProviderConsumer_DownloadShasum_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadShasumRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderConsumerServer).DownloadShasum(m, &providerConsumerDownloadShasumServer{stream})
+}
+
+type ProviderConsumer_DownloadShasumServer interface {
+	Send(*ShasumResponse) error
+	grpc.ServerStream
+}
+
+type providerConsumerDownloadShasumServer struct {
+	grpc.ServerStream
+}
+
+func (x *providerConsumerDownloadShasumServer) Send(m *ShasumResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProviderConsumer_DownloadShasumSignature_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadShasumRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderConsumerServer).DownloadShasumSignature(m, &providerConsumerDownloadShasumSignatureServer{stream})
+}
+
+type ProviderConsumer_DownloadShasumSignatureServer interface {
+	Send(*ShasumResponse) error
+	grpc.ServerStream
+}
+
+type providerConsumerDownloadShasumSignatureServer struct {
+	grpc.ServerStream
+}
+
+func (x *providerConsumerDownloadShasumSignatureServer) Send(m *ShasumResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ProviderConsumer_ServiceDesc is the grpc.ServiceDesc for ProviderConsumer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProviderConsumer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "terrarium.provider.ProviderConsumer",
+	HandlerType: (*ProviderConsumerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProviders",
+			Handler:    _ProviderConsumer_ListProviders_Handler,
+		},
+		{
+			MethodName: "ListProviderVersions",
+			Handler:    _ProviderConsumer_ListProviderVersions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadProviderBinaryZip",
+			Handler:       _ProviderConsumer_DownloadProviderBinaryZip_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadShasum",
+			Handler:       _ProviderConsumer_DownloadShasum_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadShasumSignature",
+			Handler:       _ProviderConsumer_DownloadShasumSignature_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pb/terrarium/provider/provider.proto",
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
+	"github.com/terrariumcloud/terrarium/tools/cli/pkg/bundle"
+	"google.golang.org/grpc"
+)
+
+var (
+	bundleSelection  bundle.Selection
+	bundleOutput     string
+	bundleSigningKey string
+	bundleVerifyKey  string
+)
+
+// bundleCmd represents the bundle command
+var bundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Move modules and providers between Terrarium instances through signed archives.",
+}
+
+// bundleExportCmd represents the bundle export command
+var bundleExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export modules with their transitive module dependencies, and providers, to a signed archive.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(bundleSelection.Organizations) == 0 && len(bundleSelection.Modules) == 0 && len(bundleSelection.Providers) == 0 {
+			printErrorAndExit("Nothing to export, select an organization, a module or a provider", nil, 1)
+		}
+		key, err := readBundleKey(bundleSigningKey, bundle.ParsePrivateKey)
+		if err != nil {
+			printErrorAndExit("Failed to read signing key", err, 1)
+		}
+
+		conn, clients, err := getBundleClients()
+		if err != nil {
+			printErrorAndExit("Failed to connect to terrarium", err, 1)
+		}
+		defer func() { _ = conn.Close() }()
+
+		out, err := os.Create(bundleOutput)
+		if err != nil {
+			printErrorAndExit("Failed to create bundle", err, 1)
+		}
+		defer func() { _ = out.Close() }()
+
+		manifest, err := bundle.Export(context.Background(), clients, bundleSelection, key, out)
+		if err != nil {
+			_ = out.Close()
+			_ = os.Remove(bundleOutput)
+			printErrorAndExit("Failed to export bundle", err, 1)
+		}
+		for _, m := range manifest.Modules {
+			for _, version := range m.Versions {
+				fmt.Printf("Exported module %s@%s\n", m.Name, version.Version)
+			}
+		}
+		for _, p := range manifest.Providers {
+			for _, version := range p.Versions {
+				fmt.Printf("Exported provider %s@%s\n", p.Name, version.Version)
+			}
+		}
+	},
+}
+
+// bundleImportCmd represents the bundle import command
+var bundleImportCmd = &cobra.Command{
+	Use:   "import [bundle]",
+	Short: "Import the modules and providers of a signed archive, skipping the versions already published.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		key, err := readBundleKey(bundleVerifyKey, bundle.ParsePublicKey)
+		if err != nil {
+			printErrorAndExit("Failed to read verification key", err, 1)
+		}
+
+		in, err := os.Open(args[0])
+		if err != nil {
+			printErrorAndExit("Failed to open bundle", err, 1)
+		}
+		defer func() { _ = in.Close() }()
+
+		conn, clients, err := getBundleClients()
+		if err != nil {
+			printErrorAndExit("Failed to connect to terrarium", err, 1)
+		}
+		defer func() { _ = conn.Close() }()
+
+		result, err := bundle.Import(context.Background(), clients, in, key)
+		if err != nil {
+			printErrorAndExit("Failed to import bundle", err, 1)
+		}
+		for _, version := range result.Imported {
+			fmt.Printf("Imported %s\n", version)
+		}
+		for _, version := range result.Skipped {
+			fmt.Printf("Skipped %s, already published\n", version)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(bundleCmd)
+	bundleCmd.AddCommand(bundleExportCmd, bundleImportCmd)
+
+	bundleExportCmd.Flags().StringSliceVar(&bundleSelection.Organizations, "org", nil, "Organizations to export all modules and providers of.")
+	bundleExportCmd.Flags().StringSliceVar(&bundleSelection.Modules, "module", nil, "Modules to export, e.g. cie/eks/aws.")
+	bundleExportCmd.Flags().StringSliceVar(&bundleSelection.Providers, "provider", nil, "Providers to export, e.g. cie/test.")
+	bundleExportCmd.Flags().StringVar(&bundleSelection.VersionRange, "versions", "", "Version range of the selected modules and providers, e.g. \">= 1.0, < 2.0\". All versions when not given.")
+	bundleExportCmd.Flags().StringVarP(&bundleOutput, "output", "o", "terrarium-bundle.tar.gz", "File to write the bundle to.")
+	bundleExportCmd.Flags().StringVar(&bundleSigningKey, "signing-key", "", "PEM file of the ed25519 private key to sign the bundle with.")
+	_ = bundleExportCmd.MarkFlagRequired("signing-key")
+
+	bundleImportCmd.Flags().StringVar(&bundleVerifyKey, "verify-key", "", "PEM file of the ed25519 public key the bundle must be signed with.")
+	_ = bundleImportCmd.MarkFlagRequired("verify-key")
+}
+
+func getBundleClients() (*grpc.ClientConn, bundle.Clients, error) {
+	conn, err := dialTerrarium()
+	if err != nil {
+		return nil, bundle.Clients{}, err
+	}
+	return conn, bundle.Clients{
+		ModuleConsumer:    module.NewConsumerClient(conn),
+		ModulePublisher:   module.NewPublisherClient(conn),
+		ProviderConsumer:  provider.NewProviderConsumerClient(conn),
+		ProviderPublisher: provider.NewProviderPublisherClient(conn),
+	}, nil
+}
+
+func readBundleKey[K any](filename string, parse func([]byte) (K, error)) (K, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		var key K
+		return key, err
+	}
+	return parse(data)
+}
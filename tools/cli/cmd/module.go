@@ -4,7 +4,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // moduleCmd represents the module command
@@ -18,7 +17,7 @@ func init() {
 }
 
 func getModulePublisherClient() (*grpc.ClientConn, module.PublisherClient, error) {
-	conn, err := grpc.Dial(terrariumEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := dialTerrarium()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -26,7 +25,7 @@ func getModulePublisherClient() (*grpc.ClientConn, module.PublisherClient, error
 }
 
 func getModuleConsumerClient() (*grpc.ClientConn, module.ConsumerClient, error) {
-	conn, err := grpc.Dial(terrariumEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := dialTerrarium()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -24,6 +24,7 @@ var containerDepsCmd = &cobra.Command{
 				Name:    args[0],
 				Version: args[1],
 			},
+			MaxDepth: dependenciesMaxDepth,
 		}
 		responseClient, err := client.RetrieveContainerDependenciesV2(context.Background(), &req)
 		if err != nil {
@@ -52,4 +53,5 @@ var containerDepsCmd = &cobra.Command{
 
 func init() {
 	moduleCmd.AddCommand(containerDepsCmd)
+	containerDepsCmd.Flags().Int32Var(&dependenciesMaxDepth, "max-depth", 0, "Maximum number of levels of dependencies to follow, 0 for no limit.")
 }
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+var (
+	dependentsRecursive    bool
+	dependentsVersionRange string
+)
+
+// moduleDependentsCmd represents the dependents command
+var moduleDependentsCmd = &cobra.Command{
+	Use:   "dependents [module name] [version]",
+	Short: "List the modules depending on the specified module, for all versions when no version is given.",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, client, err := getModuleConsumerClient()
+		if err != nil {
+			printErrorAndExit("Failed to connect to terrarium", err, 1)
+		}
+		defer func() { _ = conn.Close() }()
+		req := module.RetrieveDependentsRequest{
+			Module: &module.Module{
+				Name: args[0],
+			},
+			Recursive:    dependentsRecursive,
+			VersionRange: dependentsVersionRange,
+		}
+		if len(args) > 1 {
+			req.Module.Version = args[1]
+		}
+		responseClient, err := client.RetrieveDependents(context.Background(), &req)
+		if err != nil {
+			printErrorAndExit("Failed to retrieve module dependents", err, 1)
+		}
+		for {
+			response, err := responseClient.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				printErrorAndExit("Retrieving dependents failed", err, 1)
+			}
+			if response.Module.Version == "" {
+				fmt.Printf("%s:\n", response.Module.Name)
+			} else {
+				fmt.Printf("%s:%s:\n", response.Module.Name, response.Module.Version)
+			}
+			for _, module := range response.Dependents {
+				fmt.Printf("    - %s:%s\n", module.Name, module.Version)
+			}
+		}
+	},
+}
+
+func init() {
+	moduleCmd.AddCommand(moduleDependentsCmd)
+	moduleDependentsCmd.Flags().BoolVarP(&dependentsRecursive, "recursive", "r", false, "Also list the modules depending on the dependents.")
+	moduleDependentsCmd.Flags().StringVar(&dependentsVersionRange, "range", "", "Only list dependents using a version in this range, e.g. \">= 1.0.0, < 2.0.0\".")
+}
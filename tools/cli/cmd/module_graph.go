@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	modulePkg "github.com/terrariumcloud/terrarium/tools/cli/pkg/module"
+)
+
+var (
+	graphFormat   string
+	graphMaxDepth int32
+)
+
+// moduleGraphCmd represents the graph command
+var moduleGraphCmd = &cobra.Command{
+	Use:   "graph [module name] [version]",
+	Short: "Export the dependency graph of a module version as Graphviz DOT, Mermaid or JSON.",
+	Example: "  terrarium module graph cie/eks/aws 3.0.0 | dot -Tsvg > graph.svg\n" +
+		"  terrarium module graph cie/eks/aws 3.0.0 --format mermaid --max-depth 2",
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, client, err := getModuleConsumerClient()
+		if err != nil {
+			printErrorAndExit("Failed to connect to terrarium", err, 1)
+		}
+		defer func() { _ = conn.Close() }()
+		req := module.RetrieveDependencyGraphRequest{
+			Module: &module.Module{
+				Name:    args[0],
+				Version: args[1],
+			},
+			MaxDepth: graphMaxDepth,
+		}
+		response, err := client.RetrieveDependencyGraph(context.Background(), &req)
+		if err != nil {
+			printErrorAndExit("Failed to retrieve dependency graph", err, 1)
+		}
+
+		graph := modulePkg.NewGraph(response)
+		switch graphFormat {
+		case "dot":
+			err = graph.WriteDOT(os.Stdout)
+		case "mermaid":
+			err = graph.WriteMermaid(os.Stdout)
+		case "json":
+			err = graph.WriteJSON(os.Stdout)
+		default:
+			err = fmt.Errorf("%s, expected dot, mermaid or json", graphFormat)
+		}
+		if err != nil {
+			printErrorAndExit("Failed to write dependency graph", err, 1)
+		}
+	},
+}
+
+func init() {
+	moduleCmd.AddCommand(moduleGraphCmd)
+	moduleGraphCmd.Flags().StringVarP(&graphFormat, "format", "f", "dot", "Format of the graph, dot, mermaid or json.")
+	moduleGraphCmd.Flags().Int32Var(&graphMaxDepth, "max-depth", 0, "Maximum depth of dependencies to walk, unlimited when 0.")
+}
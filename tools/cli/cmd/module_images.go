@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+var (
+	imagesName      string
+	imagesNamespace string
+	imagesTag       string
+	imagesDigest    string
+)
+
+// moduleImagesCmd represents the images command
+var moduleImagesCmd = &cobra.Command{
+	Use:   "images [namespace/name:tag]",
+	Short: "List the module versions referencing a container image, by name, namespace, tag or digest prefix.",
+	Example: "  terrarium module images base/nginx:1.23\n" +
+		"  terrarium module images --digest sha256:0d17b565",
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		req := module.SearchContainerImagesRequest{
+			Name:      imagesName,
+			Namespace: imagesNamespace,
+			Tag:       imagesTag,
+			Digest:    imagesDigest,
+		}
+		if len(args) > 0 {
+			parseImageReference(args[0], &req)
+		}
+
+		conn, client, err := getModuleConsumerClient()
+		if err != nil {
+			printErrorAndExit("Failed to connect to terrarium", err, 1)
+		}
+		defer func() { _ = conn.Close() }()
+		response, err := client.SearchContainerImages(context.Background(), &req)
+		if err != nil {
+			printErrorAndExit("Failed to search container images", err, 1)
+		}
+		for _, usage := range response.Modules {
+			fmt.Printf("%s (latest %s):\n", usage.Name, usage.LatestVersion)
+			for _, version := range usage.Versions {
+				fmt.Printf("    %s:\n", version.Version)
+				names := make([]string, 0, len(version.Images))
+				for name := range version.Images {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				for _, name := range names {
+					containerDetails := version.Images[name]
+					fmt.Printf("        %s/%s:%s\n", containerDetails.Namespace, name, containerDetails.Tag)
+					for _, details := range containerDetails.Images {
+						fmt.Printf("            - arch: %s\n", details.Arch)
+						fmt.Printf("              image: %s\n", details.Image)
+					}
+				}
+			}
+		}
+	},
+}
+
+// parseImageReference fills the criteria of a search from a reference such as "base/nginx:1.23"
+// or "base/nginx@sha256:0d17...", leaving the parts not given to the flags.
+func parseImageReference(reference string, req *module.SearchContainerImagesRequest) {
+	if i := strings.Index(reference, "@"); i >= 0 {
+		req.Digest = reference[i+1:]
+		reference = reference[:i]
+	}
+	if i := strings.LastIndex(reference, ":"); i > strings.LastIndex(reference, "/") {
+		req.Tag = reference[i+1:]
+		reference = reference[:i]
+	}
+	if i := strings.LastIndex(reference, "/"); i >= 0 {
+		req.Namespace = reference[:i]
+		reference = reference[i+1:]
+	}
+	if reference != "" {
+		req.Name = reference
+	}
+}
+
+func init() {
+	moduleCmd.AddCommand(moduleImagesCmd)
+	moduleImagesCmd.Flags().StringVar(&imagesName, "name", "", "Name of the image.")
+	moduleImagesCmd.Flags().StringVar(&imagesNamespace, "namespace", "", "Namespace of the image.")
+	moduleImagesCmd.Flags().StringVar(&imagesTag, "tag", "", "Tag of the image.")
+	moduleImagesCmd.Flags().StringVar(&imagesDigest, "digest", "", "Prefix of the digest of the image, with or without the algorithm.")
+}
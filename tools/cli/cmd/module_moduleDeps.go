@@ -8,6 +8,8 @@ import (
 	"io"
 )
 
+var dependenciesMaxDepth int32
+
 // moduleDepsCmd represents the moduleDeps command
 var moduleDepsCmd = &cobra.Command{
 	Use:   "module-deps",
@@ -24,6 +26,7 @@ var moduleDepsCmd = &cobra.Command{
 				Name:    args[0],
 				Version: args[1],
 			},
+			MaxDepth: dependenciesMaxDepth,
 		}
 		responseClient, err := client.RetrieveModuleDependencies(context.Background(), &req)
 		if err != nil {
@@ -41,10 +44,21 @@ var moduleDepsCmd = &cobra.Command{
 			for _, module := range response.Dependencies {
 				fmt.Printf("    - %s:%s\n", module.Name, module.Version)
 			}
+			for _, module := range response.Cycles {
+				fmt.Printf("    ! cycle back to %s:%s\n", module.Name, module.Version)
+			}
+			for _, requirement := range response.Requirements {
+				fmt.Printf("    ~ %s %q recorded %s, resolves to %s", requirement.Name, requirement.VersionConstraint, requirement.RecordedVersion, requirement.ResolvedVersion)
+				if requirement.Differs {
+					fmt.Print(" (differs from recorded)")
+				}
+				fmt.Println()
+			}
 		}
 	},
 }
 
 func init() {
 	moduleCmd.AddCommand(moduleDepsCmd)
+	moduleDepsCmd.Flags().Int32Var(&dependenciesMaxDepth, "max-depth", 0, "Maximum number of levels of dependencies to follow, 0 for no limit.")
 }
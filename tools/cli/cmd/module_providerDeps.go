@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+// moduleProviderDepsCmd represents the provider-deps command
+var moduleProviderDepsCmd = &cobra.Command{
+	Use:   "provider-deps [module name] [version]",
+	Short: "List the providers required by the specified module version.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, client, err := getModuleConsumerClient()
+		if err != nil {
+			printErrorAndExit("Failed to connect to terrarium", err, 1)
+		}
+		defer func() { _ = conn.Close() }()
+		req := module.RetrieveProviderDependenciesRequest{
+			Module: &module.Module{
+				Name:    args[0],
+				Version: args[1],
+			},
+		}
+		response, err := client.RetrieveProviderDependencies(context.Background(), &req)
+		if err != nil {
+			printErrorAndExit("Failed to retrieve provider dependencies", err, 1)
+		}
+		fmt.Printf("%s:%s:\n", response.Module.Name, response.Module.Version)
+		for _, provider := range response.Providers {
+			fmt.Printf("    - %s (%s)", provider.Name, provider.Source)
+			if len(provider.VersionConstraints) > 0 {
+				fmt.Printf(": %s", strings.Join(provider.VersionConstraints, "; "))
+			}
+			fmt.Println()
+		}
+	},
+}
+
+func init() {
+	moduleCmd.AddCommand(moduleProviderDepsCmd)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+var (
+	reviewComment string
+)
+
+// moduleApproveCmd represents the approve command
+var moduleApproveCmd = &cobra.Command{
+	Use:   "approve [module name] [version]",
+	Short: "Approves a module version waiting for approval so it becomes visible to consumers.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		reviewModuleVersion(args[0], args[1], true)
+	},
+}
+
+// moduleRejectCmd represents the reject command
+var moduleRejectCmd = &cobra.Command{
+	Use:   "reject [module name] [version]",
+	Short: "Rejects a module version waiting for approval.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		reviewModuleVersion(args[0], args[1], false)
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{moduleApproveCmd, moduleRejectCmd} {
+		moduleCmd.AddCommand(c)
+		c.Flags().StringVar(&reviewComment, "comment", "", "Comment explaining the decision.")
+	}
+}
+
+func reviewModuleVersion(name, version string, approve bool) {
+	conn, client, err := getModulePublisherClient()
+	if err != nil {
+		printErrorAndExit("Failed to connect to terrarium", err, 1)
+	}
+	defer func() { _ = conn.Close() }()
+
+	req := module.ReviewVersionRequest{
+		Module: &module.Module{
+			Name:    name,
+			Version: version,
+		},
+		Comment: reviewComment,
+	}
+
+	if approve {
+		_, err = client.ApproveVersion(context.Background(), &req)
+	} else {
+		_, err = client.RejectVersion(context.Background(), &req)
+	}
+	if err != nil {
+		printErrorAndExit("Reviewing module version failed", err, 1)
+	}
+
+	if approve {
+		fmt.Printf("%s:%s approved.\n", name, version)
+	} else {
+		fmt.Printf("%s:%s rejected.\n", name, version)
+	}
+}
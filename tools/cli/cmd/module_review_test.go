@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type reviewPublisher struct {
+	module.UnimplementedPublisherServer
+	approved *module.ReviewVersionRequest
+	metadata metadata.MD
+}
+
+func (p *reviewPublisher) ApproveVersion(ctx context.Context, req *module.ReviewVersionRequest) (*module.Response, error) {
+	p.approved = req
+	p.metadata, _ = metadata.FromIncomingContext(ctx)
+	return &module.Response{}, nil
+}
+
+// Test_reviewModuleVersion checks:
+// - if the version is approved with the token of the caller
+func Test_reviewModuleVersion(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v.", err)
+	}
+
+	publisher := &reviewPublisher{}
+	server := grpc.NewServer()
+	module.RegisterPublisherServer(server, publisher)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	terrariumEndpoint = listener.Addr().String()
+	terrariumToken = "secret"
+	reviewComment = "Looks good"
+
+	reviewModuleVersion("cie/test/aws", "1.0.0", true)
+
+	if publisher.approved.GetModule().GetName() != "cie/test/aws" || publisher.approved.GetComment() != "Looks good" {
+		t.Errorf("Expected cie/test/aws to be approved, got %v.", publisher.approved)
+	}
+
+	if !reflect.DeepEqual(publisher.metadata.Get("authorization"), []string{"Bearer secret"}) {
+		t.Errorf("Expected the token to be sent, got %v.", publisher.metadata)
+	}
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+var (
+	sbomFormat string
+	sbomOutput string
+)
+
+// moduleSBOMCmd represents the sbom command
+var moduleSBOMCmd = &cobra.Command{
+	Use:   "sbom [module name] [version]",
+	Short: "Export the software bill of materials of a module version, covering its transitive dependencies.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		format, ok := module.SBOMFormat_value[strings.ToUpper(sbomFormat)]
+		if !ok {
+			printErrorAndExit("Unsupported SBOM format", fmt.Errorf("%s, expected cyclonedx or spdx", sbomFormat), 1)
+		}
+
+		conn, client, err := getModuleConsumerClient()
+		if err != nil {
+			printErrorAndExit("Failed to connect to terrarium", err, 1)
+		}
+		defer func() { _ = conn.Close() }()
+		req := module.ExportSBOMRequest{
+			Module: &module.Module{
+				Name:    args[0],
+				Version: args[1],
+			},
+			Format: module.SBOMFormat(format),
+		}
+		response, err := client.ExportSBOM(context.Background(), &req)
+		if err != nil {
+			printErrorAndExit("Failed to export SBOM", err, 1)
+		}
+
+		if sbomOutput == "" {
+			fmt.Println(string(response.Document))
+			return
+		}
+		if err := os.WriteFile(sbomOutput, response.Document, 0644); err != nil {
+			printErrorAndExit("Failed to write SBOM", err, 1)
+		}
+	},
+}
+
+func init() {
+	moduleCmd.AddCommand(moduleSBOMCmd)
+	moduleSBOMCmd.Flags().StringVarP(&sbomFormat, "format", "f", "cyclonedx", "Format of the SBOM, cyclonedx or spdx.")
+	moduleSBOMCmd.Flags().StringVarP(&sbomOutput, "output", "o", "", "File to write the SBOM to, printed when not given.")
+}
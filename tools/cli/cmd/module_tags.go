@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+// moduleTagsCmd represents the tags command
+var moduleTagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "Manage the tags of modules.",
+}
+
+// moduleTagsGetCmd represents the tags get command
+var moduleTagsGetCmd = &cobra.Command{
+	Use:   "get [module name]",
+	Short: "Lists the tags of a module.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, client, err := getModuleConsumerClient()
+		if err != nil {
+			printErrorAndExit("Failed to connect to terrarium", err, 1)
+		}
+		defer func() { _ = conn.Close() }()
+
+		response, err := client.GetTags(context.Background(), &module.GetTagsRequest{Name: args[0]})
+		if err != nil {
+			printErrorAndExit("Failed to get module tags", err, 1)
+		}
+		for _, tag := range response.GetTags() {
+			fmt.Println(tag)
+		}
+	},
+}
+
+// moduleTagsAddCmd represents the tags add command
+var moduleTagsAddCmd = &cobra.Command{
+	Use:   "add [module name] [tags...]",
+	Short: "Adds tags to a module, keeping its other tags.",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		updateModuleTags(args[0], args[1:], true)
+	},
+}
+
+// moduleTagsRemoveCmd represents the tags remove command
+var moduleTagsRemoveCmd = &cobra.Command{
+	Use:   "remove [module name] [tags...]",
+	Short: "Removes tags from a module.",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		updateModuleTags(args[0], args[1:], false)
+	},
+}
+
+// moduleTagsModulesCmd represents the tags modules command
+var moduleTagsModulesCmd = &cobra.Command{
+	Use:   "modules [tag]",
+	Short: "Lists the modules having a tag.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, client, err := getModuleConsumerClient()
+		if err != nil {
+			printErrorAndExit("Failed to connect to terrarium", err, 1)
+		}
+		defer func() { _ = conn.Close() }()
+
+		response, err := client.ListModulesByTag(context.Background(), &module.ListModulesByTagRequest{Tag: args[0]})
+		if err != nil {
+			printErrorAndExit("Failed to list modules by tag", err, 1)
+		}
+		for _, name := range response.GetModules() {
+			fmt.Println(name)
+		}
+	},
+}
+
+func init() {
+	moduleCmd.AddCommand(moduleTagsCmd)
+	moduleTagsCmd.AddCommand(moduleTagsGetCmd, moduleTagsAddCmd, moduleTagsRemoveCmd, moduleTagsModulesCmd)
+}
+
+func updateModuleTags(name string, tags []string, add bool) {
+	conn, client, err := getModulePublisherClient()
+	if err != nil {
+		printErrorAndExit("Failed to connect to terrarium", err, 1)
+	}
+	defer func() { _ = conn.Close() }()
+
+	req := module.PublishTagRequest{
+		Name: name,
+		Tags: tags,
+	}
+
+	if add {
+		_, err = client.AddTags(context.Background(), &req)
+	} else {
+		_, err = client.RemoveTags(context.Background(), &req)
+	}
+	if err != nil {
+		printErrorAndExit("Updating module tags failed", err, 1)
+	}
+
+	if add {
+		fmt.Printf("Added %s to %s.\n", strings.Join(tags, ", "), name)
+	} else {
+		fmt.Printf("Removed %s from %s.\n", strings.Join(tags, ", "), name)
+	}
+}
@@ -3,7 +3,6 @@ package cmd
 import (
 	"github.com/terrariumcloud/terrarium/pkg/terrarium/release"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/spf13/cobra"
 )
@@ -19,7 +18,7 @@ func init() {
 }
 
 func getReleasePublisherClient() (*grpc.ClientConn, release.ReleasePublisherClient, error) {
-	conn, err := grpc.Dial(terrariumEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := dialTerrarium()
 	if err != nil {
 		return nil, nil, err
 	}
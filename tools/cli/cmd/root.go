@@ -1,13 +1,22 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
-var terrariumEndpoint = "localhost:3001"
+// tokenEnvVar is the environment variable the token is read from when not given with the token flag.
+const tokenEnvVar = "TERRARIUM_TOKEN"
+
+var (
+	terrariumEndpoint = "localhost:3001"
+	terrariumToken    = os.Getenv(tokenEnvVar)
+)
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -32,6 +41,29 @@ func Execute() {
 
 func init() {
 	rootCmd.PersistentFlags().StringVar(&terrariumEndpoint, "endpoint", terrariumEndpoint, "GRPC Endpoint for Terrarium.")
+	rootCmd.PersistentFlags().StringVar(&terrariumToken, "token", terrariumToken, "Bearer token sent to the proxy in front of Terrarium to authenticate as the caller, defaults to $"+tokenEnvVar+".")
+}
+
+// bearerToken sends a token in the authorization metadata of every request.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity allows the token over plaintext connections, which are terminated by the proxy in front
+// of Terrarium.
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
+// dialTerrarium connects to the Terrarium endpoint, authenticating requests with the token when one is given.
+func dialTerrarium() (*grpc.ClientConn, error) {
+	options := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if terrariumToken != "" {
+		options = append(options, grpc.WithPerRPCCredentials(bearerToken(terrariumToken)))
+	}
+	return grpc.Dial(terrariumEndpoint, options...)
 }
 
 func printErrorAndExit(msg string, err error, exitCode int) {
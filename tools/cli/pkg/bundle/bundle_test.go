@@ -0,0 +1,463 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
+	"google.golang.org/grpc"
+)
+
+// stream is a server stream returning its items in turn.
+type stream[T any] struct {
+	grpc.ClientStream
+	items []T
+}
+
+func (s *stream[T]) Recv() (T, error) {
+	var item T
+	if len(s.items) == 0 {
+		return item, io.EOF
+	}
+	item, s.items = s.items[0], s.items[1:]
+	return item, nil
+}
+
+// upload is a client stream recording the requests sent.
+type upload[T, R any] struct {
+	grpc.ClientStream
+	sent []T
+	done func([]T)
+}
+
+func (u *upload[T, R]) Send(request T) error {
+	u.sent = append(u.sent, request)
+	return nil
+}
+
+func (u *upload[T, R]) CloseAndRecv() (R, error) {
+	var response R
+	u.done(u.sent)
+	return response, nil
+}
+
+// registry is an in-memory stand-in for the gateway of a Terrarium instance, serving the modules and providers it is
+// given and recording what is published to it.
+type registry struct {
+	module.ConsumerClient
+	module.PublisherClient
+	provider.ProviderConsumerClient
+	provider.ProviderPublisherClient
+
+	modules          []*module.ModuleDetails
+	moduleVersions   map[string][]*module.ModuleVersion
+	dependencies     map[string][]*module.ModuleDependenciesResponse
+	providerRequires map[string][]*module.ProviderRequirement
+	tags             map[string][]string
+	files            map[string][]byte
+	providers        []*provider.ProviderDetails
+	providerVersions map[string][]*provider.ProviderVersion
+
+	registered          []*module.RegisterModuleRequest
+	begun               []*module.BeginVersionRequest
+	ended               []*module.EndVersionRequest
+	dependenciesRequest []*module.RegisterModuleDependenciesRequest
+	tagged              []*module.PublishTagRequest
+	providerRegistered  []*provider.RegisterProviderRequest
+	providerEnded       []*provider.EndProviderRequest
+	uploaded            map[string][]byte
+}
+
+func (r *registry) ListModules(ctx context.Context, in *module.ListModulesRequest, opts ...grpc.CallOption) (*module.ListModulesResponse, error) {
+	return &module.ListModulesResponse{Modules: r.modules}, nil
+}
+
+// ListModuleVersions returns the versions listed for the name and the range.
+func (r *registry) ListModuleVersions(ctx context.Context, in *module.ListModuleVersionsRequest, opts ...grpc.CallOption) (*module.ListModuleVersionsResponse, error) {
+	return &module.ListModuleVersionsResponse{Name: in.GetName(), Versions: r.moduleVersions[in.GetName()+" "+in.GetVersionRange()]}, nil
+}
+
+func (r *registry) RetrieveModuleDependencies(ctx context.Context, in *module.RetrieveModuleDependenciesRequest, opts ...grpc.CallOption) (module.Consumer_RetrieveModuleDependenciesClient, error) {
+	return &stream[*module.ModuleDependenciesResponse]{items: r.dependencies[moduleKey(in.GetModule().GetName(), in.GetModule().GetVersion())]}, nil
+}
+
+func (r *registry) RetrieveProviderDependencies(ctx context.Context, in *module.RetrieveProviderDependenciesRequest, opts ...grpc.CallOption) (*module.ProviderDependenciesResponse, error) {
+	key := moduleKey(in.GetModule().GetName(), in.GetModule().GetVersion())
+	return &module.ProviderDependenciesResponse{Module: in.GetModule(), Providers: r.providerRequires[key]}, nil
+}
+
+func (r *registry) GetTags(ctx context.Context, in *module.GetTagsRequest, opts ...grpc.CallOption) (*module.ModuleTags, error) {
+	return &module.ModuleTags{Name: in.GetName(), Tags: r.tags[in.GetName()]}, nil
+}
+
+func (r *registry) DownloadSourceZip(ctx context.Context, in *module.DownloadSourceZipRequest, opts ...grpc.CallOption) (module.Consumer_DownloadSourceZipClient, error) {
+	data := r.files[moduleKey(in.GetModule().GetName(), in.GetModule().GetVersion())]
+	return &stream[*module.SourceZipResponse]{items: []*module.SourceZipResponse{{ZipDataChunk: data}}}, nil
+}
+
+func (r *registry) ListProviders(ctx context.Context, in *provider.ListProvidersRequest, opts ...grpc.CallOption) (*provider.ListProvidersResponse, error) {
+	return &provider.ListProvidersResponse{Providers: r.providers}, nil
+}
+
+func (r *registry) ListProviderVersions(ctx context.Context, in *provider.ListProviderVersionsRequest, opts ...grpc.CallOption) (*provider.ListProviderVersionsResponse, error) {
+	return &provider.ListProviderVersionsResponse{Name: in.GetName(), Versions: r.providerVersions[in.GetName()+" "+in.GetVersionRange()]}, nil
+}
+
+func (r *registry) DownloadProviderBinaryZip(ctx context.Context, in *provider.DownloadProviderBinaryZipRequest, opts ...grpc.CallOption) (provider.ProviderConsumer_DownloadProviderBinaryZipClient, error) {
+	data := r.files[moduleKey(in.GetProvider().GetName(), in.GetProvider().GetVersion())+" "+in.GetOs()+"_"+in.GetArch()]
+	return &stream[*provider.ProviderBinaryZipResponse]{items: []*provider.ProviderBinaryZipResponse{{ZipDataChunk: data}}}, nil
+}
+
+func (r *registry) DownloadShasum(ctx context.Context, in *provider.DownloadShasumRequest, opts ...grpc.CallOption) (provider.ProviderConsumer_DownloadShasumClient, error) {
+	data := r.files[moduleKey(in.GetProvider().GetName(), in.GetProvider().GetVersion())+" SHA256SUMS"]
+	return &stream[*provider.ShasumResponse]{items: []*provider.ShasumResponse{{ShasumDataChunk: data}}}, nil
+}
+
+func (r *registry) DownloadShasumSignature(ctx context.Context, in *provider.DownloadShasumRequest, opts ...grpc.CallOption) (provider.ProviderConsumer_DownloadShasumSignatureClient, error) {
+	data := r.files[moduleKey(in.GetProvider().GetName(), in.GetProvider().GetVersion())+" SHA256SUMS.sig"]
+	return &stream[*provider.ShasumResponse]{items: []*provider.ShasumResponse{{ShasumDataChunk: data}}}, nil
+}
+
+func (r *registry) Register(ctx context.Context, in *module.RegisterModuleRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	r.registered = append(r.registered, in)
+	return &module.Response{}, nil
+}
+
+func (r *registry) BeginVersion(ctx context.Context, in *module.BeginVersionRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	r.begun = append(r.begun, in)
+	return &module.Response{}, nil
+}
+
+func (r *registry) EndVersion(ctx context.Context, in *module.EndVersionRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	r.ended = append(r.ended, in)
+	return &module.Response{}, nil
+}
+
+func (r *registry) RegisterModuleDependencies(ctx context.Context, in *module.RegisterModuleDependenciesRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	r.dependenciesRequest = append(r.dependenciesRequest, in)
+	return &module.Response{}, nil
+}
+
+func (r *registry) AddTags(ctx context.Context, in *module.PublishTagRequest, opts ...grpc.CallOption) (*module.Response, error) {
+	r.tagged = append(r.tagged, in)
+	return &module.Response{}, nil
+}
+
+func (r *registry) UploadSourceZip(ctx context.Context, opts ...grpc.CallOption) (module.Publisher_UploadSourceZipClient, error) {
+	return &upload[*module.UploadSourceZipRequest, *module.Response]{done: func(sent []*module.UploadSourceZipRequest) {
+		for _, req := range sent {
+			key := moduleKey(req.GetModule().GetName(), req.GetModule().GetVersion())
+			r.uploaded[key] = append(r.uploaded[key], req.GetZipDataChunk()...)
+		}
+	}}, nil
+}
+
+func (r *registry) RegisterProvider(ctx context.Context, in *provider.RegisterProviderRequest, opts ...grpc.CallOption) (*provider.Response, error) {
+	r.providerRegistered = append(r.providerRegistered, in)
+	return &provider.Response{}, nil
+}
+
+func (r *registry) EndProvider(ctx context.Context, in *provider.EndProviderRequest, opts ...grpc.CallOption) (*provider.Response, error) {
+	r.providerEnded = append(r.providerEnded, in)
+	return &provider.Response{}, nil
+}
+
+func (r *registry) UploadProviderBinaryZip(ctx context.Context, opts ...grpc.CallOption) (provider.ProviderPublisher_UploadProviderBinaryZipClient, error) {
+	return &upload[*provider.UploadProviderBinaryZipRequest, *provider.Response]{done: func(sent []*provider.UploadProviderBinaryZipRequest) {
+		for _, req := range sent {
+			key := moduleKey(req.GetProvider().GetName(), req.GetProvider().GetVersion()) + " " + req.GetOs() + "_" + req.GetArch()
+			r.uploaded[key] = append(r.uploaded[key], req.GetZipDataChunk()...)
+		}
+	}}, nil
+}
+
+func (r *registry) uploadShasum(suffix string) provider.ProviderPublisher_UploadShasumClient {
+	return &upload[*provider.UploadShasumRequest, *provider.Response]{done: func(sent []*provider.UploadShasumRequest) {
+		for _, req := range sent {
+			key := moduleKey(req.GetProvider().GetName(), req.GetProvider().GetVersion()) + suffix
+			r.uploaded[key] = append(r.uploaded[key], req.GetShasumDataChunk()...)
+		}
+	}}
+}
+
+func (r *registry) UploadShasum(ctx context.Context, opts ...grpc.CallOption) (provider.ProviderPublisher_UploadShasumClient, error) {
+	return r.uploadShasum(" SHA256SUMS"), nil
+}
+
+func (r *registry) UploadShasumSignature(ctx context.Context, opts ...grpc.CallOption) (provider.ProviderPublisher_UploadShasumSignatureClient, error) {
+	return r.uploadShasum(" SHA256SUMS.sig"), nil
+}
+
+func (r *registry) clients() Clients {
+	return Clients{ModuleConsumer: r, ModulePublisher: r, ProviderConsumer: r, ProviderPublisher: r}
+}
+
+// source returns a registry serving cie/eks/aws 1.0.0, which requires base/vpc/aws "~> 1.0" resolving to 1.1.0, and
+// the cie/test provider 1.0.0 for linux_amd64.
+func source() *registry {
+	stable, beta := module.Maturity_STABLE, module.Maturity_BETA
+	return &registry{
+		modules: []*module.ModuleDetails{
+			{Name: "cie/eks/aws", Description: "EKS", Source: "https://example.com/eks", Maturity: stable},
+			{Name: "base/vpc/aws", Description: "VPC", Maturity: beta},
+		},
+		moduleVersions: map[string][]*module.ModuleVersion{
+			"cie/eks/aws < 2.0.0": {{Version: "1.0.0", Maturity: stable}},
+			"cie/eks/aws 1.0.0":   {{Version: "1.0.0", Maturity: stable}},
+			"base/vpc/aws ~> 1.0": {{Version: "1.0.0"}, {Version: "1.1.0", Maturity: beta}},
+			"base/vpc/aws 1.1.0":  {{Version: "1.1.0", Maturity: beta}},
+		},
+		dependencies: map[string][]*module.ModuleDependenciesResponse{
+			"cie/eks/aws@1.0.0": {
+				{
+					Module:       &module.Module{Name: "cie/eks/aws", Version: "1.0.0"},
+					Dependencies: []*module.Module{{Name: "base/vpc/aws", Version: "1.1.0"}},
+					Requirements: []*module.ResolvedRequirement{{Name: "base/vpc/aws", VersionConstraint: "~> 1.0", RecordedVersion: "1.1.0", ResolvedVersion: "1.1.0"}},
+				},
+				{Module: &module.Module{Name: "base/vpc/aws", Version: "~> 1.0"}, Depth: 1},
+			},
+			"base/vpc/aws@1.1.0": {{Module: &module.Module{Name: "base/vpc/aws", Version: "1.1.0"}}},
+		},
+		providerRequires: map[string][]*module.ProviderRequirement{
+			"cie/eks/aws@1.0.0": {{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{"~> 5.0"}}},
+		},
+		tags: map[string][]string{"cie/eks/aws": {"k8s"}},
+		files: map[string][]byte{
+			"cie/eks/aws@1.0.0":             []byte("PK eks"),
+			"base/vpc/aws@1.1.0":            []byte("PK vpc"),
+			"cie/test@1.0.0 linux_amd64":    []byte("PK provider"),
+			"cie/test@1.0.0 SHA256SUMS":     []byte("5f9c  terraform-provider-test_1.0.0_linux_amd64.zip\n"),
+			"cie/test@1.0.0 SHA256SUMS.sig": []byte("signature"),
+		},
+		providers: []*provider.ProviderDetails{{Name: "cie/test", Description: "Test", Maturity: provider.Maturity_BETA}},
+		providerVersions: map[string][]*provider.ProviderVersion{
+			"cie/test < 2.0.0": {{
+				Version:   "1.0.0",
+				Protocols: []string{"5.0"},
+				Platforms: []*provider.PlatformItem{{
+					Os:                  "linux",
+					Arch:                "amd64",
+					Filename:            "terraform-provider-test_1.0.0_linux_amd64.zip",
+					DownloadUrl:         "https://releases.example.com/test_1.0.0_linux_amd64.zip",
+					ShasumsSignatureUrl: "https://releases.example.com/test_1.0.0_SHA256SUMS.sig",
+					Shasum:              "5f9c",
+					SigningKeys:         &provider.SigningKeys{GpgPublicKeys: []*provider.GPGPublicKey{{KeyId: "ABC", AsciiArmor: "KEY"}}},
+				}},
+			}},
+		},
+	}
+}
+
+var selection = Selection{Modules: []string{"cie/eks/aws"}, Providers: []string{"cie/test"}, VersionRange: "< 2.0.0"}
+
+func export(t *testing.T, key ed25519.PrivateKey) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	_, err := Export(context.Background(), source().clients(), selection, key, &out)
+	require.NoError(t, err)
+	return out.Bytes()
+}
+
+func TestExport(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	manifest, err := Export(context.Background(), source().clients(), selection, key, &out)
+	require.NoError(t, err)
+
+	require.Len(t, manifest.Modules, 2)
+	eks := manifest.Modules[0]
+	require.Equal(t, "cie/eks/aws", eks.Name)
+	require.Equal(t, "STABLE", eks.Maturity)
+	require.Equal(t, []string{"k8s"}, eks.Tags)
+	require.Equal(t, []*ModuleVersion{{
+		Version:      "1.0.0",
+		Maturity:     "STABLE",
+		Archive:      "modules/cie/eks/aws/1.0.0.zip",
+		Requirements: []*Requirement{{Name: "base/vpc/aws", VersionConstraint: "~> 1.0", Version: "1.1.0"}},
+		Providers:    []*ProviderRequirement{{Name: "aws", Source: "hashicorp/aws", VersionConstraints: []string{"~> 5.0"}}},
+	}}, eks.Versions)
+
+	vpc := manifest.Modules[1]
+	require.Equal(t, "base/vpc/aws", vpc.Name)
+	require.Len(t, vpc.Versions, 1)
+	require.Equal(t, "1.1.0", vpc.Versions[0].Version)
+	require.Equal(t, "BETA", vpc.Versions[0].Maturity)
+
+	require.Len(t, manifest.Providers, 1)
+	version := manifest.Providers[0].Versions[0]
+	require.Equal(t, "providers/cie/test/1.0.0/terraform-provider-test_1.0.0_linux_amd64.zip", version.Platforms[0].Archive)
+	require.Equal(t, "providers/cie/test/1.0.0/terraform-provider-test_1.0.0_SHA256SUMS", version.Shasums)
+	require.Equal(t, version.Shasums+".sig", version.ShasumsSignature)
+	require.Len(t, manifest.Files, 5)
+}
+
+func TestImport(t *testing.T) {
+	public, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	bundle := export(t, key)
+
+	destination := &registry{
+		moduleVersions: map[string][]*module.ModuleVersion{"base/vpc/aws ": {{Version: "1.1.0"}}},
+		uploaded:       map[string][]byte{},
+	}
+	result, err := Import(context.Background(), destination.clients(), bytes.NewReader(bundle), public)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"cie/eks/aws@1.0.0", "cie/test@1.0.0"}, result.Imported)
+	require.Equal(t, []string{"base/vpc/aws@1.1.0"}, result.Skipped)
+
+	require.Len(t, destination.registered, 1)
+	require.Equal(t, module.Maturity_STABLE, destination.registered[0].GetMaturity())
+	require.Equal(t, module.Maturity_STABLE, destination.begun[0].GetMaturity())
+	require.Equal(t, module.EndVersionRequest_PUBLISH, destination.ended[0].GetAction())
+	require.Equal(t, "PK eks", string(destination.uploaded["cie/eks/aws@1.0.0"]))
+
+	dependencies := destination.dependenciesRequest[0]
+	require.Equal(t, "1.1.0", dependencies.GetRequirements()[0].GetVersion())
+	require.Equal(t, "hashicorp/aws", dependencies.GetProviders()[0].GetSource())
+	require.Equal(t, []string{"k8s"}, destination.tagged[0].GetTags())
+
+	register := destination.providerRegistered[0]
+	require.Equal(t, provider.Maturity_BETA, register.GetMaturity())
+	platform := register.GetPlatforms()[0]
+	require.Equal(t, "../../linux/amd64/terraform-provider-test_1.0.0_linux_amd64.zip", platform.GetDownloadUrl())
+	require.Equal(t, "../../terraform-provider-test_1.0.0_SHA256SUMS.sig", platform.GetShasumsSignatureUrl())
+	require.Equal(t, "ABC", platform.GetSigningKeys().GetGpgPublicKeys()[0].GetKeyId())
+	require.Equal(t, "PK provider", string(destination.uploaded["cie/test@1.0.0 linux_amd64"]))
+	require.Equal(t, "signature", string(destination.uploaded["cie/test@1.0.0 SHA256SUMS.sig"]))
+	require.Equal(t, provider.EndProviderRequest_PUBLISH, destination.providerEnded[0].GetAction())
+}
+
+// repack returns a bundle with the content of its files changed.
+func repack(t *testing.T, bundle []byte, change func(name string, data []byte) []byte) []byte {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(bundle))
+	require.NoError(t, err)
+	reader := tar.NewReader(gz)
+
+	var out bytes.Buffer
+	gzOut := gzip.NewWriter(&out)
+	writer := tar.NewWriter(gzOut)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		data = change(header.Name, data)
+		header.Size = int64(len(data))
+		require.NoError(t, writer.WriteHeader(header))
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	require.NoError(t, gzOut.Close())
+	return out.Bytes()
+}
+
+func TestImport_checksBundle(t *testing.T) {
+	public, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	bundle := export(t, key)
+
+	tests := []struct {
+		name     string
+		bundle   []byte
+		key      ed25519.PublicKey
+		expected error
+	}{
+		{
+			name:     "signed with another key",
+			bundle:   bundle,
+			key:      ed25519.PublicKey(make([]byte, ed25519.PublicKeySize)),
+			expected: ErrInvalidSignature,
+		},
+		{
+			name: "artifact changed",
+			bundle: repack(t, bundle, func(name string, data []byte) []byte {
+				if name == "modules/cie/eks/aws/1.0.0.zip" {
+					return []byte("PK tampered")
+				}
+				return data
+			}),
+			key:      public,
+			expected: ErrCorruptFile,
+		},
+		{
+			name: "manifest changed",
+			bundle: repack(t, bundle, func(name string, data []byte) []byte {
+				if name == ManifestFile {
+					return bytes.Replace(data, []byte("STABLE"), []byte("BETA"), 1)
+				}
+				return data
+			}),
+			key:      public,
+			expected: ErrInvalidSignature,
+		},
+		{
+			name: "signature too large",
+			bundle: repack(t, bundle, func(name string, data []byte) []byte {
+				if name == ManifestSignature {
+					return bytes.Repeat(data, MaxSignatureSize/len(data)+1)
+				}
+				return data
+			}),
+			key:      public,
+			expected: ErrTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destination := &registry{uploaded: map[string][]byte{}}
+			_, err := Import(context.Background(), destination.clients(), bytes.NewReader(tt.bundle), tt.key)
+			require.ErrorIs(t, err, tt.expected)
+			require.Empty(t, destination.registered)
+		})
+	}
+}
+
+func Test_dependencyOrder(t *testing.T) {
+	app := &Module{Name: "cie/app/aws", Versions: []*ModuleVersion{{Version: "1.0.0", Dependencies: []*Dependency{{Name: "cie/eks/aws", Version: "2.0.0"}}}}}
+	eks := &Module{Name: "cie/eks/aws", Versions: []*ModuleVersion{{Version: "2.0.0", Requirements: []*Requirement{{Name: "cie/vpc/aws", VersionConstraint: "~> 1.0", Version: "1.1.0"}}}}}
+	vpc := &Module{Name: "cie/vpc/aws", Versions: []*ModuleVersion{{Version: "1.1.0"}}}
+
+	var ordered []string
+	for _, entry := range dependencyOrder([]*Module{app, eks, vpc}) {
+		ordered = append(ordered, moduleKey(entry.module.Name, entry.version.Version))
+	}
+	require.Equal(t, []string{"cie/vpc/aws@1.1.0", "cie/eks/aws@2.0.0", "cie/app/aws@1.0.0"}, ordered)
+}
+
+func TestParseKeys(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+	parsedPrivate, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	require.Equal(t, private, parsedPrivate)
+
+	der, err = x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+	parsedPublic, err := ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+	require.Equal(t, public, parsedPublic)
+
+	_, err = ParsePublicKey([]byte("not a key"))
+	require.ErrorIs(t, err, ErrInvalidKey)
+}
@@ -0,0 +1,490 @@
+package bundle
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Clients are the services of the gateway of a Terrarium instance a bundle is exported from or imported into.
+type Clients struct {
+	ModuleConsumer    module.ConsumerClient
+	ModulePublisher   module.PublisherClient
+	ProviderConsumer  provider.ProviderConsumerClient
+	ProviderPublisher provider.ProviderPublisherClient
+}
+
+// Selection is what to export. Every module and provider of the organizations is selected along with the modules and
+// providers named, restricted to the versions within the version range when one is given.
+type Selection struct {
+	Organizations []string
+	Modules       []string
+	Providers     []string
+	VersionRange  string
+}
+
+// exporter gathers the content of a bundle, writing the artifacts to the archive as they are downloaded.
+type exporter struct {
+	clients  Clients
+	archive  *archiveWriter
+	manifest *Manifest
+	// modules of the manifest by name, and versions already exported by name and version
+	modules  map[string]*Module
+	exported map[string]bool
+	// details of the modules and providers of an organization, listed once
+	moduleDetails   map[string]map[string]*module.ModuleDetails
+	providerDetails map[string]map[string]*provider.ProviderDetails
+}
+
+// Export writes a bundle of the selected modules and providers, signed with the key. Each module version comes with
+// the module versions it depends on, found by walking its dependencies, so that the bundle can be imported on its own.
+// The providers required by modules are recorded but only bundled when selected.
+func Export(ctx context.Context, clients Clients, selection Selection, key ed25519.PrivateKey, out io.Writer) (*Manifest, error) {
+	e := &exporter{
+		clients:         clients,
+		archive:         newArchiveWriter(out),
+		manifest:        &Manifest{Modules: []*Module{}, Providers: []*Provider{}},
+		modules:         map[string]*Module{},
+		exported:        map[string]bool{},
+		moduleDetails:   map[string]map[string]*module.ModuleDetails{},
+		providerDetails: map[string]map[string]*provider.ProviderDetails{},
+	}
+
+	modules, err := e.selectModules(ctx, selection)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range modules {
+		versions, err := clients.ModuleConsumer.ListModuleVersions(ctx, &module.ListModuleVersionsRequest{Name: name, VersionRange: selection.VersionRange})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the versions of %s: %w", name, err)
+		}
+		for _, version := range versions.GetVersions() {
+			if err := e.exportModuleClosure(ctx, &module.Module{Name: name, Version: version.GetVersion()}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	providers, err := e.selectProviders(ctx, selection)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range providers {
+		if err := e.exportProvider(ctx, name, selection.VersionRange); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := e.archive.close(e.manifest, key); err != nil {
+		return nil, err
+	}
+	return e.manifest, nil
+}
+
+// selectModules returns the names of the modules of the organizations followed by the modules named.
+func (e *exporter) selectModules(ctx context.Context, selection Selection) ([]string, error) {
+	var names []string
+	for _, organization := range selection.Organizations {
+		details, err := e.listModules(ctx, organization)
+		if err != nil {
+			return nil, err
+		}
+		for _, module := range details {
+			names = append(names, module.GetName())
+		}
+	}
+	for _, name := range selection.Modules {
+		if _, err := e.moduleDetailsOf(ctx, name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (e *exporter) listModules(ctx context.Context, organization string) (map[string]*module.ModuleDetails, error) {
+	if details, ok := e.moduleDetails[organization]; ok {
+		return details, nil
+	}
+	res, err := e.clients.ModuleConsumer.ListModules(ctx, &module.ListModulesRequest{Organization: organization})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the modules of %s: %w", organization, err)
+	}
+	details := map[string]*module.ModuleDetails{}
+	for _, module := range res.GetModules() {
+		details[module.GetName()] = module
+	}
+	e.moduleDetails[organization] = details
+	return details, nil
+}
+
+func (e *exporter) moduleDetailsOf(ctx context.Context, name string) (*module.ModuleDetails, error) {
+	organization, _, _ := strings.Cut(name, "/")
+	details, err := e.listModules(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	if module, ok := details[name]; ok {
+		return module, nil
+	}
+	return nil, fmt.Errorf("module %s not found", name)
+}
+
+// exportModuleClosure exports a module version and every module version it depends on. Dependencies recorded with a
+// version constraint rather than a version are resolved to the newest version meeting it, and walked in turn.
+func (e *exporter) exportModuleClosure(ctx context.Context, root *module.Module) error {
+	queue := []*module.Module{root}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if e.exported[moduleKey(next.GetName(), next.GetVersion())] {
+			continue
+		}
+
+		stream, err := e.clients.ModuleConsumer.RetrieveModuleDependencies(ctx, &module.RetrieveModuleDependenciesRequest{Module: next})
+		if err != nil {
+			return fmt.Errorf("failed to retrieve the dependencies of %s %s: %w", next.GetName(), next.GetVersion(), err)
+		}
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to retrieve the dependencies of %s %s: %w", next.GetName(), next.GetVersion(), err)
+			}
+
+			version, err := e.resolveVersion(ctx, res.GetModule())
+			if err != nil {
+				return err
+			}
+			if version.GetVersion() != res.GetModule().GetVersion() {
+				queue = append(queue, &module.Module{Name: res.GetModule().GetName(), Version: version.GetVersion()})
+				continue
+			}
+			if err := e.exportModuleVersion(ctx, res, version); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveVersion returns the newest published version of a module meeting the version of a dependency, which is
+// the version itself unless the dependency was recorded with a version constraint.
+func (e *exporter) resolveVersion(ctx context.Context, dependency *module.Module) (*module.ModuleVersion, error) {
+	res, err := e.clients.ModuleConsumer.ListModuleVersions(ctx, &module.ListModuleVersionsRequest{Name: dependency.GetName(), VersionRange: dependency.GetVersion()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve dependency %s %s: %w", dependency.GetName(), dependency.GetVersion(), err)
+	}
+	versions := res.GetVersions()
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("dependency %s %s is not published", dependency.GetName(), dependency.GetVersion())
+	}
+	return versions[len(versions)-1], nil
+}
+
+// exportModuleVersion adds a module version to the bundle with its dependencies, its required providers and the
+// metadata and tags of its module.
+func (e *exporter) exportModuleVersion(ctx context.Context, dependencies *module.ModuleDependenciesResponse, version *module.ModuleVersion) error {
+	name := dependencies.GetModule().GetName()
+	key := moduleKey(name, version.GetVersion())
+	if e.exported[key] {
+		return nil
+	}
+
+	m, err := e.bundledModule(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	archive, err := e.downloadModule(ctx, &module.Module{Name: name, Version: version.GetVersion()})
+	if err != nil {
+		return fmt.Errorf("failed to download %s %s: %w", name, version.GetVersion(), err)
+	}
+	entry := &ModuleVersion{
+		Version:  version.GetVersion(),
+		Maturity: version.GetMaturity().String(),
+		Archive:  moduleArchivePath(name, version.GetVersion()),
+	}
+	if err := e.archive.add(entry.Archive, archive); err != nil {
+		return err
+	}
+
+	required := map[string]bool{}
+	for _, requirement := range dependencies.GetRequirements() {
+		required[requirement.GetName()] = true
+		entry.Requirements = append(entry.Requirements, &Requirement{
+			Name:              requirement.GetName(),
+			VersionConstraint: requirement.GetVersionConstraint(),
+			Version:           requirement.GetRecordedVersion(),
+		})
+	}
+	for _, dependency := range dependencies.GetDependencies() {
+		if !required[dependency.GetName()] {
+			entry.Dependencies = append(entry.Dependencies, &Dependency{Name: dependency.GetName(), Version: dependency.GetVersion()})
+		}
+	}
+
+	providers, err := e.clients.ModuleConsumer.RetrieveProviderDependencies(ctx, &module.RetrieveProviderDependenciesRequest{Module: &module.Module{Name: name, Version: version.GetVersion()}})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the providers of %s %s: %w", name, version.GetVersion(), err)
+	}
+	for _, requirement := range providers.GetProviders() {
+		entry.Providers = append(entry.Providers, &ProviderRequirement{
+			Name:               requirement.GetName(),
+			Source:             requirement.GetSource(),
+			VersionConstraints: requirement.GetVersionConstraints(),
+		})
+	}
+
+	m.Versions = append(m.Versions, entry)
+	e.exported[key] = true
+	return nil
+}
+
+// bundledModule returns the module of the manifest, added with its metadata and tags the first time.
+func (e *exporter) bundledModule(ctx context.Context, name string) (*Module, error) {
+	if m, ok := e.modules[name]; ok {
+		return m, nil
+	}
+
+	details, err := e.moduleDetailsOf(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := e.clients.ModuleConsumer.GetTags(ctx, &module.GetTagsRequest{Name: name})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, fmt.Errorf("failed to get the tags of %s: %w", name, err)
+	}
+
+	m := &Module{
+		Name:        name,
+		Description: details.GetDescription(),
+		Source:      details.GetSource(),
+		Maturity:    details.GetMaturity().String(),
+		Tags:        tags.GetTags(),
+	}
+	e.modules[name] = m
+	e.manifest.Modules = append(e.manifest.Modules, m)
+	return m, nil
+}
+
+// selectProviders returns the names of the providers of the organizations followed by the providers named.
+func (e *exporter) selectProviders(ctx context.Context, selection Selection) ([]string, error) {
+	var names []string
+	for _, organization := range selection.Organizations {
+		details, err := e.listProviders(ctx, organization)
+		if err != nil {
+			return nil, err
+		}
+		for _, provider := range details {
+			names = append(names, provider.GetName())
+		}
+	}
+	for _, name := range selection.Providers {
+		if _, err := e.providerDetailsOf(ctx, name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (e *exporter) listProviders(ctx context.Context, organization string) (map[string]*provider.ProviderDetails, error) {
+	if details, ok := e.providerDetails[organization]; ok {
+		return details, nil
+	}
+	res, err := e.clients.ProviderConsumer.ListProviders(ctx, &provider.ListProvidersRequest{Organization: organization})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the providers of %s: %w", organization, err)
+	}
+	details := map[string]*provider.ProviderDetails{}
+	for _, provider := range res.GetProviders() {
+		details[provider.GetName()] = provider
+	}
+	e.providerDetails[organization] = details
+	return details, nil
+}
+
+func (e *exporter) providerDetailsOf(ctx context.Context, name string) (*provider.ProviderDetails, error) {
+	organization, _, _ := strings.Cut(name, "/")
+	details, err := e.listProviders(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	if provider, ok := details[name]; ok {
+		return provider, nil
+	}
+	return nil, fmt.Errorf("provider %s not found", name)
+}
+
+// exportProvider adds the versions of a provider within the version range to the bundle, with the archive of each of
+// their platforms, their shasums and the signature of the shasums.
+func (e *exporter) exportProvider(ctx context.Context, name, versionRange string) error {
+	for _, p := range e.manifest.Providers {
+		if p.Name == name {
+			return nil
+		}
+	}
+
+	details, err := e.providerDetailsOf(ctx, name)
+	if err != nil {
+		return err
+	}
+	versions, err := e.clients.ProviderConsumer.ListProviderVersions(ctx, &provider.ListProviderVersionsRequest{Name: name, VersionRange: versionRange})
+	if err != nil {
+		return fmt.Errorf("failed to list the versions of %s: %w", name, err)
+	}
+
+	p := &Provider{
+		Name:          name,
+		Description:   details.GetDescription(),
+		SourceRepoURL: details.GetSourceRepoUrl(),
+		Maturity:      details.GetMaturity().String(),
+	}
+	for _, version := range versions.GetVersions() {
+		entry, err := e.exportProviderVersion(ctx, name, version)
+		if err != nil {
+			return err
+		}
+		p.Versions = append(p.Versions, entry)
+	}
+	e.manifest.Providers = append(e.manifest.Providers, p)
+	return nil
+}
+
+func (e *exporter) exportProviderVersion(ctx context.Context, name string, version *provider.ProviderVersion) (*ProviderVersion, error) {
+	location := &provider.Provider{Name: name, Version: version.GetVersion()}
+	entry := &ProviderVersion{Version: version.GetVersion(), Protocols: version.GetProtocols()}
+
+	signed := false
+	for _, platform := range version.GetPlatforms() {
+		archive, err := e.downloadProvider(ctx, &provider.DownloadProviderBinaryZipRequest{Provider: location, Os: platform.GetOs(), Arch: platform.GetArch()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to download %s %s for %s_%s: %w", name, version.GetVersion(), platform.GetOs(), platform.GetArch(), err)
+		}
+
+		filename := platform.GetFilename()
+		if filename == "" {
+			filename = archiveFilename(name, version.GetVersion(), platform.GetOs(), platform.GetArch())
+		}
+		item := &Platform{
+			Os:       platform.GetOs(),
+			Arch:     platform.GetArch(),
+			Filename: filename,
+			Shasum:   platform.GetShasum(),
+			Archive:  providerFilePath(name, version.GetVersion(), filename),
+		}
+		for _, key := range platform.GetSigningKeys().GetGpgPublicKeys() {
+			item.SigningKeys = append(item.SigningKeys, &GPGPublicKey{
+				KeyID:          key.GetKeyId(),
+				ASCIIArmor:     key.GetAsciiArmor(),
+				TrustSignature: key.GetTrustSignature(),
+				Source:         key.GetSource(),
+				SourceURL:      key.GetSourceUrl(),
+			})
+		}
+		if err := e.archive.add(item.Archive, archive); err != nil {
+			return nil, err
+		}
+		entry.Platforms = append(entry.Platforms, item)
+		signed = signed || platform.GetShasumsSignatureUrl() != ""
+	}
+
+	shasums, err := e.downloadShasum(ctx, location, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download the shasums of %s %s: %w", name, version.GetVersion(), err)
+	}
+	entry.Shasums = providerFilePath(name, version.GetVersion(), shasumsFilename(name, version.GetVersion()))
+	if err := e.archive.add(entry.Shasums, shasums); err != nil {
+		return nil, err
+	}
+
+	if !signed {
+		return entry, nil
+	}
+	signature, err := e.downloadShasum(ctx, location, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download the shasums signature of %s %s: %w", name, version.GetVersion(), err)
+	}
+	entry.ShasumsSignature = entry.Shasums + ".sig"
+	if err := e.archive.add(entry.ShasumsSignature, signature); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (e *exporter) downloadModule(ctx context.Context, version *module.Module) ([]byte, error) {
+	stream, err := e.clients.ModuleConsumer.DownloadSourceZip(ctx, &module.DownloadSourceZipRequest{Module: version})
+	if err != nil {
+		return nil, err
+	}
+	return receive(stream.Recv, (*module.SourceZipResponse).GetZipDataChunk)
+}
+
+func (e *exporter) downloadProvider(ctx context.Context, request *provider.DownloadProviderBinaryZipRequest) ([]byte, error) {
+	stream, err := e.clients.ProviderConsumer.DownloadProviderBinaryZip(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return receive(stream.Recv, (*provider.ProviderBinaryZipResponse).GetZipDataChunk)
+}
+
+// downloadShasum returns the shasums of a provider version, or their signature.
+func (e *exporter) downloadShasum(ctx context.Context, version *provider.Provider, signature bool) ([]byte, error) {
+	request := &provider.DownloadShasumRequest{Provider: version}
+	var stream interface {
+		Recv() (*provider.ShasumResponse, error)
+	}
+	var err error
+	if signature {
+		stream, err = e.clients.ProviderConsumer.DownloadShasumSignature(ctx, request)
+	} else {
+		stream, err = e.clients.ProviderConsumer.DownloadShasum(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return receive(stream.Recv, (*provider.ShasumResponse).GetShasumDataChunk)
+}
+
+func moduleKey(name, version string) string {
+	return name + "@" + version
+}
+
+func providerType(name string) string {
+	_, providerType, _ := strings.Cut(name, "/")
+	return providerType
+}
+
+func archiveFilename(name, version, os, arch string) string {
+	return fmt.Sprintf("terraform-provider-%s_%s_%s_%s.zip", providerType(name), version, os, arch)
+}
+
+func shasumsFilename(name, version string) string {
+	return fmt.Sprintf("terraform-provider-%s_%s_SHA256SUMS", providerType(name), version)
+}
+
+// receive returns the content of a file downloaded as a stream of chunks.
+func receive[T any](recv func() (T, error), chunk func(T) []byte) ([]byte, error) {
+	var data []byte
+	for {
+		res, err := recv()
+		if err == io.EOF {
+			return data, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, chunk(res)...)
+	}
+}
@@ -0,0 +1,370 @@
+package bundle
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/provider"
+)
+
+// ChunkSize is the size of the chunks the artifacts of a bundle are uploaded in.
+const ChunkSize = 64 * 1024
+
+// Result lists the module and provider versions of a bundle that were imported, and the ones skipped because they
+// were already published.
+type Result struct {
+	Imported []string
+	Skipped  []string
+}
+
+type importer struct {
+	clients Clients
+	dir     string
+	result  *Result
+	// modules registered so far, and published versions of the modules and providers by name
+	registered map[string]bool
+	published  map[string]map[string]bool
+}
+
+// Import publishes the content of a bundle, once its signature has been checked with the key. Module versions are
+// published after the module versions they depend on, with the maturity, dependencies and tags they were exported
+// with. Versions of organizations requiring approval are left waiting for it.
+func Import(ctx context.Context, clients Clients, in io.Reader, key ed25519.PublicKey) (*Result, error) {
+	dir, err := os.MkdirTemp("", "terrarium-bundle-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	manifest, err := extract(in, key, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &importer{
+		clients:    clients,
+		dir:        dir,
+		result:     &Result{},
+		registered: map[string]bool{},
+		published:  map[string]map[string]bool{},
+	}
+	for _, entry := range dependencyOrder(manifest.Modules) {
+		if err := i.importModuleVersion(ctx, entry.module, entry.version); err != nil {
+			return i.result, err
+		}
+	}
+	for _, m := range manifest.Modules {
+		if len(m.Tags) == 0 {
+			continue
+		}
+		if _, err := clients.ModulePublisher.AddTags(ctx, &module.PublishTagRequest{Name: m.Name, Tags: m.Tags}); err != nil {
+			return i.result, fmt.Errorf("failed to tag %s: %w", m.Name, err)
+		}
+	}
+	for _, p := range manifest.Providers {
+		for _, version := range p.Versions {
+			if err := i.importProviderVersion(ctx, p, version); err != nil {
+				return i.result, err
+			}
+		}
+	}
+	return i.result, nil
+}
+
+type moduleVersionEntry struct {
+	module  *Module
+	version *ModuleVersion
+}
+
+// dependencyOrder returns the module versions of a bundle, each one following the module versions of the bundle it
+// depends on. Dependency cycles are broken where they are found.
+func dependencyOrder(modules []*Module) []moduleVersionEntry {
+	entries := map[string]moduleVersionEntry{}
+	for _, m := range modules {
+		for _, version := range m.Versions {
+			entries[moduleKey(m.Name, version.Version)] = moduleVersionEntry{module: m, version: version}
+		}
+	}
+
+	var ordered []moduleVersionEntry
+	visited := map[string]bool{}
+	var visit func(key string)
+	visit = func(key string) {
+		entry, ok := entries[key]
+		if !ok || visited[key] {
+			return
+		}
+		visited[key] = true
+		for _, dependency := range entry.version.Dependencies {
+			visit(moduleKey(dependency.Name, dependency.Version))
+		}
+		for _, requirement := range entry.version.Requirements {
+			visit(moduleKey(requirement.Name, requirement.Version))
+		}
+		ordered = append(ordered, entry)
+	}
+	for _, m := range modules {
+		for _, version := range m.Versions {
+			visit(moduleKey(m.Name, version.Version))
+		}
+	}
+	return ordered
+}
+
+// isPublished reports whether a version is already published, listing the published versions the first time.
+func (i *importer) isPublished(name, version string, list func() ([]string, error)) (bool, error) {
+	if _, ok := i.published[name]; !ok {
+		versions, err := list()
+		if err != nil {
+			return false, fmt.Errorf("failed to list the versions of %s: %w", name, err)
+		}
+		i.published[name] = map[string]bool{}
+		for _, v := range versions {
+			i.published[name][v] = true
+		}
+	}
+	return i.published[name][version], nil
+}
+
+func (i *importer) importModuleVersion(ctx context.Context, m *Module, version *ModuleVersion) error {
+	key := moduleKey(m.Name, version.Version)
+	published, err := i.isPublished(m.Name, version.Version, func() ([]string, error) {
+		res, err := i.clients.ModuleConsumer.ListModuleVersions(ctx, &module.ListModuleVersionsRequest{Name: m.Name})
+		var versions []string
+		for _, v := range res.GetVersions() {
+			versions = append(versions, v.GetVersion())
+		}
+		return versions, err
+	})
+	if err != nil {
+		return err
+	}
+	if published {
+		i.result.Skipped = append(i.result.Skipped, key)
+		return nil
+	}
+
+	if !i.registered[m.Name] {
+		if _, err := i.clients.ModulePublisher.Register(ctx, &module.RegisterModuleRequest{
+			Name:        m.Name,
+			Description: m.Description,
+			Source:      m.Source,
+			Maturity:    module.Maturity(module.Maturity_value[m.Maturity]),
+		}); err != nil {
+			return fmt.Errorf("failed to register %s: %w", m.Name, err)
+		}
+		i.registered[m.Name] = true
+	}
+
+	moduleVersion := &module.Module{Name: m.Name, Version: version.Version}
+	maturity := module.Maturity(module.Maturity_value[version.Maturity])
+	if _, err := i.clients.ModulePublisher.BeginVersion(ctx, &module.BeginVersionRequest{Module: moduleVersion, Maturity: &maturity}); err != nil {
+		return fmt.Errorf("failed to begin %s: %w", key, err)
+	}
+
+	if err := i.uploadModule(ctx, moduleVersion, version.Archive); err != nil {
+		_, _ = i.clients.ModulePublisher.EndVersion(ctx, &module.EndVersionRequest{Module: moduleVersion, Action: module.EndVersionRequest_DISCARD})
+		return fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+	if _, err := i.clients.ModulePublisher.EndVersion(ctx, &module.EndVersionRequest{Module: moduleVersion, Action: module.EndVersionRequest_PUBLISH}); err != nil {
+		return fmt.Errorf("failed to publish %s: %w", key, err)
+	}
+
+	if len(version.Dependencies) > 0 || len(version.Requirements) > 0 || len(version.Providers) > 0 {
+		request := &module.RegisterModuleDependenciesRequest{Module: moduleVersion}
+		for _, dependency := range version.Dependencies {
+			request.Dependencies = append(request.Dependencies, &module.Module{Name: dependency.Name, Version: dependency.Version})
+		}
+		for _, requirement := range version.Requirements {
+			request.Requirements = append(request.Requirements, &module.ModuleRequirement{
+				Name:              requirement.Name,
+				VersionConstraint: requirement.VersionConstraint,
+				Version:           requirement.Version,
+			})
+		}
+		for _, requirement := range version.Providers {
+			request.Providers = append(request.Providers, &module.ProviderRequirement{
+				Name:               requirement.Name,
+				Source:             requirement.Source,
+				VersionConstraints: requirement.VersionConstraints,
+			})
+		}
+		if _, err := i.clients.ModulePublisher.RegisterModuleDependencies(ctx, request); err != nil {
+			return fmt.Errorf("failed to register the dependencies of %s: %w", key, err)
+		}
+	}
+
+	i.result.Imported = append(i.result.Imported, key)
+	return nil
+}
+
+func (i *importer) uploadModule(ctx context.Context, version *module.Module, archive string) error {
+	stream, err := i.clients.ModulePublisher.UploadSourceZip(ctx)
+	if err != nil {
+		return err
+	}
+	err = i.sendFile(archive, func(chunk []byte) error {
+		return stream.Send(&module.UploadSourceZipRequest{Module: version, ZipDataChunk: chunk})
+	})
+	if err != nil {
+		return err
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+func (i *importer) importProviderVersion(ctx context.Context, p *Provider, version *ProviderVersion) error {
+	key := moduleKey(p.Name, version.Version)
+	published, err := i.isPublished(p.Name, version.Version, func() ([]string, error) {
+		res, err := i.clients.ProviderConsumer.ListProviderVersions(ctx, &provider.ListProviderVersionsRequest{Name: p.Name})
+		var versions []string
+		for _, v := range res.GetVersions() {
+			versions = append(versions, v.GetVersion())
+		}
+		return versions, err
+	})
+	if err != nil {
+		return err
+	}
+	if published {
+		i.result.Skipped = append(i.result.Skipped, key)
+		return nil
+	}
+
+	register := &provider.RegisterProviderRequest{
+		Name:          p.Name,
+		Version:       version.Version,
+		Protocols:     version.Protocols,
+		Description:   p.Description,
+		SourceRepoUrl: p.SourceRepoURL,
+		Maturity:      provider.Maturity(provider.Maturity_value[p.Maturity]),
+	}
+	for _, platform := range version.Platforms {
+		register.Platforms = append(register.Platforms, platformItem(p.Name, version, platform))
+	}
+	if _, err := i.clients.ProviderPublisher.RegisterProvider(ctx, register); err != nil {
+		return fmt.Errorf("failed to register %s: %w", key, err)
+	}
+
+	location := &provider.Provider{Name: p.Name, Version: version.Version}
+	if err := i.uploadProvider(ctx, location, version); err != nil {
+		_, _ = i.clients.ProviderPublisher.EndProvider(ctx, &provider.EndProviderRequest{Provider: location, Action: provider.EndProviderRequest_DISCARD_VERSION})
+		return fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+	if _, err := i.clients.ProviderPublisher.EndProvider(ctx, &provider.EndProviderRequest{Provider: location, Action: provider.EndProviderRequest_PUBLISH}); err != nil {
+		return fmt.Errorf("failed to publish %s: %w", key, err)
+	}
+
+	i.result.Imported = append(i.result.Imported, key)
+	return nil
+}
+
+// platformItem returns the metadata a platform is registered with, its files being served by the instance imported
+// into. Its URLs are relative to the download endpoint of the provider registry protocol.
+func platformItem(name string, version *ProviderVersion, platform *Platform) *provider.PlatformItem {
+	shasumsURL := "../../" + shasumsFilename(name, version.Version)
+	item := &provider.PlatformItem{
+		Os:          platform.Os,
+		Arch:        platform.Arch,
+		Filename:    platform.Filename,
+		DownloadUrl: fmt.Sprintf("../../%s/%s/%s", platform.Os, platform.Arch, archiveFilename(name, version.Version, platform.Os, platform.Arch)),
+		ShasumsUrl:  shasumsURL,
+		Shasum:      platform.Shasum,
+		SigningKeys: &provider.SigningKeys{},
+	}
+	if version.ShasumsSignature != "" {
+		item.ShasumsSignatureUrl = shasumsURL + ".sig"
+	}
+	for _, key := range platform.SigningKeys {
+		item.SigningKeys.GpgPublicKeys = append(item.SigningKeys.GpgPublicKeys, &provider.GPGPublicKey{
+			KeyId:          key.KeyID,
+			AsciiArmor:     key.ASCIIArmor,
+			TrustSignature: key.TrustSignature,
+			Source:         key.Source,
+			SourceUrl:      key.SourceURL,
+		})
+	}
+	return item
+}
+
+// uploadProvider uploads the archive of each platform of a provider version, its shasums and their signature.
+func (i *importer) uploadProvider(ctx context.Context, location *provider.Provider, version *ProviderVersion) error {
+	for _, platform := range version.Platforms {
+		stream, err := i.clients.ProviderPublisher.UploadProviderBinaryZip(ctx)
+		if err != nil {
+			return err
+		}
+		err = i.sendFile(platform.Archive, func(chunk []byte) error {
+			return stream.Send(&provider.UploadProviderBinaryZipRequest{Provider: location, Os: platform.Os, Arch: platform.Arch, ZipDataChunk: chunk})
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := stream.CloseAndRecv(); err != nil {
+			return err
+		}
+	}
+
+	shasums, err := i.clients.ProviderPublisher.UploadShasum(ctx)
+	if err != nil {
+		return err
+	}
+	err = i.sendFile(version.Shasums, func(chunk []byte) error {
+		return shasums.Send(&provider.UploadShasumRequest{Provider: location, ShasumDataChunk: chunk})
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := shasums.CloseAndRecv(); err != nil {
+		return err
+	}
+
+	if version.ShasumsSignature == "" {
+		return nil
+	}
+	signature, err := i.clients.ProviderPublisher.UploadShasumSignature(ctx)
+	if err != nil {
+		return err
+	}
+	err = i.sendFile(version.ShasumsSignature, func(chunk []byte) error {
+		return signature.Send(&provider.UploadShasumRequest{Provider: location, ShasumDataChunk: chunk})
+	})
+	if err != nil {
+		return err
+	}
+	_, err = signature.CloseAndRecv()
+	return err
+}
+
+// sendFile sends a file extracted from the bundle in chunks.
+func (i *importer) sendFile(name string, send func([]byte) error) error {
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return fmt.Errorf("%w: %s", ErrUnsignedFile, name)
+	}
+	file, err := os.Open(filepath.Join(i.dir, filepath.FromSlash(name)))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	for {
+		chunk := make([]byte, ChunkSize)
+		n, err := file.Read(chunk)
+		if n > 0 {
+			if err := send(chunk[:n]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,317 @@
+// Package bundle exports modules and providers of a Terrarium instance to a single signed archive, and imports such an
+// archive into another instance, for networks that cannot reach the instance the bundle comes from.
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+const (
+	FormatVersion     = 1
+	ManifestFile      = "manifest.json"
+	ManifestSignature = "manifest.json.sig"
+
+	// MaxManifestSize and MaxSignatureSize are the largest manifest and signature in bytes read from a bundle, as
+	// they are read in memory before the signature is checked.
+	MaxManifestSize  = 64 << 20
+	MaxSignatureSize = 1 << 10
+)
+
+var (
+	ErrInvalidSignature = errors.New("bundle signature does not match its manifest")
+	ErrUnsignedFile     = errors.New("bundle file is not listed in its manifest")
+	ErrCorruptFile      = errors.New("bundle file does not match the hash recorded in its manifest")
+	ErrInvalidKey       = errors.New("not an ed25519 key")
+	ErrTooLarge         = errors.New("bundle manifest or signature is too large")
+)
+
+// Manifest describes the content of a bundle. The SHA-256 of every other file of the bundle is recorded in it, so that
+// its signature covers the whole bundle.
+type Manifest struct {
+	FormatVersion int               `json:"format_version"`
+	CreatedOn     string            `json:"created_on"`
+	Modules       []*Module         `json:"modules"`
+	Providers     []*Provider       `json:"providers"`
+	Files         map[string]string `json:"files"`
+}
+
+type Module struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Source      string           `json:"source"`
+	Maturity    string           `json:"maturity"`
+	Tags        []string         `json:"tags,omitempty"`
+	Versions    []*ModuleVersion `json:"versions"`
+}
+
+// ModuleVersion is a module version with the dependencies registered for it. Requirements are the dependencies given
+// with a version constraint, the version they resolved to being recorded with them.
+type ModuleVersion struct {
+	Version      string                 `json:"version"`
+	Maturity     string                 `json:"maturity"`
+	Archive      string                 `json:"archive"`
+	Dependencies []*Dependency          `json:"dependencies,omitempty"`
+	Requirements []*Requirement         `json:"requirements,omitempty"`
+	Providers    []*ProviderRequirement `json:"providers,omitempty"`
+}
+
+type Dependency struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type Requirement struct {
+	Name              string `json:"name"`
+	VersionConstraint string `json:"version_constraint"`
+	Version           string `json:"version"`
+}
+
+type ProviderRequirement struct {
+	Name               string   `json:"name"`
+	Source             string   `json:"source"`
+	VersionConstraints []string `json:"version_constraints,omitempty"`
+}
+
+type Provider struct {
+	Name          string             `json:"name"`
+	Description   string             `json:"description"`
+	SourceRepoURL string             `json:"source_repo_url"`
+	Maturity      string             `json:"maturity"`
+	Versions      []*ProviderVersion `json:"versions"`
+}
+
+type ProviderVersion struct {
+	Version          string      `json:"version"`
+	Protocols        []string    `json:"protocols"`
+	Platforms        []*Platform `json:"platforms"`
+	Shasums          string      `json:"shasums"`
+	ShasumsSignature string      `json:"shasums_signature,omitempty"`
+}
+
+type Platform struct {
+	Os          string          `json:"os"`
+	Arch        string          `json:"arch"`
+	Filename    string          `json:"filename"`
+	Shasum      string          `json:"shasum"`
+	Archive     string          `json:"archive"`
+	SigningKeys []*GPGPublicKey `json:"signing_keys,omitempty"`
+}
+
+type GPGPublicKey struct {
+	KeyID          string `json:"key_id"`
+	ASCIIArmor     string `json:"ascii_armor"`
+	TrustSignature string `json:"trust_signature,omitempty"`
+	Source         string `json:"source,omitempty"`
+	SourceURL      string `json:"source_url,omitempty"`
+}
+
+func moduleArchivePath(name, version string) string {
+	return path.Join("modules", name, version+".zip")
+}
+
+func providerFilePath(name, version, filename string) string {
+	return path.Join("providers", name, version, filename)
+}
+
+// ParsePrivateKey returns the ed25519 private key of a PEM encoded PKCS #8 key, as generated with
+// "openssl genpkey -algorithm ed25519".
+func ParsePrivateKey(data []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if private, ok := key.(ed25519.PrivateKey); ok {
+		return private, nil
+	}
+	return nil, ErrInvalidKey
+}
+
+// ParsePublicKey returns the ed25519 public key of a PEM encoded PKIX key, as extracted from a private key with
+// "openssl pkey -pubout".
+func ParsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if public, ok := key.(ed25519.PublicKey); ok {
+		return public, nil
+	}
+	return nil, ErrInvalidKey
+}
+
+// archiveWriter writes the files of a bundle to a gzipped tarball, recording their hash for the manifest.
+type archiveWriter struct {
+	gzip  *gzip.Writer
+	tar   *tar.Writer
+	files map[string]string
+	now   time.Time
+}
+
+func newArchiveWriter(out io.Writer) *archiveWriter {
+	gz := gzip.NewWriter(out)
+	return &archiveWriter{gzip: gz, tar: tar.NewWriter(gz), files: map[string]string{}, now: time.Now().UTC()}
+}
+
+func (w *archiveWriter) add(name string, data []byte) error {
+	sum := sha256.Sum256(data)
+	w.files[name] = hex.EncodeToString(sum[:])
+	return w.write(name, data)
+}
+
+func (w *archiveWriter) write(name string, data []byte) error {
+	header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: w.now, Typeflag: tar.TypeReg}
+	if err := w.tar.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := w.tar.Write(data)
+	return err
+}
+
+// close writes the manifest, listing the files written so far, and its signature last.
+func (w *archiveWriter) close(manifest *Manifest, key ed25519.PrivateKey) error {
+	manifest.FormatVersion = FormatVersion
+	manifest.CreatedOn = w.now.Format(time.RFC3339)
+	manifest.Files = w.files
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := w.write(ManifestFile, data); err != nil {
+		return err
+	}
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(key, data))
+	if err := w.write(ManifestSignature, []byte(signature)); err != nil {
+		return err
+	}
+	if err := w.tar.Close(); err != nil {
+		return err
+	}
+	return w.gzip.Close()
+}
+
+// extract writes the files of a bundle to a directory and returns its manifest, once its signature and the hash of
+// every file have been checked.
+func extract(in io.Reader, key ed25519.PublicKey, dir string) (*Manifest, error) {
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = gz.Close() }()
+
+	var manifestData, signature []byte
+	hashes := map[string]string{}
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		switch header.Name {
+		case ManifestFile:
+			if manifestData, err = readLimited(reader, header, MaxManifestSize); err != nil {
+				return nil, err
+			}
+		case ManifestSignature:
+			if signature, err = readLimited(reader, header, MaxSignatureSize); err != nil {
+				return nil, err
+			}
+		default:
+			if hashes[header.Name], err = extractFile(reader, dir, header.Name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(string(signature))
+	if err != nil || manifestData == nil || !ed25519.Verify(key, manifestData, decoded) {
+		return nil, ErrInvalidSignature
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(manifestData, manifest); err != nil {
+		return nil, err
+	}
+	if manifest.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("unsupported bundle format version %d", manifest.FormatVersion)
+	}
+	for name := range hashes {
+		if _, ok := manifest.Files[name]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnsignedFile, name)
+		}
+	}
+	for name, hash := range manifest.Files {
+		if hashes[name] != hash {
+			return nil, fmt.Errorf("%w: %s", ErrCorruptFile, name)
+		}
+	}
+	return manifest, nil
+}
+
+// readLimited reads a file of a bundle in memory, failing with ErrTooLarge when it is larger than limit bytes.
+func readLimited(in io.Reader, header *tar.Header, limit int64) ([]byte, error) {
+	if header.Size > limit {
+		return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrTooLarge, header.Name, limit)
+	}
+	data, err := io.ReadAll(io.LimitReader(in, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrTooLarge, header.Name, limit)
+	}
+	return data, nil
+}
+
+// extractFile writes a file of a bundle below a directory and returns its hash. Files are kept below the directory
+// whatever their name.
+func extractFile(in io.Reader, dir, name string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return "", fmt.Errorf("%w: %s", ErrUnsignedFile, name)
+	}
+	target := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return "", err
+	}
+	file, err := os.Create(target)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, hash), in); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,147 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+type GraphNode struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Depth      int32  `json:"depth"`
+	Maturity   string `json:"maturity,omitempty"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+type GraphEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Cycle bool   `json:"cycle,omitempty"`
+}
+
+type Graph struct {
+	Nodes []*GraphNode `json:"nodes"`
+	Edges []*GraphEdge `json:"edges"`
+}
+
+// NewGraph converts a dependency graph, with node types and maturities in lower case.
+func NewGraph(graph *module.DependencyGraph) *Graph {
+	converted := &Graph{
+		Nodes: make([]*GraphNode, 0, len(graph.GetNodes())),
+		Edges: make([]*GraphEdge, 0, len(graph.GetEdges())),
+	}
+	for _, n := range graph.GetNodes() {
+		node := &GraphNode{
+			ID:         n.GetId(),
+			Type:       strings.ToLower(n.GetType().String()),
+			Name:       n.GetName(),
+			Version:    n.GetVersion(),
+			Depth:      n.GetDepth(),
+			Deprecated: n.GetDeprecated(),
+		}
+		if n.Maturity != nil {
+			node.Maturity = strings.ToLower(n.GetMaturity().String())
+		}
+		converted.Nodes = append(converted.Nodes, node)
+	}
+	for _, e := range graph.GetEdges() {
+		converted.Edges = append(converted.Edges, &GraphEdge{From: e.GetFrom(), To: e.GetTo(), Cycle: e.GetCycle()})
+	}
+	return converted
+}
+
+// label returns the text shown for a node, with its maturity when known.
+func (n *GraphNode) label() string {
+	label := n.Name
+	if n.Version != "" {
+		separator := "@"
+		if n.Type == "container" {
+			separator = ":"
+		}
+		label += separator + n.Version
+	}
+	if n.Maturity != "" {
+		label += fmt.Sprintf(" (%s)", n.Maturity)
+	}
+	return label
+}
+
+// WriteJSON writes the graph as a JSON document listing nodes and edges.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language. Container images are drawn as boxes,
+// deprecated modules in red and edges closing a cycle dashed.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph dependencies {\n")
+	b.WriteString("  rankdir=LR;\n")
+	for _, n := range g.Nodes {
+		attributes := []string{"label=" + dotQuote(n.label())}
+		if n.Type == "container" {
+			attributes = append(attributes, "shape=box")
+		}
+		if n.Deprecated {
+			attributes = append(attributes, "color=red", "fontcolor=red")
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(n.ID), strings.Join(attributes, ", "))
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s", dotQuote(e.From), dotQuote(e.To))
+		if e.Cycle {
+			b.WriteString(" [style=dashed, color=red]")
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart. Nodes are given short identifiers,
+// as Mermaid does not accept the characters of module and image names in them.
+func (g *Graph) WriteMermaid(w io.Writer) error {
+	ids := make(map[string]string, len(g.Nodes))
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for i, n := range g.Nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[n.ID] = id
+		label := mermaidQuote(n.label())
+		if n.Type == "container" {
+			fmt.Fprintf(&b, "  %s[%s]\n", id, label)
+		} else {
+			fmt.Fprintf(&b, "  %s(%s)\n", id, label)
+		}
+		if n.Deprecated {
+			fmt.Fprintf(&b, "  class %s deprecated\n", id)
+		}
+	}
+	for _, e := range g.Edges {
+		arrow := "-->"
+		if e.Cycle {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&b, "  %s %s %s\n", ids[e.From], arrow, ids[e.To])
+	}
+	b.WriteString("  classDef deprecated stroke:#d00,color:#d00\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func dotQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func mermaidQuote(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, "#quot;") + `"`
+}
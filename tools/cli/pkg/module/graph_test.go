@@ -0,0 +1,74 @@
+package module
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/terrariumcloud/terrarium/pkg/terrarium/module"
+)
+
+func testGraph() *Graph {
+	deprecated := module.Maturity_DEPRECATED
+	return NewGraph(&module.DependencyGraph{
+		Nodes: []*module.GraphNode{
+			{Id: "module:cie/eks/aws@3.0.0", Type: module.GraphNode_MODULE, Name: "cie/eks/aws", Version: "3.0.0"},
+			{Id: "module:cie/vpc/aws@1.0.0", Type: module.GraphNode_MODULE, Name: "cie/vpc/aws", Version: "1.0.0", Depth: 1, Maturity: &deprecated, Deprecated: true},
+			{Id: "image:base/nginx:1.23", Type: module.GraphNode_CONTAINER, Name: "base/nginx", Version: "1.23", Depth: 2},
+		},
+		Edges: []*module.GraphEdge{
+			{From: "module:cie/eks/aws@3.0.0", To: "module:cie/vpc/aws@1.0.0"},
+			{From: "module:cie/vpc/aws@1.0.0", To: "module:cie/eks/aws@3.0.0", Cycle: true},
+			{From: "module:cie/vpc/aws@1.0.0", To: "image:base/nginx:1.23"},
+		},
+	})
+}
+
+func TestGraph_WriteDOT(t *testing.T) {
+	var out strings.Builder
+
+	require.NoError(t, testGraph().WriteDOT(&out))
+
+	expected := `digraph dependencies {
+  rankdir=LR;
+  "module:cie/eks/aws@3.0.0" [label="cie/eks/aws@3.0.0"];
+  "module:cie/vpc/aws@1.0.0" [label="cie/vpc/aws@1.0.0 (deprecated)", color=red, fontcolor=red];
+  "image:base/nginx:1.23" [label="base/nginx:1.23", shape=box];
+  "module:cie/eks/aws@3.0.0" -> "module:cie/vpc/aws@1.0.0";
+  "module:cie/vpc/aws@1.0.0" -> "module:cie/eks/aws@3.0.0" [style=dashed, color=red];
+  "module:cie/vpc/aws@1.0.0" -> "image:base/nginx:1.23";
+}
+`
+	require.Equal(t, expected, out.String())
+}
+
+func TestGraph_WriteMermaid(t *testing.T) {
+	var out strings.Builder
+
+	require.NoError(t, testGraph().WriteMermaid(&out))
+
+	expected := `flowchart LR
+  n0("cie/eks/aws@3.0.0")
+  n1("cie/vpc/aws@1.0.0 (deprecated)")
+  class n1 deprecated
+  n2["base/nginx:1.23"]
+  n0 --> n1
+  n1 -.-> n0
+  n1 --> n2
+  classDef deprecated stroke:#d00,color:#d00
+`
+	require.Equal(t, expected, out.String())
+}
+
+func TestGraph_WriteJSON(t *testing.T) {
+	var out strings.Builder
+
+	require.NoError(t, testGraph().WriteJSON(&out))
+
+	decoded := &Graph{}
+	require.NoError(t, json.Unmarshal([]byte(out.String()), decoded))
+	require.Equal(t, testGraph(), decoded)
+	require.Equal(t, "container", decoded.Nodes[2].Type)
+	require.Equal(t, "deprecated", decoded.Nodes[1].Maturity)
+}
@@ -211,6 +211,7 @@ func TestPublish(t *testing.T) {
 }
 
 type MockPublisherClient struct {
+	module.PublisherClient
 	registerCalls                         int
 	registerError                         error
 	registerResponse                      *module.Response